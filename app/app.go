@@ -558,6 +558,12 @@ func NewArkeoApp(
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
+	// Query indexes upgrade: bumps module version to 3, building the contract and provider indexes.
+	app.Keepers.UpgradeKeeper.SetUpgradeHandler("query-indexes-v3", func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		app.Logger().Info("running query indexes v3 upgrade (module version -> 3)")
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	groupConfig := group.DefaultConfig()
 	/*
 		Example of setting group params:
@@ -701,6 +707,7 @@ func NewArkeoApp(
 		logger,
 		app.Keepers.MintKeeper,
 		app.Keepers.DistrKeeper,
+		app.Keepers.AuthzKeeper,
 	)
 
 	/****  Module Options ****/
@@ -601,6 +601,7 @@ func NewArkeoApp(
 		logger,
 		app.Keepers.MintKeeper,
 		app.Keepers.DistrKeeper,
+		app.Keepers.AuthzKeeper,
 	)
 	arkeoModule := arkeomodule.NewAppModule(appCodec, app.ArkeoKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, *app.Keepers.StakingKeeper)
 
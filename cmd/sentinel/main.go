@@ -3,14 +3,23 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
+	"sort"
+
 	"github.com/arkeonetwork/arkeo/app"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/sentinel"
 	"github.com/arkeonetwork/arkeo/sentinel/conf"
-	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate-storage" {
+		os.Exit(migrateStorage(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "validate-config" {
+		os.Exit(validateConfig(os.Args[2:]))
+	}
+
 	configPath := flag.String("config", "", "Path to sentinel config YAML")
 	flag.Parse()
 
@@ -27,10 +36,89 @@ func main() {
 		fmt.Println("Failed to load config:", err)
 		return
 	}
+	if err := config.Validate(); err != nil {
+		fmt.Println("Invalid config:")
+		fmt.Println(err)
+		os.Exit(1)
+	}
 	proxy, err := sentinel.NewProxy(config)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
+	proxy.ConfigFile = *configPath
 	proxy.Run()
 }
+
+// validateConfig checks a config file, or prints the JSON schema of the
+// config file
+func validateConfig(args []string) int {
+	flags := flag.NewFlagSet("validate-config", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to sentinel config YAML")
+	printSchema := flags.Bool("schema", false, "Print the JSON schema of the config file")
+	_ = flags.Parse(args)
+
+	if *printSchema {
+		schema, err := conf.Schema()
+		if err != nil {
+			fmt.Println("Failed to build schema:", err)
+			return 1
+		}
+		fmt.Println(string(schema))
+		return 0
+	}
+	if *configPath == "" {
+		fmt.Println("Error: --config flag is required")
+		return 1
+	}
+
+	c := cosmos.GetConfig()
+	c.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+
+	config, err := conf.LoadConfigurationFromFile(*configPath)
+	if err != nil {
+		fmt.Println("Failed to load config:", err)
+		return 1
+	}
+	if err := config.Validate(); err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	fmt.Printf("%s is valid\n", *configPath)
+	return 0
+}
+
+// migrateStorage copies the LevelDB stores of the config into its SQL storage backend
+func migrateStorage(args []string) int {
+	flags := flag.NewFlagSet("migrate-storage", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to sentinel config YAML")
+	_ = flags.Parse(args)
+
+	if *configPath == "" {
+		fmt.Println("Error: --config flag is required")
+		return 1
+	}
+
+	c := cosmos.GetConfig()
+	c.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+
+	config, err := conf.LoadConfigurationFromFile(*configPath)
+	if err != nil {
+		fmt.Println("Failed to load config:", err)
+		return 1
+	}
+	result, err := sentinel.MigrateStorage(config)
+	if err != nil {
+		fmt.Println("Failed to migrate storage:", err)
+		return 1
+	}
+	names := make([]string, 0, len(result))
+	for name := range result {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("%s: %d items migrated to %s\n", name, result[name], config.Storage.Backend)
+	}
+	return 0
+}
@@ -105,6 +105,25 @@ func (pubKey *PubKey) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalYAML to Marshals to YAML using Bech32
+func (pubKey PubKey) MarshalYAML() (interface{}, error) {
+	return pubKey.String(), nil
+}
+
+// UnmarshalYAML to Unmarshal from YAML assuming Bech32 encoding
+func (pubKey *PubKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	pk, err := NewPubKey(s)
+	if err != nil {
+		return err
+	}
+	*pubKey = pk
+	return nil
+}
+
 func (pks PubKeys) Valid() error {
 	for _, pk := range pks {
 		if _, err := NewPubKey(pk.String()); err != nil {
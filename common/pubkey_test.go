@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 
@@ -30,6 +31,14 @@ func TestPubKey(t *testing.T) {
 	err = json.Unmarshal(result, &pk2)
 	require.NoError(t, err)
 	require.True(t, pk2.Equals(pk))
+
+	result, err = yaml.Marshal(pk)
+	require.NoError(t, err)
+	require.Equal(t, spk+"\n", string(result))
+	var pk3 PubKey
+	require.NoError(t, yaml.Unmarshal(result, &pk3))
+	require.True(t, pk3.Equals(pk))
+	require.Error(t, yaml.Unmarshal([]byte("bogus"), &pk3))
 }
 
 func TestEquals(t *testing.T) {
@@ -5,26 +5,32 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
 
 	"github.com/arkeonetwork/arkeo/common/logging"
 	"github.com/arkeonetwork/arkeo/directory/db"
 )
 
 type ApiService struct {
-	router *mux.Router
-	params ServiceParams
-	db     *db.DirectoryDB
+	router  *mux.Router
+	params  ServiceParams
+	db      *db.DirectoryDB
+	graphql graphql.Schema
+	feed    *eventFeed
 }
 
 type ServiceParams struct {
 	ListenAddr string      `mapstructure:"listen_addr" json:"listen_addr"`
 	StaticDir  string      `mapstructure:"static_dir" json:"static_dir"`
 	DBConfig   db.DBConfig `mapstructure:"db" json:"db"`
+	// GraphQLMaxCost is the highest estimated cost of a graphql query, DefaultGraphQLMaxCost if not set
+	GraphQLMaxCost int `mapstructure:"graphql_max_cost" json:"graphql_max_cost"`
 }
 
 const DefaultListenAddress = "localhost:7777"
@@ -39,7 +45,11 @@ func NewApiService(params ServiceParams) *ApiService {
 	if err != nil {
 		panic(fmt.Sprintf("failed to instantiate db: %+v", err))
 	}
-	a := &ApiService{params: params, db: database}
+	schema, err := newGraphQLSchema(database)
+	if err != nil {
+		panic(fmt.Sprintf("failed to build graphql schema: %+v", err))
+	}
+	a := &ApiService{params: params, db: database, graphql: schema, feed: newEventFeed(database)}
 	a.router = buildRouter(a)
 
 	return a
@@ -47,6 +57,8 @@ func NewApiService(params ServiceParams) *ApiService {
 
 func (a *ApiService) Start() (chan struct{}, error) {
 	doneChan := make(chan struct{})
+	go a.feed.run(context.Background())
+	go a.listenBlockIndexed()
 	go a.start(doneChan)
 	return doneChan, nil
 }
@@ -67,11 +79,27 @@ func (a *ApiService) start(doneChan chan struct{}) {
 	doneChan <- struct{}{}
 }
 
+// listenBlockIndexed wakes the event feed up as the indexer commits blocks, reconnecting
+// when the listen connection fails
+func (a *ApiService) listenBlockIndexed() {
+	for {
+		if err := a.db.ListenBlockIndexed(context.Background(), a.feed.notify); err != nil {
+			log.WithError(err).Error("fail to listen to indexed blocks")
+		}
+		time.Sleep(defaultFeedRetryInterval)
+	}
+}
+
 func buildRouter(a *ApiService) *mux.Router {
 	router := mux.NewRouter()
 	router.HandleFunc("/health", handleHealth).Methods(http.MethodGet)
 	router.HandleFunc("/stats", a.getStatsArkeo).Methods(http.MethodGet)
+	router.HandleFunc("/stats/history", a.getStatsHistory).Methods(http.MethodGet)
+	router.HandleFunc("/stats/history/{service}", a.getStatsHistoryService).Methods(http.MethodGet)
 	router.HandleFunc("/stats/{service}", a.getStatsService).Methods(http.MethodGet)
+	router.HandleFunc("/graphql", a.handleGraphQL).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc("/events", a.streamEvents).Methods(http.MethodGet)
+	router.HandleFunc("/events/ws", a.streamEventsWebsocket).Methods(http.MethodGet)
 
 	if a.params.StaticDir == "" {
 		log.Warnf("API_STATIC_DIR not set, using ./auto_static")
@@ -0,0 +1,251 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const feedWriteWait = 10 * time.Second
+
+// swagger:model FeedEvent
+type FeedEvent db.FeedEvent
+
+// parseFeedRequest reads the filter and the height to resume from of an event feed request
+func parseFeedRequest(r *http.Request) (db.FeedFilter, int64, error) {
+	query := r.URL.Query()
+	filter := db.FeedFilter{
+		ProviderPubkey: query.Get("provider"),
+		ClientPubkey:   query.Get("client"),
+		Service:        query.Get("service"),
+	}
+	for _, types := range query["type"] {
+		for _, t := range strings.Split(types, ",") {
+			if !slices.Contains(db.FeedEventTypes, t) {
+				return filter, 0, fmt.Errorf("invalid event type %s, expected one of %s", t, strings.Join(db.FeedEventTypes, ","))
+			}
+			filter.Types = append(filter.Types, t)
+		}
+	}
+
+	var fromHeight int64
+	if v := query.Get("from_height"); v != "" {
+		height, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || height < 1 {
+			return filter, 0, fmt.Errorf("invalid from_height %s", v)
+		}
+		fromHeight = height
+	}
+	// a reconnecting event source resumes after the last block it got
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		height, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || height < 0 {
+			return filter, 0, fmt.Errorf("invalid Last-Event-ID %s", v)
+		}
+		fromHeight = height + 1
+	}
+	return filter, fromHeight, nil
+}
+
+func feedErrorStatus(err error) int {
+	if errors.Is(err, errFeedNotReady) || errors.Is(err, errFeedTooManyClients) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// sseWriter writes the feed as server-sent events, the last event of every block carries
+// the block height as id
+type sseWriter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (s *sseWriter) writeBlock(block *feedBlock) error {
+	for i, evt := range block.Events {
+		data, err := json.Marshal(evt)
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(s.w, "event: %s\n", evt.Type); err != nil {
+			return err
+		}
+		if i == len(block.Events)-1 {
+			if _, err = fmt.Fprintf(s.w, "id: %d\n", block.Height); err != nil {
+				return err
+			}
+		}
+		if _, err = fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+			return err
+		}
+	}
+	return s.flush()
+}
+
+func (s *sseWriter) keepalive() error {
+	if _, err := fmt.Fprint(s.w, ": keepalive\n\n"); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *sseWriter) flush() error {
+	if err := s.rc.SetWriteDeadline(time.Now().Add(feedWriteWait)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// swagger:route Get /events streamEvents
+//
+// stream the events of the indexed blocks as server-sent events. The events stored from
+// from_height on are replayed first, a reconnecting client resumes from its Last-Event-ID
+// Parameters:
+//   + name: provider
+//     in: query
+//     description: provider pubkey
+//     type: string
+//   + name: client
+//     in: query
+//     description: client pubkey
+//     type: string
+//   + name: service
+//     in: query
+//     description: service name
+//     type: string
+//   + name: type
+//     in: query
+//     description: comma separated event types
+//     type: string
+//   + name: from_height
+//     in: query
+//     description: height to replay the stored events from
+//     type: integer
+//
+// Responses:
+//
+//	200: FeedEvent
+//	400: BadRequestError
+//	503: InternalServerError
+
+func (a *ApiService) streamEvents(w http.ResponseWriter, r *http.Request) {
+	filter, fromHeight, err := parseFeedRequest(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rc := http.NewResponseController(w)
+	// streams outlive the server timeouts, deadlines are handled per write
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	sub, height, err := a.feed.subscribe(filter)
+	if err != nil {
+		respondWithError(w, feedErrorStatus(err), err.Error())
+		return
+	}
+	defer a.feed.unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	writer := &sseWriter{w: w, rc: rc}
+	if err := writer.flush(); err != nil {
+		return
+	}
+	if err := a.feed.stream(r.Context(), sub, height, fromHeight, writer); err != nil {
+		log.WithError(err).Debug("event stream closed")
+		if _, werr := fmt.Fprintf(w, "event: error\ndata: %s\n\n", err); werr == nil {
+			_ = writer.flush()
+		}
+	}
+}
+
+// wsWriter writes the feed as websocket text messages, one event per message
+type wsWriter struct {
+	conn *websocket.Conn
+}
+
+func (s *wsWriter) writeBlock(block *feedBlock) error {
+	for _, evt := range block.Events {
+		_ = s.conn.SetWriteDeadline(time.Now().Add(feedWriteWait))
+		if err := s.conn.WriteJSON(evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *wsWriter) keepalive() error {
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(feedWriteWait))
+}
+
+// swagger:route Get /events/ws streamEventsWebsocket
+//
+// stream the events of the indexed blocks over a websocket, one json event per message.
+// It takes the filters and from_height of /events
+//
+// Responses:
+//
+//	101: FeedEvent
+//	400: BadRequestError
+//	503: InternalServerError
+func (a *ApiService) streamEventsWebsocket(w http.ResponseWriter, r *http.Request) {
+	filter, fromHeight, err := parseFeedRequest(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sub, height, err := a.feed.subscribe(filter)
+	if err != nil {
+		respondWithError(w, feedErrorStatus(err), err.Error())
+		return
+	}
+	defer a.feed.unsubscribe(sub)
+
+	// connections outlive the server timeouts, deadlines are handled per message
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool { return true }, // the feed is public, as the rest of the api
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.WithError(err).Error("fail to upgrade event feed connection")
+		return
+	}
+	defer conn.Close()
+
+	// the client only sends control messages, the reads detect it going away
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	_ = conn.SetReadDeadline(time.Now().Add(2 * feedKeepaliveInterval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * feedKeepaliveInterval))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := a.feed.stream(ctx, sub, height, fromHeight, &wsWriter{conn: conn}); err != nil {
+		log.WithError(err).Debug("event stream closed")
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()), time.Now().Add(feedWriteWait))
+	}
+}
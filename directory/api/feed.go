@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const (
+	defaultFeedPollInterval  = 5 * time.Second
+	defaultFeedRetryInterval = 5 * time.Second
+	defaultFeedQueryTimeout  = 10 * time.Second
+	feedKeepaliveInterval    = 15 * time.Second
+	// feedReplayBlocks is the range of blocks read at once when replaying or catching up
+	feedReplayBlocks     = 1000
+	feedSubscriberBuffer = 64
+	maxFeedSubscribers   = 1000
+)
+
+var (
+	errFeedNotReady       = errors.New("event feed is not ready")
+	errFeedTooManyClients = errors.New("too many event feed subscribers")
+	errFeedTooSlow        = errors.New("event feed subscriber too slow, resume from the last height received")
+)
+
+// feedBlock is the events of a block passing a subscriber filter
+type feedBlock struct {
+	Height int64
+	Events []*db.FeedEvent
+}
+
+type feedSubscriber struct {
+	filter db.FeedFilter
+	blocks chan *feedBlock
+}
+
+// feedWriter writes the feed to a subscriber connection
+type feedWriter interface {
+	writeBlock(block *feedBlock) error
+	keepalive() error
+}
+
+// eventFeed publishes the events of the blocks committed by the indexer to the subscribers.
+// It's woken by the notifications of the indexer, and polls the indexer status in case one
+// was missed. Every subscriber gets the blocks published after it subscribed, the blocks
+// before are replayed from the stored events
+type eventFeed struct {
+	store  db.IDataStorage
+	notify chan int64
+
+	mu          sync.Mutex
+	ready       bool
+	height      int64 // last height published
+	subscribers map[*feedSubscriber]struct{}
+}
+
+func newEventFeed(store db.IDataStorage) *eventFeed {
+	return &eventFeed{
+		store:       store,
+		notify:      make(chan int64, feedSubscriberBuffer),
+		subscribers: make(map[*feedSubscriber]struct{}),
+	}
+}
+
+func (f *eventFeed) run(ctx context.Context) {
+	ticker := time.NewTicker(defaultFeedPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f.publish(ctx); err != nil {
+			log.WithError(err).Error("fail to publish feed events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish sends the blocks indexed since the last published height to the subscribers, the
+// first call only sets where the feed starts from
+func (f *eventFeed) publish(ctx context.Context) error {
+	statusCtx, cancel := context.WithTimeout(ctx, defaultFeedQueryTimeout)
+	defer cancel()
+	var height int64
+	status, err := f.store.FindIndexerStatus(statusCtx)
+	switch {
+	case err == nil:
+		height = status.Height
+	case !errors.Is(err, db.ErrNotFound):
+		return err
+	}
+
+	f.mu.Lock()
+	from, ready := f.height+1, f.ready
+	if !ready {
+		f.height, f.ready = height, true
+	}
+	f.mu.Unlock()
+	if !ready {
+		log.Infof("event feed starting at height %d", height)
+		return nil
+	}
+
+	for from <= height {
+		to := min(from+feedReplayBlocks-1, height)
+		eventsCtx, cancel := context.WithTimeout(ctx, defaultFeedQueryTimeout)
+		events, err := f.store.FindFeedEvents(eventsCtx, db.FeedFilter{}, from, to)
+		cancel()
+		if err != nil {
+			return err
+		}
+		f.broadcast(to, groupFeedEvents(events))
+		from = to + 1
+	}
+	return nil
+}
+
+// broadcast sends every subscriber the blocks with events passing its filter, a subscriber
+// not keeping up is dropped rather than holding the others back
+func (f *eventFeed) broadcast(height int64, blocks []*feedBlock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range f.subscribers {
+		if !sub.send(blocks) {
+			delete(f.subscribers, sub)
+			close(sub.blocks)
+		}
+	}
+	f.height = height
+}
+
+// send queues the blocks with events passing the filter, it fails when the queue is full
+func (sub *feedSubscriber) send(blocks []*feedBlock) bool {
+	for _, block := range blocks {
+		filtered := &feedBlock{Height: block.Height}
+		for _, evt := range block.Events {
+			if sub.filter.Matches(evt) {
+				filtered.Events = append(filtered.Events, evt)
+			}
+		}
+		if len(filtered.Events) == 0 {
+			continue
+		}
+		select {
+		case sub.blocks <- filtered:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// subscribe registers a subscriber, which gets the blocks above the returned height
+func (f *eventFeed) subscribe(filter db.FeedFilter) (*feedSubscriber, int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.ready {
+		return nil, 0, errFeedNotReady
+	}
+	if len(f.subscribers) >= maxFeedSubscribers {
+		return nil, 0, errFeedTooManyClients
+	}
+	sub := &feedSubscriber{
+		filter: filter,
+		blocks: make(chan *feedBlock, feedSubscriberBuffer),
+	}
+	f.subscribers[sub] = struct{}{}
+	return sub, f.height, nil
+}
+
+func (f *eventFeed) unsubscribe(sub *feedSubscriber) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscribers[sub]; ok {
+		delete(f.subscribers, sub)
+		close(sub.blocks)
+	}
+}
+
+// stream writes the stored events from fromHeight on, if set, up to the height the subscriber
+// subscribed at, then the blocks it gets, until the context is done or writing fails
+func (f *eventFeed) stream(ctx context.Context, sub *feedSubscriber, height, fromHeight int64, w feedWriter) error {
+	for from := fromHeight; fromHeight > 0 && from <= height; from += feedReplayBlocks {
+		to := min(from+feedReplayBlocks-1, height)
+		events, err := f.store.FindFeedEvents(ctx, sub.filter, from, to)
+		if err != nil {
+			return err
+		}
+		for _, block := range groupFeedEvents(events) {
+			if err := w.writeBlock(block); err != nil {
+				return err
+			}
+		}
+	}
+
+	ticker := time.NewTicker(feedKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case block, ok := <-sub.blocks:
+			if !ok {
+				return errFeedTooSlow
+			}
+			if block.Height < fromHeight {
+				continue
+			}
+			if err := w.writeBlock(block); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := w.keepalive(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// groupFeedEvents groups events sorted by height into blocks
+func groupFeedEvents(events []*db.FeedEvent) []*feedBlock {
+	blocks := make([]*feedBlock, 0)
+	for _, evt := range events {
+		if len(blocks) == 0 || blocks[len(blocks)-1].Height != evt.Height {
+			blocks = append(blocks, &feedBlock{Height: evt.Height})
+		}
+		last := blocks[len(blocks)-1]
+		last.Events = append(last.Events, evt)
+	}
+	return blocks
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+func newReadyEventFeed(store db.IDataStorage, height int64) *eventFeed {
+	f := newEventFeed(store)
+	f.ready, f.height = true, height
+	return f
+}
+
+func TestEventFeedPublish(t *testing.T) {
+	store := new(db.MockDataStorage)
+	store.On("FindIndexerStatus", mock.Anything).Return(&db.IndexerStatus{Height: 10}, nil).Once()
+	store.On("FindIndexerStatus", mock.Anything).Return(&db.IndexerStatus{Height: 12}, nil).Once()
+	store.On("FindFeedEvents", mock.Anything, db.FeedFilter{}, int64(11), int64(12)).Return([]*db.FeedEvent{
+		{Height: 11, Type: db.FeedEventOpenContract, ProviderPubkey: "a"},
+		{Height: 11, Type: db.FeedEventOpenContract, ProviderPubkey: "b"},
+		{Height: 12, Type: db.FeedEventContractSettlement, ProviderPubkey: "b"},
+	}, nil)
+	f := newEventFeed(store)
+
+	// the feed can't be subscribed to before it knows where it starts
+	_, _, err := f.subscribe(db.FeedFilter{})
+	assert.ErrorIs(t, err, errFeedNotReady)
+
+	// the first publish only sets the starting height
+	assert.Nil(t, f.publish(context.Background()))
+	all, height, err := f.subscribe(db.FeedFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), height)
+	providerA, _, err := f.subscribe(db.FeedFilter{ProviderPubkey: "a"})
+	assert.Nil(t, err)
+
+	assert.Nil(t, f.publish(context.Background()))
+	assert.Equal(t, int64(12), f.height)
+	assert.Len(t, all.blocks, 2)
+	block := <-all.blocks
+	assert.Equal(t, int64(11), block.Height)
+	assert.Len(t, block.Events, 2)
+	block = <-all.blocks
+	assert.Equal(t, int64(12), block.Height)
+	assert.Len(t, providerA.blocks, 1)
+	block = <-providerA.blocks
+	assert.Equal(t, []*db.FeedEvent{{Height: 11, Type: db.FeedEventOpenContract, ProviderPubkey: "a"}}, block.Events)
+}
+
+func TestEventFeedDropsSlowSubscriber(t *testing.T) {
+	f := newReadyEventFeed(new(db.MockDataStorage), 0)
+	slow, _, err := f.subscribe(db.FeedFilter{})
+	assert.Nil(t, err)
+	blocks := make([]*feedBlock, 0, feedSubscriberBuffer+1)
+	for height := int64(1); height <= feedSubscriberBuffer+1; height++ {
+		blocks = append(blocks, &feedBlock{Height: height, Events: []*db.FeedEvent{{Height: height}}})
+	}
+	f.broadcast(feedSubscriberBuffer+1, blocks)
+	assert.Empty(t, f.subscribers)
+
+	// the queued blocks are still delivered, then the subscriber is told it was dropped
+	err = f.stream(context.Background(), slow, 0, 0, &recordingFeedWriter{})
+	assert.ErrorIs(t, err, errFeedTooSlow)
+}
+
+type recordingFeedWriter struct {
+	blocks []*feedBlock
+}
+
+func (r *recordingFeedWriter) writeBlock(block *feedBlock) error {
+	r.blocks = append(r.blocks, block)
+	return nil
+}
+
+func (r *recordingFeedWriter) keepalive() error { return nil }
+
+func TestStreamEvents(t *testing.T) {
+	filter := db.FeedFilter{ClientPubkey: "client", Types: []string{db.FeedEventOpenContract, db.FeedEventCloseContract}}
+	store := new(db.MockDataStorage)
+	store.On("FindFeedEvents", mock.Anything, filter, int64(9), int64(10)).Return([]*db.FeedEvent{
+		{Height: 9, Type: db.FeedEventOpenContract, ClientPubkey: "client", ContractID: 1},
+		{Height: 10, Type: db.FeedEventOpenContract, ClientPubkey: "client", ContractID: 2},
+		{Height: 10, Type: db.FeedEventCloseContract, ClientPubkey: "client", ContractID: 1},
+	}, nil)
+	a := &ApiService{feed: newReadyEventFeed(store, 10)}
+	server := httptest.NewServer(http.HandlerFunc(a.streamEvents))
+	defer server.Close()
+
+	// an invalid event type is rejected
+	resp, err := http.Get(server.URL + "?type=unknown")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"?client=client&type=open_contract,close_contract", nil)
+	assert.Nil(t, err)
+	// resumes after the last block received
+	req.Header.Set("Last-Event-ID", "8")
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// the stored events are replayed, then the new blocks are streamed
+	a.feed.broadcast(11, []*feedBlock{{Height: 11, Events: []*db.FeedEvent{
+		{Height: 11, Type: db.FeedEventCloseContract, ClientPubkey: "other", ContractID: 3},
+		{Height: 11, Type: db.FeedEventCloseContract, ClientPubkey: "client", ContractID: 2},
+	}}})
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+		if len(lines) == 9 {
+			break
+		}
+	}
+	assert.Equal(t, []string{
+		"event: open_contract",
+		"id: 9",
+		`data: {"height":9,"type":"open_contract","event_id":0,"client":"client","contract_id":1,"data":null}`,
+		"event: open_contract",
+		`data: {"height":10,"type":"open_contract","event_id":0,"client":"client","contract_id":2,"data":null}`,
+		"event: close_contract",
+		"id: 10",
+		`data: {"height":10,"type":"close_contract","event_id":0,"client":"client","contract_id":1,"data":null}`,
+		"event: close_contract",
+	}, lines)
+	assert.True(t, scanner.Scan())
+	assert.Equal(t, "id: 11", scanner.Text())
+}
+
+func TestStreamEventsWebsocket(t *testing.T) {
+	a := &ApiService{feed: newReadyEventFeed(new(db.MockDataStorage), 10)}
+	server := httptest.NewServer(http.HandlerFunc(a.streamEventsWebsocket))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"?provider=a", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	a.feed.broadcast(11, []*feedBlock{{Height: 11, Events: []*db.FeedEvent{
+		{Height: 11, Type: db.FeedEventProviderBond, ProviderPubkey: "b"},
+		{Height: 11, Type: db.FeedEventProviderBond, ProviderPubkey: "a", Data: json.RawMessage(`{"bond_abs":"100"}`)},
+	}}})
+	var evt db.FeedEvent
+	assert.Nil(t, conn.ReadJSON(&evt))
+	assert.Equal(t, "a", evt.ProviderPubkey)
+	assert.JSONEq(t, `{"bond_abs":"100"}`, string(evt.Data))
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+const maxGraphQLRequestBytes = 1 << 20
+
+// swagger:model GraphQLResult
+type GraphQLResult graphql.Result
+
+// graphQLRequest is a graphql query, sent as json in a POST body or as query parameters of a GET
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// swagger:route Post /graphql graphql
+//
+// query providers, contracts, validator payouts and network stats with graphql. Queries
+// costing more than the configured max cost are rejected before they're executed
+//
+// Responses:
+//
+//	200: GraphQLResult
+//	400: BadRequestError
+func (a *ApiService) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphQLRequest
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				respondWithError(w, http.StatusBadRequest, "invalid variables")
+				return
+			}
+		}
+	default:
+		r.Body = http.MaxBytesReader(w, r.Body, maxGraphQLRequestBytes)
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid graphql request")
+			return
+		}
+	}
+	if req.Query == "" {
+		respondWithError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	maxCost := a.params.GraphQLMaxCost
+	if maxCost <= 0 {
+		maxCost = DefaultGraphQLMaxCost
+	}
+	cost, err := graphQLCost(req.Query, req.OperationName, req.Variables)
+	if err == nil && cost > maxCost {
+		err = fmt.Errorf("query cost %d exceeds the max cost %d", cost, maxCost)
+	}
+	if err != nil {
+		respondWithJSON(w, http.StatusBadRequest, &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(err.Error())}})
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         a.graphql,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        r.Context(),
+	})
+	respondWithJSON(w, http.StatusOK, result)
+}
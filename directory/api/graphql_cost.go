@@ -0,0 +1,165 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	DefaultGraphQLMaxCost = 10000
+	maxGraphQLDepth       = 12
+)
+
+// graphQLConnectionFields are the fields returning a page of rows, whose selections are
+// resolved once per row
+var graphQLConnectionFields = map[string]bool{
+	"providers":        true,
+	"contracts":        true,
+	"bondHistory":      true,
+	"settlements":      true,
+	"validatorPayouts": true,
+}
+
+// graphQLCost estimates the work of a query before it's executed, each field costs one and
+// the selections of a connection field cost as many times as the rows it may return. It
+// bounds how much a single request can fan out to the database through nested connections
+func graphQLCost(query, operationName string, variables map[string]interface{}) (int, error) {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return 0, err
+	}
+	c := &costCounter{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+	var operations []*ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			c.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operations = append(operations, def)
+			}
+		}
+	}
+	cost := 0
+	for _, op := range operations {
+		c.defaults = make(map[string]ast.Value)
+		for _, def := range op.VariableDefinitions {
+			if def.DefaultValue != nil {
+				c.defaults[def.Variable.Name.Value] = def.DefaultValue
+			}
+		}
+		opCost, err := c.selectionSet(op.SelectionSet, 1, map[string]bool{})
+		if err != nil {
+			return 0, err
+		}
+		cost += opCost
+	}
+	return cost, nil
+}
+
+type costCounter struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// defaults are the default values of the variables of the operation being costed
+	defaults map[string]ast.Value
+}
+
+func (c *costCounter) selectionSet(set *ast.SelectionSet, depth int, spread map[string]bool) (int, error) {
+	if set == nil {
+		return 0, nil
+	}
+	if depth > maxGraphQLDepth {
+		return 0, fmt.Errorf("query is nested deeper than %d", maxGraphQLDepth)
+	}
+	cost := 0
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			children, err := c.selectionSet(selection.SelectionSet, depth+1, spread)
+			if err != nil {
+				return 0, err
+			}
+			if graphQLConnectionFields[selection.Name.Value] {
+				children *= c.pageSize(selection)
+			}
+			cost += 1 + children
+		case *ast.InlineFragment:
+			fragmentCost, err := c.selectionSet(selection.SelectionSet, depth, spread)
+			if err != nil {
+				return 0, err
+			}
+			cost += fragmentCost
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := c.fragments[name]
+			if !ok {
+				return 0, fmt.Errorf("unknown fragment %s", name)
+			}
+			if spread[name] {
+				return 0, fmt.Errorf("fragment %s spreads itself", name)
+			}
+			spread[name] = true
+			fragmentCost, err := c.selectionSet(fragment.SelectionSet, depth, spread)
+			delete(spread, name)
+			if err != nil {
+				return 0, err
+			}
+			cost += fragmentCost
+		}
+	}
+	return cost, nil
+}
+
+// pageSize is the first argument of a connection field, given inline or as a variable, which
+// falls back to its default value in the operation. A page size that can't be worked out is
+// costed as the largest page
+func (c *costCounter) pageSize(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		value := arg.Value
+		if variable, ok := value.(*ast.Variable); ok {
+			name := variable.Name.Value
+			if given, ok := c.variables[name]; ok {
+				return variablePageSize(given)
+			}
+			if value, ok = c.defaults[name]; !ok {
+				// the argument is left out, the connection returns its default page
+				return defaultGraphQLPageSize
+			}
+		}
+		if value, ok := value.(*ast.IntValue); ok {
+			if first, err := strconv.Atoi(value.Value); err == nil && first > 0 {
+				return min(first, maxGraphQLPageSize)
+			}
+		}
+		return maxGraphQLPageSize
+	}
+	return defaultGraphQLPageSize
+}
+
+// variablePageSize is the page size given by a variable value decoded from the request
+func variablePageSize(value interface{}) int {
+	var first int
+	switch value := value.(type) {
+	case nil:
+		return defaultGraphQLPageSize
+	case float64:
+		first = int(value)
+	case int:
+		first = value
+	default:
+		return maxGraphQLPageSize
+	}
+	if first <= 0 {
+		return maxGraphQLPageSize
+	}
+	return min(first, maxGraphQLPageSize)
+}
@@ -0,0 +1,526 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const (
+	defaultGraphQLPageSize = 20
+	maxGraphQLPageSize     = 100
+
+	cursorKindProvider        = "provider"
+	cursorKindContract        = "contract"
+	cursorKindBondEvent       = "bond"
+	cursorKindSettlementEvent = "settlement"
+	cursorKindValidatorPayout = "payout"
+)
+
+// bigIntType carries the 64 bit amounts, nonces and counts, which don't fit the 32 bit
+// graphql Int, as strings
+var bigIntType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "BigInt",
+	Description: "A 64 bit integer, serialized as a string",
+	Serialize: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case int64:
+			return strconv.FormatInt(v, 10)
+		case uint64:
+			return strconv.FormatUint(v, 10)
+		case int:
+			return strconv.Itoa(v)
+		case string:
+			return v
+		case fmt.Stringer:
+			return v.String()
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case string:
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return i
+			}
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v)
+			}
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch v := valueAST.(type) {
+		case *ast.StringValue:
+			if i, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+				return i
+			}
+		case *ast.IntValue:
+			if i, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+				return i
+			}
+		}
+		return nil
+	},
+})
+
+// connection is a page of a list, in the shape of the relay cursor connections
+type connection struct {
+	Edges    []edge   `graphql:"edges"`
+	PageInfo pageInfo `graphql:"pageInfo"`
+}
+
+type edge struct {
+	Cursor string      `graphql:"cursor"`
+	Node   interface{} `graphql:"node"`
+}
+
+type pageInfo struct {
+	HasNextPage bool    `graphql:"hasNextPage"`
+	EndCursor   *string `graphql:"endCursor"`
+}
+
+func encodeCursor(kind string, id int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", kind, id)))
+}
+
+func decodeCursor(kind, cursor string) (int64, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	prefix, id, found := strings.Cut(string(raw), ":")
+	if !found || prefix != kind {
+		return 0, fmt.Errorf("invalid %s cursor %s", kind, cursor)
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+// pageArgs returns the page asked by the first and after arguments, with one more row
+// than asked for, which tells whether there is a next page
+func pageArgs(kind string, args map[string]interface{}) (db.Page, error) {
+	first := defaultGraphQLPageSize
+	if v, ok := args["first"].(int); ok {
+		first = v
+	}
+	if first < 1 || first > maxGraphQLPageSize {
+		return db.Page{}, fmt.Errorf("first must be between 1 and %d", maxGraphQLPageSize)
+	}
+	page := db.Page{Limit: first + 1}
+	if after, ok := args["after"].(string); ok && after != "" {
+		id, err := decodeCursor(kind, after)
+		if err != nil {
+			return db.Page{}, err
+		}
+		page.After = id
+	}
+	return page, nil
+}
+
+func newConnection[T any](kind string, rows []T, page db.Page, id func(T) int64) *connection {
+	conn := &connection{Edges: make([]edge, 0, len(rows))}
+	if len(rows) == page.Limit {
+		conn.PageInfo.HasNextPage = true
+		rows = rows[:len(rows)-1]
+	}
+	for _, row := range rows {
+		conn.Edges = append(conn.Edges, edge{Cursor: encodeCursor(kind, id(row)), Node: row})
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn
+}
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"endCursor":   &graphql.Field{Type: graphql.String},
+	},
+})
+
+func newConnectionType(name string, node graphql.Output) *graphql.Object {
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(node)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Connection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edgeType)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		},
+	})
+}
+
+// connectionArgs are the arguments of every connection field, with the given filters
+func connectionArgs(filters graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"first": &graphql.ArgumentConfig{Type: graphql.Int, Description: fmt.Sprintf("page size, %d by default and at most %d", defaultGraphQLPageSize, maxGraphQLPageSize)},
+		"after": &graphql.ArgumentConfig{Type: graphql.String, Description: "cursor of the row to start after"},
+	}
+	for name, arg := range filters {
+		args[name] = arg
+	}
+	return args
+}
+
+func entityID(entity db.Entity) string {
+	return strconv.FormatInt(entity.ID, 10)
+}
+
+// optional turns a not found error into a null result
+func optional[T any](result *T, err error) (interface{}, error) {
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// newGraphQLSchema builds the schema of the graphql endpoint, resolved from the given store
+func newGraphQLSchema(store db.IDataStorage) (graphql.Schema, error) {
+	coinType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Coin",
+		Fields: graphql.Fields{
+			"denom":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"amount": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+	rateTierType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "RateTier",
+		Fields: graphql.Fields{
+			"threshold": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"rate":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(coinType)))},
+		},
+	})
+	rateCardType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "RateCard",
+		Fields: graphql.Fields{
+			"payAsYouGoTiers":   &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(rateTierType)))},
+			"subscriptionTiers": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(rateTierType)))},
+			"durationDiscounts": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+				Name: "DurationDiscount",
+				Fields: graphql.Fields{
+					"minDuration": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+					"discountBps": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				},
+			}))))},
+		},
+	})
+	ratesType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProviderRates",
+		Fields: graphql.Fields{
+			"subscription": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(coinType))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				//nolint:forcetypeassert
+				return p.Source.(*db.ProviderRates).SubscriptionRate, nil
+			}},
+			"payAsYouGo": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(coinType))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				//nolint:forcetypeassert
+				return p.Source.(*db.ProviderRates).PayAsYouGoRate, nil
+			}},
+			"rateCard": &graphql.Field{Type: graphql.NewNonNull(rateCardType)},
+		},
+	})
+	metadataType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProviderMetadata",
+		Fields: graphql.Fields{
+			"nonce":                 &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"version":               &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"moniker":               &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"website":               &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"description":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"location":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"freeRateLimit":         &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"freeRateLimitDuration": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+	bondEventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BondEvent",
+		Fields: graphql.Fields{
+			"height":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"txId":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"bondRel": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"bondAbs": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+	settlementEventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SettlementEvent",
+		Fields: graphql.Fields{
+			"height": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"txId":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"client": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				//nolint:forcetypeassert
+				return p.Source.(*db.ContractSettlementEvent).ClientPubkey, nil
+			}},
+			"nonce":   &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"paid":    &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"reserve": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+	validatorPayoutType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ValidatorPayout",
+		Fields: graphql.Fields{
+			"validator": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"height":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"paid":      &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+	networkStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "NetworkStats",
+		Fields: graphql.Fields{
+			"contractsOpen":           &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"contractsTotal":          &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"contractsMedianDuration": &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"contractsMedianRate":     &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"providerCount":           &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"queryCount":              &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+			"totalIncome":             &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+		},
+	})
+
+	// providers and contracts refer to each other, their fields are resolved lazily
+	var providerType, contractType *graphql.Object
+	var contractConnectionType *graphql.Object
+	providerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Provider",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return entityID(p.Source.(*db.ArkeoProvider).Entity), nil
+				}},
+				"pubkey":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"service":             &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"bond":                &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"status":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"metadataUri":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"metadataNonce":       &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"minContractDuration": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"maxContractDuration": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"settlementDuration":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"reputationScore":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "attested quality in basis points"},
+				"jailedUntil":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "height up to which the provider is jailed"},
+				"rates": &graphql.Field{Type: graphql.NewNonNull(ratesType), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return store.FindProviderRates(p.Context, p.Source.(*db.ArkeoProvider).ID)
+				}},
+				"metadata": &graphql.Field{Type: metadataType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return optional(store.FindProviderMetadata(p.Context, p.Source.(*db.ArkeoProvider).ID))
+				}},
+				"bondHistory": &graphql.Field{
+					Type: graphql.NewNonNull(newConnectionType("BondEvent", bondEventType)),
+					Args: connectionArgs(nil),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						page, err := pageArgs(cursorKindBondEvent, p.Args)
+						if err != nil {
+							return nil, err
+						}
+						//nolint:forcetypeassert
+						events, err := store.FindProviderBondEvents(p.Context, p.Source.(*db.ArkeoProvider).ID, page)
+						if err != nil {
+							return nil, err
+						}
+						return newConnection(cursorKindBondEvent, events, page, func(e *db.ProviderBondEvent) int64 { return e.ID }), nil
+					},
+				},
+				"contracts": &graphql.Field{
+					Type: graphql.NewNonNull(contractConnectionType),
+					Args: connectionArgs(graphql.FieldConfigArgument{
+						"client": &graphql.ArgumentConfig{Type: graphql.String, Description: "client pubkey"},
+					}),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						//nolint:forcetypeassert
+						provider := p.Source.(*db.ArkeoProvider)
+						client, _ := p.Args["client"].(string)
+						return resolveContracts(p, store, db.ContractFilter{ProviderID: provider.ID, ClientPubkey: client})
+					},
+				},
+			}
+		}),
+	})
+	contractType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Contract",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return strconv.FormatInt(p.Source.(*db.ArkeoContract).ContractID, 10), nil
+				}},
+				"provider": &graphql.Field{Type: providerType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					contract := p.Source.(*db.ArkeoContract)
+					return optional(store.FindProvider(p.Context, contract.Provider, contract.Service))
+				}},
+				"providerPubkey": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return p.Source.(*db.ArkeoContract).Provider, nil
+				}},
+				"service": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"client": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return p.Source.(*db.ArkeoContract).ClientPubkey, nil
+				}},
+				"delegate": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return p.Source.(*db.ArkeoContract).DelegatePubkey, nil
+				}},
+				"height": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"type": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return p.Source.(*db.ArkeoContract).ContractType, nil
+				}},
+				"duration":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"rate":             &graphql.Field{Type: graphql.NewNonNull(coinType)},
+				"openCost":         &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"deposit":          &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"authorization":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"queriesPerMinute": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"nonce":            &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"paid":             &graphql.Field{Type: graphql.NewNonNull(bigIntType)},
+				"settlementDuration": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return p.Source.(*db.ArkeoContract).SettlementDurtion, nil
+				}},
+				"settlementHeight": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"settlements": &graphql.Field{
+					Type: graphql.NewNonNull(newConnectionType("SettlementEvent", settlementEventType)),
+					Args: connectionArgs(nil),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						page, err := pageArgs(cursorKindSettlementEvent, p.Args)
+						if err != nil {
+							return nil, err
+						}
+						//nolint:forcetypeassert
+						events, err := store.FindContractSettlementEvents(p.Context, p.Source.(*db.ArkeoContract).ContractID, page)
+						if err != nil {
+							return nil, err
+						}
+						return newConnection(cursorKindSettlementEvent, events, page, func(e *db.ContractSettlementEvent) int64 { return e.ID }), nil
+					},
+				},
+			}
+		}),
+	})
+	contractConnectionType = newConnectionType("Contract", contractType)
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"provider": &graphql.Field{
+				Type: providerType,
+				Args: graphql.FieldConfigArgument{
+					"pubkey":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"service": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					return optional(store.FindProvider(p.Context, p.Args["pubkey"].(string), p.Args["service"].(string)))
+				},
+			},
+			"providers": &graphql.Field{
+				Type: graphql.NewNonNull(newConnectionType("Provider", providerType)),
+				Args: connectionArgs(graphql.FieldConfigArgument{
+					"service": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					page, err := pageArgs(cursorKindProvider, p.Args)
+					if err != nil {
+						return nil, err
+					}
+					service, _ := p.Args["service"].(string)
+					providers, err := store.FindProviders(p.Context, service, page)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection(cursorKindProvider, providers, page, func(p *db.ArkeoProvider) int64 { return p.ID }), nil
+				},
+			},
+			"contract": &graphql.Field{
+				Type: contractType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					//nolint:forcetypeassert
+					id, err := strconv.ParseUint(p.Args["id"].(string), 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("invalid contract id %s", p.Args["id"])
+					}
+					return optional(store.GetContract(p.Context, id))
+				},
+			},
+			"contracts": &graphql.Field{
+				Type: graphql.NewNonNull(contractConnectionType),
+				Args: connectionArgs(graphql.FieldConfigArgument{
+					"client":  &graphql.ArgumentConfig{Type: graphql.String, Description: "client pubkey"},
+					"service": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					client, _ := p.Args["client"].(string)
+					service, _ := p.Args["service"].(string)
+					return resolveContracts(p, store, db.ContractFilter{ClientPubkey: client, Service: service})
+				},
+			},
+			"validatorPayouts": &graphql.Field{
+				Type: graphql.NewNonNull(newConnectionType("ValidatorPayout", validatorPayoutType)),
+				Args: connectionArgs(graphql.FieldConfigArgument{
+					"validator": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					page, err := pageArgs(cursorKindValidatorPayout, p.Args)
+					if err != nil {
+						return nil, err
+					}
+					validator, _ := p.Args["validator"].(string)
+					payouts, err := store.FindValidatorPayoutEvents(p.Context, validator, page)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection(cursorKindValidatorPayout, payouts, page, func(e *db.ValidatorPayoutEvent) int64 { return e.ID }), nil
+				},
+			},
+			"networkStats": &graphql.Field{
+				Type: networkStatsType,
+				Args: graphql.FieldConfigArgument{
+					"service": &graphql.ArgumentConfig{Type: graphql.String, Description: "stats of a single service"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if service, _ := p.Args["service"].(string); service != "" {
+						return store.GetArkeoNetworkStatsByService(p.Context, service)
+					}
+					return store.GetArkeoNetworkStats(p.Context)
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func resolveContracts(p graphql.ResolveParams, store db.IDataStorage, filter db.ContractFilter) (interface{}, error) {
+	page, err := pageArgs(cursorKindContract, p.Args)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := store.FindContracts(p.Context, filter, page)
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(cursorKindContract, contracts, page, func(c *db.ArkeoContract) int64 { return c.ContractID }), nil
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/directory/db"
+	"github.com/arkeonetwork/arkeo/directory/types"
+)
+
+type graphQLResponse struct {
+	Data   map[string]interface{}   `json:"data"`
+	Errors []map[string]interface{} `json:"errors"`
+}
+
+func newGraphQLTestService(t *testing.T, store *db.MockDataStorage) *ApiService {
+	schema, err := newGraphQLSchema(store)
+	assert.Nil(t, err)
+	return &ApiService{graphql: schema}
+}
+
+func postGraphQL(t *testing.T, a *ApiService, query string, variables map[string]interface{}) (int, graphQLResponse) {
+	body, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	assert.Nil(t, err)
+	w := httptest.NewRecorder()
+	a.handleGraphQL(w, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	var resp graphQLResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return w.Code, resp
+}
+
+func TestGraphQLProviderNested(t *testing.T) {
+	store := new(db.MockDataStorage)
+	provider := &db.ArkeoProvider{
+		Entity:  db.Entity{ID: 7},
+		Pubkey:  "tarkeopub1provider",
+		Service: "btc-mainnet-fullnode",
+		Bond:    "20000000000",
+	}
+	store.On("FindProvider", mock.Anything, "tarkeopub1provider", "btc-mainnet-fullnode").Return(provider, nil)
+	store.On("FindProviderRates", mock.Anything, int64(7)).Return(&db.ProviderRates{
+		PayAsYouGoRate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 10)),
+	}, nil)
+	store.On("FindProviderMetadata", mock.Anything, int64(7)).Return(nil, db.ErrNotFound)
+	store.On("FindContracts", mock.Anything, db.ContractFilter{ProviderID: 7}, db.Page{Limit: 2}).Return([]*db.ArkeoContract{
+		{ContractID: 1, Provider: "tarkeopub1provider", Service: "btc-mainnet-fullnode", Rate: cosmos.NewInt64Coin("uarkeo", 10), Paid: 5000000000},
+		{ContractID: 3, Provider: "tarkeopub1provider", Service: "btc-mainnet-fullnode"},
+	}, nil)
+	store.On("FindContractSettlementEvents", mock.Anything, int64(1), db.Page{Limit: defaultGraphQLPageSize + 1}).Return([]*db.ContractSettlementEvent{
+		{Entity: db.Entity{ID: 4}, ContractID: 1, TxID: "ABCD", Height: 12, Nonce: 3, Paid: 30},
+	}, nil)
+	a := newGraphQLTestService(t, store)
+
+	code, resp := postGraphQL(t, a, `query($pubkey: String!) {
+		provider(pubkey: $pubkey, service: "btc-mainnet-fullnode") {
+			id bond
+			rates { payAsYouGo { denom amount } }
+			metadata { moniker }
+			contracts(first: 1) {
+				edges { cursor node { id paid rate { amount } settlements { edges { node { txId nonce paid } } } } }
+				pageInfo { hasNextPage endCursor }
+			}
+		}
+	}`, map[string]interface{}{"pubkey": "tarkeopub1provider"})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, resp.Errors)
+
+	//nolint:forcetypeassert
+	p := resp.Data["provider"].(map[string]interface{})
+	assert.Equal(t, "7", p["id"])
+	assert.Equal(t, "20000000000", p["bond"])
+	assert.Nil(t, p["metadata"])
+	assert.Equal(t, map[string]interface{}{"payAsYouGo": []interface{}{map[string]interface{}{"denom": "uarkeo", "amount": "10"}}}, p["rates"])
+
+	//nolint:forcetypeassert
+	contracts := p["contracts"].(map[string]interface{})
+	//nolint:forcetypeassert
+	edges := contracts["edges"].([]interface{})
+	assert.Len(t, edges, 1)
+	//nolint:forcetypeassert
+	node := edges[0].(map[string]interface{})["node"].(map[string]interface{})
+	assert.Equal(t, "1", node["id"])
+	assert.Equal(t, "5000000000", node["paid"])
+	assert.Equal(t, map[string]interface{}{"edges": []interface{}{
+		map[string]interface{}{"node": map[string]interface{}{"txId": "ABCD", "nonce": "3", "paid": "30"}},
+	}}, node["settlements"])
+	assert.Equal(t, map[string]interface{}{"hasNextPage": true, "endCursor": encodeCursor(cursorKindContract, 1)}, contracts["pageInfo"])
+}
+
+func TestGraphQLPagination(t *testing.T) {
+	store := new(db.MockDataStorage)
+	store.On("FindValidatorPayoutEvents", mock.Anything, "tarkeovaloper1", db.Page{After: 10, Limit: 3}).Return([]*db.ValidatorPayoutEvent{
+		{Entity: db.Entity{ID: 11}, Validator: "tarkeovaloper1", Height: 100, Paid: "25"},
+	}, nil)
+	a := newGraphQLTestService(t, store)
+
+	// the last page has no next page
+	query := `query($after: String) {
+		validatorPayouts(validator: "tarkeovaloper1", first: 2, after: $after) {
+			edges { cursor node { height paid } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+	code, resp := postGraphQL(t, a, query, map[string]interface{}{"after": encodeCursor(cursorKindValidatorPayout, 10)})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"edges": []interface{}{map[string]interface{}{
+			"cursor": encodeCursor(cursorKindValidatorPayout, 11),
+			"node":   map[string]interface{}{"height": float64(100), "paid": "25"},
+		}},
+		"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": encodeCursor(cursorKindValidatorPayout, 11)},
+	}, resp.Data["validatorPayouts"])
+
+	// the cursor of another kind of rows is rejected
+	_, resp = postGraphQL(t, a, query, map[string]interface{}{"after": encodeCursor(cursorKindContract, 10)})
+	assert.NotEmpty(t, resp.Errors)
+	_, resp = postGraphQL(t, a, query, map[string]interface{}{"after": "not a cursor"})
+	assert.NotEmpty(t, resp.Errors)
+	store.AssertNumberOfCalls(t, "FindValidatorPayoutEvents", 1)
+}
+
+func TestGraphQLNetworkStats(t *testing.T) {
+	store := new(db.MockDataStorage)
+	store.On("GetArkeoNetworkStatsByService", mock.Anything, "btc-mainnet-fullnode").Return(&types.ArkeoStats{ContractsOpen: 2, TotalIncome: 1 << 40}, nil)
+	a := newGraphQLTestService(t, store)
+
+	w := httptest.NewRecorder()
+	query := url.Values{"query": {`{ networkStats(service: "btc-mainnet-fullnode") { contractsOpen totalIncome } }`}}
+	a.handleGraphQL(w, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"networkStats":{"contractsOpen":"2","totalIncome":"1099511627776"}}}`, w.Body.String())
+}
+
+func TestGraphQLCost(t *testing.T) {
+	cost, err := graphQLCost(`{ providers(first: 10) { edges { node { pubkey } } } }`, "", nil)
+	assert.Nil(t, err)
+	// providers + 10 * (edges + node + pubkey)
+	assert.Equal(t, 31, cost)
+
+	// page sizes given as variables and selections in fragments are counted
+	cost, err = graphQLCost(`query($n: Int) { providers(first: $n) { ...p } } fragment p on ProviderConnection { edges { node { pubkey } } }`, "", map[string]interface{}{"n": float64(5)})
+	assert.Nil(t, err)
+	assert.Equal(t, 16, cost)
+
+	// variables left out are costed at their default value, unknown values at the largest page
+	cost, err = graphQLCost(`query($n: Int = 100) { providers(first: $n) { edges { node { pubkey } } } }`, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 301, cost)
+	cost, err = graphQLCost(`query($n: Int) { providers(first: $n) { edges { node { pubkey } } } }`, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 61, cost)
+	cost, err = graphQLCost(`query($n: Int) { providers(first: $n) { edges { node { pubkey } } } }`, "", map[string]interface{}{"n": "10"})
+	assert.Nil(t, err)
+	assert.Equal(t, 301, cost)
+
+	// nested connections multiply
+	cost, err = graphQLCost(`{ providers(first: 100) { edges { node { contracts(first: 100) { edges { node { settlements(first: 100) { edges { node { paid } } } } } } } } } }`, "", nil)
+	assert.Nil(t, err)
+	assert.Greater(t, cost, DefaultGraphQLMaxCost)
+
+	_, err = graphQLCost(`{ providers { ...p } } fragment p on ProviderConnection { ...p }`, "", nil)
+	assert.NotNil(t, err)
+
+	// an expensive query is rejected before it's resolved
+	store := new(db.MockDataStorage)
+	a := newGraphQLTestService(t, store)
+	a.params.GraphQLMaxCost = 20
+	code, resp := postGraphQL(t, a, `{ providers(first: 10) { edges { node { pubkey } } } }`, nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.NotEmpty(t, resp.Errors)
+	store.AssertNotCalled(t, "FindProviders", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -22,6 +22,12 @@ type InternalServerError struct {
 	Message string `json:"message"`
 }
 
+// Contains info about a 400 Bad Request response
+// swagger:model BadRequestError
+type BadRequestError struct {
+	Message string `json:"message"`
+}
+
 // swagger:model ArkeoProviders
 type ArkeoProviders []*db.ArkeoProvider
 
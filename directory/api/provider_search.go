@@ -32,7 +32,7 @@ import (
 //     required: false
 //     schema:
 //      type: string
-//      enum: age, conract_count, amount_paid
+//      enum: age, conract_count, amount_paid, reputation
 //   + name: max-distance
 //     in: query
 //     description: maximum distance in kilometers from provided coordinates
@@ -106,6 +106,8 @@ func (a *ApiService) searchProviders(response http.ResponseWriter, request *http
 		searchParams.SortKey = types.ProviderSortKeyAmountPaid
 	case string(types.ProviderSortKeyContractCount):
 		searchParams.SortKey = types.ProviderSortKeyContractCount
+	case string(types.ProviderSortKeyReputation):
+		searchParams.SortKey = types.ProviderSortKeyReputation
 	default:
 		respondWithError(response, http.StatusBadRequest, "sort key can not be parsed")
 		return
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const maxUsageBuckets = 1000
+
+var usageIntervals = map[string]time.Duration{
+	db.UsageHourly: time.Hour,
+	db.UsageDaily:  24 * time.Hour,
+}
+
+// defaultUsageRanges are the ranges returned when from isn't given
+var defaultUsageRanges = map[string]time.Duration{
+	db.UsageHourly: 24 * time.Hour,
+	db.UsageDaily:  30 * 24 * time.Hour,
+}
+
+// swagger:model UsageRollups
+type UsageRollups []*db.UsageRollup
+
+// parseUsageTime reads a RFC3339 time or a UTC date
+func parseUsageTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, v)
+}
+
+// parseUsageQuery reads the usage history query of a request, for the given service if set
+func parseUsageQuery(r *http.Request, service string, now time.Time) (db.UsageQuery, error) {
+	params := r.URL.Query()
+	query := db.UsageQuery{
+		Granularity:    db.UsageDaily,
+		To:             now,
+		Service:        service,
+		ProviderPubkey: params.Get("provider"),
+		GroupBy:        db.UsageByNetwork,
+	}
+	if v := params.Get("interval"); v != "" {
+		query.Granularity = v
+	}
+	interval, ok := usageIntervals[query.Granularity]
+	if !ok {
+		return query, fmt.Errorf("invalid interval %s, expected %s or %s", query.Granularity, db.UsageHourly, db.UsageDaily)
+	}
+	if v := params.Get("group_by"); v != "" {
+		query.GroupBy = v
+	}
+	switch query.GroupBy {
+	case db.UsageByNetwork, db.UsageByService, db.UsageByProvider:
+	default:
+		return query, fmt.Errorf("invalid group_by %s, expected %s, %s or %s", query.GroupBy, db.UsageByNetwork, db.UsageByService, db.UsageByProvider)
+	}
+
+	if v := params.Get("to"); v != "" {
+		to, err := parseUsageTime(v)
+		if err != nil {
+			return query, fmt.Errorf("invalid to %s", v)
+		}
+		query.To = to
+	}
+	query.From = query.To.Add(-defaultUsageRanges[query.Granularity])
+	if v := params.Get("from"); v != "" {
+		from, err := parseUsageTime(v)
+		if err != nil {
+			return query, fmt.Errorf("invalid from %s", v)
+		}
+		query.From = from
+	}
+	query.From, query.To = query.From.UTC(), query.To.UTC()
+	if !query.From.Before(query.To) {
+		return query, fmt.Errorf("from must be before to")
+	}
+	if query.To.Sub(query.From) > maxUsageBuckets*interval {
+		return query, fmt.Errorf("range is above %d %ss", maxUsageBuckets, query.Granularity)
+	}
+	return query, nil
+}
+
+var usageCSVHeader = []string{
+	"bucket", "service", "provider", "contracts_opened", "contracts_closed", "settlements",
+	"settled_volume", "reserve_tax", "validator_payouts",
+}
+
+func writeUsageCSV(w io.Writer, rollups []*db.UsageRollup) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(usageCSVHeader); err != nil {
+		return err
+	}
+	for _, rollup := range rollups {
+		record := []string{
+			rollup.Bucket.UTC().Format(time.RFC3339),
+			rollup.Service,
+			rollup.ProviderPubkey,
+			strconv.FormatInt(rollup.ContractsOpened, 10),
+			strconv.FormatInt(rollup.ContractsClosed, 10),
+			strconv.FormatInt(rollup.Settlements, 10),
+			strconv.FormatInt(rollup.SettledVolume, 10),
+			strconv.FormatInt(rollup.ReserveTax, 10),
+			strconv.FormatInt(rollup.ValidatorPayouts, 10),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// wantsCSV tells whether the usage history is asked as csv, with format=csv or the Accept header
+func wantsCSV(r *http.Request) bool {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format == "csv"
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/csv")
+}
+
+func (a *ApiService) respondWithUsage(w http.ResponseWriter, r *http.Request, service string) {
+	query, err := parseUsageQuery(r, service, time.Now())
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rollups, err := a.db.FindUsageRollups(r.Context(), query)
+	if err != nil {
+		log.WithError(err).Error("error finding usage history")
+		respondWithError(w, http.StatusInternalServerError, "error finding usage history")
+		return
+	}
+	if !wantsCSV(r) {
+		respondWithJSON(w, http.StatusOK, rollups)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="usage_%s_%s.csv"`, query.Granularity, query.GroupBy))
+	w.WriteHeader(http.StatusOK)
+	if err := writeUsageCSV(w, rollups); err != nil {
+		log.Errorf("failed to write usage csv: %s", err)
+	}
+}
+
+// swagger:route Get /stats/history getStatsHistory
+//
+// get the hourly or daily history of contracts opened and closed, settled volume, reserve
+// tax and validator payouts, of the network or broken down by service or provider
+// Parameters:
+//   + name: interval
+//     in: query
+//     description: hour or day, day by default
+//     type: string
+//   + name: from
+//     in: query
+//     description: RFC3339 time or date of the first bucket, 24 hours or 30 days before to by default
+//     type: string
+//   + name: to
+//     in: query
+//     description: RFC3339 time or date the buckets are before, now by default
+//     type: string
+//   + name: group_by
+//     in: query
+//     description: network, service or provider, network by default
+//     type: string
+//   + name: provider
+//     in: query
+//     description: provider pubkey
+//     type: string
+//   + name: format
+//     in: query
+//     description: json or csv, json by default
+//     type: string
+//
+// Responses:
+//
+//	200: UsageRollups
+//	400: BadRequestError
+//	500: InternalServerError
+
+func (a *ApiService) getStatsHistory(w http.ResponseWriter, r *http.Request) {
+	a.respondWithUsage(w, r, "")
+}
+
+// swagger:route Get /stats/history/{service} getStatsHistoryService
+//
+// get the usage history of a service, it takes the parameters of /stats/history
+// Parameters:
+//   + name: service
+//     in: path
+//     description: service identifier
+//     required: true
+//     type: string
+//
+// Responses:
+//
+//	200: UsageRollups
+//	400: BadRequestError
+//	500: InternalServerError
+
+func (a *ApiService) getStatsHistoryService(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+	if service == "" {
+		respondWithError(w, http.StatusBadRequest, "service is required")
+		return
+	}
+	a.respondWithUsage(w, r, service)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+func TestParseUsageQuery(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 30, 0, 0, time.UTC)
+	parse := func(target, service string) (db.UsageQuery, error) {
+		return parseUsageQuery(httptest.NewRequest(http.MethodGet, target, nil), service, now)
+	}
+
+	// the last 30 days of the network by default
+	query, err := parse("/stats/history", "")
+	assert.Nil(t, err)
+	assert.Equal(t, db.UsageQuery{
+		Granularity: db.UsageDaily,
+		From:        now.Add(-30 * 24 * time.Hour),
+		To:          now,
+		GroupBy:     db.UsageByNetwork,
+	}, query)
+
+	query, err = parse("/stats/history/mock?interval=hour&group_by=provider&provider=pubkey&from=2024-05-09&to=2024-05-10T06:00:00Z", "mock")
+	assert.Nil(t, err)
+	assert.Equal(t, db.UsageQuery{
+		Granularity:    db.UsageHourly,
+		From:           time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2024, 5, 10, 6, 0, 0, 0, time.UTC),
+		Service:        "mock",
+		ProviderPubkey: "pubkey",
+		GroupBy:        db.UsageByProvider,
+	}, query)
+
+	for _, target := range []string{
+		"/stats/history?interval=week",
+		"/stats/history?group_by=client",
+		"/stats/history?from=yesterday",
+		"/stats/history?from=2024-05-11",
+		// more than the max buckets
+		"/stats/history?interval=hour&from=2024-01-01",
+	} {
+		_, err = parse(target, "")
+		assert.NotNil(t, err, target)
+	}
+}
+
+func TestWriteUsageCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeUsageCSV(&buf, []*db.UsageRollup{
+		{Bucket: time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC), Service: "mock", ProviderPubkey: "pubkey", ContractsOpened: 2, Settlements: 1, SettledVolume: 300, ReserveTax: 30},
+		{Bucket: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC), ValidatorPayouts: 5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "bucket,service,provider,contracts_opened,contracts_closed,settlements,settled_volume,reserve_tax,validator_payouts\n"+
+		"2024-05-09T00:00:00Z,mock,pubkey,2,0,1,300,30,0\n"+
+		"2024-05-10T00:00:00Z,,,0,0,0,0,0,5\n", buf.String())
+
+	csvReq := httptest.NewRequest(http.MethodGet, "/stats/history", nil)
+	csvReq.Header.Set("Accept", "text/csv")
+	assert.True(t, wantsCSV(csvReq))
+	assert.True(t, wantsCSV(httptest.NewRequest(http.MethodGet, "/stats/history?format=csv", nil)))
+	assert.False(t, wantsCSV(httptest.NewRequest(http.MethodGet, "/stats/history", nil)))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/pkg/errors"
 )
 
@@ -41,6 +42,36 @@ func (d *DirectoryDB) FindLatestBlock(ctx context.Context) (*Block, error) {
 	return block, nil
 }
 
+// FindBlocks returns the stored blocks of the given heights, ordered by height
+func (d *DirectoryDB) FindBlocks(ctx context.Context, heights []int64) ([]*Block, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	blocks := make([]*Block, 0, len(heights))
+	if err := pgxscan.Select(ctx, conn, &blocks, sqlFindBlocks, heights); err != nil {
+		return nil, errors.Wrapf(err, "error selecting blocks")
+	}
+	return blocks, nil
+}
+
+// FindBlockGaps returns the ranges of heights missing between the first and the last stored blocks
+func (d *DirectoryDB) FindBlockGaps(ctx context.Context) ([]BlockGap, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	gaps := make([]BlockGap, 0)
+	if err := pgxscan.Select(ctx, conn, &gaps, sqlFindBlockGaps); err != nil {
+		return nil, errors.Wrapf(err, "error selecting block gaps")
+	}
+	return gaps, nil
+}
+
 type BlockGap struct {
 	Start int64 `db:"gap_start"`
 	End   int64 `db:"gap_end"`
@@ -11,12 +11,12 @@ const (
 	`
 
 	sqlUpsertBlock = `
-		INSERT INTO blocks(id, height, hash, block_time)
-		VALUES (1, $1, $2, $3)
-		ON CONFLICT (id) DO UPDATE
-		  SET height     = EXCLUDED.height,
-			  hash       = EXCLUDED.hash,
-			  block_time = EXCLUDED.block_time
+		INSERT INTO blocks(height, hash, block_time)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (height) DO UPDATE
+		  SET hash       = EXCLUDED.hash,
+			  block_time = EXCLUDED.block_time,
+			  updated    = now()
 		RETURNING id, created, updated
 		`
 
@@ -25,4 +25,18 @@ const (
 		from blocks b
 		where b.height = (select max(height) from blocks)
 	`
+
+	sqlFindBlocks = `
+		select ` + blockCols + `
+		from blocks b
+		where b.height = any($1::numeric[])
+		order by b.height
+	`
+
+	sqlFindBlockGaps = `
+		select (g.height + 1)::bigint as gap_start, (g.next_height - 1)::bigint as gap_end
+		from (select height, lead(height) over (order by height) as next_height from blocks) g
+		where g.next_height > g.height + 1
+		order by g.height
+	`
 )
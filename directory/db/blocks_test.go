@@ -31,7 +31,7 @@ func TestInsertBlock(t *testing.T) {
 	blockTime := time.Now()
 	returnTime := time.Now()
 
-	m.ExpectQuery(`(?i)INSERT INTO blocks\(id, height, hash, block_time\).*`).
+	m.ExpectQuery(`(?i)INSERT INTO blocks\(height, hash, block_time\).*ON CONFLICT \(height\).*`).
 		WithArgs(int64(1), hash, AnyTime{}).WillReturnRows(
 		pgxmock.NewRows([]string{"id", "created", "updated"}).
 			AddRow(int64(1), returnTime, returnTime),
@@ -90,3 +90,26 @@ func TestFindLatestBlock(t *testing.T) {
 	assert.Nil(t, b)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
+
+func TestFindBlockGaps(t *testing.T) {
+	m, err := pgxmock.NewPool()
+	assert.Nil(t, err)
+	defer m.Close()
+	db := DirectoryDB{
+		hijacker: func() (IConnection, error) {
+			return &MockDB{
+				pool: m,
+			}, nil
+		},
+	}
+
+	m.ExpectQuery(`select .* as gap_start, .* as gap_end from .*blocks.*`).WillReturnRows(
+		pgxmock.NewRows([]string{"gap_start", "gap_end"}).
+			AddRow(int64(5), int64(9)).
+			AddRow(int64(12), int64(12)),
+	)
+	gaps, err := db.FindBlockGaps(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []BlockGap{{Start: 5, End: 9}, {Start: 12, End: 12}}, gaps)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/huandu/go-sqlbuilder"
 	"github.com/pkg/errors"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -42,6 +44,25 @@ type ArkeoContract struct {
 	ReserveContribUSD   int64       `json:"reserve_contrib_usd" db:"reserve_contrib_usd"`
 }
 
+// ContractFilter narrows FindContracts down, empty fields don't filter
+type ContractFilter struct {
+	ProviderID   int64
+	ClientPubkey string
+	Service      string
+}
+
+// ContractSettlementEvent is a settlement of a contract
+type ContractSettlementEvent struct {
+	Entity
+	ContractID   int64  `db:"contract_id"`
+	TxID         string `db:"txid"`
+	ClientPubkey string `db:"client_pubkey"`
+	Height       int64  `db:"height"`
+	Nonce        int64  `db:"nonce"`
+	Paid         int64  `db:"paid"`
+	Reserve      int64  `db:"reserve"`
+}
+
 // UpsertIndexerStatus upserts the indexer status with the given height.
 func (d *DirectoryDB) UpsertIndexerStatus(ctx context.Context, height int64) (*Entity, error) {
 	conn, err := d.getConnection(ctx)
@@ -57,6 +78,40 @@ func (d *DirectoryDB) UpsertIndexerStatus(ctx context.Context, height int64) (*E
 	return entity, nil
 }
 
+// UpsertIndexerTarget records the height the indexer is catching up to, the progress of
+// a backfill is the indexer status height against it
+func (d *DirectoryDB) UpsertIndexerTarget(ctx context.Context, height int64) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return upsert(ctx, conn, sqlUpsertIndexerTarget, height)
+}
+
+// IndexerStatus is the height the indexer last reported
+type IndexerStatus struct {
+	Entity
+	Height       int64 `db:"height"`
+	TargetHeight int64 `db:"target_height"`
+}
+
+// FindIndexerStatus returns the indexer status, ErrNotFound when none was written yet
+func (d *DirectoryDB) FindIndexerStatus(ctx context.Context) (*IndexerStatus, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	status := &IndexerStatus{}
+	if err = selectOne(ctx, conn, sqlFindIndexerStatus, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
 // GetContract query db to find contract that match the given contract id
 func (d *DirectoryDB) GetContract(ctx context.Context, contractId uint64) (*ArkeoContract, error) {
 	conn, err := d.getConnection(ctx)
@@ -77,6 +132,86 @@ func (d *DirectoryDB) GetContract(ctx context.Context, contractId uint64) (*Arke
 	return &contract, nil
 }
 
+const contractSearchCols = `
+	c.id,
+	c.created,
+	c.updated,
+	coalesce(p.pubkey,'') as provider,
+	coalesce(p.service,'') as service,
+	c.delegate_pubkey,
+	c.client_pubkey,
+	c.height,
+	c.contract_type,
+	c.duration,
+	c.rate_asset,
+	c.rate_amount,
+	c.open_cost,
+	c.deposit,
+	c.auth,
+	c.queries_per_minute,
+	c.settlement_duration,
+	c.nonce,
+	c.paid,
+	c.reserve_contrib_asset,
+	c.reserve_contrib_usd,
+	c.settlement_height,
+	c.provider_id
+`
+
+// FindContracts returns a page of the contracts matching the filter
+func (d *DirectoryDB) FindContracts(ctx context.Context, filter ContractFilter, page Page) ([]*ArkeoContract, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(contractSearchCols).
+		From("contracts c").
+		JoinWithOption(sqlbuilder.LeftOuterJoin, "providers p", "p.id = c.provider_id")
+	sb = sb.Where(sb.GreaterThan("c.id", page.After))
+	if filter.ProviderID != 0 {
+		sb = sb.Where(sb.Equal("c.provider_id", filter.ProviderID))
+	}
+	if filter.ClientPubkey != "" {
+		sb = sb.Where(sb.Equal("c.client_pubkey", filter.ClientPubkey))
+	}
+	if filter.Service != "" {
+		sb = sb.Where(sb.Equal("p.service", filter.Service))
+	}
+	sb = sb.OrderBy("c.id").Asc().Limit(page.Limit)
+
+	q, params := sb.BuildWithFlavor(getFlavor())
+	log.Debugf("sql: %s\n%v", q, params)
+
+	contracts := make([]*ArkeoContract, 0, page.Limit)
+	if err := pgxscan.Select(ctx, conn, &contracts, q, params...); err != nil {
+		return nil, errors.Wrapf(err, "error selecting contracts")
+	}
+	for _, contract := range contracts {
+		if len(contract.RateAsset) > 0 {
+			contract.Rate = cosmos.NewInt64Coin(contract.RateAsset, contract.RateAmount)
+		}
+	}
+	return contracts, nil
+}
+
+// FindContractSettlementEvents returns a page of the settlements of a contract
+func (d *DirectoryDB) FindContractSettlementEvents(ctx context.Context, contractID int64, page Page) ([]*ContractSettlementEvent, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	events := make([]*ContractSettlementEvent, 0, page.Limit)
+	if err := pgxscan.Select(ctx, conn, &events, sqlFindContractSettlementEvents, contractID, page.After, page.Limit); err != nil {
+		return nil, errors.Wrapf(err, "error selecting contract settlement events")
+	}
+	return events, nil
+}
+
 // UpsertContract update database with the given open contract event, if the contract doesn't exist , it will create a new one
 func (d *DirectoryDB) UpsertContract(ctx context.Context, providerID int64, evt atypes.EventOpenContract, txID string, height int64) (*Entity, error) {
 	conn, err := d.getConnection(ctx)
@@ -166,6 +301,17 @@ func (d *DirectoryDB) CloseContract(ctx context.Context, contractID uint64, txID
 	return entity, nil
 }
 
+// TopUpContract update the deposit and duration of a contract with the given top up event
+func (d *DirectoryDB) TopUpContract(ctx context.Context, evt atypes.EventTopUpContract) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlTopUpContract, evt.Deposit.Int64(), evt.Duration, evt.ContractId)
+}
+
 func (d *DirectoryDB) UpsertContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract, txID string, height int64) (*Entity, error) {
 	conn, err := d.getConnection(ctx)
 	if err != nil {
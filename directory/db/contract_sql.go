@@ -10,6 +10,21 @@ const (
 		RETURNING id, created, updated
 	`
 
+	sqlUpsertIndexerTarget = `
+		INSERT INTO indexer_status (id, height, target_height)
+		VALUES (1, 0, $1)
+		ON CONFLICT (id) DO UPDATE SET
+			target_height = EXCLUDED.target_height,
+			updated = now()
+		RETURNING id, created, updated
+	`
+
+	sqlFindIndexerStatus = `
+		select id, created, updated, height, target_height
+		from indexer_status
+		where id = 1
+	`
+
 	sqlGetContractByID = ` select c.id,
 	c.created,
 	c.updated,
@@ -52,6 +67,13 @@ const (
 		returning id, created, updated
 	`
 
+	sqlTopUpContract = `
+		update contracts
+		set deposit = $1, duration = $2, updated = now()
+		where id = $3
+		returning id, created, updated
+	`
+
 	sqlUpsertCloseContractEventRecord = `
 		INSERT INTO close_contract_events (
 			contract_id,
@@ -116,4 +138,13 @@ const (
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		returning id, created, updated
 	`
+
+	sqlFindContractSettlementEvents = `
+		select id, created, updated, contract_id, txid, client_pubkey, height, nonce, paid, reserve
+		from contract_settlement_events
+		where contract_id = $1
+		  and id > $2
+		order by id
+		limit $3
+	`
 )
@@ -15,6 +15,33 @@ import (
 	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
+func TestIndexerStatus(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+
+	m.ExpectQuery(`(?i)INSERT INTO indexer_status \(id, height, target_height\).*`).
+		WithArgs(int64(4096)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated"}).AddRow(int64(1), testTime, testTime))
+	_, err := db.UpsertIndexerTarget(context.Background(), 4096)
+	assert.Nil(t, err)
+
+	m.ExpectQuery(`select id, created, updated, height, target_height from indexer_status where id = 1`).WillReturnRows(
+		pgxmock.NewRows([]string{"id", "created", "updated", "height", "target_height"}).AddRow(int64(1), testTime, testTime, int64(2048), int64(4096)),
+	)
+	status, err := db.FindIndexerStatus(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2048), status.Height)
+	assert.Equal(t, int64(4096), status.TargetHeight)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	m.ExpectQuery(`select.*from indexer_status`).WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated", "height", "target_height"}))
+	status, err = db.FindIndexerStatus(context.Background())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, status)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
 func TestFindContract(t *testing.T) {
 	m, db := getMockDirectoryDBForTest(t)
 	defer m.Close()
@@ -57,6 +84,45 @@ func TestFindContract(t *testing.T) {
 	assert.Nil(t, m.ExpectationsWereMet())
 }
 
+func TestFindContracts(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	testPubKey := arkeotypes.GetRandomPubKey()
+	m.ExpectQuery(`SELECT .* FROM contracts c LEFT OUTER JOIN providers p ON p.id = c.provider_id WHERE c.id > \$1 AND c.provider_id = \$2 AND c.client_pubkey = \$3 ORDER BY c.id ASC LIMIT 5`).
+		WithArgs(int64(0), int64(1), testPubKey.String()).
+		WillReturnRows(
+			pgxmock.NewRows([]string{
+				"id", "created", "updated", "provider", "service", "delegate_pubkey", "client_pubkey", "height", "contract_type", "duration", "rate_asset",
+				"rate_amount", "open_cost", "deposit", "auth", "queries_per_minute", "settlement_duration", "nonce", "paid", "reserve_contrib_asset",
+				"reserve_contrib_usd", "settlement_height", "provider_id",
+			}).AddRow(int64(7), testTime, testTime, testPubKey.String(), "mock", testPubKey.String(), testPubKey.String(), int64(1024), "PayAsYouGo",
+				int64(10), "uarkeo", int64(10), int64(10), int64(100000), "STRICT", int64(10), int64(10), int64(3), int64(1000), int64(100), int64(100), int64(0), int64(1)),
+		)
+	contracts, err := db.FindContracts(context.Background(), ContractFilter{ProviderID: 1, ClientPubkey: testPubKey.String()}, Page{Limit: 5})
+	assert.Nil(t, err)
+	assert.Len(t, contracts, 1)
+	assert.Equal(t, int64(7), contracts[0].ContractID)
+	assert.Equal(t, int64(3), contracts[0].Nonce)
+	assert.Equal(t, "uarkeo", contracts[0].Rate.Denom)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindContractSettlementEvents(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("select.*from contract_settlement_events.*").
+		WithArgs(int64(7), int64(0), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated", "contract_id", "txid", "client_pubkey", "height", "nonce", "paid", "reserve"}).
+			AddRow(int64(1), testTime, testTime, int64(7), "txid", "client", int64(100), int64(3), int64(30), int64(3)))
+	events, err := db.FindContractSettlementEvents(context.Background(), 7, Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(30), events[0].Paid)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
 func TestUpdateContract(t *testing.T) {
 	m, db := getMockDirectoryDBForTest(t)
 	defer m.Close()
@@ -153,6 +219,30 @@ func TestCloseContract(t *testing.T) {
 	assert.Nil(t, m.ExpectationsWereMet())
 }
 
+func TestTopUpContract(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("update contracts.*").
+		WithArgs(int64(2000), int64(200), uint64(1)).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "created", "updated"}).
+				AddRow(int64(1), testTime, testTime),
+		)
+	evt := arkeotypes.EventTopUpContract{
+		ContractId:    1,
+		AddedDeposit:  math.NewInt(1000),
+		AddedDuration: 100,
+		Deposit:       math.NewInt(2000),
+		Duration:      200,
+	}
+	entity, err := db.TopUpContract(context.Background(), evt)
+	assert.Nil(t, err)
+	assert.NotNil(t, entity)
+	assert.Equal(t, int64(1), entity.ID)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
 func TestUpsertContractSettltementEvent(t *testing.T) {
 	m, db := getMockDirectoryDBForTest(t)
 	defer m.Close()
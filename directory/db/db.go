@@ -31,19 +31,40 @@ type DBConfig struct {
 type IDataStorage interface {
 	FindLatestBlock(ctx context.Context) (*Block, error)
 	InsertBlock(ctx context.Context, b *Block) (*Entity, error)
+	FindBlocks(ctx context.Context, heights []int64) ([]*Block, error)
+	FindBlockGaps(ctx context.Context) ([]BlockGap, error)
 	UpsertValidatorPayoutEvent(ctx context.Context, evt atypes.EventValidatorPayout, height int64) (*Entity, error)
 	FindProvider(ctx context.Context, pubkey, service string) (*ArkeoProvider, error)
 	UpsertContract(ctx context.Context, providerID int64, evt atypes.EventOpenContract, txID string, height int64) (*Entity, error)
 	GetContract(ctx context.Context, contractId uint64) (*ArkeoContract, error)
 	CloseContract(ctx context.Context, contractID uint64, txID string, height int64) (*Entity, error)
+	TopUpContract(ctx context.Context, evt atypes.EventTopUpContract) (*Entity, error)
 	UpdateProvider(ctx context.Context, provider *ArkeoProvider) (*Entity, error)
+	UpdateProviderReputation(ctx context.Context, evt atypes.EventSubmitAttestation) (*Entity, error)
+	UpdateProviderJail(ctx context.Context, evt atypes.EventProviderSlashed) (*Entity, error)
 	UpsertContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract, txID string, height int64) (*Entity, error)
 	UpsertProviderMetadata(ctx context.Context, providerID, nonce int64, data sentinel.Metadata) (*Entity, error)
 	InsertBondProviderEvent(ctx context.Context, providerID int64, evt atypes.EventBondProvider, height int64, txID string) (*Entity, error)
 	InsertProvider(ctx context.Context, provider *ArkeoProvider) (*Entity, error)
 	InsertModProviderEvent(ctx context.Context, providerID int64, evt types.ModProviderEvent, txID string, height int64) (*Entity, error)
 	UpsertIndexerStatus(ctx context.Context, height int64) (*Entity, error)
+	UpsertIndexerTarget(ctx context.Context, height int64) (*Entity, error)
+	FindIndexerStatus(ctx context.Context) (*IndexerStatus, error)
 	InsertGenericEvent(ctx context.Context, eventType, txID string, height int64, attrJSON []byte) (*Entity, error)
+	FindProviders(ctx context.Context, service string, page Page) ([]*ArkeoProvider, error)
+	FindProviderRates(ctx context.Context, providerID int64) (*ProviderRates, error)
+	FindProviderMetadata(ctx context.Context, providerID int64) (*ProviderMetadata, error)
+	FindProviderBondEvents(ctx context.Context, providerID int64, page Page) ([]*ProviderBondEvent, error)
+	FindContracts(ctx context.Context, filter ContractFilter, page Page) ([]*ArkeoContract, error)
+	FindContractSettlementEvents(ctx context.Context, contractID int64, page Page) ([]*ContractSettlementEvent, error)
+	FindValidatorPayoutEvents(ctx context.Context, validator string, page Page) ([]*ValidatorPayoutEvent, error)
+	GetArkeoNetworkStats(ctx context.Context) (*types.ArkeoStats, error)
+	GetArkeoNetworkStatsByService(ctx context.Context, service string) (*types.ArkeoStats, error)
+	FindFeedEvents(ctx context.Context, filter FeedFilter, fromHeight, toHeight int64) ([]*FeedEvent, error)
+	NotifyBlockIndexed(ctx context.Context, height int64) error
+	UpsertUsageRollups(ctx context.Context, height int64, blockTime time.Time) error
+	FindUsageRollups(ctx context.Context, query UsageQuery) ([]*UsageRollup, error)
+	RunInTx(ctx context.Context, fn func(tx IDataStorage) error) error
 }
 
 var _ IDataStorage = &DirectoryDB{}
@@ -71,9 +92,18 @@ type (
 	DirectoryDB        struct {
 		pool     Acquireable
 		hijacker connectionHijacker // this is only used for test
+		tx       pgx.Tx             // set when the DirectoryDB is scoped to a transaction
 	}
 )
 
+// txConnection serves the queries of a transaction scoped DirectoryDB, the
+// underlying connection is released once the transaction is done
+type txConnection struct {
+	pgx.Tx
+}
+
+func (c txConnection) Release() {}
+
 // Entity base entity for db types
 type Entity struct {
 	ID      int64     `db:"id"`
@@ -85,6 +115,9 @@ var log = logging.WithoutFields()
 
 // obtain a db connection, callers must call conn.Release() when finished to return the conn to the pool
 func (d *DirectoryDB) getConnection(ctx context.Context) (IConnection, error) {
+	if d.tx != nil {
+		return txConnection{Tx: d.tx}, nil
+	}
 	if d.hijacker != nil {
 		return d.hijacker()
 	}
@@ -109,3 +142,29 @@ func New(config DBConfig) (*DirectoryDB, error) {
 		pool: pool,
 	}, nil
 }
+
+// RunInTx runs fn with a DirectoryDB whose reads and writes all go through one
+// transaction, which is committed when fn succeeds and rolled back otherwise.
+// Nested calls run in a savepoint of the outer transaction.
+func (d *DirectoryDB) RunInTx(ctx context.Context, fn func(tx IDataStorage) error) error {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error beginning transaction")
+	}
+	if err = fn(&DirectoryDB{pool: d.pool, tx: tx}); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("error rolling back transaction")
+		}
+		return err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return errors.Wrapf(err, "error committing transaction")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -59,6 +60,55 @@ func (a AnyTime) Match(v interface{}) bool {
 	return ok
 }
 
+func TestRunInTx(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	ctx := context.Background()
+	testTime := time.Now()
+
+	// writes made through the scoped db are committed together
+	m.ExpectBegin()
+	m.ExpectQuery(`(?i)INSERT INTO blocks\(height, hash, block_time\).*`).
+		WithArgs(int64(10), "hash", AnyTime{}).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated"}).AddRow(int64(1), testTime, testTime))
+	m.ExpectQuery(`(?i)INSERT INTO indexer_status.*`).
+		WithArgs(int64(10)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated"}).AddRow(int64(1), testTime, testTime))
+	m.ExpectCommit()
+	err := db.RunInTx(ctx, func(tx IDataStorage) error {
+		if _, err := tx.InsertBlock(ctx, &Block{Height: 10, Hash: "hash", BlockTime: testTime}); err != nil {
+			return err
+		}
+		_, err := tx.UpsertIndexerStatus(ctx, 10)
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	// a failed write rolls back the earlier ones, and the block marker is never written
+	m.ExpectBegin()
+	m.ExpectQuery(`(?i)INSERT INTO indexer_status.*`).
+		WithArgs(int64(11)).
+		WillReturnError(fmt.Errorf("connection reset"))
+	m.ExpectRollback()
+	err = db.RunInTx(ctx, func(tx IDataStorage) error {
+		if _, err := tx.UpsertIndexerStatus(ctx, 11); err != nil {
+			return err
+		}
+		_, err := tx.InsertBlock(ctx, &Block{Height: 11, Hash: "hash", BlockTime: testTime})
+		return err
+	})
+	assert.NotNil(t, err)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	// a failed commit is reported
+	m.ExpectBegin()
+	m.ExpectCommit().WillReturnError(fmt.Errorf("commit failed"))
+	err = db.RunInTx(ctx, func(tx IDataStorage) error { return nil })
+	assert.NotNil(t, err)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
 func getMockDirectoryDBForTest(t *testing.T) (pgxmock.PgxPoolIface, *DirectoryDB) {
 	m, err := pgxmock.NewPool()
 	assert.Nil(t, err)
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+)
+
+// Feed event types, as stored in feed_events_v
+const (
+	FeedEventOpenContract       = "open_contract"
+	FeedEventContractSettlement = "contract_settlement"
+	FeedEventCloseContract      = "close_contract"
+	FeedEventProviderBond       = "provider_bond"
+	FeedEventProviderMod        = "provider_mod"
+	FeedEventValidatorPayout    = "validator_payout"
+)
+
+// FeedEventTypes are all the feed event types, in the order they're returned within a block
+var FeedEventTypes = []string{
+	FeedEventProviderBond,
+	FeedEventProviderMod,
+	FeedEventOpenContract,
+	FeedEventContractSettlement,
+	FeedEventCloseContract,
+	FeedEventValidatorPayout,
+}
+
+// FeedEvent is an indexed event in the common shape of the event feed, the fields that
+// don't apply to the event type are empty and the rest of the event is in Data
+type FeedEvent struct {
+	Height         int64           `json:"height" db:"height"`
+	Type           string          `json:"type" db:"event_type"`
+	EventID        int64           `json:"event_id" db:"event_id"`
+	TxID           string          `json:"txid,omitempty" db:"txid"`
+	Service        string          `json:"service,omitempty" db:"service"`
+	ProviderPubkey string          `json:"provider,omitempty" db:"provider_pubkey"`
+	ClientPubkey   string          `json:"client,omitempty" db:"client_pubkey"`
+	ContractID     int64           `json:"contract_id,omitempty" db:"contract_id"`
+	Data           json.RawMessage `json:"data" db:"data"`
+}
+
+// FeedFilter narrows the feed events down, empty fields don't filter
+type FeedFilter struct {
+	ProviderPubkey string
+	ClientPubkey   string
+	Service        string
+	Types          []string
+}
+
+// Matches tells whether the event passes the filter, the same way FindFeedEvents filters
+func (f FeedFilter) Matches(evt *FeedEvent) bool {
+	return (f.ProviderPubkey == "" || f.ProviderPubkey == evt.ProviderPubkey) &&
+		(f.ClientPubkey == "" || f.ClientPubkey == evt.ClientPubkey) &&
+		(f.Service == "" || f.Service == evt.Service) &&
+		(len(f.Types) == 0 || slices.Contains(f.Types, evt.Type))
+}
+
+// FindFeedEvents returns the events between the given heights, both included, that pass the filter
+func (d *DirectoryDB) FindFeedEvents(ctx context.Context, filter FeedFilter, fromHeight, toHeight int64) ([]*FeedEvent, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	types := filter.Types
+	if types == nil {
+		types = []string{}
+	}
+	events := make([]*FeedEvent, 0)
+	if err := pgxscan.Select(ctx, conn, &events, sqlFindFeedEvents, fromHeight, toHeight, filter.ProviderPubkey, filter.ClientPubkey, filter.Service, types); err != nil {
+		return nil, errors.Wrapf(err, "error selecting feed events")
+	}
+	return events, nil
+}
+
+// NotifyBlockIndexed notifies BlockIndexedChannel of the block, when run in a transaction
+// the notification is only sent once the transaction commits
+func (d *DirectoryDB) NotifyBlockIndexed(ctx context.Context, height int64) error {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx, sqlNotifyBlockIndexed, BlockIndexedChannel, strconv.FormatInt(height, 10))
+	if err != nil {
+		return errors.Wrapf(err, "error notifying block %d", height)
+	}
+	rows.Close()
+	return rows.Err()
+}
+
+// ListenBlockIndexed sends the height of every block committed by the indexer to heights,
+// until the context is done or the connection fails
+func (d *DirectoryDB) ListenBlockIndexed(ctx context.Context, heights chan<- int64) error {
+	conn, err := d.pool.Acquire(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error obtaining db connection")
+	}
+	// a connection left in listen mode isn't fit to go back to the pool
+	defer func() {
+		if err := conn.Conn().Close(context.Background()); err != nil {
+			log.WithError(err).Error("error closing listen connection")
+		}
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "listen "+BlockIndexedChannel); err != nil {
+		return errors.Wrapf(err, "error listening to %s", BlockIndexedChannel)
+	}
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error waiting for notification")
+		}
+		height, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			log.WithError(err).Errorf("invalid %s notification %s", BlockIndexedChannel, notification.Payload)
+			continue
+		}
+		select {
+		case heights <- height:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
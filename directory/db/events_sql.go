@@ -0,0 +1,28 @@
+package db
+
+const (
+	// BlockIndexedChannel is notified with the height of every block the indexer commits
+	BlockIndexedChannel = "directory_block_indexed"
+
+	sqlNotifyBlockIndexed = `select pg_notify($1, $2)`
+
+	sqlFindFeedEvents = `
+		select e.height,
+			e.event_type,
+			e.event_id,
+			coalesce(e.txid, '') as txid,
+			coalesce(e.service, '') as service,
+			coalesce(e.provider_pubkey, '') as provider_pubkey,
+			coalesce(e.client_pubkey, '') as client_pubkey,
+			coalesce(e.contract_id, 0) as contract_id,
+			e.data
+		from feed_events_v e
+		where e.height >= $1
+			and e.height <= $2
+			and ($3 = '' or e.provider_pubkey = $3)
+			and ($4 = '' or e.client_pubkey = $4)
+			and ($5 = '' or e.service = $5)
+			and (cardinality($6::text[]) = 0 or e.event_type = any($6))
+		order by e.height, e.event_order, e.event_id
+	`
+)
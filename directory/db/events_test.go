@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindFeedEvents(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	cols := []string{"height", "event_type", "event_id", "txid", "service", "provider_pubkey", "client_pubkey", "contract_id", "data"}
+	m.ExpectQuery("select.*from feed_events_v.*").
+		WithArgs(int64(10), int64(20), "provider", "", "", []string{FeedEventOpenContract}).
+		WillReturnRows(pgxmock.NewRows(cols).
+			AddRow(int64(12), FeedEventOpenContract, int64(3), "ABCD", "mock", "provider", "client", int64(1), []byte(`{"duration":10}`)))
+	events, err := db.FindFeedEvents(context.Background(), FeedFilter{ProviderPubkey: "provider", Types: []string{FeedEventOpenContract}}, 10, 20)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(12), events[0].Height)
+	assert.JSONEq(t, `{"duration":10}`, string(events[0].Data))
+
+	// no event types filter is an empty array rather than null
+	m.ExpectQuery("select.*from feed_events_v.*").
+		WithArgs(int64(10), int64(20), "", "", "", []string{}).
+		WillReturnRows(pgxmock.NewRows(cols))
+	events, err = db.FindFeedEvents(context.Background(), FeedFilter{}, 10, 20)
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestNotifyBlockIndexed(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	m.ExpectQuery("select pg_notify.*").
+		WithArgs(BlockIndexedChannel, "42").
+		WillReturnRows(pgxmock.NewRows([]string{"pg_notify"}).AddRow(""))
+	assert.Nil(t, db.NotifyBlockIndexed(context.Background(), 42))
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFeedFilterMatches(t *testing.T) {
+	evt := &FeedEvent{Type: FeedEventCloseContract, Service: "mock", ProviderPubkey: "provider", ClientPubkey: "client"}
+	assert.True(t, FeedFilter{}.Matches(evt))
+	assert.True(t, FeedFilter{ProviderPubkey: "provider", ClientPubkey: "client", Service: "mock"}.Matches(evt))
+	assert.True(t, FeedFilter{Types: []string{FeedEventOpenContract, FeedEventCloseContract}}.Matches(evt))
+	assert.False(t, FeedFilter{Types: []string{FeedEventOpenContract}}.Matches(evt))
+	assert.False(t, FeedFilter{ClientPubkey: "other"}.Matches(evt))
+	assert.False(t, FeedFilter{Service: "other"}.Matches(&FeedEvent{Type: FeedEventValidatorPayout}))
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"github.com/arkeonetwork/arkeo/directory/types"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -34,6 +35,24 @@ func (s *MockDataStorage) InsertBlock(ctx context.Context, b *Block) (*Entity, e
 	return args.Get(0).(*Entity), args.Error(1)
 }
 
+func (s *MockDataStorage) FindBlocks(ctx context.Context, heights []int64) ([]*Block, error) {
+	args := s.Called(ctx, heights)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*Block), args.Error(1)
+}
+
+func (s *MockDataStorage) FindBlockGaps(ctx context.Context) ([]BlockGap, error) {
+	args := s.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]BlockGap), args.Error(1)
+}
+
 func (s *MockDataStorage) UpsertValidatorPayoutEvent(ctx context.Context, evt atypes.EventValidatorPayout, height int64) (*Entity, error) {
 	args := s.Called(ctx, evt, height)
 	if args.Get(0) == nil {
@@ -70,6 +89,33 @@ func (s *MockDataStorage) GetContract(ctx context.Context, contractId uint64) (*
 	return args.Get(0).(*ArkeoContract), args.Error(1)
 }
 
+func (s *MockDataStorage) TopUpContract(ctx context.Context, evt atypes.EventTopUpContract) (*Entity, error) {
+	args := s.Called(ctx, evt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
+func (s *MockDataStorage) UpdateProviderReputation(ctx context.Context, evt atypes.EventSubmitAttestation) (*Entity, error) {
+	args := s.Called(ctx, evt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
+func (s *MockDataStorage) UpdateProviderJail(ctx context.Context, evt atypes.EventProviderSlashed) (*Entity, error) {
+	args := s.Called(ctx, evt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
 func (s *MockDataStorage) CloseContract(ctx context.Context, contractID uint64, txID string, height int64) (*Entity, error) {
 	args := s.Called(ctx, contractID, txID, height)
 	if args.Get(0) == nil {
@@ -89,7 +135,7 @@ func (s *MockDataStorage) UpdateProvider(ctx context.Context, provider *ArkeoPro
 }
 
 func (s *MockDataStorage) UpsertContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract, txID string, height int64) (*Entity, error) {
-	args := s.Called(ctx, evt, txID, height)
+	args := s.Called(ctx, evt)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -150,3 +196,139 @@ func (s *MockDataStorage) InsertGenericEvent(ctx context.Context, eventType stri
 	//nolint:forcetypeassert
 	return args.Get(0).(*Entity), args.Error(1)
 }
+
+func (s *MockDataStorage) UpsertIndexerTarget(ctx context.Context, height int64) (*Entity, error) {
+	args := s.Called(ctx, height)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
+func (s *MockDataStorage) FindIndexerStatus(ctx context.Context) (*IndexerStatus, error) {
+	args := s.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*IndexerStatus), args.Error(1)
+}
+
+func (s *MockDataStorage) FindProviders(ctx context.Context, service string, page Page) ([]*ArkeoProvider, error) {
+	args := s.Called(ctx, service, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*ArkeoProvider), args.Error(1)
+}
+
+func (s *MockDataStorage) FindProviderRates(ctx context.Context, providerID int64) (*ProviderRates, error) {
+	args := s.Called(ctx, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*ProviderRates), args.Error(1)
+}
+
+func (s *MockDataStorage) FindProviderMetadata(ctx context.Context, providerID int64) (*ProviderMetadata, error) {
+	args := s.Called(ctx, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*ProviderMetadata), args.Error(1)
+}
+
+func (s *MockDataStorage) FindProviderBondEvents(ctx context.Context, providerID int64, page Page) ([]*ProviderBondEvent, error) {
+	args := s.Called(ctx, providerID, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*ProviderBondEvent), args.Error(1)
+}
+
+func (s *MockDataStorage) FindContracts(ctx context.Context, filter ContractFilter, page Page) ([]*ArkeoContract, error) {
+	args := s.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*ArkeoContract), args.Error(1)
+}
+
+func (s *MockDataStorage) FindContractSettlementEvents(ctx context.Context, contractID int64, page Page) ([]*ContractSettlementEvent, error) {
+	args := s.Called(ctx, contractID, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*ContractSettlementEvent), args.Error(1)
+}
+
+func (s *MockDataStorage) FindValidatorPayoutEvents(ctx context.Context, validator string, page Page) ([]*ValidatorPayoutEvent, error) {
+	args := s.Called(ctx, validator, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*ValidatorPayoutEvent), args.Error(1)
+}
+
+func (s *MockDataStorage) GetArkeoNetworkStats(ctx context.Context) (*types.ArkeoStats, error) {
+	args := s.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*types.ArkeoStats), args.Error(1)
+}
+
+func (s *MockDataStorage) GetArkeoNetworkStatsByService(ctx context.Context, service string) (*types.ArkeoStats, error) {
+	args := s.Called(ctx, service)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*types.ArkeoStats), args.Error(1)
+}
+
+func (s *MockDataStorage) FindFeedEvents(ctx context.Context, filter FeedFilter, fromHeight, toHeight int64) ([]*FeedEvent, error) {
+	args := s.Called(ctx, filter, fromHeight, toHeight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*FeedEvent), args.Error(1)
+}
+
+func (s *MockDataStorage) NotifyBlockIndexed(ctx context.Context, height int64) error {
+	args := s.Called(ctx, height)
+	return args.Error(0)
+}
+
+func (s *MockDataStorage) UpsertUsageRollups(ctx context.Context, height int64, blockTime time.Time) error {
+	args := s.Called(ctx, height, blockTime)
+	return args.Error(0)
+}
+
+func (s *MockDataStorage) FindUsageRollups(ctx context.Context, query UsageQuery) ([]*UsageRollup, error) {
+	args := s.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*UsageRollup), args.Error(1)
+}
+
+// RunInTx runs fn against the mock itself, as if the transaction committed
+func (s *MockDataStorage) RunInTx(ctx context.Context, fn func(tx IDataStorage) error) error {
+	args := s.Called(ctx)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(s)
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -35,6 +36,52 @@ type ArkeoProvider struct {
 	PaygoRateRaw        string       `json:"-" db:"paygo_rate"`
 	SubscriptionRate    cosmos.Coins `json:"subscription_rates" db:"-"`
 	PayAsYouGoRate      cosmos.Coins `json:"paygo_rates" db:"-"`
+	// this is a JSONB type in the db
+	RateCardRaw string          `json:"-" db:"rate_card"`
+	RateCard    atypes.RateCard `json:"rate_card" db:"-"`
+	// attested quality of the provider in basis points, see ProviderReputation
+	ReputationScore int64 `json:"reputation_score" db:"reputation_score"`
+	// height up to which the provider is jailed for an offense and can't take new contracts
+	JailedUntil int64 `json:"jailed_until" db:"jailed_until"`
+}
+
+// ProviderRates are the rates a provider charges
+type ProviderRates struct {
+	SubscriptionRate cosmos.Coins
+	PayAsYouGoRate   cosmos.Coins
+	RateCard         atypes.RateCard
+}
+
+// ProviderMetadata is the metadata a provider serves at its metadata uri
+type ProviderMetadata struct {
+	Entity
+	ProviderID            int64  `db:"provider_id"`
+	Nonce                 int64  `db:"nonce"`
+	Version               string `db:"version"`
+	Moniker               string `db:"moniker"`
+	Website               string `db:"website"`
+	Description           string `db:"description"`
+	Location              string `db:"location"`
+	FreeRateLimit         int64  `db:"free_rate_limit"`
+	FreeRateLimitDuration int64  `db:"free_rate_limit_duration"`
+}
+
+// ProviderBondEvent is a change of the bond of a provider
+type ProviderBondEvent struct {
+	Entity
+	ProviderID int64  `db:"provider_id"`
+	Height     int64  `db:"height"`
+	TxID       string `db:"txid"`
+	BondRel    string `db:"bond_rel"`
+	BondAbs    string `db:"bond_abs"`
+}
+
+// ValidatorPayoutEvent is a reward paid out to a validator
+type ValidatorPayoutEvent struct {
+	Entity
+	Validator string `db:"validator"`
+	Height    int64  `db:"height"`
+	Paid      string `db:"paid"`
 }
 
 type SubscriberContract struct {
@@ -128,6 +175,11 @@ func (d *DirectoryDB) UpdateProvider(ctx context.Context, provider *ArkeoProvide
 		}
 	}()
 
+	rateCard, err := json.Marshal(provider.RateCard)
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal rate card: %w", err)
+	}
+
 	// update provide records
 	var providerID int64
 	var created, updated time.Time
@@ -141,6 +193,7 @@ func (d *DirectoryDB) UpdateProvider(ctx context.Context, provider *ArkeoProvide
 		provider.MinContractDuration,
 		provider.MaxContractDuration,
 		provider.SettlementDuration,
+		string(rateCard),
 	).Scan(&providerID, &created, &updated)
 	if err != nil {
 		return nil, fmt.Errorf("fail to update provider,err: %w", err)
@@ -221,6 +274,11 @@ func (d *DirectoryDB) FindProvider(ctx context.Context, pubkey, service string)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error finding pay-as-you-go rates")
 	}
+	if provider.RateCardRaw != "" {
+		if err = json.Unmarshal([]byte(provider.RateCardRaw), &provider.RateCard); err != nil {
+			return nil, errors.Wrapf(err, "error parsing rate card")
+		}
+	}
 
 	return &provider, nil
 }
@@ -272,7 +330,9 @@ const provSearchCols = `
 	coalesce(p.paygo_rate,'') as paygo_rate,
 	coalesce(p.min_contract_duration,0) as min_contract_duration,
 	coalesce(p.max_contract_duration,0) as max_contract_duration,
-	coalesce(p.bond,0) as bond
+	coalesce(p.bond,0) as bond,
+	coalesce(p.reputation_score,0) as reputation_score,
+	coalesce(p.jailed_until,0) as jailed_until
 `
 
 func (d *DirectoryDB) SearchProviders(ctx context.Context, criteria types.ProviderSearchParams) ([]*ArkeoProvider, error) {
@@ -331,6 +391,8 @@ func (d *DirectoryDB) SearchProviders(ctx context.Context, criteria types.Provid
 		sb = sb.OrderBy("p.contract_count").Desc()
 	case types.ProviderSortKeyAmountPaid:
 		sb = sb.OrderBy("p.total_paid").Desc()
+	case types.ProviderSortKeyReputation:
+		sb = sb.OrderBy("p.reputation_score").Desc()
 	default:
 		return nil, fmt.Errorf("not a valid sortKey %s", criteria.SortKey)
 	}
@@ -357,6 +419,116 @@ func (d *DirectoryDB) SearchProviders(ctx context.Context, criteria types.Provid
 	return providers, nil
 }
 
+// FindProviders returns a page of the providers, of the given service if not empty
+func (d *DirectoryDB) FindProviders(ctx context.Context, service string, page Page) ([]*ArkeoProvider, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(provSearchCols).
+		From("providers_v p")
+	sb = sb.Where(sb.GreaterThan("p.id", page.After))
+	if service != "" {
+		sb = sb.Where(sb.Equal("p.service", service))
+	}
+	sb = sb.OrderBy("p.id").Asc().Limit(page.Limit)
+
+	q, params := sb.BuildWithFlavor(getFlavor())
+	log.Debugf("sql: %s\n%v", q, params)
+
+	providers := make([]*ArkeoProvider, 0, page.Limit)
+	if err := pgxscan.Select(ctx, conn, &providers, q, params...); err != nil {
+		return nil, errors.Wrapf(err, "error selecting many")
+	}
+	for _, provider := range providers {
+		coins, err := cosmos.ParseCoins(provider.SubscriptionRateRaw)
+		if err == nil {
+			provider.SubscriptionRate = coins
+		}
+		coins, err = cosmos.ParseCoins(provider.PaygoRateRaw)
+		if err == nil {
+			provider.PayAsYouGoRate = coins
+		}
+	}
+	return providers, nil
+}
+
+// FindProviderRates returns all the rates of a provider, the rate columns of the
+// providers view only hold the first rate of each kind
+func (d *DirectoryDB) FindProviderRates(ctx context.Context, providerID int64) (*ProviderRates, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	var rateCardRaw string
+	if err = selectOne(ctx, conn, sqlFindProviderRateCard, &rateCardRaw, providerID); err != nil {
+		return nil, errors.Wrapf(err, "error selecting rate card")
+	}
+	rates := &ProviderRates{}
+	if err = json.Unmarshal([]byte(rateCardRaw), &rates.RateCard); err != nil {
+		return nil, errors.Wrapf(err, "error parsing rate card")
+	}
+	rates.SubscriptionRate, err = d.findRates(conn, providerID, sqlFindProviderSubscriptionRates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding subscription rates")
+	}
+	rates.PayAsYouGoRate, err = d.findRates(conn, providerID, sqlFindProviderPayAsYouGoRates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding pay-as-you-go rates")
+	}
+	return rates, nil
+}
+
+// FindProviderMetadata returns the metadata of a provider, ErrNotFound when it wasn't downloaded
+func (d *DirectoryDB) FindProviderMetadata(ctx context.Context, providerID int64) (*ProviderMetadata, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	metadata := &ProviderMetadata{}
+	if err = selectOne(ctx, conn, sqlFindProviderMetadata, metadata, providerID); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// FindProviderBondEvents returns a page of the bond history of a provider
+func (d *DirectoryDB) FindProviderBondEvents(ctx context.Context, providerID int64, page Page) ([]*ProviderBondEvent, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	events := make([]*ProviderBondEvent, 0, page.Limit)
+	if err := pgxscan.Select(ctx, conn, &events, sqlFindProviderBondEvents, providerID, page.After, page.Limit); err != nil {
+		return nil, errors.Wrapf(err, "error selecting provider bond events")
+	}
+	return events, nil
+}
+
+// FindValidatorPayoutEvents returns a page of the validator payouts, of the given validator if not empty
+func (d *DirectoryDB) FindValidatorPayoutEvents(ctx context.Context, validator string, page Page) ([]*ValidatorPayoutEvent, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	events := make([]*ValidatorPayoutEvent, 0, page.Limit)
+	if err := pgxscan.Select(ctx, conn, &events, sqlFindValidatorPayoutEvents, validator, page.After, page.Limit); err != nil {
+		return nil, errors.Wrapf(err, "error selecting validator payout events")
+	}
+	return events, nil
+}
+
 func (d *DirectoryDB) UpsertValidatorPayoutEvent(ctx context.Context, evt atypes.EventValidatorPayout, height int64) (*Entity, error) {
 	conn, err := d.getConnection(ctx)
 	if err != nil {
@@ -390,8 +562,12 @@ func (d *DirectoryDB) InsertModProviderEvent(ctx context.Context, providerID int
 	}
 	defer conn.Release()
 
+	rateCard, err := json.Marshal(evt.RateCard)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error marshaling rate card")
+	}
 	return insert(ctx, conn, sqlInsertModProviderEvent, providerID, evt.Height, evt.TxID, evt.MetadataURI, evt.MetadataNonce, evt.Status,
-		evt.MinContractDuration, evt.MaxContractDuration)
+		evt.MinContractDuration, evt.MaxContractDuration, string(rateCard))
 }
 
 func (d *DirectoryDB) UpsertProviderMetadata(ctx context.Context, providerID, nonce int64, data sentinel.Metadata) (*Entity, error) {
@@ -449,3 +625,27 @@ func (d *DirectoryDB) ServiceExists(ctx context.Context, service string) (bool,
 	}
 	return true, nil
 }
+
+// UpdateProviderJail stores the height a slashed provider is jailed until
+func (d *DirectoryDB) UpdateProviderJail(ctx context.Context, evt atypes.EventProviderSlashed) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlUpdateProviderJail, evt.Provider.String(), evt.Service, evt.JailedUntil)
+}
+
+// UpdateProviderReputation stores the reputation of a provider from an
+// attestation event
+func (d *DirectoryDB) UpdateProviderReputation(ctx context.Context, evt atypes.EventSubmitAttestation) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlUpdateProviderReputation, evt.Provider.String(), evt.Service,
+		evt.Reputation.Score, evt.Reputation.GetWeight().String(), evt.Reputation.LastUpdate)
+}
@@ -14,6 +14,27 @@ var (
 			min_contract_duration = $7,
 			max_contract_duration = $8,
 			settlement_duration = $9,
+			rate_card = $10,
+			updated = now()
+		where pubkey = $1
+		  and service = $2
+		returning id, created, updated
+	`
+
+	sqlUpdateProviderJail = `
+		update providers
+		set jailed_until = greatest(jailed_until, $3),
+			updated = now()
+		where pubkey = $1
+		  and service = $2
+		returning id, created, updated
+	`
+
+	sqlUpdateProviderReputation = `
+		update providers
+		set reputation_score = $3,
+			reputation_weight = $4,
+			reputation_updated = $5,
 			updated = now()
 		where pubkey = $1
 		  and service = $2
@@ -33,7 +54,10 @@ var (
 			coalesce(status,'OFFLINE') as status,
 			coalesce(min_contract_duration,-1) as min_contract_duration,
 			coalesce(max_contract_duration,-1) as max_contract_duration,
-			coalesce(settlement_duration,-1) as settlement_duration
+			coalesce(settlement_duration,-1) as settlement_duration,
+			coalesce(rate_card::text,'{}') as rate_card,
+			provider_reputation_score(coalesce(reputation_score,0), reputation_updated, (select height from indexer_status limit 1)) as reputation_score,
+			coalesce(jailed_until,0) as jailed_until
 		from providers p
 		where p.pubkey = $1
 		  and p.service = $2
@@ -44,8 +68,8 @@ var (
 		where provider_bond_events.txid = $3
 		returning id, created, updated
 	`
-	sqlInsertModProviderEvent = `insert into provider_mod_events(provider_id,height,txid,metadata_uri,metadata_nonce,status,min_contract_duration,max_contract_duration)
-		values ($1,$2,$3,$4,$5,$6,$7,$8)
+	sqlInsertModProviderEvent = `insert into provider_mod_events(provider_id,height,txid,metadata_uri,metadata_nonce,status,min_contract_duration,max_contract_duration,rate_card)
+		values ($1,$2,$3,$4,$5,$6,$7,$8,$9)
 		on conflict on constraint provider_mod_events_txid_unq
 		do update set updated = now()
 		where provider_mod_events.txid = $3
@@ -172,4 +196,45 @@ var (
 	sqlServiceExists = `
 		SELECT 1 FROM providers WHERE service = $1 LIMIT 1
 	`
+
+	sqlFindProviderRateCard = `
+		SELECT coalesce(rate_card::text,'{}') FROM providers
+		WHERE id = $1
+	`
+
+	sqlFindProviderMetadata = `
+		select
+			id,
+			created,
+			updated,
+			provider_id,
+			nonce,
+			coalesce(version,'') as version,
+			coalesce(moniker,'') as moniker,
+			coalesce(website,'') as website,
+			coalesce(description,'') as description,
+			coalesce(location::text,'') as location,
+			coalesce(free_rate_limit,0) as free_rate_limit,
+			coalesce(free_rate_limit_duration,0) as free_rate_limit_duration
+		from provider_metadata
+		where provider_id = $1
+	`
+
+	sqlFindProviderBondEvents = `
+		select id, created, updated, provider_id, height, txid, bond_rel::text as bond_rel, bond_abs::text as bond_abs
+		from provider_bond_events
+		where provider_id = $1
+		  and id > $2
+		order by id
+		limit $3
+	`
+
+	sqlFindValidatorPayoutEvents = `
+		select id, created, updated, validator, height, coalesce(paid,0)::text as paid
+		from validator_payout_events
+		where ($1 = '' or validator = $1)
+		  and id > $2
+		order by id
+		limit $3
+	`
 )
@@ -100,6 +100,12 @@ func TestUpdateProvider(t *testing.T) {
 		PayAsYouGoRate: []cosmostypes.Coin{
 			cosmostypes.NewCoin("uarkeo", math.NewInt(10)),
 		},
+		RateCard: arkeotypes.RateCard{
+			PayAsYouGoTiers: []arkeotypes.RateTier{{
+				Threshold: 1000,
+				Rate:      []cosmostypes.Coin{cosmostypes.NewCoin("uarkeo", math.NewInt(5))},
+			}},
+		},
 	}
 	m.ExpectBegin().WillReturnError(fmt.Errorf("fail to begin tx"))
 	entity, err = db.UpdateProvider(context.Background(), p)
@@ -111,7 +117,8 @@ func TestUpdateProvider(t *testing.T) {
 	defer m1.Close()
 	m1.ExpectBegin()
 	m1.ExpectQuery("update providers.*").
-		WithArgs(p.Pubkey, p.Service, p.Bond, p.MetadataURI, p.MetadataNonce, p.Status, p.MinContractDuration, p.MaxContractDuration, p.SettlementDuration).
+		WithArgs(p.Pubkey, p.Service, p.Bond, p.MetadataURI, p.MetadataNonce, p.Status, p.MinContractDuration, p.MaxContractDuration, p.SettlementDuration,
+			`{"pay_as_you_go_tiers":[{"threshold":1000,"rate":[{"denom":"uarkeo","amount":"5"}]}],"subscription_tiers":null,"duration_discounts":null}`).
 		WillReturnRows(
 			pgxmock.NewRows([]string{"id", "created", "updated"}).
 				AddRow(int64(1), testTime, testTime),
@@ -143,9 +150,10 @@ func TestFindProvider(t *testing.T) {
 	m.ExpectQuery("select.*from providers.*").
 		WithArgs(testPubKey.String(), "mock").
 		WillReturnRows(pgxmock.NewRows([]string{
-			"id", "created", "updated", "pubkey", "service", "bond", "metadata_uri", "metadata_nonce", "status", "min_contract_duration", "max_contract_duration", "settlement_duration",
+			"id", "created", "updated", "pubkey", "service", "bond", "metadata_uri", "metadata_nonce", "status", "min_contract_duration", "max_contract_duration", "settlement_duration", "rate_card",
 		}).
-			AddRow(int64(1), testTime, testTime, testPubKey.String(), "mock", "1200", "http://localhost", uint64(1), "ONLINE", int64(10), int64(1000), int64(10)))
+			AddRow(int64(1), testTime, testTime, testPubKey.String(), "mock", "1200", "http://localhost", uint64(1), "ONLINE", int64(10), int64(1000), int64(10),
+				`{"subscription_tiers":[{"threshold":10,"rate":[{"denom":"uarkeo","amount":"80"}]}],"duration_discounts":[{"min_duration":1000,"discount_bps":500}]}`))
 	m.ExpectQuery("SELECT.*FROM provider_subscription_rates.*").
 		WithArgs(int64(1)).
 		WillReturnRows(
@@ -161,6 +169,135 @@ func TestFindProvider(t *testing.T) {
 	p, err := db.FindProvider(context.Background(), testPubKey.String(), "mock")
 	assert.Nil(t, err)
 	assert.NotNil(t, p)
+	assert.Len(t, p.RateCard.SubscriptionTiers, 1)
+	assert.Equal(t, int64(80), p.RateCard.SubscriptionTiers[0].Rate[0].Amount.Int64())
+	assert.Equal(t, int64(500), p.RateCard.GetDurationDiscount(1000))
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindProviders(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	testPubKey := arkeotypes.GetRandomPubKey()
+	m.ExpectQuery(`SELECT.*FROM providers_v p WHERE p.id > \$1 AND p.service = \$2 ORDER BY p.id ASC LIMIT 2`).
+		WithArgs(int64(10), "mock").
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "created", "pubkey", "service", "status", "metadata_uri", "metadata_nonce", "subscription_rate", "paygo_rate",
+			"min_contract_duration", "max_contract_duration", "bond", "reputation_score",
+		}).
+			AddRow(int64(11), testTime, testPubKey.String(), "mock", "ONLINE", "http://localhost", uint64(1), "10uarkeo", "2uarkeo",
+				int64(10), int64(1000), "1200", int64(9000)))
+	providers, err := db.FindProviders(context.Background(), "mock", Page{After: 10, Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, providers, 1)
+	assert.Equal(t, int64(11), providers[0].ID)
+	assert.Equal(t, int64(2), providers[0].PayAsYouGoRate.AmountOf("uarkeo").Int64())
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindProviderRates(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	m.ExpectQuery("SELECT.*FROM providers.*").
+		WithArgs(int64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"rate_card"}).
+			AddRow(`{"duration_discounts":[{"min_duration":1000,"discount_bps":500}]}`))
+	m.ExpectQuery("SELECT.*FROM provider_subscription_rates.*").
+		WithArgs(int64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "provider_id", "token_name", "token_amount"}).
+			AddRow(int64(1), int64(1), "uarkeo", int64(100)).
+			AddRow(int64(2), int64(1), "uatom", int64(5)))
+	m.ExpectQuery("SELECT.*FROM provider_pay_as_you_go_rates.*").
+		WithArgs(int64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "provider_id", "token_name", "token_amount"}))
+	rates, err := db.FindProviderRates(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Len(t, rates.SubscriptionRate, 2)
+	assert.Empty(t, rates.PayAsYouGoRate)
+	assert.Equal(t, int64(500), rates.RateCard.GetDurationDiscount(1000))
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindProviderMetadata(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("select.*from provider_metadata.*").
+		WithArgs(int64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "created", "updated", "provider_id", "nonce", "version", "moniker", "website", "description", "location", "free_rate_limit", "free_rate_limit_duration",
+		}).AddRow(int64(3), testTime, testTime, int64(1), int64(2), "1.0", "moniker", "https://example.com", "description", "(1,2)", int64(10), int64(60)))
+	metadata, err := db.FindProviderMetadata(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "moniker", metadata.Moniker)
+	assert.Equal(t, int64(60), metadata.FreeRateLimitDuration)
+
+	m.ExpectQuery("select.*from provider_metadata.*").
+		WithArgs(int64(2)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+	metadata, err = db.FindProviderMetadata(context.Background(), 2)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, metadata)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindProviderBondEvents(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("select.*from provider_bond_events.*").
+		WithArgs(int64(1), int64(0), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated", "provider_id", "height", "txid", "bond_rel", "bond_abs"}).
+			AddRow(int64(5), testTime, testTime, int64(1), int64(100), "txid", "100", "1100"))
+	events, err := db.FindProviderBondEvents(context.Background(), 1, Page{Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "1100", events[0].BondAbs)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindValidatorPayoutEvents(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("select.*from validator_payout_events.*").
+		WithArgs("", int64(4), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated", "validator", "height", "paid"}).
+			AddRow(int64(5), testTime, testTime, "validator", int64(100), "42"))
+	events, err := db.FindValidatorPayoutEvents(context.Background(), "", Page{After: 4, Limit: 10})
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "42", events[0].Paid)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestUpdateProviderReputation(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	testPubKey := arkeotypes.GetRandomPubKey()
+	m.ExpectQuery("update providers.*").
+		WithArgs(testPubKey.String(), "mock", int64(9000), "300", int64(100)).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "created", "updated"}).
+				AddRow(int64(1), testTime, testTime),
+		)
+	evt := arkeotypes.EventSubmitAttestation{
+		Provider: testPubKey,
+		Service:  "mock",
+		Weight:   math.NewInt(300),
+		Reputation: arkeotypes.ProviderReputation{
+			Score:        9000,
+			Weight:       math.NewInt(300),
+			LastUpdate:   100,
+			Attestations: 1,
+		},
+	}
+	entity, err := db.UpdateProviderReputation(context.Background(), evt)
+	assert.Nil(t, err)
+	assert.NotNil(t, entity)
+	assert.Equal(t, int64(1), entity.ID)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
 
@@ -238,7 +375,7 @@ func TestInsertModProviderEvent(t *testing.T) {
 	}
 	m.ExpectQuery("insert into provider_mod_events.*").
 		WithArgs(int64(1), evt.Height, evt.TxID, evt.MetadataURI, evt.MetadataNonce, evt.Status,
-			evt.MinContractDuration, evt.MaxContractDuration).
+			evt.MinContractDuration, evt.MaxContractDuration, `{"pay_as_you_go_tiers":null,"subscription_tiers":null,"duration_discounts":null}`).
 		WillReturnRows(
 			pgxmock.NewRows([]string{"id", "created", "updated"}).
 				AddRow(int64(1), testTime, testTime))
@@ -2,7 +2,10 @@ package db
 
 import (
 	"context"
+	"time"
 
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/huandu/go-sqlbuilder"
 	"github.com/pkg/errors"
 
 	"github.com/arkeonetwork/arkeo/directory/types"
@@ -36,3 +39,102 @@ func (d *DirectoryDB) GetArkeoNetworkStatsByService(ctx context.Context, service
 
 	return &stats, nil
 }
+
+// Usage rollup granularities
+const (
+	UsageHourly = "hour"
+	UsageDaily  = "day"
+)
+
+// Usage rollup breakdowns, the network totals aren't broken down
+const (
+	UsageByNetwork  = "network"
+	UsageByService  = "service"
+	UsageByProvider = "provider"
+)
+
+// UsageRollup is the activity of an hour or a day, of the network or broken down by service or
+// provider. Validator payouts don't belong to any service, they're only in the network totals
+type UsageRollup struct {
+	Bucket           time.Time `json:"bucket" db:"bucket"`
+	Service          string    `json:"service,omitempty" db:"service"`
+	ProviderPubkey   string    `json:"provider,omitempty" db:"provider_pubkey"`
+	ContractsOpened  int64     `json:"contracts_opened" db:"contracts_opened"`
+	ContractsClosed  int64     `json:"contracts_closed" db:"contracts_closed"`
+	Settlements      int64     `json:"settlements" db:"settlements"`
+	SettledVolume    int64     `json:"settled_volume" db:"settled_volume"`
+	ReserveTax       int64     `json:"reserve_tax" db:"reserve_tax"`
+	ValidatorPayouts int64     `json:"validator_payouts" db:"validator_payouts"`
+}
+
+// UsageQuery selects the rollups of FindUsageRollups, the buckets from From included to To
+// excluded. Empty Service and ProviderPubkey don't filter
+type UsageQuery struct {
+	Granularity    string
+	From           time.Time
+	To             time.Time
+	Service        string
+	ProviderPubkey string
+	GroupBy        string
+}
+
+// UpsertUsageRollups adds the stored events of the block to the hourly and daily usage rollups,
+// it's run in the transaction indexing the block so that every block is counted once
+func (d *DirectoryDB) UpsertUsageRollups(ctx context.Context, height int64, blockTime time.Time) error {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx, sqlUpsertUsageRollups, height, blockTime)
+	if err != nil {
+		return errors.Wrapf(err, "error upserting usage rollups of block %d", height)
+	}
+	rows.Close()
+	return rows.Err()
+}
+
+// FindUsageRollups returns the usage rollups of the query, sorted by bucket
+func (d *DirectoryDB) FindUsageRollups(ctx context.Context, query UsageQuery) ([]*UsageRollup, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	groupBy := []string{"r.bucket"}
+	switch query.GroupBy {
+	case UsageByService:
+		groupBy = append(groupBy, "r.service")
+	case UsageByProvider:
+		groupBy = append(groupBy, "r.service", "r.provider_pubkey")
+	}
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(append(groupBy, usageRollupSums)...).
+		From("usage_rollups r")
+	sb = sb.Where(
+		sb.Equal("r.granularity", query.Granularity),
+		sb.GreaterEqualThan("r.bucket", query.From),
+		sb.LessThan("r.bucket", query.To),
+	)
+	if query.GroupBy == UsageByService || query.GroupBy == UsageByProvider {
+		sb = sb.Where(sb.NotEqual("r.service", ""))
+	}
+	if query.Service != "" {
+		sb = sb.Where(sb.Equal("r.service", query.Service))
+	}
+	if query.ProviderPubkey != "" {
+		sb = sb.Where(sb.Equal("r.provider_pubkey", query.ProviderPubkey))
+	}
+	sb = sb.GroupBy(groupBy...).OrderBy(groupBy...).Asc()
+
+	q, params := sb.BuildWithFlavor(getFlavor())
+	log.Debugf("sql: %s\n%v", q, params)
+
+	rollups := make([]*UsageRollup, 0)
+	if err := pgxscan.Select(ctx, conn, &rollups, q, params...); err != nil {
+		return nil, errors.Wrapf(err, "error selecting usage rollups")
+	}
+	return rollups, nil
+}
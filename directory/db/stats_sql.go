@@ -72,3 +72,41 @@ var sqlGetNetworkStatsByService = `
 		AND (p7.service = $1)
 	) as total_paid
 	`
+
+// sqlUpsertUsageRollups adds the events of the block at $1 to the hourly and daily rollups
+// of the block time $2, the block events are read back from feed_events_v
+var sqlUpsertUsageRollups = `
+	INSERT INTO usage_rollups (granularity, bucket, service, provider_pubkey, contracts_opened, contracts_closed,
+		settlements, settled_volume, reserve_tax, validator_payouts)
+	SELECT g.granularity,
+		date_trunc(g.granularity, $2::timestamptz AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+		coalesce(e.service, ''),
+		coalesce(e.provider_pubkey, ''),
+		count(1) FILTER (WHERE e.event_type = 'open_contract'),
+		count(1) FILTER (WHERE e.event_type = 'close_contract'),
+		count(1) FILTER (WHERE e.event_type = 'contract_settlement'),
+		coalesce(sum((e.data ->> 'paid')::numeric) FILTER (WHERE e.event_type = 'contract_settlement'), 0),
+		coalesce(sum((e.data ->> 'reserve')::numeric) FILTER (WHERE e.event_type = 'contract_settlement'), 0),
+		coalesce(sum((e.data ->> 'paid')::numeric) FILTER (WHERE e.event_type = 'validator_payout'), 0)
+	FROM feed_events_v e
+	CROSS JOIN (VALUES ('hour'), ('day')) AS g(granularity)
+	WHERE e.height = $1
+	  AND e.event_type IN ('open_contract', 'close_contract', 'contract_settlement', 'validator_payout')
+	GROUP BY 1, 2, 3, 4
+	ON CONFLICT (granularity, bucket, service, provider_pubkey) DO UPDATE SET
+		contracts_opened  = usage_rollups.contracts_opened + EXCLUDED.contracts_opened,
+		contracts_closed  = usage_rollups.contracts_closed + EXCLUDED.contracts_closed,
+		settlements       = usage_rollups.settlements + EXCLUDED.settlements,
+		settled_volume    = usage_rollups.settled_volume + EXCLUDED.settled_volume,
+		reserve_tax       = usage_rollups.reserve_tax + EXCLUDED.reserve_tax,
+		validator_payouts = usage_rollups.validator_payouts + EXCLUDED.validator_payouts,
+		updated           = now()
+`
+
+var usageRollupSums = `
+	sum(r.contracts_opened)::bigint AS contracts_opened,
+	sum(r.contracts_closed)::bigint AS contracts_closed,
+	sum(r.settlements)::bigint AS settlements,
+	sum(r.settled_volume)::bigint AS settled_volume,
+	sum(r.reserve_tax)::bigint AS reserve_tax,
+	sum(r.validator_payouts)::bigint AS validator_payouts`
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pashagolub/pgxmock/v2"
 	"github.com/stretchr/testify/assert"
@@ -30,3 +31,42 @@ func TestGetArkeoNetworkStats(t *testing.T) {
 	assert.Equal(t, int64(7), state.TotalIncome)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
+
+func TestUpsertUsageRollups(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	blockTime := time.Date(2024, 5, 1, 13, 20, 0, 0, time.UTC)
+	m.ExpectQuery(`(?is)insert into usage_rollups.*from feed_events_v.*on conflict.*`).
+		WithArgs(int64(12), blockTime).
+		WillReturnRows(pgxmock.NewRows([]string{}))
+	assert.Nil(t, db.UpsertUsageRollups(context.Background(), 12, blockTime))
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindUsageRollups(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+	sums := []string{"contracts_opened", "contracts_closed", "settlements", "settled_volume", "reserve_tax", "validator_payouts"}
+
+	// network totals are summed over services and providers
+	m.ExpectQuery(`(?is)select r.bucket, .*sum.*from usage_rollups r where .* group by r.bucket order by r.bucket asc`).
+		WithArgs(UsageDaily, from, to).
+		WillReturnRows(pgxmock.NewRows(append([]string{"bucket"}, sums...)).
+			AddRow(from, int64(3), int64(1), int64(4), int64(400), int64(40), int64(7)))
+	rollups, err := db.FindUsageRollups(context.Background(), UsageQuery{Granularity: UsageDaily, From: from, To: to, GroupBy: UsageByNetwork})
+	assert.Nil(t, err)
+	assert.Equal(t, []*UsageRollup{{Bucket: from, ContractsOpened: 3, ContractsClosed: 1, Settlements: 4, SettledVolume: 400, ReserveTax: 40, ValidatorPayouts: 7}}, rollups)
+
+	// providers of a service, validator payouts don't belong to any
+	m.ExpectQuery(`(?is)select r.bucket, r.service, r.provider_pubkey, .*from usage_rollups r where .*r.service <> .* group by r.bucket, r.service, r.provider_pubkey .*`).
+		WithArgs(UsageHourly, from, to, "", "mock").
+		WillReturnRows(pgxmock.NewRows(append([]string{"bucket", "service", "provider_pubkey"}, sums...)).
+			AddRow(from, "mock", "provider", int64(1), int64(0), int64(0), int64(0), int64(0), int64(0)))
+	rollups, err = db.FindUsageRollups(context.Background(), UsageQuery{Granularity: UsageHourly, From: from, To: to, Service: "mock", GroupBy: UsageByProvider})
+	assert.Nil(t, err)
+	assert.Len(t, rollups, 1)
+	assert.Equal(t, "provider", rollups[0].ProviderPubkey)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -83,6 +83,13 @@ func upsert(ctx context.Context, conn IConnection, sql string, params ...interfa
 	return entity, nil
 }
 
+// Page selects up to Limit rows with an id above After, in id order. Paging on the
+// id keeps the pages stable while rows are inserted
+type Page struct {
+	After int64
+	Limit int
+}
+
 func getFlavor() sqlbuilder.Flavor {
 	return sqlbuilder.PostgreSQL
 }
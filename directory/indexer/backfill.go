@@ -0,0 +1,189 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const defaultBackfillProgressInterval = 10 * time.Second
+
+// stagedRange is a range of blocks fetched by a backfill worker, done is closed once the
+// worker is finished with it, blocks hold what was fetched before an error if any
+type stagedRange struct {
+	gap    db.BlockGap
+	blocks []*stagedBlock
+	err    error
+	done   chan struct{}
+}
+
+func newStagedRange(start, end int64) *stagedRange {
+	return &stagedRange{
+		gap:  db.BlockGap{Start: start, End: end},
+		done: make(chan struct{}),
+	}
+}
+
+func (r *stagedRange) fetch(ctx context.Context, s *Service) {
+	defer close(r.done)
+	for height := r.gap.Start; height <= r.gap.End; height++ {
+		if err := ctx.Err(); err != nil {
+			r.err = err
+			return
+		}
+		block, err := s.retrieveBlock(ctx, height)
+		if err != nil {
+			r.err = fmt.Errorf("error retrieving block %d: %w", height, err)
+			return
+		}
+		r.blocks = append(r.blocks, block)
+	}
+}
+
+// fillGap will consume all the blocks from arkeo node, and index all the events in it.
+// It runs in two phases, workers fetch ranges of blocks from the node concurrently into
+// a staging area, and a single applier indexes the staged blocks strictly in order, as
+// the events of a block depend on the ones before it, ie a contract closed after it was
+// opened. It stops at the first block that fails, the failed block is rolled back as a
+// whole and retried by the next gap fill
+func (s *Service) fillGap(gap db.BlockGap) error {
+	params := s.params.Backfill.withDefaults()
+	s.logger.Infof("gap filling %s", gap)
+
+	// a gap below the last stored block is a hole being repaired, it doesn't move the
+	// indexed or target heights
+	ctxTarget, cancelTarget := context.WithTimeout(context.Background(), defaultDBWriteTimeout)
+	advance := true
+	if latest, err := s.db.FindLatestBlock(ctxTarget); err == nil && latest.Height > gap.End {
+		advance = false
+	} else if _, err := s.db.UpsertIndexerTarget(ctxTarget, gap.End); err != nil {
+		s.logger.WithError(err).Errorf("failed to upsert indexer target height %d", gap.End)
+	}
+	cancelTarget()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	workers := &sync.WaitGroup{}
+	defer func() {
+		cancel()
+		workers.Wait()
+	}()
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// ranges are queued in order to both the workers and the applier, the applier
+	// waits on each range in turn while the workers fill them in any order. The
+	// ranges queue bounds how far the workers can fetch ahead of the applier
+	tasks := make(chan *stagedRange)
+	ranges := make(chan *stagedRange, params.Workers)
+	for i := 0; i < params.Workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for r := range tasks {
+				r.fetch(ctx, s)
+			}
+		}()
+	}
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		defer close(tasks)
+		defer close(ranges)
+		for start := gap.Start; start <= gap.End; start += params.BatchSize {
+			r := newStagedRange(start, min(start+params.BatchSize-1, gap.End))
+			select {
+			case ranges <- r:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case tasks <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	progress := newBackfillProgress(gap)
+	for r := range ranges {
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			s.logger.Info("shutdown requested; stopping gap fill")
+			return nil
+		}
+		for _, block := range r.blocks {
+			select {
+			case <-s.done:
+				s.logger.Info("shutdown requested; stopping gap fill")
+				return nil
+			default:
+			}
+			s.logger.Debugf("processing block: %d", block.block.Block.Height)
+
+			if _, err := s.indexBlock(block, advance); err != nil {
+				return fmt.Errorf("error consuming block %d: %w", block.block.Block.Height, err)
+			}
+			progress.indexed(block.block.Block.Height)
+			if progress.due() {
+				s.logger.Info(progress)
+			}
+		}
+		if r.err != nil {
+			return r.err
+		}
+	}
+	if gap.End > gap.Start {
+		s.logger.Info(progress)
+	}
+	return nil
+}
+
+// backfillProgress tracks how far a gap fill got, to be logged every defaultBackfillProgressInterval
+type backfillProgress struct {
+	gap      db.BlockGap
+	height   int64
+	started  time.Time
+	reported time.Time
+}
+
+func newBackfillProgress(gap db.BlockGap) *backfillProgress {
+	now := time.Now()
+	return &backfillProgress{
+		gap:      gap,
+		height:   gap.Start - 1,
+		started:  now,
+		reported: now,
+	}
+}
+
+func (p *backfillProgress) indexed(height int64) {
+	p.height = height
+}
+
+func (p *backfillProgress) due() bool {
+	if time.Since(p.reported) < defaultBackfillProgressInterval {
+		return false
+	}
+	p.reported = time.Now()
+	return true
+}
+
+func (p *backfillProgress) String() string {
+	done := p.height - p.gap.Start + 1
+	total := p.gap.End - p.gap.Start + 1
+	rate := float64(done) / time.Since(p.started).Seconds()
+	msg := fmt.Sprintf("gap fill %s at block %d, %d/%d blocks (%.1f%%), %.1f blocks/s", p.gap, p.height, done, total, float64(done)*100/float64(total), rate)
+	if rate > 0 && done < total {
+		msg += fmt.Sprintf(", eta %s", time.Duration(float64(total-done)/rate*float64(time.Second)).Round(time.Second))
+	}
+	return msg
+}
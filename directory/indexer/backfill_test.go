@@ -0,0 +1,138 @@
+package indexer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/common/logging"
+	"github.com/arkeonetwork/arkeo/common/utils"
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+// newMockChain serves the block and block_results rpc of a chain, the lower heights are
+// served slower so that the workers finish them out of order. failHeight isn't served
+func newMockChain(t *testing.T, failHeight int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		var params struct {
+			Height string `json:"height"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			t.Error(err)
+			return
+		}
+		height, err := strconv.ParseInt(params.Height, 10, 64)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		time.Sleep(time.Duration(20-height) * time.Millisecond)
+
+		resp := rpctypes.NewRPCErrorResponse(req.ID, -32603, "height not available", "")
+		if height != failHeight {
+			switch req.Method {
+			case "block":
+				block := tmtypes.MakeBlock(height, nil, &tmtypes.Commit{}, nil)
+				resp = rpctypes.NewRPCSuccessResponse(req.ID, &ctypes.ResultBlock{Block: block})
+			case "block_results":
+				resp = rpctypes.NewRPCSuccessResponse(req.ID, &ctypes.ResultBlockResults{Height: height})
+			}
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+func TestFillGap(t *testing.T) {
+	newService := func(server *httptest.Server, latest *db.Block) (*Service, *[]int64) {
+		client, err := utils.NewTendermintClient(server.URL)
+		assert.Nil(t, err)
+		indexed := make([]int64, 0)
+		mockDb := new(db.MockDataStorage)
+		if latest == nil {
+			mockDb.On("FindLatestBlock", mock.Anything).Return(nil, db.ErrNotFound)
+		} else {
+			mockDb.On("FindLatestBlock", mock.Anything).Return(latest, nil)
+		}
+		mockDb.On("UpsertIndexerTarget", mock.Anything, int64(15)).Return(&db.Entity{ID: 1}, nil)
+		mockDb.On("RunInTx", mock.Anything).Return(nil)
+		mockDb.On("InsertBlock", mock.Anything, mock.Anything).Return(&db.Entity{ID: 1}, nil).Run(func(args mock.Arguments) {
+			//nolint:forcetypeassert
+			indexed = append(indexed, args.Get(1).(*db.Block).Height)
+		})
+		mockDb.On("UpsertIndexerStatus", mock.Anything, mock.Anything).Return(&db.Entity{ID: 1}, nil)
+		mockDb.On("NotifyBlockIndexed", mock.Anything, mock.Anything).Return(nil)
+		mockDb.On("UpsertUsageRollups", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		return &Service{
+			params: ServiceParams{
+				Backfill: BackfillParams{Workers: 4, BatchSize: 2},
+			},
+			db:             mockDb,
+			done:           make(chan struct{}),
+			wg:             &sync.WaitGroup{},
+			logger:         logging.WithoutFields(),
+			tmClient:       client,
+			blockFillQueue: make(chan db.BlockGap),
+		}, &indexed
+	}
+
+	// blocks fetched concurrently are indexed in order
+	server := newMockChain(t, 0)
+	defer server.Close()
+	s, indexed := newService(server, nil)
+	assert.Nil(t, s.fillGap(db.BlockGap{Start: 1, End: 15}))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, *indexed)
+
+	// the blocks before a block that can't be fetched are indexed, none after it
+	failing := newMockChain(t, 6)
+	defer failing.Close()
+	s, indexed = newService(failing, nil)
+	assert.NotNil(t, s.fillGap(db.BlockGap{Start: 1, End: 15}))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, *indexed)
+
+	// a hole below the last stored block is filled without moving the indexed height
+	s, indexed = newService(server, &db.Block{Height: 20})
+	assert.Nil(t, s.fillGap(db.BlockGap{Start: 1, End: 15}))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, *indexed)
+	//nolint:forcetypeassert
+	mockDb := s.db.(*db.MockDataStorage)
+	mockDb.AssertNotCalled(t, "UpsertIndexerTarget", mock.Anything, mock.Anything)
+	mockDb.AssertNotCalled(t, "UpsertIndexerStatus", mock.Anything, mock.Anything)
+	mockDb.AssertNotCalled(t, "NotifyBlockIndexed", mock.Anything, mock.Anything)
+}
+
+func TestSampleHeights(t *testing.T) {
+	s := &Service{}
+	assert.Empty(t, s.sampleHeights(1))
+	assert.Equal(t, []int64{1, 2, 3}, s.sampleHeights(4))
+	assert.Equal(t, []int64{1, 13, 26, 38, 51, 63, 76, 88}, s.sampleHeights(101))
+
+	s.params.Backfill.StartHeight = 50
+	assert.Equal(t, []int64{50, 56, 62, 69, 75, 81, 88, 94}, s.sampleHeights(101))
+}
+
+func TestBackfillParamsDefaults(t *testing.T) {
+	params := BackfillParams{}.withDefaults()
+	assert.Equal(t, defaultBackfillWorkers, params.Workers)
+	assert.Equal(t, int64(defaultBackfillBatchSize), params.BatchSize)
+
+	params = BackfillParams{Workers: 8, BatchSize: 500}.withDefaults()
+	assert.Equal(t, 8, params.Workers)
+	assert.Equal(t, int64(500), params.BatchSize)
+}
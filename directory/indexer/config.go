@@ -2,14 +2,41 @@ package indexer
 
 import "github.com/arkeonetwork/arkeo/directory/db"
 
+const (
+	defaultBackfillWorkers   = 1
+	defaultBackfillBatchSize = 100
+)
+
 // ServiceParams hold all necessary parameters for indexer app to run
 type ServiceParams struct {
-	ArkeoApi            string      `mapstructure:"arkeo_api" json:"arkeo_api"`
-	TendermintApi       string      `mapstructure:"tendermint_api" json:"tendermint_api"`
-	TendermintWs        string      `mapstructure:"tendermint_ws" json:"tendermint_ws"`
-	ChainID             string      `mapstructure:"chain_id" json:"chain_id"`
-	Bech32PrefixAccAddr string      `mapstructure:"bech32_pref_acc_addr" json:"bech32_pref_acc_addr"`
-	Bech32PrefixAccPub  string      `mapstructure:"bech32_pref_acc_pub" json:"bech32_pref_acc_pub"`
-	IndexerID           int64       `json:"-"`
-	DB                  db.DBConfig `mapstructure:"db" json:"db"`
+	ArkeoApi            string         `mapstructure:"arkeo_api" json:"arkeo_api"`
+	TendermintApi       string         `mapstructure:"tendermint_api" json:"tendermint_api"`
+	TendermintWs        string         `mapstructure:"tendermint_ws" json:"tendermint_ws"`
+	ChainID             string         `mapstructure:"chain_id" json:"chain_id"`
+	Bech32PrefixAccAddr string         `mapstructure:"bech32_pref_acc_addr" json:"bech32_pref_acc_addr"`
+	Bech32PrefixAccPub  string         `mapstructure:"bech32_pref_acc_pub" json:"bech32_pref_acc_pub"`
+	IndexerID           int64          `json:"-"`
+	DB                  db.DBConfig    `mapstructure:"db" json:"db"`
+	Backfill            BackfillParams `mapstructure:"backfill" json:"backfill"`
+}
+
+// BackfillParams configure how historical blocks are fetched, workers fetch ranges of
+// BatchSize blocks concurrently while the blocks are always indexed in order
+type BackfillParams struct {
+	Workers   int   `mapstructure:"workers" json:"workers"`
+	BatchSize int64 `mapstructure:"batch_size" json:"batch_size"`
+	// StartHeight is the first height indexed into an empty database, EndHeight the last height
+	// indexed, 0 to follow the chain
+	StartHeight int64 `mapstructure:"start_height" json:"start_height"`
+	EndHeight   int64 `mapstructure:"end_height" json:"end_height"`
+}
+
+func (p BackfillParams) withDefaults() BackfillParams {
+	if p.Workers <= 0 {
+		p.Workers = defaultBackfillWorkers
+	}
+	if p.BatchSize <= 0 {
+		p.BatchSize = defaultBackfillBatchSize
+	}
+	return p
 }
@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const (
+	defaultConsistencyCheckTimeout = time.Second * 30
+	// consistencySamples is how many stored blocks below the last one are re-verified on start
+	consistencySamples = 8
+)
+
+// checkConsistency re-verifies the last indexed block, and a sample of the blocks stored before it,
+// against the chain before indexing resumes. A stored block the chain doesn't have, or has with
+// another hash, means the database was filled from another chain or node and indexing on top of it
+// would mix the two, so it fails instead. The heights missing below the last stored block are
+// returned, to be filled. The indexer status is brought back in line with the stored block, which
+// is the commit marker
+func (s *Service) checkConsistency() ([]db.BlockGap, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultConsistencyCheckTimeout)
+	defer cancel()
+	stored, err := s.db.FindLatestBlock(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			// nothing indexed yet
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to find latest stored block,err: %w", err)
+	}
+	if err := s.verifyBlock(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	samples, err := s.db.FindBlocks(ctx, s.sampleHeights(stored.Height))
+	if err != nil {
+		return nil, fmt.Errorf("fail to find sampled stored blocks,err: %w", err)
+	}
+	for _, block := range samples {
+		if err := s.verifyBlock(ctx, block); err != nil {
+			return nil, err
+		}
+	}
+
+	gaps, err := s.db.FindBlockGaps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to find block gaps,err: %w", err)
+	}
+	for _, gap := range gaps {
+		s.logger.WithField("gap", gap.String()).Warn("blocks missing below the last stored block")
+	}
+
+	status, err := s.db.FindIndexerStatus(ctx)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, fmt.Errorf("fail to find indexer status,err: %w", err)
+	}
+	if status != nil && status.Height == stored.Height {
+		return gaps, nil
+	}
+	s.logger.WithField("height", stored.Height).Warn("indexer status out of line with the stored block, resetting it")
+	if _, err = s.db.UpsertIndexerStatus(ctx, stored.Height); err != nil {
+		return nil, fmt.Errorf("fail to reset indexer status to height %d,err: %w", stored.Height, err)
+	}
+	return gaps, nil
+}
+
+// verifyBlock checks the stored block has the hash of the chain block at its height
+func (s *Service) verifyBlock(ctx context.Context, stored *db.Block) error {
+	height := stored.Height
+	chainBlock, err := s.tmClient.Block(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("fail to read stored block %d from chain,err: %w", stored.Height, err)
+	}
+	if hash := chainBlock.Block.Hash().String(); !strings.EqualFold(hash, stored.Hash) {
+		return fmt.Errorf("stored block %d has hash %s, chain has %s", stored.Height, stored.Hash, hash)
+	}
+	return nil
+}
+
+// sampleHeights spreads consistencySamples heights evenly from the first indexed height to the
+// one before the given height, heights that aren't stored are left out by the lookup
+func (s *Service) sampleHeights(height int64) []int64 {
+	first := max(s.params.Backfill.StartHeight, 1)
+	span := height - first
+	if span <= 0 {
+		return []int64{}
+	}
+	n := min(span, consistencySamples)
+	heights := make([]int64, 0, n)
+	for i := int64(0); i < n; i++ {
+		heights = append(heights, first+i*span/n)
+	}
+	return heights
+}
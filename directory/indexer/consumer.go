@@ -123,8 +123,14 @@ func (s *Service) consumeEvents() error {
 	}
 }
 
-// consumeHistoricalBlock index one block at a time
-func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, err error) {
+// stagedBlock is a block fetched from the chain, waiting to be indexed
+type stagedBlock struct {
+	block   *ctypes.ResultBlock
+	results *ctypes.ResultBlockResults
+}
+
+// retrieveBlock fetches the block and the block results at the given height
+func (s *Service) retrieveBlock(ctx context.Context, blockHeight int64) (*stagedBlock, error) {
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 
@@ -135,7 +141,7 @@ func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, e
 	go func() {
 		defer wg.Done()
 		start := time.Now()
-		ctx, cancel := context.WithTimeout(context.Background(), defaultRetrieveBlockTimeout)
+		ctx, cancel := context.WithTimeout(ctx, defaultRetrieveBlockTimeout)
 		defer cancel()
 		block, blockErr = s.tmClient.Block(ctx, &blockHeight)
 		// TODO: change this to use prometheus
@@ -147,7 +153,7 @@ func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, e
 	go func() {
 		defer wg.Done()
 		start := time.Now()
-		ctx, cancel := context.WithTimeout(context.Background(), defaultRetrieveBlockTimeout)
+		ctx, cancel := context.WithTimeout(ctx, defaultRetrieveBlockTimeout)
 		defer cancel()
 		blockResults, resultsErr = s.tmClient.BlockResults(ctx, &blockHeight)
 		if time.Since(start) > 500*time.Millisecond {
@@ -162,7 +168,14 @@ func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, e
 	if resultsErr != nil {
 		return nil, fmt.Errorf("fail to read blockresult,err: %w", resultsErr)
 	}
+	return &stagedBlock{block: block, results: blockResults}, nil
+}
 
+// indexBlock index one block at a time, the block is only stored once all its events are indexed.
+// advance moves the indexer status to the block and notifies the event feed, it is false for the
+// blocks of a hole filled below the indexed height
+func (s *Service) indexBlock(staged *stagedBlock, advance bool) (*db.Block, error) {
+	block, blockResults := staged.block, staged.results
 	log := s.logger.WithField("height", block.Block.Height)
 
 	if len(blockResults.TxsResults) != len(block.Block.Txs) {
@@ -171,27 +184,57 @@ func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, e
 			Warn("tx results count mismatch")
 	}
 
-	for i, transaction := range block.Block.Txs {
-		var txResult *abcitypes.ExecTxResult
-		if i < len(blockResults.TxsResults) {
-			txResult = blockResults.TxsResults[i]
+	r := &db.Block{
+		Height:    block.Block.Height,
+		Hash:      block.Block.Hash().String(),
+		BlockTime: block.Block.Time,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultIndexBlockTimeout)
+	defer cancel()
+	// all the writes of the block are made in one transaction, so that a block is either
+	// indexed as a whole or not at all
+	err := s.db.RunInTx(ctx, func(tx db.IDataStorage) error {
+		scoped := s.withStore(tx)
+		for i, transaction := range block.Block.Txs {
+			var txResult *abcitypes.ExecTxResult
+			if i < len(blockResults.TxsResults) {
+				txResult = blockResults.TxsResults[i]
+			}
+			if err := scoped.handleTransaction(block.Block.Height, transaction, txResult); err != nil {
+				return fmt.Errorf("fail to handle transaction,err: %w", err)
+			}
 		}
-		if err := s.handleTransaction(block.Block.Height, transaction, txResult); err != nil {
-			log.WithError(err).Error("fail to handle transaction")
+
+		for _, event := range blockResults.FinalizeBlockEvents {
+			log.Debugf("received %s endblock event", event.Type)
+			if err := scoped.handleAbciEvent(event, nil, block.Block.Height); err != nil {
+				return fmt.Errorf("error handling %s endblock event,err: %w", event.Type, err)
+			}
 		}
-	}
 
-	for _, event := range blockResults.FinalizeBlockEvents {
-		log.Debugf("received %s endblock event", event.Type)
-		if err := s.handleAbciEvent(event, nil, block.Block.Height); err != nil {
-			log.WithError(err).Errorf("error handling abci event %#v", event)
+		if err := tx.UpsertUsageRollups(ctx, r.Height, r.BlockTime); err != nil {
+			return fmt.Errorf("error rolling up usage of block %d,err: %w", r.Height, err)
 		}
-	}
 
-	r := &db.Block{
-		Height:    block.Block.Height,
-		Hash:      block.Block.Hash().String(),
-		BlockTime: block.Block.Time,
+		// the block is written last, it marks the block as indexed once the transaction commits
+		if _, err := tx.InsertBlock(ctx, r); err != nil {
+			return fmt.Errorf("error inserting block %d with hash %s,err: %w", r.Height, r.Hash, err)
+		}
+		if !advance {
+			return nil
+		}
+		if _, err := tx.UpsertIndexerStatus(ctx, r.Height); err != nil {
+			return fmt.Errorf("error upserting indexer status for height %d,err: %w", r.Height, err)
+		}
+		// subscribers of the event feed are notified once the block is committed
+		if err := tx.NotifyBlockIndexed(ctx, r.Height); err != nil {
+			return fmt.Errorf("error notifying block %d,err: %w", r.Height, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return r, nil
 }
@@ -203,8 +246,7 @@ func (s *Service) handleTransaction(height int64, transaction tmtypes.Tx, txResu
 	for _, event := range txResult.Events {
 		s.logger.WithField("height", height).Debugf("received %s txevent", event.Type)
 		if err := s.handleAbciEvent(event, transaction, height); err != nil {
-			// move on
-			s.logger.WithError(err).WithField("event", Stringfy(event)).Errorf("error handling abci event")
+			return fmt.Errorf("error handling abci event %s,err: %w", Stringfy(event), err)
 		}
 	}
 	return nil
@@ -247,6 +289,14 @@ func (s *Service) handleAbciEvent(event abcitypes.Event, transaction tmtypes.Tx,
 		if err := s.handleOpenContractEvent(ctx, contractOpenEvent, txID, height); err != nil {
 			return err
 		}
+	case atypes.EventTypeTopUpContract:
+		topUpContractEvent, err := parseEventToConcreteType[atypes.EventTopUpContract](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleTopUpContractEvent(ctx, topUpContractEvent); err != nil {
+			return err
+		}
 	case atypes.EventTypeSettleContract:
 		eventSettleContract, err := parseEventToConcreteType[atypes.EventSettleContract](event)
 		if err != nil {
@@ -255,6 +305,41 @@ func (s *Service) handleAbciEvent(event abcitypes.Event, transaction tmtypes.Tx,
 		if err := s.handleContractSettlementEvent(ctx, eventSettleContract, txID, height); err != nil {
 			return err
 		}
+	case atypes.EventTypeSubmitAttestation:
+		attestationEvent, err := parseEventToConcreteType[atypes.EventSubmitAttestation](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleSubmitAttestationEvent(ctx, attestationEvent); err != nil {
+			return err
+		}
+	case atypes.EventTypeProviderSlashed:
+		// the slashed bond comes with its own bond event, the slash jails the provider
+		slashedEvent, err := parseEventToConcreteType[atypes.EventProviderSlashed](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleProviderSlashedEvent(ctx, slashedEvent); err != nil {
+			return err
+		}
+		attrJSON, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return err
+		}
+		if err := s.handleGenericEvent(ctx, event.Type, txID, height, attrJSON); err != nil {
+			return err
+		}
+	case atypes.EventTypeProviderUnbonding, atypes.EventTypeProviderUnbonded, atypes.EventTypeSetConfig,
+		atypes.EventTypeSetContractRenewal, atypes.EventTypeContractRenewed, atypes.EventTypeContractRenewalFailed,
+		atypes.EventTypeOpenContractGroup, atypes.EventTypeSettleContractGroup, atypes.EventTypeSetDelegateLimit,
+		atypes.EventTypeSetProber:
+		attrJSON, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return err
+		}
+		if err := s.handleGenericEvent(ctx, event.Type, txID, height, attrJSON); err != nil {
+			return err
+		}
 	case atypes.EventTypeValidatorPayout:
 		// Intentionally ignored: writing these to `validator_payout_events` is very high-volume
 		// and isn't currently used by the directory/indexer.
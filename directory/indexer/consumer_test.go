@@ -100,6 +100,21 @@ func TestEventParsing(t *testing.T) {
 				assert.Equal(t, int64(1000000000), e.BondAbs.Int64())
 			},
 		},
+		{
+			Name:    "EventProviderSlashed",
+			Payload: `{"type": "arkeo.arkeo.EventProviderSlashed", "attributes": [ { "key": "contract_id", "value": "\"2\"", "index": true }, { "key": "jailed_until", "value": "\"2500\"", "index": true }, { "key": "nonce", "value": "\"5\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve\"", "index": true }, { "key": "reporter", "value": "\"tarkeo19358z26jwh3e4rd6psxqf8q6f3pe6f8s7v0x2a\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true }, { "key": "slashed", "value": "\"100000000\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				assert.IsType(t, arkeotypes.EventProviderSlashed{}, result)
+				e, ok := result.(arkeotypes.EventProviderSlashed)
+				assert.True(t, ok)
+				assert.Equal(t, "mock", e.Service)
+				assert.Equal(t, "tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve", e.Provider.String())
+				assert.Equal(t, uint64(2), e.ContractId)
+				assert.Equal(t, int64(5), e.Nonce)
+				assert.Equal(t, int64(100000000), e.Slashed.Int64())
+				assert.Equal(t, int64(2500), e.JailedUntil)
+			},
+		},
 	}
 	for _, c := range inputs {
 		var event abcitypes.Event
@@ -117,6 +132,8 @@ func TestEventParsing(t *testing.T) {
 			result, err = parseEventToConcreteType[arkeotypes.EventModProvider](event)
 		case arkeotypes.EventTypeBondProvider:
 			result, err = parseEventToConcreteType[arkeotypes.EventBondProvider](event)
+		case arkeotypes.EventTypeProviderSlashed:
+			result, err = parseEventToConcreteType[arkeotypes.EventProviderSlashed](event)
 		}
 		assert.Nil(t, err)
 		c.Checker(t, result)
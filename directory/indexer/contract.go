@@ -29,6 +29,13 @@ func (s *Service) handleCloseContractEvent(ctx context.Context, evt atypes.Event
 	return nil
 }
 
+func (s *Service) handleTopUpContractEvent(ctx context.Context, evt atypes.EventTopUpContract) error {
+	if _, err := s.db.TopUpContract(ctx, evt); err != nil {
+		return errors.Wrapf(err, "error topping up contract %d", evt.ContractId)
+	}
+	return nil
+}
+
 func (s *Service) handleContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract, txID string, height int64) error {
 	if _, err := s.db.UpsertContractSettlementEvent(ctx, evt, txID, height); err != nil {
 		return errors.Wrapf(err, "error upserting contract settlement event")
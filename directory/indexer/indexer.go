@@ -20,6 +20,7 @@ const (
 	defaultHandleEventTimeout   = time.Second * 5
 	defaultFindLastBlockTimeout = time.Second
 	defaultDBWriteTimeout       = time.Second * 5
+	defaultIndexBlockTimeout    = time.Minute
 )
 
 // Service consume events from blockchain and persist it to a database
@@ -60,6 +61,10 @@ func NewIndexer(params ServiceParams) (*Service, error) {
 // Run start the indexer service
 func (s *Service) Run() error {
 	s.logger.Info("start to indexer service")
+	gaps, err := s.checkConsistency()
+	if err != nil {
+		return fmt.Errorf("fail consistency check,err: %w", err)
+	}
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -69,6 +74,18 @@ func (s *Service) Run() error {
 	}()
 	s.wg.Add(1)
 	go s.blockGapProcessor()
+	// the gaps found below the last stored block are queued behind whatever gap fill is running
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for _, gap := range gaps {
+			select {
+			case s.blockFillQueue <- gap:
+			case <-s.done:
+				return
+			}
+		}
+	}()
 	return nil
 }
 
@@ -95,13 +112,20 @@ func (s *Service) gapFiller() error {
 		return fmt.Errorf("fail to find latest block,err: %w", err)
 	}
 	var todo db.BlockGap
-	if latest.Block.Height-latestStored.Height <= 0 {
+	start := latestStored.Height + 1
+	if latestStored.Height == 0 && start < s.params.Backfill.StartHeight {
+		start = s.params.Backfill.StartHeight
+	}
+	end := latest.Block.Height
+	if s.params.Backfill.EndHeight > 0 && end > s.params.Backfill.EndHeight {
+		end = s.params.Backfill.EndHeight
+	}
+	if end < start {
 		return nil
 	}
 
-	start := latestStored.Height + 1
-	s.logger.Infof("%d missed blocks from %d to current %d", latest.Block.Height-latestStored.Height, latestStored.Height, latest.Block.Height)
-	todo = db.BlockGap{Start: start, End: latest.Block.Height}
+	s.logger.Infof("%d missed blocks from %d to current %d", end-start+1, start-1, end)
+	todo = db.BlockGap{Start: start, End: end}
 	select {
 	// blockFillQueue is a blocking channel, only one gapfill task can be push into this channel when block gap processor is waiting on the other end
 	// this ensures the service is only indexing one block at a time
@@ -147,41 +171,12 @@ func (s *Service) blockGapProcessor() {
 	}
 }
 
-// fillGap will consume all the blocks from arkeo node, and index all the events in it
-func (s *Service) fillGap(gap db.BlockGap) error {
-	s.logger.Infof("gap filling %s", gap)
-
-	for i := gap.Start; i <= gap.End; i++ {
-		select {
-		case <-s.done:
-			s.logger.Info("shutdown requested; stopping gap fill")
-			return nil
-		default:
-		}
-		s.logger.Infof("processing block: %d", i)
-
-		block, err := s.consumeHistoricalBlock(i)
-		if err != nil {
-			return fmt.Errorf("error consuming block %d: %w", i, err)
-		}
-
-		ctxInsertBlock, cancelInsertBlock := context.WithTimeout(context.Background(), defaultDBWriteTimeout)
-		_, err = s.db.InsertBlock(ctxInsertBlock, block)
-		cancelInsertBlock()
-		if err != nil {
-			s.logger.WithError(err).Errorf("error inserting block %d with hash %s", block.Height, block.Hash)
-			continue
-		}
-
-		// Upsert indexer status to track latest block height (best-effort)
-		ctxUpsertStatus, cancelUpsertStatus := context.WithTimeout(context.Background(), defaultDBWriteTimeout)
-		_, err = s.db.UpsertIndexerStatus(ctxUpsertStatus, i)
-		cancelUpsertStatus()
-		if err != nil {
-			s.logger.WithError(err).Errorf("failed to upsert indexer status for height %d", i)
-		}
-	}
-	return nil
+// withStore returns a copy of the service whose event handlers write to the given
+// store, it is used to run the handlers of a block within the block's transaction
+func (s *Service) withStore(store db.IDataStorage) *Service {
+	scoped := *s
+	scoped.db = store
+	return &scoped
 }
 
 // Close will be called when it is time to shut down the service
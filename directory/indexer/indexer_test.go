@@ -1 +1,53 @@
 package indexer
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/common/logging"
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+func TestHandleTransactionInBlockStore(t *testing.T) {
+	mockDb := new(db.MockDataStorage)
+	s := Service{
+		params:         ServiceParams{},
+		db:             mockDb,
+		done:           make(chan struct{}),
+		wg:             &sync.WaitGroup{},
+		logger:         logging.WithoutFields(),
+		tmClient:       nil,
+		blockFillQueue: make(chan db.BlockGap),
+	}
+	txResult := &abcitypes.ExecTxResult{
+		Events: []abcitypes.Event{
+			{Type: "create_validator", Attributes: []abcitypes.EventAttribute{{Key: "validator", Value: "a"}}},
+			{Type: "edit_validator", Attributes: []abcitypes.EventAttribute{{Key: "validator", Value: "a"}}},
+		},
+	}
+
+	// the handlers of a scoped service write to the block store
+	blockDb := new(db.MockDataStorage)
+	blockDb.On("InsertGenericEvent", mock.Anything, mock.Anything, mock.Anything, int64(1), mock.Anything).Return(&db.Entity{ID: 1}, nil)
+	err := s.withStore(blockDb).handleTransaction(1, tmtypes.Tx("tx"), txResult)
+	assert.Nil(t, err)
+	blockDb.AssertNumberOfCalls(t, "InsertGenericEvent", 2)
+	mockDb.AssertNotCalled(t, "InsertGenericEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// a failed event fails the transaction, the events after it are not handled
+	blockDb = new(db.MockDataStorage)
+	blockDb.On("InsertGenericEvent", mock.Anything, "create_validator", mock.Anything, int64(1), mock.Anything).Return(nil, fmt.Errorf("fail to insert"))
+	err = s.withStore(blockDb).handleTransaction(1, tmtypes.Tx("tx"), txResult)
+	assert.NotNil(t, err)
+	blockDb.AssertNumberOfCalls(t, "InsertGenericEvent", 1)
+
+	// a transaction without result can't be indexed
+	err = s.handleTransaction(1, tmtypes.Tx("tx"), nil)
+	assert.NotNil(t, err)
+}
@@ -30,6 +30,7 @@ func (s *Service) handleModProviderEvent(ctx context.Context, evt atypes.EventMo
 	provider.SubscriptionRate = evt.SubscriptionRate
 	provider.PayAsYouGoRate = evt.PayAsYouGoRate
 	provider.SettlementDuration = evt.SettlementDuration
+	provider.RateCard = evt.RateCard
 
 	if _, err = s.db.UpdateProvider(ctx, provider); err != nil {
 		return fmt.Errorf("error updating provider for mod event %s service %s,err: %w", provider.Pubkey, provider.Service, err)
@@ -50,6 +51,7 @@ func (s *Service) handleModProviderEvent(ctx context.Context, evt atypes.EventMo
 		SettlementDuration:  evt.SettlementDuration,
 		SubscriptionRate:    evt.SubscriptionRate,
 		PayAsYouGoRate:      evt.PayAsYouGoRate,
+		RateCard:            evt.RateCard,
 	}
 	if _, err = s.db.InsertModProviderEvent(ctx, provider.ID, modEvent, txID, height); err != nil {
 		return errors.Wrapf(err, "error inserting ModProviderEvent for %s service %s", evt.Provider, evt.Service)
@@ -139,3 +141,17 @@ func validateMetadataURI(uri string) bool {
 	}
 	return true
 }
+
+func (s *Service) handleProviderSlashedEvent(ctx context.Context, evt atypes.EventProviderSlashed) error {
+	if _, err := s.db.UpdateProviderJail(ctx, evt); err != nil {
+		return errors.Wrapf(err, "error updating jail of provider %s service %s", evt.Provider, evt.Service)
+	}
+	return nil
+}
+
+func (s *Service) handleSubmitAttestationEvent(ctx context.Context, evt atypes.EventSubmitAttestation) error {
+	if _, err := s.db.UpdateProviderReputation(ctx, evt); err != nil {
+		return errors.Wrapf(err, "error updating reputation of provider %s service %s", evt.Provider, evt.Service)
+	}
+	return nil
+}
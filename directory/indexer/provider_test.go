@@ -250,3 +250,30 @@ func TestHandleModProviderEvent(t *testing.T) {
 	}, txID, height)
 	assert.Nil(t, err)
 }
+
+func TestHandleProviderSlashedEvent(t *testing.T) {
+	mockDb := new(db.MockDataStorage)
+	s := Service{
+		params:         ServiceParams{},
+		db:             mockDb,
+		done:           make(chan struct{}),
+		wg:             &sync.WaitGroup{},
+		logger:         logging.WithoutFields(),
+		tmClient:       nil,
+		blockFillQueue: make(chan db.BlockGap),
+	}
+	evt := arkeotypes.EventProviderSlashed{
+		Provider:    arkeotypes.GetRandomPubKey(),
+		Service:     "mock",
+		Slashed:     math.NewInt(10),
+		JailedUntil: 2500,
+	}
+
+	mockUpdate := mockDb.On("UpdateProviderJail", mock.Anything, evt).Return(nil, fmt.Errorf("fail to update provider"))
+	assert.NotNil(t, s.handleProviderSlashedEvent(context.Background(), evt))
+	mockUpdate.Unset()
+
+	mockDb.On("UpdateProviderJail", mock.Anything, evt).Return(&db.Entity{ID: 1}, nil)
+	assert.Nil(t, s.handleProviderSlashedEvent(context.Background(), evt))
+	mockDb.AssertExpectations(t)
+}
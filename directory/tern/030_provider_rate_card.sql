@@ -0,0 +1,8 @@
+ALTER TABLE providers ADD COLUMN rate_card JSONB;
+
+ALTER TABLE provider_mod_events ADD COLUMN rate_card JSONB;
+
+---- create above / drop below ----
+
+ALTER TABLE provider_mod_events DROP COLUMN rate_card;
+ALTER TABLE providers DROP COLUMN rate_card;
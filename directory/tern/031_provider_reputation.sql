@@ -0,0 +1,19 @@
+ALTER TABLE providers ADD COLUMN reputation_score BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE providers ADD COLUMN reputation_weight NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE providers ADD COLUMN reputation_updated BIGINT NOT NULL DEFAULT 0;
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v3.sql" . }}
+{{ template "views/providers_v_v2.sql" . }}
+
+---- create above / drop below ----
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v2.sql" . }}
+{{ template "views/providers_v_v1.sql" . }}
+
+ALTER TABLE providers DROP COLUMN reputation_updated;
+ALTER TABLE providers DROP COLUMN reputation_weight;
+ALTER TABLE providers DROP COLUMN reputation_score;
@@ -0,0 +1,6 @@
+alter table indexer_status
+    add column target_height numeric not null default 0 check ( target_height >= 0 );
+
+---- create above / drop below ----
+alter table indexer_status
+    drop column if exists target_height;
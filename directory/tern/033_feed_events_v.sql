@@ -0,0 +1,16 @@
+create index open_contract_evts_height_idx on open_contract_events (height);
+create index contract_settlement_evts_height_idx on contract_settlement_events (height);
+create index close_contract_evts_height_idx on close_contract_events (height);
+create index prov_bond_evts_height_idx on provider_bond_events (height);
+create index prov_mod_evts_height_idx on provider_mod_events (height);
+create index validator_payout_evts_height_idx on validator_payout_events (height);
+
+{{ template "views/feed_events_v.sql" . }}
+---- create above / drop below ----
+drop view feed_events_v;
+drop index validator_payout_evts_height_idx;
+drop index prov_mod_evts_height_idx;
+drop index prov_bond_evts_height_idx;
+drop index close_contract_evts_height_idx;
+drop index contract_settlement_evts_height_idx;
+drop index open_contract_evts_height_idx;
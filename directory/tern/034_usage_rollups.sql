@@ -0,0 +1,41 @@
+create table usage_rollups
+(
+    granularity       text        not null check ( granularity in ('hour', 'day') ),
+    bucket            timestamptz not null,
+    service           text        not null, -- '' for the events of no service, ie validator payouts
+    provider_pubkey   text        not null,
+    contracts_opened  bigint      not null default 0,
+    contracts_closed  bigint      not null default 0,
+    settlements       bigint      not null default 0,
+    settled_volume    numeric     not null default 0,
+    reserve_tax       numeric     not null default 0,
+    validator_payouts numeric     not null default 0,
+    updated           timestamptz not null default now(),
+    constraint usage_rollups_pk
+        primary key (granularity, bucket, service, provider_pubkey)
+);
+
+create index usage_rollups_service_idx on usage_rollups (granularity, service, bucket);
+create index usage_rollups_provider_idx on usage_rollups (granularity, provider_pubkey, bucket);
+
+-- the events indexed before the rollups are rolled up from the times of their stored blocks
+insert into usage_rollups (granularity, bucket, service, provider_pubkey, contracts_opened, contracts_closed,
+                           settlements, settled_volume, reserve_tax, validator_payouts)
+select g.granularity,
+       date_trunc(g.granularity, b.block_time at time zone 'UTC') at time zone 'UTC',
+       coalesce(e.service, ''),
+       coalesce(e.provider_pubkey, ''),
+       count(1) filter ( where e.event_type = 'open_contract' ),
+       count(1) filter ( where e.event_type = 'close_contract' ),
+       count(1) filter ( where e.event_type = 'contract_settlement' ),
+       coalesce(sum((e.data ->> 'paid')::numeric) filter ( where e.event_type = 'contract_settlement' ), 0),
+       coalesce(sum((e.data ->> 'reserve')::numeric) filter ( where e.event_type = 'contract_settlement' ), 0),
+       coalesce(sum((e.data ->> 'paid')::numeric) filter ( where e.event_type = 'validator_payout' ), 0)
+from feed_events_v e
+         join blocks b on b.height = e.height
+         cross join (values ('hour'), ('day')) as g(granularity)
+where e.event_type in ('open_contract', 'close_contract', 'contract_settlement', 'validator_payout')
+group by 1, 2, 3, 4;
+
+---- create above / drop below ----
+drop table usage_rollups;
@@ -0,0 +1,24 @@
+-- the reputation score of a provider halves every ReputationHalfLife blocks
+-- (100800 by default) since its last attestation, as it does on chain
+CREATE OR REPLACE FUNCTION provider_reputation_score(score BIGINT, updated BIGINT, height BIGINT) RETURNS BIGINT AS
+$$
+SELECT CASE
+           WHEN height IS NULL OR height <= updated THEN score
+           WHEN (height - updated) / 100800 >= 63 THEN 0
+           ELSE score >> ((height - updated) / 100800)::int
+           END
+$$ LANGUAGE sql IMMUTABLE;
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v4.sql" . }}
+{{ template "views/providers_v_v3.sql" . }}
+
+---- create above / drop below ----
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v3.sql" . }}
+{{ template "views/providers_v_v2.sql" . }}
+
+DROP FUNCTION IF EXISTS provider_reputation_score(BIGINT, BIGINT, BIGINT);
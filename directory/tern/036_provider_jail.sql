@@ -0,0 +1,15 @@
+ALTER TABLE providers ADD COLUMN jailed_until BIGINT NOT NULL DEFAULT 0;
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v5.sql" . }}
+{{ template "views/providers_v_v4.sql" . }}
+
+---- create above / drop below ----
+
+DROP VIEW IF EXISTS public.providers_v;
+DROP VIEW IF EXISTS public.providers_base_v;
+{{ template "views/providers_base_v_v4.sql" . }}
+{{ template "views/providers_v_v3.sql" . }}
+
+ALTER TABLE providers DROP COLUMN jailed_until;
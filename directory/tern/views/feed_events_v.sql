@@ -0,0 +1,55 @@
+create or replace view feed_events_v as
+(
+with evts as (
+    select e.id, e.height, e.txid, 'open_contract' as event_type, 1 as event_order, c.id as contract_id,
+           c.provider_id, e.client_pubkey,
+           jsonb_build_object('contract_type', e.contract_type, 'duration', e.duration, 'rate', e.rate,
+                              'open_cost', e.open_cost) as data
+    from open_contract_events e
+             join contracts c on c.id = e.contract_id
+    union all
+    select e.id, e.height, e.txid, 'contract_settlement', 2, c.id, c.provider_id, e.client_pubkey,
+           jsonb_build_object('nonce', e.nonce, 'paid', e.paid, 'reserve', e.reserve)
+    from contract_settlement_events e
+             join contracts c on c.id = e.contract_id
+    union all
+    select e.id, e.height, e.txid, 'close_contract', 3, c.id, c.provider_id, e.client_pubkey,
+           jsonb_build_object('delegate_pubkey', e.delegate_pubkey)
+    from close_contract_events e
+             join contracts c on c.id = e.contract_id
+    union all
+    select e.id, e.height, e.txid, 'provider_bond', 4, null, e.provider_id, null,
+           jsonb_build_object('bond_rel', e.bond_rel::text, 'bond_abs', e.bond_abs::text)
+    from provider_bond_events e
+    union all
+    select e.id, e.height, e.txid, 'provider_mod', 5, null, e.provider_id, null,
+           jsonb_strip_nulls(jsonb_build_object('status', e.status, 'metadata_uri', e.metadata_uri,
+                                                'metadata_nonce', e.metadata_nonce,
+                                                'min_contract_duration', e.min_contract_duration,
+                                                'max_contract_duration', e.max_contract_duration))
+    from provider_mod_events e)
+select evts.height,
+       evts.event_type,
+       evts.event_order,
+       evts.id             as event_id,
+       evts.txid,
+       p.service,
+       p.pubkey            as provider_pubkey,
+       evts.client_pubkey,
+       evts.contract_id,
+       evts.data
+from evts
+         join providers p on p.id = evts.provider_id
+union all
+select v.height,
+       'validator_payout',
+       6,
+       v.id,
+       null,
+       null,
+       null,
+       null,
+       null,
+       jsonb_build_object('validator', v.validator, 'paid', v.paid::text)
+from validator_payout_events v
+);
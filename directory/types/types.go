@@ -1,6 +1,9 @@
 package types
 
-import "github.com/arkeonetwork/arkeo/common/cosmos"
+import (
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
 
 type BondProviderEvent struct {
 	Pubkey       string `mapstructure:"provider"`
@@ -52,18 +55,19 @@ var (
 )
 
 type ModProviderEvent struct {
-	Pubkey              string         `mapstructure:"provider"`
-	Service             string         `mapstructure:"service"`
-	Height              int64          `mapstructure:"height"`
-	TxID                string         `mapstructure:"hash"`
-	MetadataURI         string         `mapstructure:"metadata_uri"`
-	MetadataNonce       uint64         `mapstructure:"metadata_nonce"`
-	Status              ProviderStatus `mapstructure:"status"`
-	MinContractDuration int64          `mapstructure:"min_contract_duration"`
-	MaxContractDuration int64          `mapstructure:"max_contract_duration"`
-	SettlementDuration  int64          `mapstructure:"settlement_duration"`
-	SubscriptionRate    cosmos.Coins   `mapstructure:"subscription_rate"`
-	PayAsYouGoRate      cosmos.Coins   `mapstructure:"pay_as_you_go_rate"`
+	Pubkey              string          `mapstructure:"provider"`
+	Service             string          `mapstructure:"service"`
+	Height              int64           `mapstructure:"height"`
+	TxID                string          `mapstructure:"hash"`
+	MetadataURI         string          `mapstructure:"metadata_uri"`
+	MetadataNonce       uint64          `mapstructure:"metadata_nonce"`
+	Status              ProviderStatus  `mapstructure:"status"`
+	MinContractDuration int64           `mapstructure:"min_contract_duration"`
+	MaxContractDuration int64           `mapstructure:"max_contract_duration"`
+	SettlementDuration  int64           `mapstructure:"settlement_duration"`
+	SubscriptionRate    cosmos.Coins    `mapstructure:"subscription_rate"`
+	PayAsYouGoRate      cosmos.Coins    `mapstructure:"pay_as_you_go_rate"`
+	RateCard            atypes.RateCard `mapstructure:"rate_card"`
 }
 
 type Coordinates struct {
@@ -78,6 +82,7 @@ var (
 	ProviderSortKeyAge           ProviderSortKey = "age"
 	ProviderSortKeyContractCount ProviderSortKey = "contract_count"
 	ProviderSortKeyAmountPaid    ProviderSortKey = "amount_paid"
+	ProviderSortKeyReputation    ProviderSortKey = "reputation"
 )
 
 type ProviderSearchParams struct {
@@ -24,17 +24,11 @@ func NewArkeoAuthManager(contractId uint64, chainId string, mnemonic string, non
 		return nil, nil // Auth not configured
 	}
 
-	// Get default HD path (same as in signThis function)
-	hdPath := hd.NewFundraiserParams(0, 118, 0).String()
-
-	// Derive private key from mnemonic
-	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, "", hdPath)
+	privKey, err := privKeyFromMnemonic(mnemonic)
 	if err != nil {
-		return nil, fmt.Errorf("failed to derive private key: %w", err)
+		return nil, err
 	}
 
-	privKey := hd.Secp256k1.Generate()(derivedPriv).(*secp256k1.PrivKey)
-
 	// Load last nonce from store
 	lastNonce := int64(0)
 	if nonceStore != nil {
@@ -57,6 +51,20 @@ func NewArkeoAuthManager(contractId uint64, chainId string, mnemonic string, non
 	}, nil
 }
 
+// privKeyFromMnemonic derives the first secp256k1 account key of a mnemonic
+func privKeyFromMnemonic(mnemonic string) (*secp256k1.PrivKey, error) {
+	// Get default HD path (same as in signThis function)
+	hdPath := hd.NewFundraiserParams(0, 118, 0).String()
+
+	// Derive private key from mnemonic
+	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, "", hdPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive private key: %w", err)
+	}
+
+	return hd.Secp256k1.Generate()(derivedPriv).(*secp256k1.PrivKey), nil
+}
+
 func (am *ArkeoAuthManager) GenerateAuthHeader() (string, error) {
 	am.mu.Lock()
 	defer am.mu.Unlock()
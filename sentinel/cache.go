@@ -0,0 +1,467 @@
+package sentinel
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	defaultCacheMaxEntries  = 10000
+	defaultCacheMaxBodySize = 256 << 10
+	HeaderCache             = "X-Cache"
+)
+
+// default number of blocks after which a block can't be reorganized anymore
+var defaultFinalityDepth = map[string]int64{
+	"evm":    64,
+	"btc":    6,
+	"cosmos": 0, // tendermint blocks are final once committed
+}
+
+// cacheEntry is a cached response. JSON-RPC entries hold the result only, the
+// response is rebuilt with the id of the request.
+type cacheEntry struct {
+	Body        []byte    `json:"body"`
+	ContentType string    `json:"content_type,omitempty"`
+	Expires     time.Time `json:"expires,omitempty"`
+}
+
+// CacheStats are the hit/miss counters of a service
+type CacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+type cacheCounters struct {
+	hits, misses atomic.Uint64
+}
+
+// ResponseCache keeps the responses of immutable chain data, in a size
+// bounded in-memory LRU backed by an optional LevelDB folder.
+type ResponseCache struct {
+	config conf.CacheConfiguration
+	mem    *lru.Cache[string, cacheEntry]
+	disk   *TypedStore[cacheEntry]
+	now    func() time.Time
+
+	mu    sync.Mutex
+	stats map[string]*cacheCounters
+}
+
+func NewResponseCache(config conf.CacheConfiguration) (*ResponseCache, error) {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaultCacheMaxEntries
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = defaultCacheMaxBodySize
+	}
+	mem, err := lru.New[string, cacheEntry](config.MaxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create response cache: %w", err)
+	}
+	c := &ResponseCache{
+		config: config,
+		mem:    mem,
+		now:    time.Now,
+		stats:  make(map[string]*cacheCounters),
+	}
+	if len(config.DiskLocation) > 0 {
+		db, err := NewLevelDBStore(config.DiskLocation)
+		if err != nil {
+			return nil, fmt.Errorf("fail to open response cache folder: %w", err)
+		}
+		c.disk = NewTypedStore[cacheEntry](db, "cache")
+	}
+	return c, nil
+}
+
+func (c *ResponseCache) counters(service string) *cacheCounters {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counters, ok := c.stats[service]
+	if !ok {
+		counters = &cacheCounters{}
+		c.stats[service] = counters
+	}
+	return counters
+}
+
+func (c *ResponseCache) expired(entry cacheEntry) bool {
+	return !entry.Expires.IsZero() && c.now().After(entry.Expires)
+}
+
+// Get returns the entry cached under key, and counts the hit or miss
+func (c *ResponseCache) Get(service, key string) (cacheEntry, bool) {
+	entry, ok := c.mem.Get(key)
+	if !ok && c.disk != nil {
+		var err error
+		if entry, err = c.disk.Get(key); err == nil {
+			ok = true
+			c.mem.Add(key, entry)
+		}
+	}
+	if ok && c.expired(entry) {
+		c.mem.Remove(key)
+		if c.disk != nil {
+			_ = c.disk.Remove(key)
+		}
+		ok = false
+	}
+	if ok {
+		c.counters(service).hits.Add(1)
+	} else {
+		c.counters(service).misses.Add(1)
+	}
+	return entry, ok
+}
+
+func (c *ResponseCache) Set(key string, entry cacheEntry) error {
+	c.mem.Add(key, entry)
+	if c.disk != nil {
+		return c.disk.Set(key, entry)
+	}
+	return nil
+}
+
+func (c *ResponseCache) Stats(service string) CacheStats {
+	counters := c.counters(service)
+	return CacheStats{Hits: counters.hits.Load(), Misses: counters.misses.Load()}
+}
+
+func (c *ResponseCache) Close() error {
+	if c.disk != nil {
+		return c.disk.Close()
+	}
+	return nil
+}
+
+// cachedRequest is a request whose response may be cached
+type cachedRequest struct {
+	service string
+	key     string
+	call    *rpcCall // nil for REST requests
+	ttl     time.Duration
+	// final tells if a JSON-RPC result is settled, for lookups by hash of
+	// data that may still be reorganized
+	final func(result json.RawMessage) bool
+}
+
+// cacheableRequest returns the cache entry of a request, nil when the request
+// isn't cacheable. head is the highest block of the service's upstreams.
+func cacheableRequest(service conf.ServiceConfig, r *http.Request, body []byte, head int64) *cachedRequest {
+	if !service.Cache.Enabled {
+		return nil
+	}
+	kind := strings.ToLower(service.Type)
+	depth := service.Cache.FinalityDepth
+	if depth <= 0 {
+		depth = defaultFinalityDepth[kind]
+	}
+	isFinal := func(height int64) bool {
+		return head > 0 && height >= 0 && height <= head-depth
+	}
+	req := &cachedRequest{service: service.Name, ttl: service.Cache.TTL}
+
+	if r.Method == http.MethodGet {
+		if kind != "cosmos" || !cosmosCacheable(strings.TrimPrefix(pathTail(r.URL.Path), "/"), r.URL.Query().Get("height"), isFinal) {
+			return nil
+		}
+		req.key = cacheKey(service.Name, r.URL.Path, r.URL.Query().Encode())
+		return req
+	}
+	if r.Method != http.MethodPost {
+		return nil
+	}
+
+	// batches are not cached
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		return nil
+	}
+	calls := parseRPCCalls(body)
+	if len(calls) != 1 || len(calls[0].Method) == 0 {
+		return nil
+	}
+	call := calls[0]
+	var params []any
+	var decoded any
+	if len(call.Params) > 0 {
+		if err := json.Unmarshal(call.Params, &decoded); err != nil {
+			return nil
+		}
+		switch v := decoded.(type) {
+		case []any:
+			params = v
+		case map[string]any:
+			// named params, only used by tendermint
+			if height, ok := v["height"]; ok {
+				params = []any{height}
+			}
+		}
+	}
+
+	cacheable := false
+	for _, method := range service.Cache.Methods {
+		if conf.MatchMethod(method, call.Method) {
+			cacheable = true
+		}
+	}
+	if !cacheable {
+		switch kind {
+		case "evm":
+			cacheable = evmCacheable(call.Method, params, isFinal)
+			req.final = func(result json.RawMessage) bool {
+				return evmResultFinal(result, isFinal)
+			}
+		case "btc":
+			cacheable = btcCacheable(call.Method, params, isFinal)
+		case "cosmos":
+			height := ""
+			if len(params) > 0 {
+				height = fmt.Sprint(params[0])
+			}
+			cacheable = cosmosCacheable(call.Method, height, isFinal)
+		}
+	}
+	if !cacheable {
+		return nil
+	}
+	// objects are marshaled with sorted keys
+	normalized, _ := json.Marshal(decoded)
+	req.key = cacheKey(service.Name, call.Method, string(normalized))
+	req.call = &call
+	return req
+}
+
+// pathTail returns the last segment of a path
+func pathTail(path string) string {
+	return path[strings.LastIndex(path, "/"):]
+}
+
+func cacheKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseHeight parses a block number, in hex (0x prefixed) or decimal
+func parseHeight(value any) (int64, bool) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+	if hexValue, ok := strings.CutPrefix(s, "0x"); ok {
+		height, err := strconv.ParseInt(hexValue, 16, 64)
+		return height, err == nil
+	}
+	height, err := strconv.ParseInt(s, 10, 64)
+	return height, err == nil
+}
+
+// evm methods looked up by hash, their result is final once its block is
+var evmByHash = map[string]bool{
+	"eth_getBlockByHash":                    true,
+	"eth_getTransactionByHash":              true,
+	"eth_getTransactionReceipt":             true,
+	"eth_getBlockTransactionCountByHash":    true,
+	"eth_getTransactionByBlockHashAndIndex": true,
+	"eth_getUncleByBlockHashAndIndex":       true,
+	"eth_getUncleCountByBlockHash":          true,
+	"eth_chainId":                           true,
+	"net_version":                           true,
+}
+
+// evm methods taking a block number, and the position of the block param
+var evmByNumber = map[string]int{
+	"eth_getBlockByNumber":                    0,
+	"eth_getBlockTransactionCountByNumber":    0,
+	"eth_getTransactionByBlockNumberAndIndex": 0,
+	"eth_getUncleByBlockNumberAndIndex":       0,
+	"eth_getUncleCountByBlockNumber":          0,
+	"eth_getBlockReceipts":                    0,
+	"eth_getBalance":                          1,
+	"eth_getCode":                             1,
+	"eth_getTransactionCount":                 1,
+	"eth_call":                                1,
+	"eth_getStorageAt":                        2,
+}
+
+func evmCacheable(method string, params []any, isFinal func(int64) bool) bool {
+	if evmByHash[method] {
+		return true
+	}
+	idx, ok := evmByNumber[method]
+	if !ok || len(params) <= idx {
+		return false
+	}
+	// block tags (latest, pending, safe, ...) are not cacheable
+	tag, _ := params[idx].(string)
+	if !strings.HasPrefix(tag, "0x") {
+		return false
+	}
+	height, ok := parseHeight(tag)
+	return ok && isFinal(height)
+}
+
+// evmResultFinal rejects results of pending transactions, or of blocks that
+// may still be reorganized
+func evmResultFinal(result json.RawMessage, isFinal func(int64) bool) bool {
+	var block struct {
+		BlockNumber *string `json:"blockNumber"`
+		Number      *string `json:"number"`
+	}
+	if err := json.Unmarshal(result, &block); err != nil {
+		return true // not an object
+	}
+	number := block.BlockNumber
+	if number == nil {
+		number = block.Number
+	}
+	if number == nil {
+		return !bytes.Contains(result, []byte(`"blockNumber":null`)) && !bytes.Contains(result, []byte(`"number":null`))
+	}
+	height, ok := parseHeight(*number)
+	return ok && isFinal(height)
+}
+
+func btcCacheable(method string, params []any, isFinal func(int64) bool) bool {
+	switch method {
+	case "getblockhash":
+		if len(params) == 0 {
+			return false
+		}
+		height, ok := parseHeight(params[0])
+		return ok && isFinal(height)
+	case "getblock", "getblockheader", "getrawtransaction":
+		// verbose results include the number of confirmations
+		if len(params) < 2 {
+			return method == "getrawtransaction"
+		}
+		switch verbose := params[1].(type) {
+		case bool:
+			return !verbose
+		case float64:
+			return verbose == 0
+		}
+	}
+	return false
+}
+
+// cosmosCacheable applies to the tendermint rpc, by path (GET) or method
+func cosmosCacheable(method, height string, isFinal func(int64) bool) bool {
+	switch method {
+	case "block_by_hash", "header_by_hash", "tx":
+		return true
+	case "block", "block_results", "commit", "validators", "header":
+		h, ok := parseHeight(height)
+		return ok && isFinal(h)
+	}
+	return false
+}
+
+// serveCached answers the request from the cache, if possible
+func (p *Proxy) serveCached(w http.ResponseWriter, r *http.Request, req *cachedRequest) bool {
+	entry, ok := p.cache.Get(req.service, req.key)
+	if !ok {
+		return false
+	}
+	body := entry.Body
+	if req.call != nil {
+		id := req.call.Id
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		if req.call.JSONRPC == "2.0" {
+			body, _ = json.Marshal(map[string]json.RawMessage{"jsonrpc": json.RawMessage(`"2.0"`), "id": id, "result": entry.Body})
+		} else {
+			body, _ = json.Marshal(map[string]json.RawMessage{"result": entry.Body, "error": json.RawMessage("null"), "id": id})
+		}
+	}
+	contentType := entry.ContentType
+	if len(contentType) == 0 {
+		contentType = "application/json"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set(HeaderCache, "HIT")
+	p.signResponse(r, w.Header(), body)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+	return true
+}
+
+// storeCached caches a successful upstream response, leaving it readable
+func (p *Proxy) storeCached(req *cachedRequest, resp *http.Response) {
+	resp.Header.Set(HeaderCache, "MISS")
+	if resp.StatusCode != http.StatusOK || len(resp.Header.Get("Content-Encoding")) > 0 {
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(p.cache.config.MaxBodySize)+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil || len(body) > p.cache.config.MaxBodySize {
+		return
+	}
+	// some nodes answer errors with a 200 status, errors may change
+	if errorReply(body) {
+		return
+	}
+
+	entry := cacheEntry{Body: body, ContentType: resp.Header.Get("Content-Type")}
+	if req.call != nil {
+		var reply struct {
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(body, &reply); err != nil {
+			return
+		}
+		// missing data (null) may change
+		if len(reply.Result) == 0 || string(reply.Result) == "null" {
+			return
+		}
+		if req.final != nil && !req.final(reply.Result) {
+			return
+		}
+		entry.Body = reply.Result
+	}
+	if req.ttl > 0 {
+		entry.Expires = p.cache.now().Add(req.ttl)
+	}
+	if err := p.cache.Set(req.key, entry); err != nil {
+		p.logger.Error("fail to cache response", "service", req.service, "error", err)
+	}
+}
+
+// errorReply reports whether a json body carries an error, either an "error"
+// member (json-rpc) or a non zero "code" (cosmos rest gateway)
+func errorReply(body []byte) bool {
+	var reply struct {
+		Error json.RawMessage `json:"error"`
+		Code  json.RawMessage `json:"code"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return false
+	}
+	if len(reply.Error) > 0 && string(reply.Error) != "null" {
+		return true
+	}
+	return len(reply.Code) > 0 && string(reply.Code) != "null" && string(reply.Code) != "0"
+}
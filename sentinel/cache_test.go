@@ -0,0 +1,185 @@
+package sentinel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestCacheableRequest(t *testing.T) {
+	services := map[string]conf.ServiceConfig{
+		"evm":    {Name: "eth", Type: "evm", Cache: conf.ServiceCacheConfig{Enabled: true, Methods: []string{"web3_*"}}},
+		"btc":    {Name: "btc", Type: "btc", Cache: conf.ServiceCacheConfig{Enabled: true}},
+		"cosmos": {Name: "gaia", Type: "cosmos", Cache: conf.ServiceCacheConfig{Enabled: true}},
+	}
+	head := int64(1000)
+	testCases := []struct {
+		name      string
+		kind      string
+		method    string
+		target    string
+		body      string
+		cacheable bool
+	}{
+		{"evm final block", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10",false]}`, true},
+		{"evm recent block", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x3e0",false]}`, false},
+		{"evm latest block", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest",false]}`, false},
+		{"evm receipt", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0xabc"]}`, true},
+		{"evm call at final block", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x1"},"0x10"]}`, true},
+		{"evm call at latest", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x1"},"latest"]}`, false},
+		{"evm configured method", "evm", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion"}`, true},
+		{"evm batch", "evm", http.MethodPost, "/", `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}]`, false},
+		{"btc final block hash", "btc", http.MethodPost, "/", `{"id":1,"method":"getblockhash","params":[100]}`, true},
+		{"btc tip block hash", "btc", http.MethodPost, "/", `{"id":1,"method":"getblockhash","params":[998]}`, false},
+		{"btc raw block", "btc", http.MethodPost, "/", `{"id":1,"method":"getblock","params":["00ab",0]}`, true},
+		{"btc verbose block", "btc", http.MethodPost, "/", `{"id":1,"method":"getblock","params":["00ab",1]}`, false},
+		{"btc block count", "btc", http.MethodPost, "/", `{"id":1,"method":"getblockcount","params":[]}`, false},
+		{"cosmos block by height", "cosmos", http.MethodGet, "/gaia/block?height=999", ``, true},
+		{"cosmos future block", "cosmos", http.MethodGet, "/gaia/block?height=1001", ``, false},
+		{"cosmos latest block", "cosmos", http.MethodGet, "/gaia/block", ``, false},
+		{"cosmos status", "cosmos", http.MethodGet, "/gaia/status", ``, false},
+		{"cosmos jsonrpc block", "cosmos", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"10"}}`, true},
+		{"cosmos jsonrpc block by hash", "cosmos", http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"block_by_hash","params":{"hash":"AB"}}`, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			req := cacheableRequest(services[tc.kind], r, []byte(tc.body), head)
+			require.Equal(t, tc.cacheable, req != nil)
+		})
+	}
+
+	// unknown heights are never final
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10",false]}`
+	require.Nil(t, cacheableRequest(services["evm"], httptest.NewRequest(http.MethodPost, "/", nil), []byte(body), 0))
+
+	// keys ignore the request id and the order of object params
+	a := cacheableRequest(services["cosmos"], httptest.NewRequest(http.MethodPost, "/", nil), []byte(`{"id":1,"method":"block_by_hash","params":{"hash":"AB","prove":false}}`), head)
+	b := cacheableRequest(services["cosmos"], httptest.NewRequest(http.MethodPost, "/", nil), []byte(`{"id":2,"method":"block_by_hash","params":{"prove":false,"hash":"AB"}}`), head)
+	c := cacheableRequest(services["cosmos"], httptest.NewRequest(http.MethodPost, "/", nil), []byte(`{"id":1,"method":"block_by_hash","params":{"hash":"CD","prove":false}}`), head)
+	require.Equal(t, a.key, b.key)
+	require.NotEqual(t, a.key, c.key)
+}
+
+func TestErrorReply(t *testing.T) {
+	for body, expected := range map[string]bool{
+		`{"result":"00abcd","error":null,"id":1}`:             false,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32000}}`:    true,
+		`{"code":5,"message":"block not found","details":[]}`: true,
+		`{"code":0,"block":{}}`:                               false,
+		`{"tx_response":{"code":5},"tx":{}}`:                  false,
+		`[{"result":1},{"error":{"code":-32601}}]`:            false,
+		`not json`: false,
+	} {
+		require.Equal(t, expected, errorReply([]byte(body)), body)
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewResponseCache(conf.CacheConfiguration{MaxEntries: 2, DiskLocation: dir})
+	require.NoError(t, err)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	require.NoError(t, cache.Set("a", cacheEntry{Body: []byte("1")}))
+	require.NoError(t, cache.Set("b", cacheEntry{Body: []byte("2"), Expires: now.Add(time.Minute)}))
+	require.NoError(t, cache.Set("c", cacheEntry{Body: []byte("3")}))
+	require.Equal(t, 2, cache.mem.Len())
+
+	// evicted from memory, still on disk
+	entry, ok := cache.Get("svc", "a")
+	require.True(t, ok)
+	require.Equal(t, "1", string(entry.Body))
+
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.Get("svc", "b")
+	require.False(t, ok)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 1}, cache.Stats("svc"))
+	require.NoError(t, cache.Close())
+
+	// the disk backend survives restarts
+	cache, err = NewResponseCache(conf.CacheConfiguration{DiskLocation: dir})
+	require.NoError(t, err)
+	defer cache.Close()
+	_, ok = cache.Get("svc", "c")
+	require.True(t, ok)
+}
+
+func TestProxyResponseCache(t *testing.T) {
+	var calls atomic.Int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "getblockchaininfo") {
+			_, _ = w.Write([]byte(`{"result":{"blocks":1000,"headers":1000},"error":null,"id":1}`))
+			return
+		}
+		calls.Add(1)
+		switch {
+		case strings.Contains(string(body), "getblockhash"):
+			_, _ = w.Write([]byte(`{"result":"00abcd","error":null,"id":7}`))
+		case strings.Contains(string(body), "getrawtransaction"):
+			_, _ = w.Write([]byte(`{"result":null,"error":{"code":-5,"message":"No such mempool or blockchain transaction"},"id":7}`))
+		default:
+			_, _ = w.Write([]byte(`{"result":1000,"error":null,"id":7}`))
+		}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = []conf.ServiceConfig{{
+		Name:   "btc-mainnet-fullnode",
+		Type:   "btc",
+		RpcUrl: upstream.URL,
+		Cache:  conf.ServiceCacheConfig{Enabled: true},
+	}}
+	env := newPaidTestEnv(t, config, types.ContractType_SUBSCRIPTION, 100, upstream.URL)
+	pools, err := loadUpstreamPools(config, log.NewNopLogger())
+	require.NoError(t, err)
+	env.proxy.pools = pools
+	env.proxy.cache, err = NewResponseCache(config.Cache)
+	require.NoError(t, err)
+	pools["btc-mainnet-fullnode"].Probe(context.Background())
+
+	nonce := int64(0)
+	post := func(body string) (string, string) {
+		nonce++
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		received, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(received), resp.Header.Get(HeaderCache)
+	}
+
+	body, cache := post(`{"id":7,"method":"getblockhash","params":[10]}`)
+	require.Equal(t, "MISS", cache)
+	require.JSONEq(t, `{"result":"00abcd","error":null,"id":7}`, body)
+	body, cache = post(`{"id":8,"method":"getblockhash","params":[10]}`)
+	require.Equal(t, "HIT", cache)
+	require.JSONEq(t, `{"result":"00abcd","error":null,"id":8}`, body)
+	require.Equal(t, int64(1), calls.Load())
+
+	// errors, and calls that aren't cacheable, always hit the upstream
+	for i := 0; i < 2; i++ {
+		_, cache = post(`{"id":7,"method":"getrawtransaction","params":["ff"]}`)
+		require.Equal(t, "MISS", cache)
+		_, cache = post(`{"id":7,"method":"getblockcount","params":[]}`)
+		require.Empty(t, cache)
+	}
+	require.Equal(t, int64(5), calls.Load())
+	require.Equal(t, CacheStats{Hits: 1, Misses: 3}, env.proxy.cache.Stats("btc-mainnet-fullnode"))
+}
@@ -0,0 +1,263 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// TxResult is the subset of a cosmos tx response the sentinel cares about.
+type TxResult struct {
+	Hash      string `json:"txhash"`
+	Height    int64  `json:"height,string"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
+	RawLog    string `json:"raw_log"`
+}
+
+// ChainClient is the part of the arkeo chain api used to broadcast txs.
+type ChainClient interface {
+	// GetAccount returns the account number and sequence of the address
+	GetAccount(ctx context.Context, address string) (uint64, uint64, error)
+	// BroadcastTx submits the tx and returns the result of CheckTx
+	BroadcastTx(ctx context.Context, txBytes []byte) (TxResult, error)
+	// GetTx returns the result of a tx once it has been included in a block
+	GetTx(ctx context.Context, hash string) (TxResult, bool, error)
+}
+
+// RestChainClient talks to the cosmos REST gateway of an arkeo node.
+type RestChainClient struct {
+	baseURL     string
+	client      http.Client
+	authManager *ArkeoAuthManager
+}
+
+func NewRestChainClient(baseURL string, authManager *ArkeoAuthManager) *RestChainClient {
+	return &RestChainClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: http.Client{
+			Timeout: 10 * time.Second,
+		},
+		authManager: authManager,
+	}
+}
+
+func (c *RestChainClient) do(ctx context.Context, method, path string, body []byte) (int, []byte, error) {
+	return c.send(ctx, method, path, body, c.authManager != nil)
+}
+
+// send sends the request, signed with a fresh arkauth nonce when auth is set
+func (c *RestChainClient) send(ctx context.Context, method, path string, body []byte, auth bool) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, fmt.Errorf("fail to create http request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if auth {
+		authHeader, err := c.authManager.GenerateAuthHeader()
+		if err != nil {
+			return 0, nil, fmt.Errorf("fail to generate auth header: %w", err)
+		}
+		req.Header.Set(QueryArkAuth, authHeader)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("fail to send http request: %w", err)
+	}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.StatusCode, nil, fmt.Errorf("fail to read response body: %w", err)
+	}
+	return res.StatusCode, resBody, nil
+}
+
+func (c *RestChainClient) GetAccount(ctx context.Context, address string) (uint64, uint64, error) {
+	code, body, err := c.do(ctx, http.MethodGet, "/cosmos/auth/v1beta1/accounts/"+address, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	if code != http.StatusOK {
+		return 0, 0, fmt.Errorf("fail to fetch account %s (%d): %s", address, code, body)
+	}
+	var data struct {
+		Account struct {
+			AccountNumber string `json:"account_number"`
+			Sequence      string `json:"sequence"`
+		} `json:"account"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return 0, 0, fmt.Errorf("fail to unmarshal account: %w", err)
+	}
+	accNum, err := strconv.ParseUint(data.Account.AccountNumber, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad account number %q: %w", data.Account.AccountNumber, err)
+	}
+	seq, err := strconv.ParseUint(data.Account.Sequence, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad account sequence %q: %w", data.Account.Sequence, err)
+	}
+	return accNum, seq, nil
+}
+
+func (c *RestChainClient) BroadcastTx(ctx context.Context, txBytes []byte) (TxResult, error) {
+	payload, err := json.Marshal(map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+		"mode":     "BROADCAST_MODE_SYNC",
+	})
+	if err != nil {
+		return TxResult{}, err
+	}
+	code, body, err := c.do(ctx, http.MethodPost, "/cosmos/tx/v1beta1/txs", payload)
+	if err != nil {
+		return TxResult{}, err
+	}
+	if code != http.StatusOK {
+		return TxResult{}, fmt.Errorf("fail to broadcast tx (%d): %s", code, body)
+	}
+	var data struct {
+		TxResponse TxResult `json:"tx_response"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return TxResult{}, fmt.Errorf("fail to unmarshal broadcast response: %w", err)
+	}
+	return data.TxResponse, nil
+}
+
+// GetTx is polled until the tx is in a block, it is sent without arkauth so
+// that the polling does not use up a contract nonce per request
+func (c *RestChainClient) GetTx(ctx context.Context, hash string) (TxResult, bool, error) {
+	code, body, err := c.send(ctx, http.MethodGet, "/cosmos/tx/v1beta1/txs/"+hash, nil, false)
+	if err != nil {
+		return TxResult{}, false, err
+	}
+	// the gateway answers with 404 (or 400 on older nodes) until the tx is in a block
+	if code == http.StatusNotFound || (code == http.StatusBadRequest && strings.Contains(string(body), "not found")) {
+		return TxResult{}, false, nil
+	}
+	if code != http.StatusOK {
+		return TxResult{}, false, fmt.Errorf("fail to fetch tx %s (%d): %s", hash, code, body)
+	}
+	var data struct {
+		TxResponse TxResult `json:"tx_response"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return TxResult{}, false, fmt.Errorf("fail to unmarshal tx response: %w", err)
+	}
+	return data.TxResponse, true, nil
+}
+
+// GetLatestHeight returns the height of the latest block of the chain
+func (c *RestChainClient) GetLatestHeight(ctx context.Context) (int64, error) {
+	code, body, err := c.do(ctx, http.MethodGet, "/cosmos/base/tendermint/v1beta1/blocks/latest", nil)
+	if err != nil {
+		return 0, err
+	}
+	if code != http.StatusOK {
+		return 0, fmt.Errorf("fail to fetch latest block (%d): %s", code, body)
+	}
+	var data struct {
+		Block struct {
+			Header struct {
+				Height string `json:"height"`
+			} `json:"header"`
+		} `json:"block"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return 0, fmt.Errorf("fail to unmarshal latest block: %w", err)
+	}
+	height, err := strconv.ParseInt(data.Block.Header.Height, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad block height %q: %w", data.Block.Header.Height, err)
+	}
+	return height, nil
+}
+
+// TxSigner builds and signs txs with a single secp256k1 key.
+type TxSigner struct {
+	privKey  *secp256k1.PrivKey
+	chainId  string
+	txConfig client.TxConfig
+}
+
+func NewTxSigner(privKey *secp256k1.PrivKey, chainId string) *TxSigner {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	return &TxSigner{
+		privKey:  privKey,
+		chainId:  chainId,
+		txConfig: authtx.NewTxConfig(codec.NewProtoCodec(registry), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT}),
+	}
+}
+
+func (s *TxSigner) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.privKey.PubKey().Address())
+}
+
+func (s *TxSigner) TxConfig() client.TxConfig {
+	return s.txConfig
+}
+
+// Sign returns the encoded signed tx
+func (s *TxSigner) Sign(msgs []sdk.Msg, accountNumber, sequence uint64, fees sdk.Coins, gasLimit uint64, memo string) ([]byte, error) {
+	builder := s.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, fmt.Errorf("fail to set msgs: %w", err)
+	}
+	builder.SetGasLimit(gasLimit)
+	builder.SetFeeAmount(fees)
+	builder.SetMemo(memo)
+
+	pubKey := s.privKey.PubKey()
+	// an empty signature has to be set first so the signer info is part of the sign bytes
+	if err := builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}); err != nil {
+		return nil, fmt.Errorf("fail to set empty signature: %w", err)
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       s.Address().String(),
+		ChainID:       s.chainId,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		PubKey:        pubKey,
+	}
+	sig, err := clienttx.SignWithPrivKey(context.Background(), signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, builder, s.privKey, s.txConfig, sequence)
+	if err != nil {
+		return nil, fmt.Errorf("fail to sign tx: %w", err)
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return nil, fmt.Errorf("fail to set signature: %w", err)
+	}
+	return s.txConfig.TxEncoder()(builder.GetTx())
+}
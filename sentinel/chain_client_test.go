@@ -0,0 +1,45 @@
+package sentinel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestChainClientAuth(t *testing.T) {
+	authed := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authed[r.URL.Path] = r.Header.Get(QueryArkAuth) != ""
+		if r.URL.Path == "/cosmos/tx/v1beta1/txs/ABCD" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"tx_response":{"txhash":"ABCD"}}`))
+	}))
+	defer server.Close()
+
+	store, err := NewNonceStore("")
+	require.NoError(t, err)
+	am, err := NewArkeoAuthManager(12345, testChainId, testMnemonic, store, log.NewNopLogger())
+	require.NoError(t, err)
+	defer am.Close()
+	client := NewRestChainClient(server.URL, am)
+
+	_, err = client.BroadcastTx(context.Background(), []byte("tx"))
+	require.NoError(t, err)
+	require.True(t, authed["/cosmos/tx/v1beta1/txs"])
+	nonce := am.GetNonce()
+
+	// polling for the tx does not use up nonces
+	for i := 0; i < 3; i++ {
+		_, found, err := client.GetTx(context.Background(), "ABCD")
+		require.NoError(t, err)
+		require.False(t, found)
+	}
+	require.False(t, authed["/cosmos/tx/v1beta1/txs/ABCD"])
+	require.Equal(t, nonce, am.GetNonce())
+}
@@ -7,14 +7,74 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/arkeonetwork/arkeo/common"
 	"gopkg.in/yaml.v2"
 )
 
+const DefaultPort = "3636"
+
 type TLSConfiguration struct {
-	Cert string `json:"tls_certificate,omitempty"`
-	Key  string `json:"tls_key,omitempty"`
+	Cert string `json:"tls_certificate,omitempty" yaml:"tls_certificate,omitempty"`
+	Key  string `json:"tls_key,omitempty" yaml:"tls_key,omitempty"`
+}
+
+// RateLimitConfiguration selects where rate limit counters are kept. The
+// "memory" backend is local to the process, the "redis" backend is shared by
+// every sentinel pointing at the same redis server.
+type RateLimitConfiguration struct {
+	Backend       string        `json:"backend,omitempty" yaml:"backend,omitempty"`
+	RedisAddr     string        `json:"redis_addr,omitempty" yaml:"redis_addr,omitempty"`
+	RedisPassword string        `json:"redis_password,omitempty" yaml:"redis_password,omitempty"`
+	RedisDB       int           `json:"redis_db,omitempty" yaml:"redis_db,omitempty"`
+	KeyPrefix     string        `json:"key_prefix,omitempty" yaml:"key_prefix,omitempty"`
+	IdleTimeout   time.Duration `json:"idle_timeout,omitempty" yaml:"idle_timeout,omitempty"` // evict in-memory limiters idle for this long
+}
+
+// SettlementConfiguration controls the claim settlement worker, which signs
+// and broadcasts MsgClaimContractIncome for pay-as-you-go contracts with the
+// provider's key.
+type SettlementConfiguration struct {
+	Enabled          bool          `json:"enabled" yaml:"enabled"`
+	Mnemonic         string        `json:"-" yaml:"mnemonic,omitempty"` // provider key used to sign claim txs
+	ChainId          string        `json:"chain_id,omitempty" yaml:"chain_id,omitempty"`
+	Interval         time.Duration `json:"interval,omitempty" yaml:"interval,omitempty"`                   // how often claims are submitted
+	MinClaimValue    int64         `json:"min_claim_value,omitempty" yaml:"min_claim_value,omitempty"`     // skip claims worth less than this, unless the settlement period is about to end
+	SettlementMargin int64         `json:"settlement_margin,omitempty" yaml:"settlement_margin,omitempty"` // blocks before the settlement period end when claims are always submitted
+	BatchSize        int           `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+	Fees             string        `json:"fees,omitempty" yaml:"fees,omitempty"`
+	GasLimit         uint64        `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
+	MaxRetries       int           `json:"max_retries,omitempty" yaml:"max_retries,omitempty"`
+	RetryBackoff     time.Duration `json:"retry_backoff,omitempty" yaml:"retry_backoff,omitempty"`
+	TxTimeout        time.Duration `json:"tx_timeout,omitempty" yaml:"tx_timeout,omitempty"` // how long to wait for a tx to be included in a block
+}
+
+// StorageConfiguration selects where claims, contract and provider
+// configurations and nonces are persisted. "leveldb" keeps a folder per store
+// (the *_STORE_LOCATION settings), "postgres" and "sqlite" keep a table per
+// store in the database given by DSN.
+type StorageConfiguration struct {
+	Backend string `json:"backend,omitempty" yaml:"backend,omitempty"`
+	DSN     string `json:"-" yaml:"dsn,omitempty"` // may contain credentials
+}
+
+// CacheConfiguration sizes the response cache shared by the services. Entries
+// are kept in memory, and in a LevelDB folder at DiskLocation when set.
+type CacheConfiguration struct {
+	MaxEntries   int    `json:"max_entries,omitempty" yaml:"max_entries,omitempty"`
+	MaxBodySize  int    `json:"max_body_size,omitempty" yaml:"max_body_size,omitempty"` // larger responses are not cached, in bytes
+	DiskLocation string `json:"disk_location,omitempty" yaml:"disk_location,omitempty"`
+}
+
+// WebSocketConfiguration controls proxied websocket connections. Pay-as-you-go
+// clients are metered per message and must send a fresh signed nonce every
+// MessagesPerNonce messages, within NonceTimeout.
+type WebSocketConfiguration struct {
+	MessagesPerNonce int           `json:"messages_per_nonce,omitempty" yaml:"messages_per_nonce,omitempty"`
+	NonceTimeout     time.Duration `json:"nonce_timeout,omitempty" yaml:"nonce_timeout,omitempty"`
+	PingInterval     time.Duration `json:"ping_interval,omitempty" yaml:"ping_interval,omitempty"`
+	MaxMessageSize   int64         `json:"max_message_size,omitempty" yaml:"max_message_size,omitempty"` // in bytes
 }
 
 type ServiceConfig struct {
@@ -24,28 +84,158 @@ type ServiceConfig struct {
 	RpcUrl  string `json:"rpc_url" yaml:"rpc_url,omitempty"`
 	RpcUser string `json:"rpc_user,omitempty" yaml:"rpc_user,omitempty"`
 	RpcPass string `json:"rpc_pass,omitempty" yaml:"rpc_pass,omitempty"`
+	// MethodWeights is the number of nonces a pay-as-you-go call of the method
+	// costs, methods not listed cost one
+	MethodWeights map[string]int64 `json:"method_weights,omitempty" yaml:"method_weights,omitempty"`
+	Methods       MethodPolicy     `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// Upstreams are the nodes serving the service, RpcUrl is used when empty
+	Upstreams     []UpstreamConfig   `json:"upstreams,omitempty" yaml:"upstreams,omitempty"`
+	LoadBalancing string             `json:"load_balancing,omitempty" yaml:"load_balancing,omitempty"` // "round_robin" (default) or "least_latency"
+	HealthCheck   HealthCheckConfig  `json:"health_check,omitempty" yaml:"health_check,omitempty"`
+	Cache         ServiceCacheConfig `json:"cache,omitempty" yaml:"cache,omitempty"`
+}
+
+// ServiceCacheConfig enables the response cache of a service. Calls are
+// cached when their result can't change anymore, ie blocks at least
+// FinalityDepth blocks deep, according to the rules of the service type.
+// Methods are always cached, with TTL when set.
+type ServiceCacheConfig struct {
+	Enabled       bool          `json:"enabled" yaml:"enabled"`
+	FinalityDepth int64         `json:"finality_depth,omitempty" yaml:"finality_depth,omitempty"`
+	TTL           time.Duration `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	Methods       []string      `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+// UpstreamConfig is one of the nodes serving a service
+type UpstreamConfig struct {
+	Url     string `json:"url" yaml:"url"`
+	Weight  int    `json:"weight,omitempty" yaml:"weight,omitempty"` // share of the traffic relative to the other upstreams, defaults to 1
+	RpcUser string `json:"rpc_user,omitempty" yaml:"rpc_user,omitempty"`
+	RpcPass string `json:"rpc_pass,omitempty" yaml:"rpc_pass,omitempty"`
+}
+
+// HealthCheckConfig controls the health of the upstreams of a service. They
+// are probed every Interval (a negative interval disables probes) and taken
+// out of rotation when they lag more than MaxBlockLag blocks behind the best
+// upstream, or for EjectDuration after MaxFailures consecutive 5xx responses
+// or timeouts.
+type HealthCheckConfig struct {
+	Interval      time.Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Timeout       time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	MaxBlockLag   int64         `json:"max_block_lag,omitempty" yaml:"max_block_lag,omitempty"`
+	MaxFailures   int           `json:"max_failures,omitempty" yaml:"max_failures,omitempty"`
+	EjectDuration time.Duration `json:"eject_duration,omitempty" yaml:"eject_duration,omitempty"`
+}
+
+// GetUpstreams returns the upstreams of the service, or its RpcUrl when none
+// are configured
+func (s ServiceConfig) GetUpstreams() []UpstreamConfig {
+	if len(s.Upstreams) > 0 {
+		return s.Upstreams
+	}
+	if len(s.RpcUrl) == 0 {
+		return nil
+	}
+	return []UpstreamConfig{{Url: s.RpcUrl, Weight: 1, RpcUser: s.RpcUser, RpcPass: s.RpcPass}}
+}
+
+// MethodPolicy restricts the JSON-RPC methods a service or a contract may call.
+// Method names match exactly, or by prefix when they end with "*" (ie "debug_*").
+type MethodPolicy struct {
+	Allow           []string         `json:"allow,omitempty" yaml:"allow,omitempty"` // when set, only these methods are served
+	Deny            []string         `json:"deny,omitempty" yaml:"deny,omitempty"`
+	RateLimits      map[string]int   `json:"rate_limits,omitempty" yaml:"rate_limits,omitempty"`           // calls per minute
+	CostMultipliers map[string]int64 `json:"cost_multipliers,omitempty" yaml:"cost_multipliers,omitempty"` // applied on top of the method weight
+}
+
+// MatchMethod tells if method matches pattern, exactly or by prefix when the
+// pattern ends with "*"
+func MatchMethod(pattern, method string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+	return pattern == method
+}
+
+// lookupMethod returns the value of the most specific pattern matching method
+func lookupMethod[T any](values map[string]T, method string) (value T, found bool) {
+	if v, ok := values[method]; ok {
+		return v, true
+	}
+	longest := -1
+	for pattern, v := range values {
+		if MatchMethod(pattern, method) && len(pattern) > longest {
+			value, found, longest = v, true, len(pattern)
+		}
+	}
+	return value, found
+}
+
+// Allows reports whether the policy lets method be called
+func (m MethodPolicy) Allows(method string) bool {
+	for _, pattern := range m.Deny {
+		if MatchMethod(pattern, method) {
+			return false
+		}
+	}
+	if len(m.Allow) == 0 {
+		return true
+	}
+	for _, pattern := range m.Allow {
+		if MatchMethod(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimit returns the calls per minute allowed for method, 0 when unlimited
+func (m MethodPolicy) RateLimit(method string) int {
+	limit, _ := lookupMethod(m.RateLimits, method)
+	return limit
+}
+
+// CostMultiplier returns the cost multiplier of method, 1 when not set
+func (m MethodPolicy) CostMultiplier(method string) int64 {
+	if multiplier, ok := lookupMethod(m.CostMultipliers, method); ok && multiplier >= 0 {
+		return multiplier
+	}
+	return 1
+}
+
+// MethodWeight returns the nonce cost of a call to the given method
+func (s ServiceConfig) MethodWeight(method string) int64 {
+	if weight, ok := lookupMethod(s.MethodWeights, method); ok && weight >= 0 {
+		return weight
+	}
+	return 1
 }
 
 type Configuration struct {
-	Moniker                     string           `json:"moniker,omitempty"`
-	Website                     string           `json:"website,omitempty"`
-	Description                 string           `json:"description,omitempty"`
-	Location                    string           `json:"location,omitempty"`
-	Port                        string           `json:"port,omitempty"`
-	SourceChain                 string           `json:"source_chain,omitempty"` // base url for arkeo block chain
-	HubProviderURI              string           `json:"hub_provider_uri,omitempty"`
-	EventStreamHost             string           `json:"event_stream_host,omitempty"`
-	ClaimStoreLocation          string           `json:"claim_store_location,omitempty"`           // file location where claims are stored
-	ContractConfigStoreLocation string           `json:"contract_config_store_location,omitempty"` // file location where contract configurations are stored
-	ProviderConfigStoreLocation string           `json:"provider_config_store_location,omitempty"` // file location where provider configurations are stored
-	ProviderPubKey              common.PubKey    `json:"provider_pubkey,omitempty"`
-	FreeTierRateLimit           int              `json:"free_tier_rate_limit,omitempty"`
-	TLS                         TLSConfiguration `json:"tls"`
-	Services                    []ServiceConfig  `json:"services" yaml:"services"`
-	ArkeoAuthContractId         uint64           `json:"arkeo_auth_contract_id,omitempty"` // Contract ID for auth
-	ArkeoAuthChainId            string           `json:"arkeo_auth_chain_id,omitempty"`    // Chain ID for auth
-	ArkeoAuthMnemonic           string           `json:"arkeo_auth_mnemonic,omitempty"`    // Mnemonic phrase for signing
-	ArkeoAuthNonceStore         string           `json:"arkeo_auth_nonce_store,omitempty"` // LevelDB path for nonce storage
+	Moniker                     string                  `json:"moniker,omitempty" yaml:"moniker,omitempty"`
+	Website                     string                  `json:"website,omitempty" yaml:"website,omitempty"`
+	Description                 string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	Location                    string                  `json:"location,omitempty" yaml:"location,omitempty"`
+	Port                        string                  `json:"port,omitempty" yaml:"port,omitempty"`
+	SourceChain                 string                  `json:"source_chain,omitempty" yaml:"source_chain,omitempty"` // base url for arkeo block chain
+	HubProviderURI              string                  `json:"hub_provider_uri,omitempty" yaml:"hub_provider_uri,omitempty"`
+	EventStreamHost             string                  `json:"event_stream_host,omitempty" yaml:"event_stream_host,omitempty"`
+	ClaimStoreLocation          string                  `json:"claim_store_location,omitempty" yaml:"claim_store_location,omitempty"`                     // file location where claims are stored
+	ContractConfigStoreLocation string                  `json:"contract_config_store_location,omitempty" yaml:"contract_config_store_location,omitempty"` // file location where contract configurations are stored
+	ProviderConfigStoreLocation string                  `json:"provider_config_store_location,omitempty" yaml:"provider_config_store_location,omitempty"` // file location where provider configurations are stored
+	ProviderPubKey              common.PubKey           `json:"provider_pubkey,omitempty" yaml:"provider_pubkey,omitempty"`
+	FreeTierRateLimit           int                     `json:"free_tier_rate_limit,omitempty" yaml:"free_tier_rate_limit,omitempty"`
+	TLS                         TLSConfiguration        `json:"tls" yaml:"tls"`
+	Storage                     StorageConfiguration    `json:"storage" yaml:"storage"`
+	RateLimit                   RateLimitConfiguration  `json:"rate_limit" yaml:"rate_limit"`
+	Settlement                  SettlementConfiguration `json:"settlement" yaml:"settlement"`
+	WebSocket                   WebSocketConfiguration  `json:"websocket" yaml:"websocket"`
+	Cache                       CacheConfiguration      `json:"cache" yaml:"cache"`
+	Services                    []ServiceConfig         `json:"services" yaml:"services"`
+	ArkeoAuthContractId         uint64                  `json:"arkeo_auth_contract_id,omitempty" yaml:"arkeo_auth_contract_id,omitempty"` // Contract ID for auth
+	ArkeoAuthChainId            string                  `json:"arkeo_auth_chain_id,omitempty" yaml:"arkeo_auth_chain_id,omitempty"`       // Chain ID for auth
+	ArkeoAuthMnemonic           string                  `json:"arkeo_auth_mnemonic,omitempty" yaml:"arkeo_auth_mnemonic,omitempty"`       // Mnemonic phrase for signing
+	ArkeoAuthNonceStore         string                  `json:"arkeo_auth_nonce_store,omitempty" yaml:"arkeo_auth_nonce_store,omitempty"` // LevelDB path for nonce storage
 }
 
 // Simple helper function to read an environment or return a default value
@@ -100,6 +290,18 @@ func loadVarIntOptional(key string, defaultValue int) int {
 	return i
 }
 
+func loadVarDurationOptional(key string, defaultValue time.Duration) time.Duration {
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		panic(fmt.Errorf("env var %s is not a duration: %s", key, err))
+	}
+	return d
+}
+
 func NewTLSConfiguration() TLSConfiguration {
 	return TLSConfiguration{
 		Cert: getEnv("TLS_CERT", ""),
@@ -107,6 +309,68 @@ func NewTLSConfiguration() TLSConfiguration {
 	}
 }
 
+func NewStorageConfiguration() StorageConfiguration {
+	return StorageConfiguration{
+		Backend: getEnv("STORAGE_BACKEND", "leveldb"),
+		DSN:     getEnv("STORAGE_DSN", ""),
+	}
+}
+
+func NewRateLimitConfiguration() RateLimitConfiguration {
+	return RateLimitConfiguration{
+		Backend:       getEnv("RATE_LIMIT_BACKEND", "memory"),
+		RedisAddr:     getEnv("RATE_LIMIT_REDIS_ADDR", ""),
+		RedisPassword: getEnv("RATE_LIMIT_REDIS_PASSWORD", ""),
+		RedisDB:       loadVarIntOptional("RATE_LIMIT_REDIS_DB", 0),
+		KeyPrefix:     getEnv("RATE_LIMIT_KEY_PREFIX", ""),
+		IdleTimeout:   loadVarDurationOptional("RATE_LIMIT_IDLE_TIMEOUT", 0),
+	}
+}
+
+func NewSettlementConfiguration() SettlementConfiguration {
+	return SettlementConfiguration{
+		Enabled:          getEnv("SETTLEMENT_ENABLED", "false") == "true",
+		Mnemonic:         getEnv("SETTLEMENT_MNEMONIC", ""),
+		ChainId:          getEnv("SETTLEMENT_CHAIN_ID", ""),
+		Interval:         loadVarDurationOptional("SETTLEMENT_INTERVAL", 0),
+		MinClaimValue:    int64(loadVarIntOptional("SETTLEMENT_MIN_CLAIM_VALUE", 0)),
+		SettlementMargin: int64(loadVarIntOptional("SETTLEMENT_MARGIN", 0)),
+		BatchSize:        loadVarIntOptional("SETTLEMENT_BATCH_SIZE", 0),
+		Fees:             getEnv("SETTLEMENT_FEES", ""),
+		GasLimit:         uint64(loadVarIntOptional("SETTLEMENT_GAS_LIMIT", 0)),
+		MaxRetries:       loadVarIntOptional("SETTLEMENT_MAX_RETRIES", 0),
+		RetryBackoff:     loadVarDurationOptional("SETTLEMENT_RETRY_BACKOFF", 0),
+		TxTimeout:        loadVarDurationOptional("SETTLEMENT_TX_TIMEOUT", 0),
+	}
+}
+
+func NewWebSocketConfiguration() WebSocketConfiguration {
+	return WebSocketConfiguration{
+		MessagesPerNonce: loadVarIntOptional("WS_MESSAGES_PER_NONCE", 0),
+		NonceTimeout:     loadVarDurationOptional("WS_NONCE_TIMEOUT", 0),
+		PingInterval:     loadVarDurationOptional("WS_PING_INTERVAL", 0),
+		MaxMessageSize:   int64(loadVarIntOptional("WS_MAX_MESSAGE_SIZE", 0)),
+	}
+}
+
+func NewCacheConfiguration() CacheConfiguration {
+	return CacheConfiguration{
+		MaxEntries:   loadVarIntOptional("CACHE_MAX_ENTRIES", 0),
+		MaxBodySize:  loadVarIntOptional("CACHE_MAX_BODY_SIZE", 0),
+		DiskLocation: getEnv("CACHE_DISK_LOCATION", ""),
+	}
+}
+
+// GetService returns the config of the service with the given name
+func (c Configuration) GetService(name string) (ServiceConfig, bool) {
+	for _, svc := range c.Services {
+		if strings.EqualFold(svc.Name, name) {
+			return svc, true
+		}
+	}
+	return ServiceConfig{}, false
+}
+
 func (c TLSConfiguration) HasTLS() bool {
 	return len(c.Cert) > 0 && len(c.Key) > 0
 }
@@ -117,7 +381,7 @@ func NewConfiguration() Configuration {
 		Website:                     loadVarString("WEBSITE"),
 		Description:                 loadVarString("DESCRIPTION"),
 		Location:                    loadVarString("LOCATION"),
-		Port:                        getEnv("PORT", "3636"),
+		Port:                        getEnv("PORT", DefaultPort),
 		SourceChain:                 loadVarString("SOURCE_CHAIN"),
 		HubProviderURI:              loadVarString("PROVIDER_HUB_URI"),
 		EventStreamHost:             loadVarString("EVENT_STREAM_HOST"),
@@ -126,6 +390,11 @@ func NewConfiguration() Configuration {
 		ClaimStoreLocation:          loadVarString("CLAIM_STORE_LOCATION"),
 		ContractConfigStoreLocation: loadVarString("CONTRACT_CONFIG_STORE_LOCATION"),
 		TLS:                         NewTLSConfiguration(),
+		Storage:                     NewStorageConfiguration(),
+		RateLimit:                   NewRateLimitConfiguration(),
+		Settlement:                  NewSettlementConfiguration(),
+		WebSocket:                   NewWebSocketConfiguration(),
+		Cache:                       NewCacheConfiguration(),
 		ProviderConfigStoreLocation: loadVarString("PROVIDER_CONFIG_STORE_LOCATION"),
 		ArkeoAuthContractId:         uint64(loadVarIntOptional("ARKEO_AUTH_CONTRACT_ID", 0)),
 		ArkeoAuthChainId:            getEnv("ARKEO_AUTH_CHAIN_ID", ""),
@@ -150,6 +419,17 @@ func (c Configuration) Print() {
 	fmt.Fprintln(writer, "Contract Config Store Location\t", c.ContractConfigStoreLocation)
 	fmt.Fprintln(writer, "Free Tier Rate Limit\t", fmt.Sprintf("%d requests per 1m", c.FreeTierRateLimit))
 	fmt.Fprintln(writer, "Provider Config Store Location\t", c.ProviderConfigStoreLocation)
+	fmt.Fprintln(writer, "Storage Backend\t", c.Storage.Backend)
+	fmt.Fprintln(writer, "Rate Limit Backend\t", c.RateLimit.Backend)
+	if c.RateLimit.Backend == "redis" {
+		fmt.Fprintln(writer, "Rate Limit Redis Address\t", c.RateLimit.RedisAddr)
+	}
+
+	fmt.Fprintln(writer, "Claim Settlement Enabled\t", c.Settlement.Enabled)
+	if c.Settlement.Enabled {
+		fmt.Fprintln(writer, "Claim Settlement Interval\t", c.Settlement.Interval)
+		fmt.Fprintln(writer, "Claim Settlement Min Value\t", c.Settlement.MinClaimValue)
+	}
 
 	if c.ArkeoAuthContractId > 0 {
 		fmt.Fprintln(writer, "Arkeo Auth Contract ID\t", c.ArkeoAuthContractId)
@@ -161,6 +441,76 @@ func (c Configuration) Print() {
 	writer.Flush()
 }
 
+// legacyKeys maps the keys of configuration files written before the settings
+// had snake_case yaml keys (the lowercased field names) to the current ones
+var (
+	legacyKeys = map[string]string{
+		"sourcechain":                 "source_chain",
+		"hubprovideruri":              "hub_provider_uri",
+		"eventstreamhost":             "event_stream_host",
+		"claimstorelocation":          "claim_store_location",
+		"contractconfigstorelocation": "contract_config_store_location",
+		"providerconfigstorelocation": "provider_config_store_location",
+		"providerpubkey":              "provider_pubkey",
+		"freetierratelimit":           "free_tier_rate_limit",
+		"arkeoauthcontractid":         "arkeo_auth_contract_id",
+		"arkeoauthchainid":            "arkeo_auth_chain_id",
+		"arkeoauthmnemonic":           "arkeo_auth_mnemonic",
+		"arkeoauthnoncestore":         "arkeo_auth_nonce_store",
+	}
+	legacyTLSKeys = map[string]string{
+		"cert": "tls_certificate",
+		"key":  "tls_key",
+	}
+)
+
+// renameLegacyKeys rewrites the legacy keys of a configuration file to the
+// current ones, the file is returned as is when it has none
+func renameLegacyKeys(data []byte) ([]byte, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	renamed, err := renameKeys(doc, legacyKeys, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range doc {
+		if tls, ok := item.Value.(yaml.MapSlice); ok && item.Key == "tls" {
+			ok, err := renameKeys(tls, legacyTLSKeys, "tls.")
+			if err != nil {
+				return nil, err
+			}
+			renamed = renamed || ok
+		}
+	}
+	if !renamed {
+		return data, nil
+	}
+	return yaml.Marshal(doc)
+}
+
+func renameKeys(doc yaml.MapSlice, keys map[string]string, prefix string) (bool, error) {
+	present := make(map[any]bool, len(doc))
+	for _, item := range doc {
+		present[item.Key] = true
+	}
+	renamed := false
+	for i, item := range doc {
+		key, _ := item.Key.(string)
+		current, ok := keys[key]
+		if !ok {
+			continue
+		}
+		if present[current] {
+			return false, fmt.Errorf("%s%s is the legacy name of %s%s, set only one of them", prefix, key, prefix, current)
+		}
+		doc[i].Key = current
+		renamed = true
+	}
+	return renamed, nil
+}
+
 // LoadConfigurationFromFile loads the configuration from a YAML file and applies environment variable overrides.
 func LoadConfigurationFromFile(filename string) (Configuration, error) {
 	var cfg Configuration
@@ -168,7 +518,11 @@ func LoadConfigurationFromFile(filename string) (Configuration, error) {
 	if err != nil {
 		return cfg, err
 	}
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	if data, err = renameLegacyKeys(data); err != nil {
+		return cfg, err
+	}
+	// unknown keys are errors, a misspelled setting must not be ignored
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
 		return cfg, err
 	}
 
@@ -225,10 +579,58 @@ func LoadConfigurationFromFile(filename string) (Configuration, error) {
 	if v := os.Getenv("TLS_KEY"); v != "" {
 		cfg.TLS.Key = v
 	}
+	// Storage overrides
+	cfg.Storage.Backend = overrideString("STORAGE_BACKEND", cfg.Storage.Backend)
+	cfg.Storage.DSN = overrideString("STORAGE_DSN", cfg.Storage.DSN)
+	// Rate limit overrides
+	cfg.RateLimit.Backend = overrideString("RATE_LIMIT_BACKEND", cfg.RateLimit.Backend)
+	cfg.RateLimit.RedisAddr = overrideString("RATE_LIMIT_REDIS_ADDR", cfg.RateLimit.RedisAddr)
+	cfg.RateLimit.RedisPassword = overrideString("RATE_LIMIT_REDIS_PASSWORD", cfg.RateLimit.RedisPassword)
+	cfg.RateLimit.RedisDB = overrideInt("RATE_LIMIT_REDIS_DB", cfg.RateLimit.RedisDB)
+	cfg.RateLimit.KeyPrefix = overrideString("RATE_LIMIT_KEY_PREFIX", cfg.RateLimit.KeyPrefix)
+	if v := os.Getenv("RATE_LIMIT_IDLE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.RateLimit.IdleTimeout = d
+		}
+	}
+	// Settlement overrides
+	if v := os.Getenv("SETTLEMENT_ENABLED"); v != "" {
+		cfg.Settlement.Enabled = v == "true"
+	}
+	cfg.Settlement.Mnemonic = overrideString("SETTLEMENT_MNEMONIC", cfg.Settlement.Mnemonic)
+	cfg.Settlement.ChainId = overrideString("SETTLEMENT_CHAIN_ID", cfg.Settlement.ChainId)
+	cfg.Settlement.Fees = overrideString("SETTLEMENT_FEES", cfg.Settlement.Fees)
+	cfg.Settlement.MinClaimValue = int64(overrideInt("SETTLEMENT_MIN_CLAIM_VALUE", int(cfg.Settlement.MinClaimValue)))
+	cfg.Settlement.BatchSize = overrideInt("SETTLEMENT_BATCH_SIZE", cfg.Settlement.BatchSize)
+	if v := os.Getenv("SETTLEMENT_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Settlement.Interval = d
+		}
+	}
+	// WebSocket overrides
+	cfg.WebSocket.MessagesPerNonce = overrideInt("WS_MESSAGES_PER_NONCE", cfg.WebSocket.MessagesPerNonce)
+	if v := os.Getenv("WS_NONCE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.WebSocket.NonceTimeout = d
+		}
+	}
+	if v := os.Getenv("WS_PING_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.WebSocket.PingInterval = d
+		}
+	}
+	cfg.WebSocket.MaxMessageSize = int64(overrideInt("WS_MAX_MESSAGE_SIZE", int(cfg.WebSocket.MaxMessageSize)))
+	// Cache overrides
+	cfg.Cache.MaxEntries = overrideInt("CACHE_MAX_ENTRIES", cfg.Cache.MaxEntries)
+	cfg.Cache.MaxBodySize = overrideInt("CACHE_MAX_BODY_SIZE", cfg.Cache.MaxBodySize)
+	cfg.Cache.DiskLocation = overrideString("CACHE_DISK_LOCATION", cfg.Cache.DiskLocation)
 	cfg.ArkeoAuthContractId = overrideUint64("ArkeoAuthContractId", cfg.ArkeoAuthContractId)
 	cfg.ArkeoAuthChainId = overrideString("ArkeoAuthChainId", cfg.ArkeoAuthChainId)
 	cfg.ArkeoAuthMnemonic = overrideString("ArkeoAuthMnemonic", cfg.ArkeoAuthMnemonic)
 	cfg.ArkeoAuthNonceStore = overrideString("ArkeoAuthNonceStore", cfg.ArkeoAuthNonceStore)
 
+	if len(cfg.Port) == 0 {
+		cfg.Port = DefaultPort
+	}
 	return cfg, nil
 }
@@ -1,8 +1,12 @@
 package conf
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -35,3 +39,166 @@ func TestConfiguration(t *testing.T) {
 	require.Equal(t, config.ContractConfigStoreLocation, "configy")
 	require.Equal(t, config.ProviderConfigStoreLocation, "providy")
 }
+
+func TestMethodPolicy(t *testing.T) {
+	policy := MethodPolicy{
+		Allow:           []string{"eth_*", "net_version"},
+		Deny:            []string{"eth_sendRawTransaction"},
+		RateLimits:      map[string]int{"eth_*": 100, "eth_getLogs": 10},
+		CostMultipliers: map[string]int64{"eth_getLogs": 3, "eth_call": 0},
+	}
+	require.True(t, policy.Allows("eth_blockNumber"))
+	require.True(t, policy.Allows("net_version"))
+	require.False(t, policy.Allows("eth_sendRawTransaction"))
+	require.False(t, policy.Allows("debug_traceTransaction"))
+	require.True(t, MethodPolicy{Deny: []string{"debug_*"}}.Allows("eth_call"))
+
+	require.Equal(t, 10, policy.RateLimit("eth_getLogs"))
+	require.Equal(t, 100, policy.RateLimit("eth_call"))
+	require.Equal(t, 0, policy.RateLimit("net_version"))
+
+	require.Equal(t, int64(3), policy.CostMultiplier("eth_getLogs"))
+	require.Equal(t, int64(0), policy.CostMultiplier("eth_call"))
+	require.Equal(t, int64(1), policy.CostMultiplier("net_version"))
+}
+
+const testConfigFile = `
+moniker: local
+source_chain: http://localhost:1317
+hub_provider_uri: http://localhost:1317
+event_stream_host: localhost:26657
+provider_pubkey: cosmospub1addwnpepqg3523h7e7ggeh6na2lsde6s394tqxnvufsz0urld6zwl8687ue9c3dasgu
+free_tier_rate_limit: 10
+claim_store_location: /tmp/claims
+contract_config_store_location: /tmp/contracts
+provider_config_store_location: /tmp/providers
+services:
+  - name: eth-mainnet-fullnode
+    type: evm
+    upstreams:
+      - url: http://localhost:8545
+        weight: 2
+    health_check:
+      interval: 15s
+`
+
+func writeConfigFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "sentinel.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+func TestLoadConfigurationFromFile(t *testing.T) {
+	config, err := LoadConfigurationFromFile(writeConfigFile(t, testConfigFile))
+	require.NoError(t, err)
+	require.NoError(t, config.Validate())
+	require.Equal(t, "local", config.Moniker)
+	require.Equal(t, DefaultPort, config.Port)
+	require.Equal(t, 10, config.FreeTierRateLimit)
+	require.Equal(t, "cosmospub1addwnpepqg3523h7e7ggeh6na2lsde6s394tqxnvufsz0urld6zwl8687ue9c3dasgu", config.ProviderPubKey.String())
+	require.Equal(t, 15*time.Second, config.Services[0].HealthCheck.Interval)
+	require.Equal(t, 2, config.Services[0].Upstreams[0].Weight)
+
+	// misspelled keys are refused
+	_, err = LoadConfigurationFromFile(writeConfigFile(t, strings.Replace(testConfigFile, "free_tier_rate_limit", "free_tier_ratelimit", 1)))
+	require.ErrorContains(t, err, "free_tier_ratelimit")
+}
+
+// baselineConfigFile uses the keys of the config files written before the
+// settings had snake_case keys
+const baselineConfigFile = `
+moniker: local
+port: "3636"
+sourcechain: http://localhost:1317
+hubprovideruri: http://localhost:1317
+eventstreamhost: localhost:26657
+providerpubkey: cosmospub1addwnpepqg3523h7e7ggeh6na2lsde6s394tqxnvufsz0urld6zwl8687ue9c3dasgu
+freetierratelimit: 10
+claimstorelocation: /tmp/claims
+tls:
+  cert: ""
+  key: ""
+services:
+  - name: btc-mainnet-fullnode
+    id: 1
+    type: btc
+    rpc_url: http://localhost:8332
+arkeoauthcontractid: 0
+`
+
+func TestLoadBaselineConfigurationFile(t *testing.T) {
+	config, err := LoadConfigurationFromFile(writeConfigFile(t, baselineConfigFile))
+	require.NoError(t, err)
+	require.NoError(t, config.Validate())
+	require.Equal(t, "http://localhost:1317", config.SourceChain)
+	require.Equal(t, "localhost:26657", config.EventStreamHost)
+	require.Equal(t, "cosmospub1addwnpepqg3523h7e7ggeh6na2lsde6s394tqxnvufsz0urld6zwl8687ue9c3dasgu", config.ProviderPubKey.String())
+	require.Equal(t, 10, config.FreeTierRateLimit)
+	require.Equal(t, "/tmp/claims", config.ClaimStoreLocation)
+	// the other stores are kept in memory
+	require.Empty(t, config.ContractConfigStoreLocation)
+	require.Equal(t, "http://localhost:8332", config.Services[0].RpcUrl)
+
+	_, err = LoadConfigurationFromFile(writeConfigFile(t, baselineConfigFile+"source_chain: http://localhost:1318\n"))
+	require.ErrorContains(t, err, "sourcechain is the legacy name of source_chain")
+}
+
+func TestValidate(t *testing.T) {
+	config, err := LoadConfigurationFromFile(writeConfigFile(t, testConfigFile))
+	require.NoError(t, err)
+
+	config.Moniker = ""
+	config.Port = "http"
+	config.TLS.Cert = "cert.pem"
+	config.RateLimit.Backend = "memcached"
+	config.Services = append(config.Services,
+		ServiceConfig{Name: "eth-mainnet-fullnode", RpcUrl: "localhost:8545", LoadBalancing: "random"},
+		ServiceConfig{Name: "btc-mainnet-fullnode", HealthCheck: HealthCheckConfig{Interval: -1, MaxFailures: -1}},
+	)
+	err = config.Validate()
+	require.Error(t, err)
+	require.Equal(t, []string{
+		"moniker is required",
+		`port: "http" is not a valid port`,
+		"tls: tls_certificate and tls_key must be set together",
+		"tls.tls_certificate: stat cert.pem: no such file or directory",
+		"rate_limit.backend: must be one of memory, redis",
+		"services[eth-mainnet-fullnode]: duplicate service name",
+		`services[eth-mainnet-fullnode].rpc_url: "localhost:8545" is not an absolute url`,
+		"services[eth-mainnet-fullnode].load_balancing: must be one of round_robin, least_latency",
+		"services[btc-mainnet-fullnode]: rpc_url or upstreams is required",
+		"services[btc-mainnet-fullnode].health_check.max_failures must not be negative",
+	}, strings.Split(err.Error(), "\n"))
+}
+
+func TestRestartRequired(t *testing.T) {
+	config, err := LoadConfigurationFromFile(writeConfigFile(t, testConfigFile))
+	require.NoError(t, err)
+
+	next := config
+	next.Services = nil
+	next.FreeTierRateLimit = 20
+	next.TLS = TLSConfiguration{Cert: "cert.pem", Key: "key.pem"}
+	require.Empty(t, config.RestartRequired(next))
+
+	next.Port = "4000"
+	next.Storage.Backend = "sqlite"
+	require.Equal(t, []string{"port", "storage"}, config.RestartRequired(next))
+}
+
+func TestSchema(t *testing.T) {
+	data, err := Schema()
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Contains(t, schema["required"], "services")
+	require.Equal(t, false, schema["additionalProperties"])
+
+	properties := schema["properties"].(map[string]any)
+	require.Equal(t, "integer", properties["free_tier_rate_limit"].(map[string]any)["type"])
+	service := properties["services"].(map[string]any)["items"].(map[string]any)["properties"].(map[string]any)
+	interval := service["health_check"].(map[string]any)["properties"].(map[string]any)["interval"].(map[string]any)
+	require.Equal(t, "string", interval["type"])
+	require.Regexp(t, interval["pattern"], "1m30s")
+	require.NotRegexp(t, interval["pattern"], "90")
+}
@@ -0,0 +1,58 @@
+package conf
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+// durations are go duration strings, ie "1m30s"
+const durationPattern = `^-?([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+
+// Schema returns the JSON schema of the configuration file. It is derived from
+// the yaml keys of Configuration, Validate checks what the schema can't
+// express.
+func Schema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeOf(Configuration{}))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = "Sentinel configuration"
+	schema["required"] = []string{"moniker", "source_chain", "hub_provider_uri", "event_stream_host", "provider_pubkey", "services"}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func typeSchema(t reflect.Type) map[string]any {
+	switch t {
+	case reflect.TypeOf(time.Duration(0)):
+		return map[string]any{"type": "string", "pattern": durationPattern}
+	case reflect.TypeOf(common.PubKey("")):
+		return map[string]any{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		properties := make(map[string]any)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Tag.Get("yaml") == "-" {
+				continue
+			}
+			properties[yamlName(field)] = typeSchema(field.Type)
+		}
+		return map[string]any{"type": "object", "properties": properties, "additionalProperties": false}
+	}
+	return map[string]any{}
+}
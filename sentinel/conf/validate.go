@@ -0,0 +1,220 @@
+package conf
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+// accepted values of the backend and load balancing settings, an empty value
+// selects the first one
+var (
+	storageBackends   = []string{"leveldb", "postgres", "sqlite"}
+	rateLimitBackends = []string{"memory", "redis"}
+	loadBalancers     = []string{"round_robin", "least_latency"}
+)
+
+// Validate checks the configuration and returns every problem found, joined
+// in a single error
+func (c Configuration) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	required := func(key, value string) {
+		if len(strings.TrimSpace(value)) == 0 {
+			fail("%s is required", key)
+		}
+	}
+
+	required("moniker", c.Moniker)
+	required("source_chain", c.SourceChain)
+	required("hub_provider_uri", c.HubProviderURI)
+	required("event_stream_host", c.EventStreamHost)
+	required("provider_pubkey", c.ProviderPubKey.String())
+	if _, err := common.NewPubKey(c.ProviderPubKey.String()); len(c.ProviderPubKey) > 0 && err != nil {
+		fail("provider_pubkey: %w", err)
+	}
+	if err := validateURL(c.SourceChain); len(c.SourceChain) > 0 && err != nil {
+		fail("source_chain: %w", err)
+	}
+	if err := validateURL(c.HubProviderURI); len(c.HubProviderURI) > 0 && err != nil {
+		fail("hub_provider_uri: %w", err)
+	}
+	if port, err := strconv.Atoi(c.Port); len(c.Port) > 0 && (err != nil || port <= 0 || port > 65535) {
+		fail("port: %q is not a valid port", c.Port)
+	}
+	if c.FreeTierRateLimit < 0 {
+		fail("free_tier_rate_limit must not be negative")
+	}
+
+	if len(c.TLS.Cert) > 0 != (len(c.TLS.Key) > 0) {
+		fail("tls: tls_certificate and tls_key must be set together")
+	}
+	if _, err := os.Stat(c.TLS.Cert); len(c.TLS.Cert) > 0 && err != nil {
+		fail("tls.tls_certificate: %w", err)
+	}
+	if _, err := os.Stat(c.TLS.Key); len(c.TLS.Key) > 0 && err != nil {
+		fail("tls.tls_key: %w", err)
+	}
+
+	// leveldb stores without a location are kept in memory
+	if !validChoice(c.Storage.Backend, storageBackends) {
+		fail("storage.backend: must be one of %s", strings.Join(storageBackends, ", "))
+	} else if c.Storage.Backend != "" && c.Storage.Backend != "leveldb" {
+		required("storage.dsn", c.Storage.DSN)
+	}
+
+	if !validChoice(c.RateLimit.Backend, rateLimitBackends) {
+		fail("rate_limit.backend: must be one of %s", strings.Join(rateLimitBackends, ", "))
+	} else if c.RateLimit.Backend == "redis" {
+		required("rate_limit.redis_addr", c.RateLimit.RedisAddr)
+	}
+
+	if c.Settlement.Enabled {
+		required("settlement.mnemonic", c.Settlement.Mnemonic)
+		required("settlement.chain_id", c.Settlement.ChainId)
+	}
+	if c.ArkeoAuthContractId > 0 {
+		required("arkeo_auth_mnemonic", c.ArkeoAuthMnemonic)
+		required("arkeo_auth_chain_id", c.ArkeoAuthChainId)
+	}
+	errs = append(errs, negativeFields("settlement", c.Settlement)...)
+	errs = append(errs, negativeFields("websocket", c.WebSocket)...)
+	errs = append(errs, negativeFields("cache", c.Cache)...)
+
+	if len(c.Services) == 0 {
+		fail("services: at least one service is required")
+	}
+	names := make(map[string]bool)
+	for i, svc := range c.Services {
+		key := fmt.Sprintf("services[%d]", i)
+		if len(svc.Name) == 0 {
+			fail("%s.name is required", key)
+		} else {
+			key = fmt.Sprintf("services[%s]", svc.Name)
+		}
+		if names[strings.ToLower(svc.Name)] {
+			fail("%s: duplicate service name", key)
+		}
+		names[strings.ToLower(svc.Name)] = true
+		errs = append(errs, svc.validate(key)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s ServiceConfig) validate(key string) []error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(key+format, args...))
+	}
+
+	if len(s.RpcUrl) > 0 {
+		if err := validateURL(s.RpcUrl); err != nil {
+			fail(".rpc_url: %w", err)
+		}
+	}
+	for i, upstream := range s.Upstreams {
+		if err := validateURL(upstream.Url); err != nil {
+			fail(".upstreams[%d].url: %w", i, err)
+		}
+		if upstream.Weight < 0 {
+			fail(".upstreams[%d].weight must not be negative", i)
+		}
+	}
+	if len(s.GetUpstreams()) == 0 {
+		fail(": rpc_url or upstreams is required")
+	}
+	if !validChoice(s.LoadBalancing, loadBalancers) {
+		fail(".load_balancing: must be one of %s", strings.Join(loadBalancers, ", "))
+	}
+	for method, weight := range s.MethodWeights {
+		if weight < 0 {
+			fail(".method_weights[%s] must not be negative", method)
+		}
+	}
+	for method, limit := range s.Methods.RateLimits {
+		if limit < 0 {
+			fail(".methods.rate_limits[%s] must not be negative", method)
+		}
+	}
+	for method, multiplier := range s.Methods.CostMultipliers {
+		if multiplier < 0 {
+			fail(".methods.cost_multipliers[%s] must not be negative", method)
+		}
+	}
+	// a negative health check interval disables the probes
+	health := s.HealthCheck
+	health.Interval = 0
+	errs = append(errs, negativeFields(key+".health_check", health)...)
+	errs = append(errs, negativeFields(key+".cache", s.Cache)...)
+	return errs
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return fmt.Errorf("%q is not an absolute url", raw)
+	}
+	return nil
+}
+
+func validChoice(value string, choices []string) bool {
+	return len(value) == 0 || slices.Contains(choices, value)
+}
+
+// negativeFields reports the numeric fields of a settings struct that are
+// negative
+func negativeFields(key string, settings any) []error {
+	var errs []error
+	v := reflect.ValueOf(settings)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if field.Int() < 0 {
+				errs = append(errs, fmt.Errorf("%s.%s must not be negative", key, yamlName(v.Type().Field(i))))
+			}
+		}
+	}
+	return errs
+}
+
+// yamlName returns the key of a struct field in the configuration file
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if len(name) == 0 {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// RestartRequired lists the settings of next that differ from c but can't be
+// applied without restarting the sentinel. Services, free_tier_rate_limit and
+// tls are reloaded live.
+func (c Configuration) RestartRequired(next Configuration) []string {
+	var changed []string
+	current, updated := reflect.ValueOf(c), reflect.ValueOf(next)
+	for i := 0; i < current.NumField(); i++ {
+		field := current.Type().Field(i)
+		switch field.Name {
+		case "Services", "FreeTierRateLimit", "TLS":
+			continue
+		}
+		if !reflect.DeepEqual(current.Field(i).Interface(), updated.Field(i).Interface()) {
+			changed = append(changed, yamlName(field))
+		}
+	}
+	return changed
+}
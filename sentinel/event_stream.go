@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"cosmossdk.io/errors"
 	"github.com/gogo/protobuf/proto"
@@ -37,6 +38,29 @@ func subscribe(client *tmclient.HTTP, logger log.Logger, query string) <-chan tm
 	return out
 }
 
+// resubscribe subscribes to query again until it succeeds, it returns nil
+// once the client is stopped
+func resubscribe(client *tmclient.HTTP, logger log.Logger, query string) <-chan tmCoreTypes.ResultEvent {
+	backoff := time.Second
+	for {
+		logger.Error("event subscription dropped, resubscribing", "query", query)
+		_ = client.Unsubscribe(context.Background(), "", query)
+		out, err := client.Subscribe(context.Background(), "", query)
+		if err == nil {
+			return out
+		}
+		logger.Error("fail to resubscribe", "query", query, "error", err)
+		select {
+		case <-client.Quit():
+			return nil
+		case <-time.After(backoff):
+		}
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+}
+
 func NewTendermintClient(baseURL string, authManager *ArkeoAuthManager) (*tmclient.HTTP, error) {
 	// Add auth to WebSocket URL if configured
 	if authManager != nil {
@@ -104,16 +128,24 @@ func (p Proxy) EventListener(host string, authManager *ArkeoAuthManager) {
 		for _, query := range queries {
 			out := subscribe(client, logger, query)
 
-			go func(out <-chan tmCoreTypes.ResultEvent) {
+			go func(query string, out <-chan tmCoreTypes.ResultEvent) {
 				for {
 					select {
-					case result := <-out:
+					case result, ok := <-out:
+						if !ok {
+							// the subscription was dropped, subscribe again
+							if out = resubscribe(client, logger, query); out == nil {
+								return
+							}
+							p.metrics.eventStreamReconnected()
+							continue
+						}
 						eventChan <- result
 					case <-client.Quit():
 						return
 					}
 				}
-			}(out)
+			}(query, out)
 		}
 	}
 
@@ -122,12 +154,15 @@ func (p Proxy) EventListener(host string, authManager *ArkeoAuthManager) {
 		"tm.event = 'NewBlock'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgOpenContract'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgCloseContract'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgTopUpContract'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgOpenContractGroup'",
 	)
 
 	go subscribeToEvents(clients[1],
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgClaimContractIncome'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgBondProvider'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgModProvider'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgSetDelegateLimit'",
 	)
 
 	dispatchEvents := func(result tmCoreTypes.ResultEvent) {
@@ -135,12 +170,18 @@ func (p Proxy) EventListener(host string, authManager *ArkeoAuthManager) {
 		case strings.Contains(result.Query, "NewBlock"):
 			p.handleNewBlockHeaderEvent(result)
 
+		case strings.Contains(result.Query, "MsgOpenContractGroup"):
+			p.handleOpenContractGroupEvent(result)
+
 		case strings.Contains(result.Query, "MsgOpenContract"):
 			p.handleOpenContractEvent(result)
 
 		case strings.Contains(result.Query, "MsgCloseContract"):
 			p.handleCloseContractEvent(result)
 
+		case strings.Contains(result.Query, "MsgTopUpContract"):
+			p.handleTopUpContractEvent(result)
+
 		case strings.Contains(result.Query, "MsgClaimContractIncome"):
 			p.handleContractSettlementEvent(result)
 
@@ -150,6 +191,9 @@ func (p Proxy) EventListener(host string, authManager *ArkeoAuthManager) {
 		case strings.Contains(result.Query, "MsgBondProvider"):
 			p.handleBondProviderEvent(result)
 
+		case strings.Contains(result.Query, "MsgSetDelegateLimit"):
+			p.handleSetDelegateLimitEvent(result)
+
 		default:
 			logger.Error("Unknown Event Type", "Query", result.Query)
 		}
@@ -182,6 +226,9 @@ func (p Proxy) handleContractSettlementEvent(result tmCoreTypes.ResultEvent) {
 		return
 	}
 
+	// any claim may pay a member of a contract group from its shared deposit
+	p.MemStore.ResetGroups()
+
 	if !p.isMyPubKey(evt.Provider) {
 		return
 	}
@@ -195,6 +242,16 @@ func (p Proxy) handleContractSettlementEvent(result tmCoreTypes.ResultEvent) {
 		Id:       evt.ContractId,
 	}
 
+	if cached, ok := p.MemStore.Cached(contract.Key()); ok && !evt.Paid.IsNil() {
+		if cached.Paid.IsNil() {
+			cached.Paid = cosmos.ZeroInt()
+		}
+		cached.Paid = cached.Paid.Add(evt.Paid)
+		cached.Nonce = evt.Nonce
+		cached.AddDelegateSpent(p.MemStore.GetHeight(), evt.Paid)
+		p.MemStore.Put(cached)
+	}
+
 	spender := contract.GetSpender()
 	newClaim := NewClaim(contract.Id, spender, evt.Nonce, "")
 	currClaim, err := p.ClaimStore.Get(newClaim.Key())
@@ -250,7 +307,30 @@ func (p Proxy) handleOpenContractEvent(result tmCoreTypes.ResultEvent) {
 		p.logger.Error(fmt.Sprintf("failed to cast %T to EventOpenContract", typedEvent))
 		return
 	}
+	p.putOpenContract(evt)
+}
+
+// handleOpenContractGroupEvent caches the contracts of a group opened with
+// this provider, each member is an ordinary pay-as-you-go contract
+func (p Proxy) handleOpenContractGroupEvent(result tmCoreTypes.ResultEvent) {
+	typedEvents, err := parseTypedEvents(result, "arkeo.arkeo.EventOpenContract")
+	if err != nil {
+		p.logger.Error("failed to parse typed events", "error", err)
+		return
+	}
+	for _, typedEvent := range typedEvents {
+		evt, ok := typedEvent.(*types.EventOpenContract)
+		if !ok {
+			p.logger.Error(fmt.Sprintf("failed to cast %T to EventOpenContract", typedEvent))
+			continue
+		}
+		p.putOpenContract(evt)
+	}
+}
 
+// putOpenContract caches a contract opened by a client or by the renewal of a
+// subscription
+func (p Proxy) putOpenContract(evt *types.EventOpenContract) {
 	service := common.Service(common.ServiceLookup[evt.Service])
 	contract := types.Contract{
 		Provider:           evt.Provider,
@@ -266,6 +346,11 @@ func (p Proxy) handleOpenContractEvent(result tmCoreTypes.ResultEvent) {
 		SettlementDuration: evt.SettlementDuration,
 		Authorization:      evt.Authorization,
 		QueriesPerMinute:   evt.QueriesPerMinute,
+		PayAsYouGoTiers:    evt.PayAsYouGoTiers,
+		DiscountBps:        evt.DiscountBps,
+		GroupId:            evt.GroupId,
+		DelegateLimit:      evt.DelegateLimit,
+		Payer:              evt.Payer,
 	}
 
 	if !p.isMyPubKey(evt.Provider) {
@@ -278,6 +363,58 @@ func (p Proxy) handleOpenContractEvent(result tmCoreTypes.ResultEvent) {
 	p.MemStore.Put(contract)
 }
 
+// handleTopUpContractEvent updates the deposit and duration of a cached
+// contract, contracts that aren't cached are fetched when used
+func (p Proxy) handleTopUpContractEvent(result tmCoreTypes.ResultEvent) {
+	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventTopUpContract")
+	if err != nil {
+		p.logger.Error("failed to parse typed event", "error", err)
+		return
+	}
+
+	evt, ok := typedEvent.(*types.EventTopUpContract)
+	if !ok {
+		p.logger.Error(fmt.Sprintf("failed to cast %T to EventTopUpContract", typedEvent))
+		return
+	}
+
+	if !p.isMyPubKey(evt.Provider) {
+		return
+	}
+	contract, ok := p.MemStore.Cached(types.Contract{Id: evt.ContractId}.Key())
+	if !ok {
+		return
+	}
+	contract.Deposit = evt.Deposit
+	contract.Duration = evt.Duration
+	p.MemStore.Put(contract)
+}
+
+// handleSetDelegateLimitEvent updates the spend limit of a cached contract
+func (p Proxy) handleSetDelegateLimitEvent(result tmCoreTypes.ResultEvent) {
+	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventSetDelegateLimit")
+	if err != nil {
+		p.logger.Error("failed to parse typed event", "error", err)
+		return
+	}
+
+	evt, ok := typedEvent.(*types.EventSetDelegateLimit)
+	if !ok {
+		p.logger.Error(fmt.Sprintf("failed to cast %T to EventSetDelegateLimit", typedEvent))
+		return
+	}
+
+	if !p.isMyPubKey(evt.Provider) {
+		return
+	}
+	contract, ok := p.MemStore.Cached(types.Contract{Id: evt.ContractId}.Key())
+	if !ok {
+		return
+	}
+	contract.DelegateLimit = evt.DelegateLimit
+	p.MemStore.Put(contract)
+}
+
 func (p Proxy) handleNewBlockHeaderEvent(result tmCoreTypes.ResultEvent) {
 	data, ok := result.Data.(tmtypes.EventDataNewBlock)
 	if !ok {
@@ -289,6 +426,18 @@ func (p Proxy) handleNewBlockHeaderEvent(result tmCoreTypes.ResultEvent) {
 	p.MemStore.SetHeight(height)
 
 	for _, evt := range data.ResultFinalizeBlock.Events {
+		// subscriptions renewed at expiry
+		if evt.Type == types.EventTypeOpenContract {
+			typedEvent, err := sdk.ParseTypedEvent(evt)
+			if err != nil {
+				p.logger.Error("failed to parse typed event", "error", err)
+				continue
+			}
+			if openEvent, ok := typedEvent.(*types.EventOpenContract); ok {
+				p.putOpenContract(openEvent)
+			}
+			continue
+		}
 		if evt.Type == types.EventTypeSettleContract {
 			input := make(map[string]string)
 			for _, attr := range evt.Attributes {
@@ -342,6 +491,30 @@ func parseTypedEvent(result tmCoreTypes.ResultEvent, eventType string) (proto.Me
 	return msg, fmt.Errorf("event %s not found", eventType)
 }
 
+// parseTypedEvents returns every event of the given type in the tx
+func parseTypedEvents(result tmCoreTypes.ResultEvent, eventType string) ([]proto.Message, error) {
+	eventDataTx, ok := result.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return nil, fmt.Errorf("failed cast %T to EventDataTx", result.Data)
+	}
+
+	var msgs []proto.Message
+	for _, evt := range eventDataTx.TxResult.Result.Events {
+		if evt.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("event %s not found", eventType)
+	}
+	return msgs, nil
+}
+
 func (p Proxy) handleBondProviderEvent(result tmCoreTypes.ResultEvent) {
 	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventBondProvider")
 	if err != nil {
@@ -406,8 +579,8 @@ func (p Proxy) handleModProviderEvent(result tmCoreTypes.ResultEvent) {
 		return
 	}
 
-	p.logger.Error(fmt.Sprintf("evt.Provider: ", evt.Provider))
-	p.logger.Error(fmt.Sprintf("service.String(): ", service.String()))
+	p.logger.Error(fmt.Sprintf("evt.Provider: %s", evt.Provider))
+	p.logger.Error(fmt.Sprintf("service.String(): %s", service.String()))
 
 	providerConfig, err := p.ProviderConfigStore.Get(evt.Provider, service.String())
 	if err != nil {
@@ -108,6 +108,40 @@ func TestHandleOpenContractEvent(t *testing.T) {
 	require.Equal(t, inputContract, outputContract)
 }
 
+func TestHandleRenewedContractEvent(t *testing.T) {
+	testConfig := newTestConfig()
+	testConfig.Services = []conf.ServiceConfig{{Name: "btc-mainnet-fullnode", RpcUrl: "http://localhost:8332"}}
+	proxy, err := NewProxy(testConfig)
+	require.NoError(t, err)
+
+	// renewals open their contract in the end blocker
+	renewed := types.Contract{
+		Provider:         testConfig.ProviderPubKey,
+		Service:          common.BTCService,
+		Client:           types.GetRandomPubKey(),
+		Delegate:         common.EmptyPubKey,
+		Type:             types.ContractType_SUBSCRIPTION,
+		Height:           200,
+		Duration:         100,
+		Rate:             cosmos.NewInt64Coin("uarkeo", 1),
+		Deposit:          cosmos.NewInt(100),
+		Id:               7,
+		QueriesPerMinute: 1,
+	}
+	openEvent := types.NewOpenContractEvent(0, &renewed)
+	sdkEvt, err := sdk.TypedEventToEvent(&openEvent)
+	require.NoError(t, err)
+	block := tmtypes.EventDataNewBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: 200}}}
+	block.ResultFinalizeBlock.Events = []abciTypes.Event{abciTypes.Event(sdkEvt)}
+	proxy.handleNewBlockHeaderEvent(tmCoreTypes.ResultEvent{Query: "tm.event = 'NewBlock'", Data: block})
+
+	contract, ok := proxy.MemStore.Cached(renewed.Key())
+	require.True(t, ok)
+	require.Equal(t, types.ContractType_SUBSCRIPTION, contract.Type)
+	require.Equal(t, renewed.Client, contract.Client)
+	require.Equal(t, int64(200), contract.Height)
+}
+
 func TestHandleCloseContractEvent(t *testing.T) {
 	testConfig := newTestConfig()
 	proxy, err := NewProxy(testConfig)
@@ -196,7 +230,7 @@ func TestHandleHandleContractSettlementEvent(t *testing.T) {
 		Spender:    inputContract.Client,
 		Nonce:      10,
 	}
-	_, err = proxy.paidTier(arkAuth, "")
+	_, _, err = proxy.paidTier(arkAuth, "", 1, nil)
 	require.NoError(t, err)
 
 	// confirm our claim exists in the claim store
@@ -0,0 +1,87 @@
+package sentinel
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// JSON-RPC error codes returned by the sentinel
+const (
+	JSONRPCErrUnauthorized = -32001
+	JSONRPCErrMethodDenied = -32004
+	JSONRPCErrRateLimited  = -32005
+)
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  any             `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+// newJSONRPCError builds an error response for the request with the given id
+func newJSONRPCError(id json.RawMessage, code int, message string) []byte {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	buf, _ := json.Marshal(jsonRPCMessage{JSONRPC: "2.0", Id: id, Error: &jsonRPCError{Code: code, Message: message}})
+	return buf
+}
+
+// jsonRPCIds returns the ids of a JSON-RPC message or batch, messages that are
+// not JSON-RPC have none.
+func jsonRPCIds(msg []byte) []string {
+	msg = bytes.TrimSpace(msg)
+	var batch []jsonRPCMessage
+	if len(msg) > 0 && msg[0] == '[' {
+		if err := json.Unmarshal(msg, &batch); err != nil {
+			return nil
+		}
+	} else {
+		var m jsonRPCMessage
+		if err := json.Unmarshal(msg, &m); err != nil {
+			return nil
+		}
+		batch = append(batch, m)
+	}
+	ids := make([]string, 0, len(batch))
+	for _, m := range batch {
+		if len(m.Id) > 0 && string(m.Id) != "null" {
+			ids = append(ids, string(m.Id))
+		}
+	}
+	return ids
+}
+
+// jsonRPCErrorBody builds the error response to a request, with an error
+// object for every call of a batch
+func jsonRPCErrorBody(calls []rpcCall, code int, message string) []byte {
+	if len(calls) <= 1 {
+		var id json.RawMessage
+		if len(calls) == 1 {
+			id = calls[0].Id
+		}
+		return newJSONRPCError(id, code, message)
+	}
+	batch := make([]json.RawMessage, 0, len(calls))
+	for _, call := range calls {
+		batch = append(batch, newJSONRPCError(call.Id, code, message))
+	}
+	buf, _ := json.Marshal(batch)
+	return buf
+}
+
+// respondWithJSONRPCError refuses a JSON-RPC request
+func respondWithJSONRPCError(w http.ResponseWriter, status int, calls []rpcCall, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(jsonRPCErrorBody(calls, code, message))
+}
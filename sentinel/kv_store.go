@@ -0,0 +1,198 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// ErrNotFound is returned by a KVStore when the key doesn't exist
+var ErrNotFound = errors.New("not found")
+
+// KVItem is a single key/value pair written in a batch
+type KVItem struct {
+	Key   []byte
+	Value []byte
+}
+
+// KVStore is the storage backend of the sentinel stores. Values are the JSON
+// encoded items of the store.
+type KVStore interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Put(key, value []byte) error
+	PutBatch(items []KVItem) error
+	Delete(key []byte) error
+	// Iterate calls fn for every item, in key order, until fn returns an error
+	Iterate(fn func(key, value []byte) error) error
+	Close() error
+}
+
+// LevelDBStore is a KVStore backed by a LevelDB folder
+type LevelDBStore struct {
+	db *leveldb.DB
+}
+
+var _ KVStore = &LevelDBStore{}
+
+// NewLevelDBStore opens the LevelDB folder, or an in memory database when the
+// folder is empty.
+func NewLevelDBStore(levelDbFolder string) (*LevelDBStore, error) {
+	var db *leveldb.DB
+	var err error
+	if len(levelDbFolder) == 0 {
+		log.Warn().Msg("level db folder is empty, create in memory storage")
+		// no directory given, use in memory store
+		storage := storage.NewMemStorage()
+		db, err = leveldb.Open(storage, nil)
+		if err != nil {
+			return nil, fmt.Errorf("fail to in memory open level db: %w", err)
+		}
+	} else {
+		db, err = leveldb.OpenFile(levelDbFolder, nil)
+		if err != nil {
+			return nil, fmt.Errorf("fail to open level db %s: %w", levelDbFolder, err)
+		}
+	}
+	return &LevelDBStore{db: db}, nil
+}
+
+func (s *LevelDBStore) Get(key []byte) ([]byte, error) {
+	buf, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	return buf, err
+}
+
+func (s *LevelDBStore) Has(key []byte) (bool, error) {
+	return s.db.Has(key, nil)
+}
+
+func (s *LevelDBStore) Put(key, value []byte) error {
+	return s.db.Put(key, value, nil)
+}
+
+func (s *LevelDBStore) PutBatch(items []KVItem) error {
+	batch := new(leveldb.Batch)
+	for _, item := range items {
+		batch.Put(item.Key, item.Value)
+	}
+	return s.db.Write(batch, nil)
+}
+
+func (s *LevelDBStore) Delete(key []byte) error {
+	return s.db.Delete(key, nil)
+}
+
+func (s *LevelDBStore) Iterate(fn func(key, value []byte) error) error {
+	iterator := s.db.NewIterator(util.BytesPrefix([]byte(nil)), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		if err := fn(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+	}
+	return iterator.Error()
+}
+
+func (s *LevelDBStore) Close() error {
+	return s.db.Close()
+}
+
+// TypedStore stores JSON encoded items of a single type in a KVStore. It
+// holds the Get/Set/List logic shared by the sentinel stores.
+type TypedStore[T any] struct {
+	logger zerolog.Logger
+	db     KVStore
+}
+
+func NewTypedStore[T any](db KVStore, module string) *TypedStore[T] {
+	return &TypedStore[T]{
+		logger: log.With().Str("module", module).Logger(),
+		db:     db,
+	}
+}
+
+// Get returns the item stored under key, or ErrNotFound
+func (s *TypedStore[T]) Get(key string) (item T, err error) {
+	buf, err := s.db.Get([]byte(key))
+	if err != nil {
+		return item, err
+	}
+	if err := json.Unmarshal(buf, &item); err != nil {
+		s.logger.Error().Err(err).Msg("fail to unmarshal store item")
+		return item, err
+	}
+	return item, nil
+}
+
+func (s *TypedStore[T]) Set(key string, item T) error {
+	buf, err := json.Marshal(item)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to marshal store item")
+		return err
+	}
+	if err := s.db.Put([]byte(key), buf); err != nil {
+		s.logger.Error().Err(err).Msg("fail to set store item")
+		return err
+	}
+	return nil
+}
+
+// Batch writes all items at once, keyed by the given function
+func (s *TypedStore[T]) Batch(items []T, key func(T) string) error {
+	batch := make([]KVItem, 0, len(items))
+	for _, item := range items {
+		buf, err := json.Marshal(item)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("fail to marshal store item")
+			return err
+		}
+		batch = append(batch, KVItem{Key: []byte(key(item)), Value: buf})
+	}
+	return s.db.PutBatch(batch)
+}
+
+// Has check whether the given key exist in key value store
+func (s *TypedStore[T]) Has(key string) bool {
+	ok, _ := s.db.Has([]byte(key))
+	return ok
+}
+
+// Remove remove the given item from key values store
+func (s *TypedStore[T]) Remove(key string) error {
+	return s.db.Delete([]byte(key))
+}
+
+// List returns every item of the store, items that fail to decode are skipped
+func (s *TypedStore[T]) List() []T {
+	var results []T
+	err := s.db.Iterate(func(_, buf []byte) error {
+		if len(buf) == 0 {
+			return nil
+		}
+		var item T
+		if err := json.Unmarshal(buf, &item); err != nil {
+			s.logger.Error().Err(err).Msg("fail to unmarshal store item")
+			return nil
+		}
+		results = append(results, item)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to iterate store")
+	}
+	return results
+}
+
+// Close underlying db
+func (s *TypedStore[T]) Close() error {
+	return s.db.Close()
+}
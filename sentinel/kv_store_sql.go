@@ -0,0 +1,174 @@
+package sentinel
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // postgres driver
+	_ "modernc.org/sqlite"             // sqlite driver
+)
+
+const (
+	StorageBackendLevelDB  = "leveldb"
+	StorageBackendPostgres = "postgres"
+	StorageBackendSQLite   = "sqlite"
+)
+
+var validTableName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// SQLStore is a KVStore kept in a two column (key, value) table. Values are
+// stored as JSON (JSONB on postgres), so operators can query the sentinel
+// state with plain SQL, ie
+//
+//	SELECT value->>'nonce' FROM sentinel_claims WHERE NOT (value->>'claimed')::bool
+type SQLStore struct {
+	db      *sql.DB
+	table   string
+	backend string
+}
+
+var _ KVStore = &SQLStore{}
+
+// NewSQLStore creates the table (if missing) and returns a store on it
+func NewSQLStore(db *sql.DB, backend, table string) (*SQLStore, error) {
+	if !validTableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid table name: %s", table)
+	}
+	s := &SQLStore{db: db, table: table, backend: backend}
+	valueType := "TEXT"
+	switch backend {
+	case StorageBackendPostgres:
+		valueType = "JSONB"
+	case StorageBackendSQLite:
+	default:
+		return nil, fmt.Errorf("unsupported sql backend: %s", backend)
+	}
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (key TEXT PRIMARY KEY, value %s NOT NULL)`, table, valueType)
+	if _, err := db.Exec(query); err != nil {
+		return nil, fmt.Errorf("fail to create table %s: %w", table, err)
+	}
+	return s, nil
+}
+
+// OpenSQLDB opens the database of a SQL storage backend
+func OpenSQLDB(backend, dsn string) (*sql.DB, error) {
+	driver := ""
+	switch backend {
+	case StorageBackendPostgres:
+		driver = "pgx"
+	case StorageBackendSQLite:
+		driver = "sqlite"
+	default:
+		return nil, fmt.Errorf("unsupported sql backend: %s", backend)
+	}
+	if len(dsn) == 0 {
+		return nil, fmt.Errorf("%s storage backend requires a dsn", backend)
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open %s database: %w", backend, err)
+	}
+	if backend == StorageBackendSQLite {
+		// sqlite only supports a single writer
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("fail to connect to %s database: %w", backend, err)
+	}
+	return db, nil
+}
+
+// placeholder returns the n-th (1 based) bind parameter of the dialect
+func (s *SQLStore) placeholder(n int) string {
+	if s.backend == StorageBackendPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (s *SQLStore) upsertQuery() string {
+	value := s.placeholder(2)
+	if s.backend == StorageBackendPostgres {
+		value += "::jsonb"
+	}
+	return fmt.Sprintf(`INSERT INTO %s (key, value) VALUES (%s, %s) ON CONFLICT (key) DO UPDATE SET value = excluded.value`, s.table, s.placeholder(1), value)
+}
+
+func (s *SQLStore) Get(key []byte) ([]byte, error) {
+	var value string
+	query := fmt.Sprintf(`SELECT value FROM %s WHERE key = %s`, s.table, s.placeholder(1))
+	err := s.db.QueryRow(query, string(key)).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []byte(value), nil
+}
+
+func (s *SQLStore) Has(key []byte) (bool, error) {
+	var count int
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE key = %s`, s.table, s.placeholder(1))
+	if err := s.db.QueryRow(query, string(key)).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (s *SQLStore) Put(key, value []byte) error {
+	_, err := s.db.Exec(s.upsertQuery(), string(key), string(value))
+	return err
+}
+
+func (s *SQLStore) PutBatch(items []KVItem) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(s.upsertQuery())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	for _, item := range items {
+		if _, err := stmt.Exec(string(item.Key), string(item.Value)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLStore) Delete(key []byte) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE key = %s`, s.table, s.placeholder(1))
+	_, err := s.db.Exec(query, string(key))
+	return err
+}
+
+func (s *SQLStore) Iterate(fn func(key, value []byte) error) error {
+	rows, err := s.db.Query(fmt.Sprintf(`SELECT key, value FROM %s ORDER BY key`, s.table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return err
+		}
+		if err := fn([]byte(key), []byte(value)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Close is a no-op, the database is shared by the stores and closed by Storage
+func (s *SQLStore) Close() error {
+	return nil
+}
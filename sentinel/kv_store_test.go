@@ -0,0 +1,162 @@
+package sentinel
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func newTestSQLiteStorage(t *testing.T) *Storage {
+	storage, err := NewStorage(conf.StorageConfiguration{
+		Backend: StorageBackendSQLite,
+		DSN:     filepath.Join(t.TempDir(), "sentinel.db"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = storage.Close() })
+	return storage
+}
+
+func TestKVStores(t *testing.T) {
+	backends := map[string]func(t *testing.T) KVStore{
+		StorageBackendLevelDB: func(t *testing.T) KVStore {
+			db, err := NewLevelDBStore(t.TempDir())
+			require.NoError(t, err)
+			return db
+		},
+		StorageBackendSQLite: func(t *testing.T) KVStore {
+			db, err := newTestSQLiteStorage(t).Open(StoreClaims, "")
+			require.NoError(t, err)
+			return db
+		},
+	}
+
+	for name, open := range backends {
+		t.Run(name, func(t *testing.T) {
+			db := open(t)
+			defer db.Close()
+
+			_, err := db.Get([]byte("missing"))
+			require.ErrorIs(t, err, ErrNotFound)
+			ok, err := db.Has([]byte("missing"))
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			require.NoError(t, db.Put([]byte("b"), []byte(`{"v":1}`)))
+			require.NoError(t, db.Put([]byte("b"), []byte(`{"v":2}`)))
+			value, err := db.Get([]byte("b"))
+			require.NoError(t, err)
+			require.JSONEq(t, `{"v":2}`, string(value))
+
+			require.NoError(t, db.PutBatch([]KVItem{
+				{Key: []byte("c"), Value: []byte(`{"v":3}`)},
+				{Key: []byte("a"), Value: []byte(`{"v":0}`)},
+			}))
+			var keys []string
+			require.NoError(t, db.Iterate(func(key, _ []byte) error {
+				keys = append(keys, string(key))
+				return nil
+			}))
+			require.Equal(t, []string{"a", "b", "c"}, keys)
+
+			require.NoError(t, db.Delete([]byte("b")))
+			ok, err = db.Has([]byte("b"))
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestSQLStoresSemantics(t *testing.T) {
+	storage := newTestSQLiteStorage(t)
+
+	claimDb, err := storage.Open(StoreClaims, "")
+	require.NoError(t, err)
+	claims := NewClaimStoreWithKV(claimDb)
+	claim := NewClaim(5, types.GetRandomPubKey(), 3, "sig")
+	require.NoError(t, claims.Set(claim))
+	stored, err := claims.Get(claim.Key())
+	require.NoError(t, err)
+	require.Equal(t, claim, stored)
+	require.Len(t, claims.List(), 1)
+
+	contractDb, err := storage.Open(StoreContractConfigs, "")
+	require.NoError(t, err)
+	contracts := NewContractConfigurationStoreWithKV(contractDb)
+	config, err := contracts.Get(7)
+	require.NoError(t, err)
+	require.Equal(t, NewCORs(), config.CORs)
+	require.False(t, contracts.Has(7))
+
+	providerDb, err := storage.Open(StoreProviderConfigs, "")
+	require.NoError(t, err)
+	providers := NewProviderConfigurationStoreWithKV(providerDb)
+	_, err = providers.Get(types.GetRandomPubKey(), common.BTCService.String())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	nonceDb, err := storage.Open(StoreNonces, "")
+	require.NoError(t, err)
+	nonces := NewNonceStoreWithKV(nonceDb)
+	nonce, err := nonces.Get(9)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), nonce)
+	require.NoError(t, nonces.Set(9, 4))
+	nonce, err = nonces.Get(9)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), nonce)
+}
+
+func TestMigrateStorage(t *testing.T) {
+	dir := t.TempDir()
+	config := conf.Configuration{
+		ClaimStoreLocation:          filepath.Join(dir, "claims"),
+		ContractConfigStoreLocation: filepath.Join(dir, "contracts"),
+		Storage: conf.StorageConfiguration{
+			Backend: StorageBackendSQLite,
+			DSN:     filepath.Join(dir, "sentinel.db"),
+		},
+	}
+
+	claims, err := NewClaimStore(config.ClaimStoreLocation)
+	require.NoError(t, err)
+	var items []Claim
+	for i := uint64(1); i <= 3; i++ {
+		items = append(items, NewClaim(i, types.GetRandomPubKey(), int64(i*10), "sig"))
+	}
+	require.NoError(t, claims.Batch(items))
+	require.NoError(t, claims.Close())
+
+	contracts, err := NewContractConfigurationStore(config.ContractConfigStoreLocation)
+	require.NoError(t, err)
+	require.NoError(t, contracts.Set(NewContractConfiguration(2, NewCORs(), []string{"127.0.0.1"}, 10)))
+	require.NoError(t, contracts.Close())
+
+	result, err := MigrateStorage(config)
+	require.NoError(t, err)
+	require.Equal(t, MigrationResult{StoreClaims: 3, StoreContractConfigs: 1}, result)
+
+	// running it again overwrites instead of duplicating
+	_, err = MigrateStorage(config)
+	require.NoError(t, err)
+
+	storage, err := NewStorage(config.Storage)
+	require.NoError(t, err)
+	defer storage.Close()
+	claimDb, err := storage.Open(StoreClaims, "")
+	require.NoError(t, err)
+	require.ElementsMatch(t, items, NewClaimStoreWithKV(claimDb).List())
+	contractDb, err := storage.Open(StoreContractConfigs, "")
+	require.NoError(t, err)
+	contract, err := NewContractConfigurationStoreWithKV(contractDb).Get(2)
+	require.NoError(t, err)
+	require.Equal(t, 10, contract.PerUserRateLimit)
+	require.Equal(t, []string{"127.0.0.1"}, contract.WhitelistIPAddresses)
+
+	config.Storage.Backend = StorageBackendLevelDB
+	_, err = MigrateStorage(config)
+	require.Error(t, err)
+}
@@ -24,6 +24,7 @@ var ModuleBasics = module.NewBasicManager()
 type MemStore struct {
 	storeLock   *sync.Mutex
 	db          map[string]types.Contract
+	groups      map[uint64]types.ContractGroup
 	client      http.Client
 	baseURL     string
 	blockHeight int64
@@ -35,6 +36,7 @@ func NewMemStore(baseURL string, authManager *ArkeoAuthManager, logger log.Logge
 	return &MemStore{
 		storeLock: &sync.Mutex{},
 		db:        make(map[string]types.Contract),
+		groups:    make(map[uint64]types.ContractGroup),
 		client: http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -75,6 +77,14 @@ func (k *MemStore) Get(key string) (types.Contract, error) {
 	return contract, nil
 }
 
+// Cached returns the contract if it is in memory, without querying the chain
+func (k *MemStore) Cached(key string) (types.Contract, bool) {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	contract, ok := k.db[key]
+	return contract, ok
+}
+
 func (k *MemStore) Put(contract types.Contract) {
 	k.storeLock.Lock()
 	defer k.storeLock.Unlock()
@@ -86,6 +96,39 @@ func (k *MemStore) Put(contract types.Contract) {
 	k.db[key] = contract
 }
 
+// GetGroup returns the contract group, its payments change with the claims of
+// every provider of the group so it is only cached until the next claim
+func (k *MemStore) GetGroup(id uint64) (types.ContractGroup, error) {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	if group, ok := k.groups[id]; ok {
+		return group, nil
+	}
+	group, err := k.fetchGroup(id)
+	if err != nil {
+		return group, err
+	}
+	if group.IsEmpty() {
+		return group, fmt.Errorf("contract group %d not found", id)
+	}
+	k.groups[id] = group
+	return group, nil
+}
+
+// PutGroup caches a contract group
+func (k *MemStore) PutGroup(group types.ContractGroup) {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	k.groups[group.Id] = group
+}
+
+// ResetGroups drops the cached contract groups, to be fetched again when used
+func (k *MemStore) ResetGroups() {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	k.groups = make(map[uint64]types.ContractGroup)
+}
+
 func (k *MemStore) GetActiveContract(provider common.PubKey, service common.Service, spender common.PubKey) (types.Contract, error) {
 	k.storeLock.Lock()
 	defer k.storeLock.Unlock()
@@ -104,6 +147,17 @@ func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 	// TODO: this should cache a "miss" for 5 seconds, to stop DoS/thrashing
 	var contract types.Contract
 
+	type fetchRateTier struct {
+		Threshold string       `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+		Rate      cosmos.Coins `protobuf:"bytes,2,rep,name=rate,proto3" json:"rate"`
+	}
+
+	type fetchDelegateLimit struct {
+		MaxNonce string `protobuf:"varint,1,opt,name=max_nonce,json=maxNonce,proto3" json:"max_nonce,omitempty"`
+		MaxValue string `protobuf:"bytes,2,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+		Period   string `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	}
+
 	type fetchContract struct {
 		Id               string                      `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
 		ProviderPubKey   common.PubKey               `protobuf:"bytes,1,opt,name=provider_pub_key,json=providerPubKey,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider_pub_key,omitempty"`
@@ -120,6 +174,12 @@ func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 		SettlementHeight string                      `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
 		Authorization    types.ContractAuthorization `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
 		QueriesPerMinute string                      `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+		PayAsYouGoTiers  []fetchRateTier             `protobuf:"bytes,19,rep,name=pay_as_you_go_tiers,json=payAsYouGoTiers,proto3" json:"pay_as_you_go_tiers,omitempty"`
+		DiscountBps      string                      `protobuf:"varint,20,opt,name=discount_bps,json=discountBps,proto3" json:"discount_bps,omitempty"`
+		GroupId          string                      `protobuf:"varint,21,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+		DelegateLimit    fetchDelegateLimit          `protobuf:"bytes,22,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+		DelegateSpent    string                      `protobuf:"bytes,23,opt,name=delegate_spent,json=delegateSpent,proto3" json:"delegate_spent,omitempty"`
+		DelegatePeriod   string                      `protobuf:"varint,24,opt,name=delegate_period_start,json=delegatePeriodStart,proto3" json:"delegate_period_start,omitempty"`
 	}
 
 	type fetch struct {
@@ -180,6 +240,78 @@ func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 	contract.SettlementHeight, _ = strconv.ParseInt(data.Contract.SettlementHeight, 10, 64)
 	contract.Authorization = data.Contract.Authorization
 	contract.QueriesPerMinute, _ = strconv.ParseInt(data.Contract.QueriesPerMinute, 10, 64)
+	for _, tier := range data.Contract.PayAsYouGoTiers {
+		threshold, _ := strconv.ParseInt(tier.Threshold, 10, 64)
+		contract.PayAsYouGoTiers = append(contract.PayAsYouGoTiers, types.RateTier{Threshold: threshold, Rate: tier.Rate})
+	}
+	contract.DiscountBps, _ = strconv.ParseInt(data.Contract.DiscountBps, 10, 64)
+	contract.GroupId, _ = strconv.ParseUint(data.Contract.GroupId, 10, 64)
+	contract.DelegateLimit.MaxNonce, _ = strconv.ParseInt(data.Contract.DelegateLimit.MaxNonce, 10, 64)
+	contract.DelegateLimit.MaxValue, _ = cosmos.NewIntFromString(data.Contract.DelegateLimit.MaxValue)
+	contract.DelegateLimit.Period, _ = strconv.ParseInt(data.Contract.DelegateLimit.Period, 10, 64)
+	contract.DelegateSpent, _ = cosmos.NewIntFromString(data.Contract.DelegateSpent)
+	contract.DelegatePeriodStart, _ = strconv.ParseInt(data.Contract.DelegatePeriod, 10, 64)
 
 	return contract, nil
 }
+
+func (k *MemStore) fetchGroup(id uint64) (types.ContractGroup, error) {
+	var group types.ContractGroup
+
+	type fetchContractGroup struct {
+		Id               string   `json:"id,omitempty"`
+		Height           string   `json:"height,omitempty"`
+		Duration         string   `json:"duration,omitempty"`
+		Denom            string   `json:"denom,omitempty"`
+		Deposit          string   `json:"deposit,omitempty"`
+		Paid             string   `json:"paid,omitempty"`
+		ContractIds      []string `json:"contract_ids,omitempty"`
+		SettlementHeight string   `json:"settlement_height,omitempty"`
+	}
+
+	type fetch struct {
+		ContractGroup fetchContractGroup `json:"contract_group"`
+	}
+
+	requestURL := fmt.Sprintf("%s/arkeo/contract-group/%d", k.baseURL, id)
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return group, err
+	}
+	if k.authManager != nil {
+		authHeader, err := k.authManager.GenerateAuthHeader()
+		if err != nil {
+			return group, err
+		}
+		req.Header.Set(QueryArkAuth, authHeader)
+	}
+
+	res, err := k.client.Do(req)
+	if err != nil {
+		k.logger.Error("fail to send http request", "error", err)
+		return group, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return group, fmt.Errorf("fail to fetch contract group %d: %s", id, res.Status)
+	}
+
+	var data fetch
+	if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return group, err
+	}
+
+	group.Id, _ = strconv.ParseUint(data.ContractGroup.Id, 10, 64)
+	group.Height, _ = strconv.ParseInt(data.ContractGroup.Height, 10, 64)
+	group.Duration, _ = strconv.ParseInt(data.ContractGroup.Duration, 10, 64)
+	group.Denom = data.ContractGroup.Denom
+	group.Deposit, _ = cosmos.NewIntFromString(data.ContractGroup.Deposit)
+	group.Paid, _ = cosmos.NewIntFromString(data.ContractGroup.Paid)
+	for _, contractId := range data.ContractGroup.ContractIds {
+		cid, _ := strconv.ParseUint(contractId, 10, 64)
+		group.ContractIds = append(group.ContractIds, cid)
+	}
+	group.SettlementHeight, _ = strconv.ParseInt(data.ContractGroup.SettlementHeight, 10, 64)
+
+	return group, nil
+}
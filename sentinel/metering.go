@@ -0,0 +1,153 @@
+package sentinel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// maxMeteredBodySize is the largest request body inspected for metering,
+// bigger bodies are rejected by handleRequestAndRedirect anyway
+const maxMeteredBodySize = 1 << 20
+
+// rpcCall is a single call of a JSON-RPC or GraphQL request body
+type rpcCall struct {
+	JSONRPC string          `json:"jsonrpc,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	// graphql
+	Query         string `json:"query"`
+	OperationName string `json:"operationName"`
+}
+
+// Name returns the method of a JSON-RPC call, or the operation name of a
+// GraphQL query
+func (c rpcCall) Name() string {
+	if len(c.Method) > 0 {
+		return c.Method
+	}
+	return c.OperationName
+}
+
+// parseRPCCalls returns the calls of a JSON-RPC (or GraphQL) body, batches
+// return one entry per call. Bodies that are neither return nil.
+func parseRPCCalls(body []byte) []rpcCall {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+	var calls []rpcCall
+	if body[0] == '[' {
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil
+		}
+	} else {
+		var call rpcCall
+		if err := json.Unmarshal(body, &call); err != nil {
+			return nil
+		}
+		calls = append(calls, call)
+	}
+	for _, call := range calls {
+		if len(call.Method) == 0 && len(call.Query) == 0 {
+			return nil
+		}
+	}
+	return calls
+}
+
+// methodPolicy is the method policy of a request, combining the provider's
+// service config and the client's contract config.
+type methodPolicy struct {
+	service  conf.ServiceConfig
+	contract conf.MethodPolicy
+}
+
+func (mp methodPolicy) allows(method string) bool {
+	return mp.service.Methods.Allows(method) && mp.contract.Allows(method)
+}
+
+// rateLimit returns the strictest per-method limit, 0 when unlimited
+func (mp methodPolicy) rateLimit(method string) int {
+	limit := mp.service.Methods.RateLimit(method)
+	if contractLimit := mp.contract.RateLimit(method); contractLimit > 0 && (limit == 0 || contractLimit < limit) {
+		limit = contractLimit
+	}
+	return limit
+}
+
+// cost returns the number of nonces a call of method costs
+func (mp methodPolicy) cost(method string) int64 {
+	return mp.service.MethodWeight(method) * mp.service.Methods.CostMultiplier(method) * mp.contract.CostMultiplier(method)
+}
+
+// requestCost returns the number of nonces a request body costs, the sum of
+// the cost of every call of a batch. Anything that isn't JSON-RPC or GraphQL
+// costs one.
+func (mp methodPolicy) requestCost(calls []rpcCall) int64 {
+	if len(calls) == 0 {
+		return 1
+	}
+	var cost int64
+	for _, call := range calls {
+		cost += mp.cost(call.Name())
+	}
+	if cost < 1 {
+		return 1
+	}
+	return cost
+}
+
+// peekBody reads the request body, leaving it in place for the upstream
+func peekBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxMeteredBodySize))
+	if err != nil {
+		return nil, err
+	}
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	return body, nil
+}
+
+// checkMethods enforces the method policy on the calls of a request, it
+// returns the JSON-RPC error code and the reason of the first refused call.
+// Calls without a method name (anonymous GraphQL queries) are not restricted.
+func (p *Proxy) checkMethods(calls []rpcCall, policy methodPolicy, contractId uint64, remoteAddr string) (int, error) {
+	for _, call := range calls {
+		if method := call.Name(); len(method) > 0 && !policy.allows(method) {
+			return JSONRPCErrMethodDenied, fmt.Errorf("method %s is not allowed", method)
+		}
+	}
+	for _, call := range calls {
+		method := call.Name()
+		if len(method) == 0 {
+			continue
+		}
+		if limit := policy.rateLimit(method); limit > 0 && p.isRateLimited(contractId, remoteAddr+"/"+method, limit, 60, nil) {
+			return JSONRPCErrRateLimited, fmt.Errorf("method %s is rate limited", method)
+		}
+	}
+	return 0, nil
+}
+
+// jsonRPCErrorStatus is the http status of a refused JSON-RPC request
+func jsonRPCErrorStatus(code int) int {
+	switch code {
+	case JSONRPCErrRateLimited:
+		return http.StatusTooManyRequests
+	case JSONRPCErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusForbidden
+	}
+}
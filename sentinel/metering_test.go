@@ -0,0 +1,231 @@
+package sentinel
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestRequestCost(t *testing.T) {
+	service := conf.ServiceConfig{
+		Name:          "eth-mainnet-fullnode",
+		MethodWeights: map[string]int64{"eth_getLogs": 10, "net_version": 0},
+		Methods:       conf.MethodPolicy{CostMultipliers: map[string]int64{"debug_*": 4}},
+	}
+	testCases := []struct {
+		name string
+		body string
+		cost int64
+	}{
+		{"empty body", ``, 1},
+		{"not json", `hello`, 1},
+		{"single call", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`, 1},
+		{"weighted call", `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`, 10},
+		{"free call", `{"jsonrpc":"2.0","id":1,"method":"net_version"}`, 1},
+		{"batch", ` [{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"eth_getLogs"},{"id":3,"method":"net_version"}]`, 11},
+		{"multiplied call", `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlock"}`, 4},
+		{"graphql", `{"query":"{ block { number } }"}`, 1},
+		{"graphql batch", `[{"query":"{ block { number } }"},{"query":"{ syncing { startingBlock } }"}]`, 2},
+		{"json without method", `{"foo":"bar"}`, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.cost, methodPolicy{service: service}.requestCost(parseRPCCalls([]byte(tc.body))))
+		})
+	}
+}
+
+func TestPaidTierBatchCost(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = []conf.ServiceConfig{{
+		Name:          "btc-mainnet-fullnode",
+		MethodWeights: map[string]int64{"getblock": 5},
+	}}
+	env := newPaidTestEnv(t, config, types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+
+	post := func(nonce int64, body string) *http.Response {
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			received, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, body, string(received))
+		}
+		return resp
+	}
+
+	batch := `[{"id":1,"method":"getblockcount"},{"id":2,"method":"getblock"}]`
+	// the batch costs 6 nonces
+	require.Equal(t, http.StatusPaymentRequired, post(5, batch).StatusCode)
+	require.Equal(t, http.StatusOK, post(6, batch).StatusCode)
+	require.Equal(t, http.StatusOK, post(7, `{"id":3,"method":"getblockcount"}`).StatusCode)
+
+	claim, err := env.proxy.ClaimStore.Get("1")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), claim.Nonce)
+}
+
+func TestPaidTierContractGroup(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	// the other provider of the group was paid 4 of the 10 deposited
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/arkeo/contract-group/7", r.URL.Path)
+		_, _ = w.Write([]byte(`{"contract_group":{"id":"7","height":"1","duration":"100","denom":"uarkeo","deposit":"10","paid":"4","contract_ids":["1","2"]}}`))
+	}))
+	defer chain.Close()
+
+	env := newPaidTestEnv(t, newTestConfig(), types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+	env.proxy.MemStore.baseURL = chain.URL
+	env.contract.GroupId = 7
+	env.contract.Deposit = cosmos.NewInt(10)
+	env.proxy.MemStore.Put(env.contract)
+
+	post := func(nonce int64) int {
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", bytes.NewBufferString(`{"id":1,"method":"getblockcount"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, post(6))
+	require.Equal(t, http.StatusPaymentRequired, post(7))
+
+	// the group is fetched again after a claim
+	env.proxy.MemStore.PutGroup(types.ContractGroup{Id: 7, Height: 1, Deposit: cosmos.NewInt(10), Paid: cosmos.ZeroInt()})
+	require.Equal(t, http.StatusOK, post(7))
+	env.proxy.MemStore.ResetGroups()
+	require.Equal(t, http.StatusPaymentRequired, post(8))
+}
+
+func TestPaidTierDelegateLimit(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	// the spender can use 5 every 10 blocks
+	env := newPaidTestEnv(t, newTestConfig(), types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+	env.contract.DelegateLimit = types.NewDelegateLimit(0, cosmos.NewInt(5), 10)
+	env.contract.DelegateSpent = cosmos.ZeroInt()
+	env.proxy.MemStore.Put(env.contract)
+	env.proxy.MemStore.SetHeight(5)
+
+	post := func(nonce int64) int {
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", bytes.NewBufferString(`{"id":1,"method":"getblockcount"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, post(5))
+	require.Equal(t, http.StatusPaymentRequired, post(6))
+
+	// the claim of nonce 5 spends the period
+	contract, ok := env.proxy.MemStore.Cached(env.contract.Key())
+	require.True(t, ok)
+	contract.Paid = cosmos.NewInt(5)
+	contract.AddDelegateSpent(5, cosmos.NewInt(5))
+	env.proxy.MemStore.Put(contract)
+	require.Equal(t, http.StatusPaymentRequired, post(6))
+
+	// the next period has a new allowance
+	env.proxy.MemStore.SetHeight(11)
+	require.Equal(t, http.StatusOK, post(6))
+	require.Equal(t, http.StatusPaymentRequired, post(11))
+}
+
+func TestMethodPolicyEnforcement(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = []conf.ServiceConfig{{
+		Name: "btc-mainnet-fullnode",
+		Methods: conf.MethodPolicy{
+			Deny:       []string{"stop"},
+			RateLimits: map[string]int{"getblock": 2},
+		},
+	}}
+	env := newPaidTestEnv(t, config, types.ContractType_SUBSCRIPTION, 100, upstream.URL)
+	require.NoError(t, env.proxy.ContractConfigStore.Set(ContractConfiguration{
+		ContractId: env.contract.Id,
+		CORs:       NewCORs(),
+		Methods: conf.MethodPolicy{
+			Deny:            []string{"getrawtransaction"},
+			CostMultipliers: map[string]int64{"getblock": 2},
+		},
+	}))
+
+	nonce := int64(0)
+	post := func(body string) (*http.Response, []byte) {
+		nonce++
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		received, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, received
+	}
+
+	resp, _ := post(`{"id":1,"method":"getblockcount"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// denied by the provider, or by the client's contract config
+	for _, method := range []string{"stop", "getrawtransaction"} {
+		resp, body := post(`{"jsonrpc":"2.0","id":7,"method":"` + method + `"}`)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+		var reply jsonRPCMessage
+		require.NoError(t, json.Unmarshal(body, &reply))
+		require.Equal(t, "7", string(reply.Id))
+		require.Equal(t, JSONRPCErrMethodDenied, reply.Error.Code)
+	}
+
+	// a single denied call refuses the whole batch
+	resp, body := post(`[{"id":1,"method":"getblockcount"},{"id":2,"method":"stop"}]`)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	var replies []jsonRPCMessage
+	require.NoError(t, json.Unmarshal(body, &replies))
+	require.Len(t, replies, 2)
+
+	// per-method rate limit
+	for i := 0; i < 2; i++ {
+		resp, _ = post(`{"id":1,"method":"getblock"}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	resp, body = post(`{"id":1,"method":"getblock"}`)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	var reply jsonRPCMessage
+	require.NoError(t, json.Unmarshal(body, &reply))
+	require.Equal(t, JSONRPCErrRateLimited, reply.Error.Code)
+	resp, _ = post(`{"id":1,"method":"getblockcount"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,295 @@
+package sentinel
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace        = "sentinel"
+	chainHeightPollInterval = 30 * time.Second
+	// services that aren't configured share a label, to bound cardinality
+	unknownServiceLabel = "unknown"
+)
+
+// Metrics are the prometheus metrics of the sentinel, served on /metrics. A
+// nil *Metrics is valid and records nothing.
+type Metrics struct {
+	registry              *prometheus.Registry
+	requests              *prometheus.CounterVec
+	requestDuration       *prometheus.HistogramVec
+	rateLimited           *prometheus.CounterVec
+	upstreamErrors        *prometheus.CounterVec
+	eventStreamReconnects prometheus.Counter
+	chainHeight           atomic.Int64
+}
+
+func NewMetrics(p *Proxy) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Proxied requests by service, tier and status code.",
+		}, []string{"service", "tier", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of proxied requests by service and tier.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "tier"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rate_limited_total",
+			Help:      "Requests rejected by a rate limit, by tier.",
+		}, []string{"tier"}),
+		upstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "upstream_errors_total",
+			Help:      "Failed upstream requests (5xx responses and transport errors) by service and upstream.",
+		}, []string{"service", "upstream"}),
+		eventStreamReconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "event_stream_reconnects_total",
+			Help:      "Event stream subscriptions re-established after being dropped.",
+		}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.requestDuration,
+		m.rateLimited,
+		m.upstreamErrors,
+		m.eventStreamReconnects,
+		&proxyCollector{proxy: p, metrics: m},
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Handler serves the metrics in the prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) observeRequest(service, tier string, code int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(service, tier, strconv.Itoa(code)).Inc()
+	m.requestDuration.WithLabelValues(service, tier).Observe(duration.Seconds())
+}
+
+func (m *Metrics) rateLimitRejected(tier string) {
+	if m == nil {
+		return
+	}
+	m.rateLimited.WithLabelValues(tier).Inc()
+}
+
+func (m *Metrics) upstreamError(service, upstream string) {
+	if m == nil {
+		return
+	}
+	m.upstreamErrors.WithLabelValues(service, upstream).Inc()
+}
+
+func (m *Metrics) eventStreamReconnected() {
+	if m == nil {
+		return
+	}
+	m.eventStreamReconnects.Inc()
+}
+
+func (m *Metrics) setChainHeight(height int64) {
+	if m == nil {
+		return
+	}
+	m.chainHeight.Store(height)
+}
+
+// proxyCollector reports the state of the stores when scraped
+type proxyCollector struct {
+	proxy   *Proxy
+	metrics *Metrics
+}
+
+var (
+	claimsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "claims"),
+		"Claims in the claim store, by status.",
+		[]string{"status"}, nil,
+	)
+	unclaimedValueDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "unclaimed_value"),
+		"Value of the nonces served but not yet claimed on chain, per pay-as-you-go contract.",
+		[]string{"contract_id", "denom"}, nil,
+	)
+	memStoreHeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "memstore_height"),
+		"Latest block height seen by the event stream.",
+		nil, nil,
+	)
+	chainHeightLagDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "chain_height_lag"),
+		"Blocks between the chain head and the height seen by the event stream.",
+		nil, nil,
+	)
+)
+
+func (c *proxyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- claimsDesc
+	ch <- unclaimedValueDesc
+	ch <- memStoreHeightDesc
+	ch <- chainHeightLagDesc
+}
+
+func (c *proxyCollector) Collect(ch chan<- prometheus.Metric) {
+	p := c.proxy
+	if p.ClaimStore != nil {
+		var claimed, unclaimed int
+		for _, claim := range p.ClaimStore.List() {
+			if claim.Claimed {
+				claimed++
+				continue
+			}
+			unclaimed++
+			// only contracts in memory, scrapes must not query the chain
+			contract, ok := p.MemStore.Cached(claim.Key())
+			if !ok || !contract.IsPayAsYouGo() {
+				continue
+			}
+			value := contract.PayAsYouGoCost(claim.Nonce)
+			if !contract.Paid.IsNil() {
+				value = value.Sub(contract.Paid)
+			}
+			if !value.IsPositive() {
+				continue
+			}
+			amount, _ := new(big.Float).SetInt(value.BigInt()).Float64()
+			ch <- prometheus.MustNewConstMetric(unclaimedValueDesc, prometheus.GaugeValue, amount,
+				strconv.FormatUint(claim.ContractId, 10), contract.Rate.Denom)
+		}
+		ch <- prometheus.MustNewConstMetric(claimsDesc, prometheus.GaugeValue, float64(claimed), "claimed")
+		ch <- prometheus.MustNewConstMetric(claimsDesc, prometheus.GaugeValue, float64(unclaimed), "unclaimed")
+	}
+
+	height := p.MemStore.GetHeight()
+	ch <- prometheus.MustNewConstMetric(memStoreHeightDesc, prometheus.GaugeValue, float64(height))
+	if chainHeight := c.metrics.chainHeight.Load(); chainHeight > 0 {
+		ch <- prometheus.MustNewConstMetric(chainHeightLagDesc, prometheus.GaugeValue, float64(chainHeight-height))
+	}
+}
+
+// statusRecorder keeps the status code of a response. It stays usable by
+// websocket upgrades (Hijack) and http.ResponseController (Unwrap).
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response does not implement http.Hijacker")
+	}
+	if r.code == 0 {
+		r.code = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// serviceLabel returns the service of a request for metric labels
+func (p *Proxy) serviceLabel(r *http.Request) string {
+	name := requestServiceName(r)
+	if _, ok := p.currentConfig().GetService(name); ok {
+		return name
+	}
+	p.proxyMu.RLock()
+	defer p.proxyMu.RUnlock()
+	if _, ok := p.proxies[name]; ok {
+		return name
+	}
+	return unknownServiceLabel
+}
+
+// instrument records the count, status and latency of proxied requests
+func (p *Proxy) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p.metrics == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		start := time.Now()
+		service := p.serviceLabel(r)
+		recorder := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+		code := recorder.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+		tier := w.Header().Get("tier")
+		if len(tier) == 0 {
+			tier = "none"
+		}
+		p.metrics.observeRequest(service, tier, code, time.Since(start))
+	})
+}
+
+// trackChainHeight polls the chain head, for the event stream lag
+func (p *Proxy) trackChainHeight(ctx context.Context) {
+	if p.metrics == nil || len(p.Config.HubProviderURI) == 0 {
+		return
+	}
+	client := NewRestChainClient(p.Config.HubProviderURI, p.authManager)
+	ticker := time.NewTicker(chainHeightPollInterval)
+	defer ticker.Stop()
+	for {
+		height, err := client.GetLatestHeight(ctx)
+		if err != nil {
+			p.logger.Error("fail to fetch chain height", "error", err)
+		} else {
+			p.metrics.setChainHeight(height)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
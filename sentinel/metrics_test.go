@@ -0,0 +1,96 @@
+package sentinel
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestMetrics(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "fail") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.FreeTierRateLimit = 1
+	config.Services = []conf.ServiceConfig{{Name: "btc-mainnet-fullnode", RpcUrl: upstream.URL}}
+	env := newPaidTestEnv(t, config, types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+	metrics := NewMetrics(env.proxy)
+	env.proxy.metrics = metrics
+	env.server.Config.Handler = env.proxy.getRouter()
+
+	post := func(query, body string) int {
+		resp, err := http.Post(env.server.URL+"/btc-mainnet-fullnode"+query, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	arkauth := func(nonce int64) string {
+		return "?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+	}
+
+	require.Equal(t, http.StatusOK, post(arkauth(1), `{"id":1,"method":"getblockcount"}`))
+	require.Equal(t, http.StatusOK, post(arkauth(2), `{"id":1,"method":"getblockcount"}`))
+	require.Equal(t, http.StatusInternalServerError, post(arkauth(3), `{"id":1,"method":"fail"}`))
+	require.Equal(t, http.StatusOK, post("", `{"id":1,"method":"getblockcount"}`))
+	require.Equal(t, http.StatusTooManyRequests, post("", `{"id":1,"method":"getblockcount"}`))
+	// services that aren't configured share a label
+	resp, err := http.Post(env.server.URL+"/not-a-service", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues("btc-mainnet-fullnode", "paid", "200")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("btc-mainnet-fullnode", "paid", "500")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("btc-mainnet-fullnode", "free", "200")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("btc-mainnet-fullnode", "free", "429")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(unknownServiceLabel, "free", "429")))
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.rateLimited.WithLabelValues("free")))
+	require.Equal(t, 1, testutil.CollectAndCount(metrics.upstreamErrors))
+	require.Equal(t, 3, testutil.CollectAndCount(metrics.requestDuration))
+
+	// nothing is paid yet, the claim at nonce 3 is worth 3uarkeo
+	env.proxy.MemStore.SetHeight(40)
+	metrics.setChainHeight(42)
+	resp, err = http.Get(env.server.URL + RoutesMetrics)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	scraped, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	body := string(scraped)
+	require.Contains(t, body, `sentinel_claims{status="unclaimed"} 1`)
+	require.Contains(t, body, `sentinel_unclaimed_value{contract_id="1",denom="uarkeo"} 3`)
+	require.Contains(t, body, `sentinel_memstore_height 40`)
+	require.Contains(t, body, `sentinel_chain_height_lag 2`)
+	require.Contains(t, body, `sentinel_upstream_errors_total{service="btc-mainnet-fullnode"`)
+}
+
+func TestStatusRecorderWebSocket(t *testing.T) {
+	// upgrades must go through the recorder
+	env := newWSTestEnv(t, types.ContractType_SUBSCRIPTION, 100, conf.WebSocketConfiguration{})
+	metrics := NewMetrics(env.proxy)
+	env.proxy.metrics = metrics
+	env.server.Config.Handler = env.proxy.getRouter()
+	conn := env.dial(t, env.arkAuth(t, 1))
+	sendJSONRPC(t, conn, 1, "getblockcount")
+	require.Equal(t, "1", string(readJSONRPC(t, conn).Id))
+	require.NoError(t, conn.Close())
+
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.requests.WithLabelValues("btc-mainnet-fullnode", "paid", "101")) == 1
+	}, 5e9, 1e7)
+}
@@ -1,20 +1,13 @@
 package sentinel
 
 import (
-	"encoding/json"
-	"fmt"
+	"errors"
 	"strconv"
 	"time"
-
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 )
 
 type NonceStore struct {
-	logger zerolog.Logger
-	db     *leveldb.DB
+	db *TypedStore[NonceRecord]
 }
 
 type NonceRecord struct {
@@ -24,51 +17,28 @@ type NonceRecord struct {
 }
 
 func NewNonceStore(levelDbFolder string) (*NonceStore, error) {
-	var db *leveldb.DB
-	var err error
-	if len(levelDbFolder) == 0 {
-		log.Warn().Msg("nonce store folder is empty, create in memory storage")
-		// no directory given, use in memory store
-		storage := storage.NewMemStorage()
-		db, err = leveldb.Open(storage, nil)
-		if err != nil {
-			return nil, fmt.Errorf("fail to in memory open level db: %w", err)
-		}
-	} else {
-		db, err = leveldb.OpenFile(levelDbFolder, nil)
-		if err != nil {
-			return nil, fmt.Errorf("fail to open level db %s: %w", levelDbFolder, err)
-		}
+	db, err := NewLevelDBStore(levelDbFolder)
+	if err != nil {
+		return nil, err
 	}
+	return NewNonceStoreWithKV(db), nil
+}
+
+// NewNonceStoreWithKV creates a nonce store on top of the given storage backend
+func NewNonceStoreWithKV(db KVStore) *NonceStore {
 	return &NonceStore{
-		logger: log.With().Str("module", "nonce-storage").Logger(),
-		db:     db,
-	}, nil
+		db: NewTypedStore[NonceRecord](db, "nonce-storage"),
+	}
 }
 
 func (s *NonceStore) Get(contractId uint64) (int64, error) {
-	key := strconv.FormatUint(contractId, 10)
-	exist, err := s.db.Has([]byte(key), nil)
-	if err != nil {
-		s.logger.Error().Err(err).Msg("fail to check nonce existence")
-		return 0, err
-	}
-	if !exist {
+	record, err := s.db.Get(strconv.FormatUint(contractId, 10))
+	if errors.Is(err, ErrNotFound) {
 		return 0, nil // Start from 0 if not found
 	}
-
-	value, err := s.db.Get([]byte(key), nil)
 	if err != nil {
-		s.logger.Error().Err(err).Msg("fail to get nonce record")
-		return 0, err
-	}
-	
-	var record NonceRecord
-	if err := json.Unmarshal(value, &record); err != nil {
-		s.logger.Error().Err(err).Msg("fail to unmarshal nonce record")
 		return 0, err
 	}
-	
 	return record.Nonce, nil
 }
 
@@ -78,22 +48,9 @@ func (s *NonceStore) Set(contractId uint64, nonce int64) error {
 		Nonce:      nonce,
 		UpdatedAt:  time.Now().Unix(),
 	}
-	
-	key := strconv.FormatUint(contractId, 10)
-	buf, err := json.Marshal(record)
-	if err != nil {
-		s.logger.Error().Err(err).Msg("fail to marshal nonce record")
-		return err
-	}
-	
-	if err := s.db.Put([]byte(key), buf, nil); err != nil {
-		s.logger.Error().Err(err).Msg("fail to set nonce record")
-		return err
-	}
-	
-	return nil
+	return s.db.Set(strconv.FormatUint(contractId, 10), record)
 }
 
 func (s *NonceStore) Close() error {
 	return s.db.Close()
-}
\ No newline at end of file
+}
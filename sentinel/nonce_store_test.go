@@ -1,7 +1,6 @@
 package sentinel
 
 import (
-	"encoding/json"
 	"os"
 	"testing"
 	"time"
@@ -116,12 +115,7 @@ func TestNonceRecord_UpdatedAt(t *testing.T) {
 	after := time.Now().Unix()
 
 	// Get raw record to check UpdatedAt
-	key := "12345"
-	value, err := store.db.Get([]byte(key), nil)
-	assert.NoError(t, err)
-
-	var record NonceRecord
-	err = json.Unmarshal(value, &record)
+	record, err := store.db.Get("12345")
 	assert.NoError(t, err)
 
 	assert.GreaterOrEqual(t, record.UpdatedAt, before)
@@ -1,46 +1,29 @@
 package sentinel
 
 import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
-
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
 type ProviderConfigurationStore struct {
-	logger zerolog.Logger
-	db     *leveldb.DB
+	db *TypedStore[ProviderConfiguration]
 }
 
 func NewProviderConfigurationStore(levelDbFolder string) (*ProviderConfigurationStore, error) {
-	var db *leveldb.DB
-	var err error
-	if len(levelDbFolder) == 0 {
-		log.Warn().Msg("level db folder is empty, create in memory storage")
-		// no directory given, use in memory store
-		storage := storage.NewMemStorage()
-		db, err = leveldb.Open(storage, nil)
-		if err != nil {
-			return nil, fmt.Errorf("fail to in memory open level db: %w", err)
-		}
-	} else {
-		db, err = leveldb.OpenFile(levelDbFolder, nil)
-		if err != nil {
-			return nil, fmt.Errorf("fail to open level db %s: %w", levelDbFolder, err)
-		}
+	db, err := NewLevelDBStore(levelDbFolder)
+	if err != nil {
+		return nil, err
 	}
+	return NewProviderConfigurationStoreWithKV(db), nil
+}
 
+// NewProviderConfigurationStoreWithKV creates a provider configuration store on
+// top of the given storage backend
+func NewProviderConfigurationStoreWithKV(db KVStore) *ProviderConfigurationStore {
 	return &ProviderConfigurationStore{
-		logger: log.With().Str("module", "provider-config-store").Logger(),
-		db:     db,
-	}, nil
+		db: NewTypedStore[ProviderConfiguration](db, "provider-config-store"),
+	}
 }
 
 type ProviderConfiguration struct {
@@ -60,33 +43,19 @@ type ProviderConfiguration struct {
 
 // GetProviderModOrBondConfig retrieves a ProviderConfiguration by its PubKey
 func (ps *ProviderConfigurationStore) Get(pubKey common.PubKey, service string) (ProviderConfiguration, error) {
-	data, err := ps.db.Get([]byte(pubKey.String()+service), nil)
-	if err != nil {
-		return ProviderConfiguration{}, err
-	}
-
-	var config ProviderConfiguration
-	err = json.Unmarshal(data, &config)
-	if err != nil {
-		return ProviderConfiguration{}, err
-	}
-	return config, nil
+	return ps.db.Get(pubKey.String() + service)
 }
 
 // SetProviderModOrBondConfig saves or updates a ProviderConfiguration in the database
 func (ps *ProviderConfigurationStore) Set(config ProviderConfiguration) error {
-	data, err := json.Marshal(config)
-	if err != nil {
-		return err
-	}
-
-	err = ps.db.Put([]byte(config.PubKey.String()+config.Service.String()), data, nil)
-	if err != nil {
-		return err
-	}
-	return nil
+	return ps.db.Set(config.PubKey.String()+config.Service.String(), config)
 }
 
 func (p *ProviderConfigurationStore) Remove(pubKey common.PubKey, service string) error {
-	return p.db.Delete([]byte(pubKey.String()+service), nil)
+	return p.db.Remove(pubKey.String() + service)
+}
+
+// Close underlying db
+func (p *ProviderConfigurationStore) Close() error {
+	return p.db.Close()
 }
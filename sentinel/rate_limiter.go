@@ -0,0 +1,178 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	RateLimitBackendMemory = "memory"
+	RateLimitBackendRedis  = "redis"
+
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+
+	defaultRateLimitIdleTimeout = 10 * time.Minute
+)
+
+// RateLimitResult is the outcome of a single rate limit check.
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter is how long until the caller has its full quota again.
+	ResetAfter time.Duration
+}
+
+// RateLimiter decides whether a request for the given key may proceed,
+// allowing at most limit requests per window. A limit of zero or less denies
+// every request.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error)
+	Close() error
+}
+
+// NewRateLimiter creates the rate limiter backend selected in the configuration.
+func NewRateLimiter(config conf.RateLimitConfiguration) (RateLimiter, error) {
+	switch config.Backend {
+	case "", RateLimitBackendMemory:
+		return NewMemoryRateLimiter(config.IdleTimeout), nil
+	case RateLimitBackendRedis:
+		return NewRedisRateLimiter(config)
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend: %s", config.Backend)
+	}
+}
+
+// SetHeaders writes the X-RateLimit-* headers describing the result.
+func (r RateLimitResult) SetHeaders(header http.Header) {
+	if header == nil {
+		return
+	}
+	header.Set(HeaderRateLimitLimit, strconv.Itoa(r.Limit))
+	header.Set(HeaderRateLimitRemaining, strconv.Itoa(r.Remaining))
+	header.Set(HeaderRateLimitReset, strconv.FormatInt(int64(math.Ceil(r.ResetAfter.Seconds())), 10))
+	if !r.Allowed {
+		header.Set("Retry-After", strconv.FormatInt(int64(math.Ceil(r.ResetAfter.Seconds())), 10))
+	}
+}
+
+// newTokenBucketResult describes a check against a bucket of limit tokens
+// refilled over window, left with the given tokens.
+func newTokenBucketResult(allowed bool, limit int, window time.Duration, tokens float64) RateLimitResult {
+	return RateLimitResult{
+		Allowed:    allowed,
+		Limit:      limit,
+		Remaining:  int(math.Max(0, math.Floor(tokens))),
+		ResetAfter: time.Duration((float64(limit) - tokens) / float64(limit) * float64(window)),
+	}
+}
+
+// denyAll is the result of a zero limit, which lets no request through.
+func denyAll(window time.Duration) RateLimitResult {
+	return RateLimitResult{Allowed: false, ResetAfter: window}
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// MemoryRateLimiter keeps a token bucket per key in process memory. Buckets
+// that have not been used for longer than the idle timeout are evicted.
+type MemoryRateLimiter struct {
+	mu          sync.Mutex
+	visitors    map[string]*visitor
+	idleTimeout time.Duration
+	now         func() time.Time
+	quit        chan struct{}
+	closeOnce   sync.Once
+}
+
+func NewMemoryRateLimiter(idleTimeout time.Duration) *MemoryRateLimiter {
+	if idleTimeout <= 0 {
+		idleTimeout = defaultRateLimitIdleTimeout
+	}
+	m := &MemoryRateLimiter{
+		visitors:    make(map[string]*visitor),
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+		quit:        make(chan struct{}),
+	}
+	go m.janitor()
+	return m
+}
+
+func (m *MemoryRateLimiter) Allow(_ context.Context, key string, limit int, window time.Duration) (RateLimitResult, error) {
+	if window <= 0 {
+		return RateLimitResult{}, fmt.Errorf("invalid rate limit %d per %s", limit, window)
+	}
+	if limit <= 0 {
+		return denyAll(window), nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	every := rate.Limit(float64(limit) / window.Seconds())
+	v, ok := m.visitors[key]
+	if !ok {
+		v = &visitor{limiter: rate.NewLimiter(every, limit)}
+		m.visitors[key] = v
+	} else if v.limiter.Limit() != every || v.limiter.Burst() != limit {
+		// the limit changed (ie contract was modified), adjust the bucket in place
+		v.limiter.SetLimitAt(now, every)
+		v.limiter.SetBurstAt(now, limit)
+	}
+	v.lastSeen = now
+
+	allowed := v.limiter.AllowN(now, 1)
+	return newTokenBucketResult(allowed, limit, window, v.limiter.TokensAt(now)), nil
+}
+
+// Len returns the number of tracked keys.
+func (m *MemoryRateLimiter) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.visitors)
+}
+
+// evict removes every key that has been idle for longer than the idle timeout.
+func (m *MemoryRateLimiter) evict() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cutoff := m.now().Add(-m.idleTimeout)
+	for key, v := range m.visitors {
+		if v.lastSeen.Before(cutoff) {
+			delete(m.visitors, key)
+		}
+	}
+}
+
+func (m *MemoryRateLimiter) janitor() {
+	ticker := time.NewTicker(m.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-ticker.C:
+			m.evict()
+		}
+	}
+}
+
+func (m *MemoryRateLimiter) Close() error {
+	m.closeOnce.Do(func() { close(m.quit) })
+	return nil
+}
@@ -0,0 +1,108 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const defaultRedisKeyPrefix = "sentinel:ratelimit:"
+
+// token bucket refilled with limit tokens per window, as the memory backend.
+// The bucket is timed by the redis clock so that the sentinels sharing it agree
+// on the refill, and it expires once it is full again.
+var redisRateLimitScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = limit
+	ts = now
+end
+tokens = math.min(limit, tokens + math.max(0, now - ts) * limit / window)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(now))
+redis.call("PEXPIRE", KEYS[1], window)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisRateLimiter shares rate limit counters between several sentinels
+// through any server speaking the redis protocol.
+type RedisRateLimiter struct {
+	client    redis.UniversalClient
+	keyPrefix string
+}
+
+func NewRedisRateLimiter(config conf.RateLimitConfiguration) (*RedisRateLimiter, error) {
+	if len(config.RedisAddr) == 0 {
+		return nil, fmt.Errorf("redis rate limit backend requires a redis address")
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     config.RedisAddr,
+		Password: config.RedisPassword,
+		DB:       config.RedisDB,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("fail to connect to redis %s: %w", config.RedisAddr, err)
+	}
+	return NewRedisRateLimiterFromClient(client, config.KeyPrefix), nil
+}
+
+func NewRedisRateLimiterFromClient(client redis.UniversalClient, keyPrefix string) *RedisRateLimiter {
+	if len(keyPrefix) == 0 {
+		keyPrefix = defaultRedisKeyPrefix
+	}
+	return &RedisRateLimiter{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (r *RedisRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error) {
+	if window <= 0 {
+		return RateLimitResult{}, fmt.Errorf("invalid rate limit %d per %s", limit, window)
+	}
+	if limit <= 0 {
+		return denyAll(window), nil
+	}
+	res, err := redisRateLimitScript.Run(ctx, r.client, []string{r.keyPrefix + key}, limit, window.Milliseconds()).Slice()
+	if err != nil {
+		return RateLimitResult{}, fmt.Errorf("fail to run rate limit script: %w", err)
+	}
+	if len(res) != 2 {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+	allowed, ok := res[0].(int64)
+	if !ok {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+	tokensStr, ok := res[1].(string)
+	if !ok {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script result: %w", err)
+	}
+	return newTokenBucketResult(allowed == 1, limit, window, tokens), nil
+}
+
+func (r *RedisRateLimiter) Close() error {
+	return r.client.Close()
+}
@@ -0,0 +1,158 @@
+package sentinel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestMemoryRateLimiter(t *testing.T) {
+	limiter := NewMemoryRateLimiter(time.Minute)
+	defer limiter.Close()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, err := limiter.Allow(ctx, "1-127.0.0.1", 3, time.Minute)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, 3, res.Limit)
+		require.Equal(t, 2-i, res.Remaining)
+	}
+	res, err := limiter.Allow(ctx, "1-127.0.0.1", 3, time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	require.True(t, res.ResetAfter > 0)
+
+	// other keys have their own quota
+	res, err = limiter.Allow(ctx, "2-127.0.0.1", 3, time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	// a zero limit denies every request
+	res, err = limiter.Allow(ctx, "3-127.0.0.1", 0, time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	_, err = limiter.Allow(ctx, "3-127.0.0.1", 3, 0)
+	require.Error(t, err)
+}
+
+func TestMemoryRateLimiterEviction(t *testing.T) {
+	limiter := NewMemoryRateLimiter(time.Minute)
+	defer limiter.Close()
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	_, err := limiter.Allow(context.Background(), "old", 10, time.Minute)
+	require.NoError(t, err)
+	now = now.Add(30 * time.Second)
+	_, err = limiter.Allow(context.Background(), "new", 10, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, limiter.Len())
+
+	now = now.Add(45 * time.Second)
+	limiter.evict()
+	require.Equal(t, 1, limiter.Len())
+}
+
+func TestRedisRateLimiter(t *testing.T) {
+	server := miniredis.RunT(t)
+	now := time.Now()
+	server.SetTime(now)
+	limiter := NewRedisRateLimiterFromClient(redis.NewClient(&redis.Options{Addr: server.Addr()}), "")
+	defer limiter.Close()
+	// a second sentinel sharing the same redis
+	peer := NewRedisRateLimiterFromClient(redis.NewClient(&redis.Options{Addr: server.Addr()}), "")
+	defer peer.Close()
+	ctx := context.Background()
+
+	res, err := limiter.Allow(ctx, "1-127.0.0.1", 2, time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 1, res.Remaining)
+	require.Equal(t, 30*time.Second, res.ResetAfter)
+
+	res, err = peer.Allow(ctx, "1-127.0.0.1", 2, time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+
+	res, err = limiter.Allow(ctx, "1-127.0.0.1", 2, time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, time.Minute, res.ResetAfter)
+
+	// the bucket refills over the window, as the memory one
+	server.SetTime(now.Add(30 * time.Second))
+	res, err = peer.Allow(ctx, "1-127.0.0.1", 2, time.Minute)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	res, err = peer.Allow(ctx, "1-127.0.0.1", 2, time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+
+	// a zero limit denies every request
+	res, err = limiter.Allow(ctx, "2-127.0.0.1", 0, time.Minute)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+}
+
+func TestRateLimiterBackendsAgree(t *testing.T) {
+	server := miniredis.RunT(t)
+	now := time.Now()
+	server.SetTime(now)
+	memory := NewMemoryRateLimiter(time.Minute)
+	defer memory.Close()
+	memory.now = func() time.Time { return now }
+	backends := []RateLimiter{memory, NewRedisRateLimiterFromClient(redis.NewClient(&redis.Options{Addr: server.Addr()}), "")}
+	defer backends[1].Close()
+
+	for _, step := range []time.Duration{0, 0, 0, 0, 10 * time.Second, 0, 20 * time.Second, 0, 0} {
+		now = now.Add(step)
+		server.SetTime(now)
+		var results []RateLimitResult
+		for _, backend := range backends {
+			res, err := backend.Allow(context.Background(), "1-127.0.0.1", 3, time.Minute)
+			require.NoError(t, err)
+			results = append(results, res)
+		}
+		require.Equal(t, results[0].Allowed, results[1].Allowed)
+		require.Equal(t, results[0].Remaining, results[1].Remaining)
+		require.InDelta(t, results[0].ResetAfter, results[1].ResetAfter, float64(time.Millisecond))
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter, err := NewRateLimiter(conf.RateLimitConfiguration{})
+	require.NoError(t, err)
+	require.IsType(t, &MemoryRateLimiter{}, limiter)
+	require.NoError(t, limiter.Close())
+
+	server := miniredis.RunT(t)
+	limiter, err = NewRateLimiter(conf.RateLimitConfiguration{Backend: RateLimitBackendRedis, RedisAddr: server.Addr()})
+	require.NoError(t, err)
+	require.IsType(t, &RedisRateLimiter{}, limiter)
+	require.NoError(t, limiter.Close())
+
+	_, err = NewRateLimiter(conf.RateLimitConfiguration{Backend: RateLimitBackendRedis})
+	require.Error(t, err)
+	_, err = NewRateLimiter(conf.RateLimitConfiguration{Backend: "bogus"})
+	require.Error(t, err)
+}
+
+func TestRateLimitResultHeaders(t *testing.T) {
+	header := http.Header{}
+	RateLimitResult{Allowed: false, Limit: 60, Remaining: 0, ResetAfter: 1500 * time.Millisecond}.SetHeaders(header)
+	require.Equal(t, "60", header.Get(HeaderRateLimitLimit))
+	require.Equal(t, "0", header.Get(HeaderRateLimitRemaining))
+	require.Equal(t, "2", header.Get(HeaderRateLimitReset))
+	require.Equal(t, "2", header.Get("Retry-After"))
+}
@@ -0,0 +1,115 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// HeaderReceipt carries the receipt of a paid response, the JSON encoded
+// types.ResponseReceipt signed by the provider
+const HeaderReceipt = "X-Arkeo-Receipt"
+
+// ReceiptSigner signs the receipts of the responses served to paid requests,
+// clients holding receipts can prove a provider served conflicting responses
+// for a nonce, or a nonce they never signed.
+type ReceiptSigner struct {
+	privKey *secp256k1.PrivKey
+	chainId string
+}
+
+// NewReceiptSigner returns nil when the provider key (settlement mnemonic and
+// chain id) is not configured
+func NewReceiptSigner(config conf.Configuration) (*ReceiptSigner, error) {
+	settlement := config.Settlement
+	if len(settlement.Mnemonic) == 0 || len(settlement.ChainId) == 0 {
+		return nil, nil
+	}
+	privKey, err := privKeyFromMnemonic(settlement.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := common.NewPubKeyFromCrypto(privKey.PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("fail to convert provider pubkey: %w", err)
+	}
+	if !pk.Equals(config.ProviderPubKey) {
+		return nil, fmt.Errorf("settlement mnemonic does not belong to provider %s", config.ProviderPubKey)
+	}
+	return &ReceiptSigner{privKey: privKey, chainId: settlement.ChainId}, nil
+}
+
+// Sign returns the receipt of body served for the arkauth nonce
+func (s *ReceiptSigner) Sign(aa ArkAuth, body []byte) (types.ResponseReceipt, error) {
+	hash := sha256.Sum256(body)
+	receipt := types.ResponseReceipt{
+		ContractId:      aa.ContractId,
+		Nonce:           aa.Nonce,
+		ResponseHash:    hash[:],
+		ClientSignature: aa.Signature,
+	}
+	sig, err := s.privKey.Sign(receipt.GetBytesToSign(s.chainId))
+	if err != nil {
+		return receipt, err
+	}
+	receipt.Signature = sig
+	return receipt, nil
+}
+
+type receiptAuthKey struct{}
+
+// withReceiptAuth marks a request as paid with the arkauth, its response gets
+// a receipt
+func withReceiptAuth(r *http.Request, aa ArkAuth) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), receiptAuthKey{}, aa))
+}
+
+func receiptAuth(r *http.Request) (ArkAuth, bool) {
+	aa, ok := r.Context().Value(receiptAuthKey{}).(ArkAuth)
+	return aa, ok
+}
+
+// signResponse sets the receipt header of the response to a paid request
+func (p *Proxy) signResponse(r *http.Request, header http.Header, body []byte) {
+	aa, ok := receiptAuth(r)
+	if !ok || p.receipts == nil {
+		return
+	}
+	receipt, err := p.receipts.Sign(aa, body)
+	if err != nil {
+		p.logger.Error("fail to sign response receipt", "contract_id", aa.ContractId, "nonce", aa.Nonce, "error", err)
+		return
+	}
+	buf, err := json.Marshal(receipt)
+	if err != nil {
+		p.logger.Error("fail to encode response receipt", "contract_id", aa.ContractId, "nonce", aa.Nonce, "error", err)
+		return
+	}
+	header.Set(HeaderReceipt, string(buf))
+}
+
+// signUpstreamResponse reads the upstream response to sign its receipt,
+// leaving it readable
+func (p *Proxy) signUpstreamResponse(resp *http.Response) error {
+	if _, ok := receiptAuth(resp.Request); !ok || p.receipts == nil {
+		return nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("fail to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	p.signResponse(resp.Request, resp.Header, body)
+	return nil
+}
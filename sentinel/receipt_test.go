@@ -0,0 +1,144 @@
+package sentinel
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestNewReceiptSigner(t *testing.T) {
+	config := newTestConfig()
+	signer, err := NewReceiptSigner(config)
+	require.NoError(t, err)
+	require.Nil(t, signer)
+
+	// the mnemonic does not belong to the provider
+	config.Settlement.Mnemonic = testMnemonic
+	config.Settlement.ChainId = testChainId
+	_, err = NewReceiptSigner(config)
+	require.Error(t, err)
+
+	privKey, err := privKeyFromMnemonic(testMnemonic)
+	require.NoError(t, err)
+	config.ProviderPubKey, err = common.NewPubKeyFromCrypto(privKey.PubKey())
+	require.NoError(t, err)
+	signer, err = NewReceiptSigner(config)
+	require.NoError(t, err)
+	require.NotNil(t, signer)
+}
+
+func TestResponseReceipt(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Settlement.Mnemonic = testMnemonic
+	config.Settlement.ChainId = testChainId
+	privKey, err := privKeyFromMnemonic(testMnemonic)
+	require.NoError(t, err)
+	config.ProviderPubKey, err = common.NewPubKeyFromCrypto(privKey.PubKey())
+	require.NoError(t, err)
+
+	env := newPaidTestEnv(t, config, types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+	env.proxy.receipts, err = NewReceiptSigner(config)
+	require.NoError(t, err)
+
+	arkauth := env.arkAuth(t, 5)
+	body := `{"id":1,"method":"getblockcount"}`
+	resp, err := http.Post(env.server.URL+"/btc-mainnet-fullnode?"+QueryArkAuth+"="+arkauth, "application/json", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	received, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(received))
+
+	var receipt types.ResponseReceipt
+	require.NoError(t, json.Unmarshal([]byte(resp.Header.Get(HeaderReceipt)), &receipt))
+	require.NoError(t, receipt.ValidateBasic())
+	require.Equal(t, env.contract.Id, receipt.ContractId)
+	require.Equal(t, int64(5), receipt.Nonce)
+	hash := sha256.Sum256(received)
+	require.Equal(t, hash[:], receipt.ResponseHash)
+
+	aa, err := parseArkAuth(arkauth, "")
+	require.NoError(t, err)
+	require.Equal(t, aa.Signature, receipt.ClientSignature)
+
+	// the receipt is signed by the provider, the client signature verifies
+	require.True(t, privKey.PubKey().VerifySignature(receipt.GetBytesToSign(testChainId), receipt.Signature))
+	require.True(t, env.client.PubKey().VerifySignature([]byte(GenerateMessageToSign(receipt.ContractId, receipt.Nonce, "")), receipt.ClientSignature))
+
+	// free tier responses carry no receipt
+	resp, err = http.Post(env.server.URL+"/btc-mainnet-fullnode", "application/json", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Empty(t, resp.Header.Get(HeaderReceipt))
+
+	// the nonces of open contracts aren't claimed, their responses carry no receipt
+	env.contract.Authorization = types.ContractAuthorization_OPEN
+	env.proxy.MemStore.Put(env.contract)
+	resp, err = http.Post(env.server.URL+"/btc-mainnet-fullnode?"+QueryArkAuth+"="+env.arkAuth(t, 6), "application/json", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(HeaderReceipt))
+}
+
+func TestResponseReceiptSpoofedSpender(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Settlement.Mnemonic = testMnemonic
+	config.Settlement.ChainId = testChainId
+	privKey, err := privKeyFromMnemonic(testMnemonic)
+	require.NoError(t, err)
+	config.ProviderPubKey, err = common.NewPubKeyFromCrypto(privKey.PubKey())
+	require.NoError(t, err)
+
+	env := newPaidTestEnv(t, config, types.ContractType_PAY_AS_YOU_GO, 100, upstream.URL)
+	env.proxy.receipts, err = NewReceiptSigner(config)
+	require.NoError(t, err)
+
+	post := func(key *secp256k1.PrivKey, nonce int64) *http.Response {
+		pubKey, err := common.NewPubKeyFromCrypto(key.PubKey())
+		require.NoError(t, err)
+		sig, err := key.Sign([]byte(GenerateMessageToSign(env.contract.Id, nonce, "")))
+		require.NoError(t, err)
+		arkauth := fmt.Sprintf("%d:%s:%d:%s", env.contract.Id, pubKey, nonce, hex.EncodeToString(sig))
+		resp, err := http.Post(env.server.URL+"/btc-mainnet-fullnode?"+QueryArkAuth+"="+arkauth, "application/json", bytes.NewBufferString(`{"id":1,"method":"getblockcount"}`))
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	// a key other than the contract spender's isn't served on the contract nor given a receipt
+	resp := post(secp256k1.GenPrivKey(), 5)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(HeaderReceipt))
+	require.False(t, env.proxy.ClaimStore.Has(strconv.FormatUint(env.contract.Id, 10)))
+
+	resp = post(env.client, 5)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get(HeaderReceipt))
+}
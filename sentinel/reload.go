@@ -0,0 +1,252 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// file events are coalesced, editors and config map updates write in steps
+const configReloadDelay = 500 * time.Millisecond
+
+// liveConfig is the configuration in effect, it changes when the config file
+// is reloaded
+type liveConfig struct {
+	reloadMu sync.Mutex // serializes reloads
+	mu       sync.RWMutex
+	config   conf.Configuration
+}
+
+func newLiveConfig(config conf.Configuration) *liveConfig {
+	return &liveConfig{config: config}
+}
+
+func (l *liveConfig) get() conf.Configuration {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.config
+}
+
+func (l *liveConfig) set(config conf.Configuration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.config = config
+}
+
+// currentConfig returns the configuration in effect. Services,
+// free_tier_rate_limit and tls must be read from it rather than Config, which
+// is the configuration the sentinel started with.
+func (p *Proxy) currentConfig() conf.Configuration {
+	if p.live == nil {
+		return p.Config
+	}
+	return p.live.get()
+}
+
+// certReloader serves the TLS certificate, which is swapped on reload without
+// restarting the listener
+type certReloader struct {
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertReloader(config conf.TLSConfiguration) (*certReloader, error) {
+	c := &certReloader{}
+	if err := c.Load(config); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *certReloader) Load(config conf.TLSConfiguration) error {
+	cert, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+	if err != nil {
+		return fmt.Errorf("fail to load tls certificate: %w", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert = &cert
+	return nil
+}
+
+func (c *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
+}
+
+// Reload applies the services, upstreams, free tier rate limit and TLS
+// certificate of config. The other settings need a restart, their changes are
+// logged and ignored. On error nothing is applied.
+func (p *Proxy) Reload(ctx context.Context, config conf.Configuration) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if p.live == nil {
+		p.live = newLiveConfig(p.Config)
+	}
+	p.live.reloadMu.Lock()
+	defer p.live.reloadMu.Unlock()
+
+	current := p.live.get()
+	next := current
+	next.Services = config.Services
+	next.FreeTierRateLimit = config.FreeTierRateLimit
+	restart := current.RestartRequired(config)
+
+	switch {
+	case p.certs != nil && config.TLS.HasTLS():
+		// the files are read again even when the paths are the same, to pick
+		// up renewed certificates
+		if err := p.certs.Load(config.TLS); err != nil {
+			return err
+		}
+		next.TLS = config.TLS
+	case current.TLS.HasTLS() != config.TLS.HasTLS():
+		restart = append(restart, "tls")
+	}
+	if p.cache == nil {
+		for _, svc := range config.Services {
+			if svc.Cache.Enabled {
+				restart = append(restart, "services.cache")
+				break
+			}
+		}
+	}
+
+	// pools of unchanged services are kept with their health state
+	p.proxyMu.RLock()
+	previous := p.pools
+	p.proxyMu.RUnlock()
+	pools := make(map[string]*UpstreamPool)
+	var started []*UpstreamPool
+	for _, svc := range config.Services {
+		if len(svc.GetUpstreams()) == 0 {
+			continue
+		}
+		if old, ok := current.GetService(svc.Name); ok && reflect.DeepEqual(old, svc) && previous[svc.Name] != nil {
+			pools[svc.Name] = previous[svc.Name]
+			continue
+		}
+		pool, err := NewUpstreamPool(svc, p.logger)
+		if err != nil {
+			return err
+		}
+		pools[svc.Name] = pool
+		started = append(started, pool)
+	}
+
+	p.serviceMu.RLock()
+	serviceIDs := p.serviceIDs
+	p.serviceMu.RUnlock()
+	proxies := loadProxies(next, p.logger, serviceIDs)
+
+	p.proxyMu.Lock()
+	p.pools = pools
+	p.proxies = proxies
+	p.proxyMu.Unlock()
+	p.live.set(next)
+
+	for _, pool := range started {
+		go pool.Run(ctx)
+	}
+	for name, pool := range previous {
+		if pools[name] != pool {
+			pool.Close()
+		}
+	}
+
+	if len(restart) > 0 {
+		p.logger.Error("config changes need a restart to apply", "settings", strings.Join(restart, ", "))
+	}
+	p.logger.Info("configuration reloaded", "services", len(next.Services), "free_tier_rate_limit", next.FreeTierRateLimit)
+	return nil
+}
+
+// reloadConfigFile reloads ConfigFile, the running config is kept when the
+// file is invalid
+func (p *Proxy) reloadConfigFile(ctx context.Context) {
+	config, err := conf.LoadConfigurationFromFile(p.ConfigFile)
+	if err == nil {
+		err = p.Reload(ctx, config)
+	}
+	if err != nil {
+		p.logger.Error("fail to reload configuration, keeping the running one", "file", p.ConfigFile, "error", err)
+	}
+}
+
+// watchConfig reloads ConfigFile on SIGHUP, and when it or the TLS
+// certificate changes on disk. Once running, it sends on ready (when not nil)
+// whether the files are watched.
+func (p *Proxy) watchConfig(ctx context.Context, ready chan<- bool) {
+	if len(p.ConfigFile) == 0 {
+		if ready != nil {
+			ready <- false
+		}
+		return
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	// directories are watched rather than files, editors and config maps
+	// replace files instead of writing them
+	files := map[string]bool{filepath.Clean(p.ConfigFile): true}
+	if tlsConfig := p.currentConfig().TLS; tlsConfig.HasTLS() {
+		files[filepath.Clean(tlsConfig.Cert)] = true
+		files[filepath.Clean(tlsConfig.Key)] = true
+	}
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	watcher, err := fsnotify.NewWatcher()
+	watching := err == nil
+	if err != nil {
+		p.logger.Error("fail to watch config file, reload with SIGHUP", "error", err)
+	} else {
+		defer watcher.Close()
+		for file := range files {
+			if err := watcher.Add(filepath.Dir(file)); err != nil {
+				p.logger.Error("fail to watch config file, reload with SIGHUP", "file", file, "error", err)
+				watching = false
+			}
+		}
+		events, errs = watcher.Events, watcher.Errors
+	}
+	if ready != nil {
+		ready <- watching
+	}
+
+	var pending <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			p.logger.Info("SIGHUP received, reloading configuration")
+			p.reloadConfigFile(ctx)
+		case event := <-events:
+			// kubernetes swaps a "..data" symlink when a config map changes
+			if files[filepath.Clean(event.Name)] || strings.HasPrefix(filepath.Base(event.Name), "..") {
+				pending = time.After(configReloadDelay)
+			}
+		case err := <-errs:
+			p.logger.Error("config file watch error", "error", err)
+		case <-pending:
+			pending = nil
+			p.logger.Info("config file changed, reloading configuration")
+			p.reloadConfigFile(ctx)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newUpstream answers every request with its name
+func newUpstream(t *testing.T, name string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(name))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newReloadTestConfig(upstreamURL string) conf.Configuration {
+	config := newTestConfig()
+	config.HubProviderURI = "http://localhost:1317"
+	config.ClaimStoreLocation = "claims"
+	config.ContractConfigStoreLocation = "contracts"
+	config.ProviderConfigStoreLocation = "providers"
+	config.Services = []conf.ServiceConfig{{
+		Name:        "btc-mainnet-fullnode",
+		Upstreams:   []conf.UpstreamConfig{{Url: upstreamURL}},
+		HealthCheck: conf.HealthCheckConfig{Interval: -1},
+	}}
+	return config
+}
+
+func TestReload(t *testing.T) {
+	a, b := newUpstream(t, "a"), newUpstream(t, "b")
+	config := newReloadTestConfig(a.URL)
+	config.FreeTierRateLimit = 1
+	env := newPaidTestEnv(t, config, types.ContractType_SUBSCRIPTION, 100, a.URL)
+	env.proxy.live = newLiveConfig(config)
+	env.proxy.pools, _ = loadUpstreamPools(config, env.proxy.logger)
+	env.server.Config.Handler = env.proxy.getRouter()
+
+	nonce := int64(0)
+	get := func(paid bool) (*http.Response, string) {
+		url := env.server.URL + "/btc-mainnet-fullnode"
+		if paid {
+			nonce++
+			url += "?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		}
+		resp, err := http.Get(url)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+	resp, body := get(false)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "a", body)
+	resp, _ = get(false)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// invalid configs are refused as a whole
+	next := newReloadTestConfig(b.URL)
+	next.FreeTierRateLimit = 10
+	next.Moniker = ""
+	require.ErrorContains(t, env.proxy.Reload(context.Background(), next), "moniker is required")
+	_, body = get(true)
+	require.Equal(t, "a", body)
+
+	next.Moniker = config.Moniker
+	next.Port = "4000" // needs a restart, ignored
+	require.NoError(t, env.proxy.Reload(context.Background(), next))
+	_, body = get(true)
+	require.Equal(t, "b", body)
+	resp, _ = get(false)
+	require.Equal(t, "10", resp.Header.Get(HeaderRateLimitLimit))
+	require.Equal(t, "3636", env.proxy.currentConfig().Port)
+
+	// unchanged services keep their pool and its health state
+	pool := env.proxy.upstreamPool("btc-mainnet-fullnode")
+	next.FreeTierRateLimit = 20
+	require.NoError(t, env.proxy.Reload(context.Background(), next))
+	require.Same(t, pool, env.proxy.upstreamPool("btc-mainnet-fullnode"))
+	require.Equal(t, 20, env.proxy.currentConfig().FreeTierRateLimit)
+}
+
+func TestWatchConfig(t *testing.T) {
+	upstream := newUpstream(t, "a")
+	config := newReloadTestConfig(upstream.URL)
+	file := filepath.Join(t.TempDir(), "sentinel.yaml")
+	writeConfig := func(config conf.Configuration) {
+		data, err := yaml.Marshal(config)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(file, data, 0o600))
+	}
+	writeConfig(config)
+
+	env := newPaidTestEnv(t, config, types.ContractType_SUBSCRIPTION, 100, upstream.URL)
+	env.proxy.live = newLiveConfig(config)
+	env.proxy.ConfigFile = file
+	ctx, cancel := context.WithCancel(context.Background())
+	ready, done := make(chan bool, 1), make(chan struct{})
+	go func() {
+		env.proxy.watchConfig(ctx, ready)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// the watcher must be running before the file changes
+	if !<-ready {
+		t.Skip("config files can't be watched here (ie too many open files)")
+	}
+	config.FreeTierRateLimit = 42
+	writeConfig(config)
+	require.Eventually(t, func() bool {
+		return env.proxy.currentConfig().FreeTierRateLimit == 42
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a broken file keeps the running config
+	require.NoError(t, os.WriteFile(file, []byte("free_tier_rate_limit: [\n"), 0o600))
+	time.Sleep(2 * configReloadDelay)
+	require.Equal(t, 42, env.proxy.currentConfig().FreeTierRateLimit)
+}
+
+// writeTestCert writes a self-signed certificate for the given name
+func writeTestCert(t *testing.T, dir, name string) conf.TLSConfiguration {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	config := conf.TLSConfiguration{Cert: filepath.Join(dir, "cert.pem"), Key: filepath.Join(dir, "key.pem")}
+	require.NoError(t, os.WriteFile(config.Cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(config.Key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return config
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	tlsConfig := writeTestCert(t, dir, "first")
+	certs, err := newCertReloader(tlsConfig)
+	require.NoError(t, err)
+
+	commonName := func() string {
+		cert, err := certs.GetCertificate(&tls.ClientHelloInfo{})
+		require.NoError(t, err)
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return parsed.Subject.CommonName
+	}
+	require.Equal(t, "first", commonName())
+
+	// renewed in place
+	writeTestCert(t, dir, "second")
+	require.NoError(t, certs.Load(tlsConfig))
+	require.Equal(t, "second", commonName())
+
+	// a broken pair keeps the current certificate
+	require.NoError(t, os.WriteFile(tlsConfig.Key, []byte("garbage"), 0o600))
+	require.Error(t, certs.Load(tlsConfig))
+	require.Equal(t, "second", commonName())
+	require.True(t, strings.HasPrefix(fmt.Sprint(certs.Load(conf.TLSConfiguration{})), "fail to load tls certificate"))
+}
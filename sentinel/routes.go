@@ -8,4 +8,5 @@ const (
 	RoutesClaims         = "/claims"
 	RouteManage          = "/manage/contract/{id}"
 	RouteProviderData    = "/provider/{service}"
+	RoutesMetrics        = "/metrics"
 )
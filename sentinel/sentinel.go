@@ -20,7 +20,6 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
-	"github.com/koding/websocketproxy"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 	"sync"
@@ -31,17 +30,27 @@ import (
 
 type Proxy struct {
 	Metadata            Metadata
-	Config              conf.Configuration
+	Config              conf.Configuration // as started, see currentConfig for reloadable settings
+	ConfigFile          string             // reloaded on SIGHUP or when it changes, when set
 	MemStore            *MemStore
 	ClaimStore          *ClaimStore
 	ContractConfigStore *ContractConfigurationStore
 	ProviderConfigStore *ProviderConfigurationStore
 	logger              log.Logger
 	proxies             map[string]*url.URL
+	pools               map[string]*UpstreamPool
+	cache               *ResponseCache // nil when no service enables caching
+	metrics             *Metrics
+	live                *liveConfig
+	certs               *certReloader // nil without TLS
 	proxyMu             sync.RWMutex
 	serviceIDs          map[string]int32
 	authManager         *ArkeoAuthManager
 	serviceMu           sync.RWMutex
+	storage             *Storage
+	rateLimiter         RateLimiter
+	settler             *ClaimSettler
+	receipts            *ReceiptSigner // nil without the provider key
 }
 
 func NewProxy(config conf.Configuration) (*Proxy, error) {
@@ -54,24 +63,54 @@ func NewProxy(config conf.Configuration) (*Proxy, error) {
 
 	logger.Error("DEBUG:FUNCTION NewProxy")
 
-	claimStore, err := NewClaimStore(config.ClaimStoreLocation)
+	storage, err := NewStorage(config.Storage)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to open storage with error: %s", err))
+		return nil, fmt.Errorf("failed to open storage with error: %s", err)
+	}
+	claimDb, err := storage.Open(StoreClaims, config.ClaimStoreLocation)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create claim store with error: %s", err))
 		return nil, fmt.Errorf("failed to create claim store with error: %s", err)
 	}
-	contractConfigStore, err := NewContractConfigurationStore(config.ContractConfigStoreLocation)
+	claimStore := NewClaimStoreWithKV(claimDb)
+	contractConfigDb, err := storage.Open(StoreContractConfigs, config.ContractConfigStoreLocation)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create contract config store with error: %s", err))
 		return nil, fmt.Errorf("failed to create contract config store with error: %s", err)
 	}
-	providerConfigStore, err := NewProviderConfigurationStore(config.ProviderConfigStoreLocation)
+	contractConfigStore := NewContractConfigurationStoreWithKV(contractConfigDb)
+	providerConfigDb, err := storage.Open(StoreProviderConfigs, config.ProviderConfigStoreLocation)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create provider config store with error: %s", err))
 		return nil, fmt.Errorf("failed to create provider config store with error: %s", err)
 	}
+	providerConfigStore := NewProviderConfigurationStoreWithKV(providerConfigDb)
+
+	rateLimiter, err := NewRateLimiter(config.RateLimit)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create rate limiter with error: %s", err))
+		return nil, fmt.Errorf("failed to create rate limiter with error: %s", err)
+	}
 
 	serviceIDs := loadServiceRegistry(config, logger)
 	proxies := loadProxies(config, logger, serviceIDs)
+	pools, err := loadUpstreamPools(config, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to load upstreams with error: %s", err))
+		return nil, fmt.Errorf("failed to load upstreams with error: %s", err)
+	}
+	var cache *ResponseCache
+	for _, svc := range config.Services {
+		if svc.Cache.Enabled {
+			cache, err = NewResponseCache(config.Cache)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to create response cache with error: %s", err))
+				return nil, fmt.Errorf("failed to create response cache with error: %s", err)
+			}
+			break
+		}
+	}
 
 	fmt.Println("DEBUG: Proxies loaded at startup:")
 	for name, uri := range proxies {
@@ -85,11 +124,12 @@ func NewProxy(config conf.Configuration) (*Proxy, error) {
 	// Initialize auth manager if configured
 	var authManager *ArkeoAuthManager
 	if config.ArkeoAuthContractId > 0 && config.ArkeoAuthMnemonic != "" {
-		nonceStore, err := NewNonceStore(config.ArkeoAuthNonceStore)
+		nonceDb, err := storage.Open(StoreNonces, config.ArkeoAuthNonceStore)
 		if err != nil {
 			logger.Error(fmt.Sprintf("failed to create nonce store: %s", err))
 			return nil, fmt.Errorf("failed to create nonce store: %s", err)
 		}
+		nonceStore := NewNonceStoreWithKV(nonceDb)
 
 		authManager, err = NewArkeoAuthManager(
 			config.ArkeoAuthContractId,
@@ -109,20 +149,57 @@ func NewProxy(config conf.Configuration) (*Proxy, error) {
 		)
 	}
 
-	return &Proxy{
+	var certs *certReloader
+	if config.TLS.HasTLS() {
+		certs, err = newCertReloader(config.TLS)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+	}
+
+	memStore := NewMemStore(config.HubProviderURI, authManager, logger)
+
+	var settler *ClaimSettler
+	if config.Settlement.Enabled {
+		chainClient := NewRestChainClient(config.HubProviderURI, authManager)
+		settler, err = NewClaimSettler(config, claimStore, memStore, chainClient, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create claim settler: %s", err))
+			return nil, fmt.Errorf("failed to create claim settler: %s", err)
+		}
+	}
+
+	receipts, err := NewReceiptSigner(config)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create receipt signer: %s", err))
+		return nil, fmt.Errorf("failed to create receipt signer: %s", err)
+	}
+
+	proxy := &Proxy{
 		Metadata:            NewMetadata(config),
 		Config:              config,
-		MemStore:            NewMemStore(config.HubProviderURI, authManager, logger),
+		MemStore:            memStore,
 		ClaimStore:          claimStore,
 		ContractConfigStore: contractConfigStore,
 		proxies:             proxies, // <-- use the local variable here
+		pools:               pools,
+		cache:               cache,
 		proxyMu:             sync.RWMutex{},
 		logger:              logger,
 		ProviderConfigStore: providerConfigStore,
 		serviceIDs:          serviceIDs,
 		authManager:         authManager,
 		serviceMu:           sync.RWMutex{},
-	}, nil
+		storage:             storage,
+		rateLimiter:         rateLimiter,
+		settler:             settler,
+		receipts:            receipts,
+		live:                newLiveConfig(config),
+		certs:               certs,
+	}
+	proxy.metrics = NewMetrics(proxy)
+	return proxy, nil
 }
 
 func loadProxies(config conf.Configuration, logger log.Logger, serviceIDs map[string]int32) map[string]*url.URL {
@@ -154,22 +231,14 @@ func loadProxies(config conf.Configuration, logger log.Logger, serviceIDs map[st
 	for serviceName := range serviceIDs {
 		//logger.Error("DEBUG: Checking serviceName", "serviceName", serviceName)
 		if svc, ok := serviceMap[serviceName]; ok {
-			var fullURL string
 			logger.Error("DEBUG: Found serviceMap", "serviceName", serviceName, "RpcUrl", svc.RpcUrl)
-			if strings.HasPrefix(svc.RpcUrl, "https://") {
-				if svc.RpcUser != "" && svc.RpcPass != "" {
-					rpcURL := strings.TrimPrefix(svc.RpcUrl, "https://")
-					fullURL = fmt.Sprintf("https://%s:%s@%s", svc.RpcUser, svc.RpcPass, rpcURL)
-				} else {
-					fullURL = svc.RpcUrl
-				}
-			} else {
-				if svc.RpcUser != "" && svc.RpcPass != "" {
-					rpcURL := strings.TrimPrefix(svc.RpcUrl, "http://")
-					fullURL = fmt.Sprintf("http://%s:%s@%s", svc.RpcUser, svc.RpcPass, rpcURL)
-				} else {
-					fullURL = svc.RpcUrl
-				}
+			// requests are balanced by the service's upstream pool, this is
+			// the default target
+			var fullURL string
+			if upstreams := svc.GetUpstreams(); len(upstreams) > 0 {
+				fullURL = upstreamURL(upstreams[0].Url, upstreams[0].RpcUser, upstreams[0].RpcPass)
+			}
+			if strings.HasPrefix(fullURL, "http://") {
 				logger.Error("DEBUG: Insecure endpoint", "serviceName", serviceName, "url", fullURL)
 			}
 
@@ -260,7 +329,7 @@ func (p *Proxy) refreshServiceRegistry(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			reg := loadServiceRegistry(p.Config, p.logger)
+			reg := loadServiceRegistry(p.currentConfig(), p.logger)
 			if len(reg) == 0 {
 				continue
 			}
@@ -269,7 +338,7 @@ func (p *Proxy) refreshServiceRegistry(ctx context.Context) {
 			p.serviceMu.Unlock()
 
 			// rebuild proxies to include any new services (using existing config/env)
-			newProxies := loadProxies(p.Config, p.logger, reg)
+			newProxies := loadProxies(p.currentConfig(), p.logger, reg)
 			p.proxyMu.Lock()
 			p.proxies = newProxies
 			p.proxyMu.Unlock()
@@ -291,6 +360,12 @@ func (p *Proxy) handleRequestAndRedirect(w http.ResponseWriter, r *http.Request)
 
 	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // TODO: Check
 
+	// websocket sessions keep the handshake arkauth to meter the connection
+	var wsAuth ArkAuth
+	if websocket.IsWebSocketUpgrade(r) {
+		wsAuth, _ = p.fetchArkAuth(r)
+	}
+
 	// remove arkauth query arg
 	values := r.URL.Query()
 	values.Del(QueryArkAuth)
@@ -319,6 +394,10 @@ func (p *Proxy) handleRequestAndRedirect(w http.ResponseWriter, r *http.Request)
 	p.proxyMu.RLock()
 	uri, exists := p.proxies[serviceName]
 	p.proxyMu.RUnlock()
+	pool := p.upstreamPool(serviceName)
+	if pool != nil {
+		uri, exists = pool.Next(), true
+	}
 	if !exists || uri == nil {
 		p.logger.Error("DEBUG:TRACE: Service proxy not found or nil", "serviceName", serviceName)
 		respondWithError(w, "could not find service", http.StatusBadRequest)
@@ -347,23 +426,54 @@ func (p *Proxy) handleRequestAndRedirect(w http.ResponseWriter, r *http.Request)
 	// check for the WebSocket upgrade header
 	if websocket.IsWebSocketUpgrade(r) {
 		p.logger.Info("[TRACE] WebSocket upgrade detected", "url", r.URL.String())
-		fmt.Println(">>>>>>> Entering websocket....")
-		wsProxyURL := *r.URL
-		wsProxyURL.Scheme = "ws" // use the WebSocket scheme
-		wsProxy := websocketproxy.NewProxy(&wsProxyURL)
-		wsProxy.ServeHTTP(w, r)
+		service, _ := p.currentConfig().GetService(serviceName)
+		p.handleWebSocket(w, r, *r.URL, wsAuth, service)
 		return
 	}
 
+	// answer immutable chain data from the cache
+	var cached *cachedRequest
+	if service, ok := p.currentConfig().GetService(serviceName); ok && service.Cache.Enabled && p.cache != nil {
+		body, err := peekBody(r)
+		if err != nil {
+			respondWithError(w, "fail to read request body", http.StatusBadRequest)
+			return
+		}
+		var head int64
+		if pool != nil {
+			head = pool.Height()
+		}
+		if cached = cacheableRequest(service, r, body, head); cached != nil && p.serveCached(w, r, cached) {
+			return
+		}
+	}
+
 	// Serve a reverse proxy for a given url
 	// create the reverse proxy
 	proxy := common.NewSingleHostReverseProxy(r.URL)
+	start := time.Now()
 	proxy.ModifyResponse = func(resp *http.Response) error {
 		p.logger.Info("DEBUG:PROXY: Upstream response", "status", resp.StatusCode, "url", resp.Request.URL.String())
-		return nil
+		if cached != nil {
+			p.storeCached(cached, resp)
+		}
+		failed := resp.StatusCode >= http.StatusInternalServerError
+		if failed {
+			p.metrics.upstreamError(serviceName, uri.Host)
+		}
+		if pool != nil {
+			pool.Report(uri, time.Since(start), failed, fmt.Sprintf("status %d", resp.StatusCode))
+		}
+		return p.signUpstreamResponse(resp)
 	}
 	proxy.ErrorHandler = func(rw http.ResponseWriter, req *http.Request, err error) {
 		p.logger.Error("DEBUG:PROXY ERROR: ", "err", err, "target", r.URL.String(), "serviceName", serviceName)
+		if req.Context().Err() == nil {
+			p.metrics.upstreamError(serviceName, uri.Host)
+			if pool != nil {
+				pool.Report(uri, time.Since(start), true, err.Error())
+			}
+		}
 		http.Error(rw, "Proxy error: "+err.Error(), http.StatusBadGateway)
 	}
 	p.logger.Info("DEBUG: Outgoing Proxy URL", "url", r.URL.String(), "method", r.Method)
@@ -376,10 +486,16 @@ func (p *Proxy) handleMetadata(w http.ResponseWriter, r *http.Request) {
 
 	// Only include these fields at the top level of "config"
 	// Also, include "version" at the top level.
+	type serviceHealth struct {
+		Healthy   bool             `json:"healthy"`
+		Upstreams []UpstreamHealth `json:"upstreams"`
+	}
 	type serviceInfo struct {
-		Name string `json:"name"`
-		ID   string `json:"id"`
-		Type string `json:"type"`
+		Name   string         `json:"name"`
+		ID     string         `json:"id"`
+		Type   string         `json:"type"`
+		Health *serviceHealth `json:"health,omitempty"`
+		Cache  *CacheStats    `json:"cache,omitempty"`
 	}
 	type configInfo struct {
 		Moniker           string        `json:"moniker"`
@@ -399,16 +515,24 @@ func (p *Proxy) handleMetadata(w http.ResponseWriter, r *http.Request) {
 		Config  configInfo `json:"config"`
 	}
 
-	cfg := p.Metadata.Configuration // use the canonical config as source
+	cfg := p.currentConfig()
 
 	// Build the services array from config.Services
 	services := make([]serviceInfo, 0, len(cfg.Services))
 	for _, svc := range cfg.Services {
-		services = append(services, serviceInfo{
+		info := serviceInfo{
 			Name: svc.Name,
 			ID:   strconv.Itoa(svc.Id),
 			Type: svc.Type,
-		})
+		}
+		if pool := p.upstreamPool(svc.Name); pool != nil {
+			info.Health = &serviceHealth{Healthy: pool.Healthy(), Upstreams: pool.Health()}
+		}
+		if svc.Cache.Enabled && p.cache != nil {
+			stats := p.cache.Stats(svc.Name)
+			info.Cache = &stats
+		}
+		services = append(services, info)
 	}
 
 	config := configInfo{
@@ -535,9 +659,10 @@ func (p *Proxy) handleContract(w http.ResponseWriter, r *http.Request) {
 		}
 
 		type PostContractConfig struct {
-			PerUserRateLimit     int      `json:"per_user_rate_limit"`
-			CORs                 CORs     `json:"cors"`
-			WhitelistIPAddresses []string `json:"white_listed_ip_addresses"`
+			PerUserRateLimit     int               `json:"per_user_rate_limit"`
+			CORs                 CORs              `json:"cors"`
+			WhitelistIPAddresses []string          `json:"white_listed_ip_addresses"`
+			Methods              conf.MethodPolicy `json:"methods"`
 		}
 		var changes PostContractConfig
 		if err := json.Unmarshal(body, &changes); err != nil {
@@ -548,6 +673,7 @@ func (p *Proxy) handleContract(w http.ResponseWriter, r *http.Request) {
 		contractConf.PerUserRateLimit = changes.PerUserRateLimit
 		contractConf.CORs = changes.CORs
 		contractConf.WhitelistIPAddresses = changes.WhitelistIPAddresses
+		contractConf.Methods = changes.Methods
 		err = p.ContractConfigStore.Set(contractConf)
 		if err != nil {
 			p.logger.Error("fail to save contract config", "error", err, "id", contractConf.ContractId)
@@ -721,12 +847,30 @@ func (p *Proxy) Run() {
 		p.refreshServiceRegistry(ctx)
 		return nil
 	})
+	g.Go(func() error {
+		p.runHealthChecks(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		p.trackChainHeight(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		p.watchConfig(ctx, nil)
+		return nil
+	})
+	if p.settler != nil {
+		g.Go(func() error {
+			p.settler.Run(ctx)
+			return nil
+		})
+	}
 
 	// Add the Logrus middleware to the router
 	loggingRouter := p.logrusMiddleware(router)
 
 	// Check if TLS certificates are configured
-	if p.Config.TLS.HasTLS() {
+	if p.certs != nil {
 		// Start a goroutine that listens to on port 80 and redirects HTTP to HTTPS
 		go func() {
 			redirectServer := &http.Server{
@@ -752,6 +896,8 @@ func (p *Proxy) Run() {
 			WriteTimeout:      5 * time.Second,
 			IdleTimeout:       120 * time.Second,
 			TLSConfig: &tls.Config{
+				// the certificate is swapped on config reload
+				GetCertificate: p.certs.GetCertificate,
 				// Policies
 				MinVersion: tls.VersionTLS13,
 				CipherSuites: []uint16{
@@ -762,7 +908,7 @@ func (p *Proxy) Run() {
 			},
 			MaxHeaderBytes: 1 << 20,
 		}
-		if err := server.ListenAndServeTLS(p.Config.TLS.Cert, p.Config.TLS.Key); err != nil {
+		if err := server.ListenAndServeTLS("", ""); err != nil {
 			panic(err)
 		}
 	} else {
@@ -801,10 +947,15 @@ func (p *Proxy) getRouter() *mux.Router {
 
 	router.HandleFunc(RouteManage, p.handleContract).Methods(http.MethodGet, http.MethodPost)
 	router.HandleFunc(RouteProviderData, p.handleProviderData).Methods(http.MethodGet)
+	if p.metrics != nil {
+		router.Handle(RoutesMetrics, p.metrics.Handler()).Methods(http.MethodGet)
+	}
 	router.PathPrefix("/").Handler(
-		p.auth(
-			handlers.ProxyHeaders(
-				http.HandlerFunc(p.handleRequestAndRedirect),
+		p.instrument(
+			p.auth(
+				handlers.ProxyHeaders(
+					http.HandlerFunc(p.handleRequestAndRedirect),
+				),
 			),
 		),
 	)
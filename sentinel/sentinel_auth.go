@@ -1,18 +1,19 @@
 package sentinel
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 	"golang.org/x/crypto/sha3"
-	"golang.org/x/time/rate"
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
+	"time"
 )
 
 const (
@@ -21,12 +22,6 @@ const (
 	ServiceHeader = "arkservice"
 )
 
-// Create a map to hold the rate limiters for each visitor and a mutex.
-var (
-	visitors = make(map[string]*rate.Limiter)
-	mu       sync.Mutex
-)
-
 type ContractAuth struct {
 	ContractId uint64
 	Timestamp  int64
@@ -305,12 +300,14 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 
 		// fetch conf and check whitelist/IP/rate
 		whitelisted := false
+		var contractMethods conf.MethodPolicy
 		if !contract.Client.IsEmpty() {
 			conf, err := p.ContractConfigStore.Get(contract.Id)
 			if err != nil {
 				p.logger.Error("failed to fetch contract", "error", err)
 			} else {
 				w = p.enableCORS(w, conf.CORs)
+				contractMethods = conf.Methods
 
 				// Check IP whitelist
 				addr := remoteAddr
@@ -338,13 +335,7 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 
 			// Determine service name from header or URL path.
 			rawHeaderService := r.Header.Get(ServiceHeader)
-			serviceName := rawHeaderService
-			if serviceName == "" {
-				parts := strings.Split(r.URL.Path, "/")
-				if len(parts) > 1 {
-					serviceName = parts[1]
-				}
-			}
+			serviceName := requestServiceName(r)
 
 			// Log the raw header and derived service name.
 			p.logger.Info("DEBUG: service header and path",
@@ -388,8 +379,32 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 				// allow if registry doesn’t know it (dynamic addition)
 			}
 
-			httpCode, tierErr := p.paidTier(aa, remoteAddr)
+			body, err := peekBody(r)
+			if err != nil {
+				http.Error(w, "fail to read request body", http.StatusBadRequest)
+				return
+			}
+			calls := parseRPCCalls(body)
+			service, _ := p.currentConfig().GetService(serviceName)
+			policy := methodPolicy{service: service, contract: contractMethods}
+			if code, err := p.checkMethods(calls, policy, contract.Id, remoteAddr); err != nil {
+				respondWithJSONRPCError(w, jsonRPCErrorStatus(code), calls, code, err.Error())
+				return
+			}
+
+			// pay-as-you-go requests pay one nonce per call of a batch
+			cost := int64(1)
+			if contract.IsPayAsYouGo() {
+				cost = policy.requestCost(calls)
+			}
+
+			httpCode, claimed, tierErr := p.paidTier(aa, remoteAddr, cost, w.Header())
 			if tierErr == nil {
+				// only a nonce recorded as claimed gets a receipt, the provider can't be
+				// held to a nonce it doesn't track
+				if claimed {
+					r = withReceiptAuth(r, aa)
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -405,7 +420,18 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 		}
 
 		w.Header().Set("tier", "free")
-		httpCode, err := p.freeTier(remoteAddr)
+		body, err := peekBody(r)
+		if err != nil {
+			http.Error(w, "fail to read request body", http.StatusBadRequest)
+			return
+		}
+		calls := parseRPCCalls(body)
+		service, _ := p.currentConfig().GetService(requestServiceName(r))
+		if code, err := p.checkMethods(calls, methodPolicy{service: service}, 0, remoteAddr); err != nil {
+			respondWithJSONRPCError(w, jsonRPCErrorStatus(code), calls, code, err.Error())
+			return
+		}
+		httpCode, err := p.freeTier(remoteAddr, w.Header())
 		if err != nil {
 			http.Error(w, err.Error(), httpCode)
 			return
@@ -414,6 +440,19 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 	})
 }
 
+// requestServiceName returns the service of a request, from the service
+// header or else the first segment of the path
+func requestServiceName(r *http.Request) string {
+	if serviceName := r.Header.Get(ServiceHeader); serviceName != "" {
+		return serviceName
+	}
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) > 1 {
+		return parts[1]
+	}
+	return ""
+}
+
 const (
 	forwardHeaderName = `X-Forwarded-For`
 	xRealIPName       = `X-Real-Ip`
@@ -436,71 +475,98 @@ func (p Proxy) getRemoteAddr(r *http.Request) string {
 	return ip
 }
 
-func (p Proxy) isRateLimited(contractId uint64, key string, limitTokens int, windowSeconds int) bool {
-	mu.Lock()
-	defer mu.Unlock()
-
+// isRateLimited consumes one request from the quota of the given key and
+// writes the resulting X-RateLimit-* headers. If the rate limit backend is
+// unavailable the request is let through rather than failing every request.
+func (p Proxy) isRateLimited(contractId uint64, key string, limitTokens int, windowSeconds int, header http.Header) bool {
 	key = fmt.Sprintf("%d-%s", contractId, key)
-	limiter, exists := visitors[key]
-	if !exists {
-		limiter = rate.NewLimiter(rate.Limit(float64(limitTokens)/float64(windowSeconds)), limitTokens)
-		visitors[key] = limiter
-	}
-
-	allowed := limiter.Allow()
-	p.logger.Info("DEBUG: Rate limiting check",
-		"key", key,
-		"limit_per_sec", limiter.Limit(),
-		"burst", limiter.Burst(),
-		"allowed", allowed,
-	)
-	if allowed {
-		p.logger.Debug("DEBUG: Rate limit result", "status", "allowed", "key", key)
+	window := time.Duration(windowSeconds) * time.Second
+	result, err := p.rateLimiter.Allow(context.Background(), key, limitTokens, window)
+	if err != nil {
+		// fail closed, an unavailable limiter must not lift the limits
+		p.logger.Error("fail to check rate limit", "key", key, "error", err)
+		return true
+	}
+	result.SetHeaders(header)
+
+	if result.Allowed {
+		p.logger.Debug("DEBUG: Rate limit result", "status", "allowed", "key", key, "remaining", result.Remaining)
 	} else {
-		p.logger.Debug("DEBUG: Rate limit result", "status", "rate limited", "key", key)
+		p.logger.Debug("DEBUG: Rate limit result", "status", "rate limited", "key", key, "reset_after", result.ResetAfter)
+		tier := "paid"
+		if contractId == 0 {
+			tier = "free"
+		}
+		p.metrics.rateLimitRejected(tier)
 	}
-	return !allowed
+	return !result.Allowed
 }
 
-func (p Proxy) freeTier(remoteAddr string) (int, error) {
-	if ok := p.isRateLimited(0, remoteAddr, p.Config.FreeTierRateLimit, 60); ok {
+func (p Proxy) freeTier(remoteAddr string, header http.Header) (int, error) {
+	if ok := p.isRateLimited(0, remoteAddr, p.currentConfig().FreeTierRateLimit, 60, header); ok {
 		return http.StatusTooManyRequests, fmt.Errorf("free client is rate limited (%s)", http.StatusText(429))
 	}
 
 	return http.StatusOK, nil
 }
 
-func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
+// paidTier authorizes a request of a paid contract, cost is the number of
+// nonces the request consumes on a pay-as-you-go contract. claimed is true
+// when the nonce of the request was recorded as the claim of the contract.
+func (p Proxy) paidTier(aa ArkAuth, remoteAddr string, cost int64, header http.Header) (code int, claimed bool, err error) {
 
 	// Fetch contract by ID; error if not found or datastore issue.
 	key := strconv.FormatUint(aa.ContractId, 10)
 	contract, err := p.MemStore.Get(key)
 	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("internal server error: %w", err)
+		return http.StatusInternalServerError, false, fmt.Errorf("internal server error: %w", err)
 	}
 
 	// Ensure spender (client) is recorded in the claim even when arkauth is 3-part.
 	if aa.Spender.IsEmpty() {
-		aa.Spender = contract.Client
+		aa.Spender = contract.GetSpender()
 		p.logger.Debug("paidTier: inferred spender from contract client", "spender", aa.Spender.String())
 	}
 
 	// Check if the contract has expired (based on current chain height).
 	// If expired, require the client to open a new contract before continuing.
 	if contract.IsExpired(p.MemStore.GetHeight()) {
-		return http.StatusPaymentRequired, fmt.Errorf("open a contract")
+		return http.StatusPaymentRequired, false, fmt.Errorf("open a contract")
 	}
 
 	// check if we've exceeded the total number of pay-as-you-go queries
 	if contract.IsPayAsYouGo() {
-		if contract.Deposit.IsNil() || contract.Deposit.LT(cosmos.NewInt(aa.Nonce*contract.Rate.Amount.Int64())) {
-			return http.StatusPaymentRequired, fmt.Errorf("contract spent")
+		if contract.Deposit.IsNil() || contract.Deposit.LT(contract.PayAsYouGoCost(aa.Nonce)) {
+			return http.StatusPaymentRequired, false, fmt.Errorf("contract spent")
+		}
+		paid := contract.Paid
+		if paid.IsNil() {
+			paid = cosmos.ZeroInt()
+		}
+		// the chain doesn't pay past the spend limit of the spender, what is
+		// not claimed yet has to fit in what is left of the current period
+		if limit := contract.DelegateLimit; limit.MaxNonce > 0 && aa.Nonce > limit.MaxNonce {
+			return http.StatusPaymentRequired, false, fmt.Errorf("delegate limit reached")
+		}
+		if allowance, limited := contract.DelegateAllowance(p.MemStore.GetHeight()); limited && allowance.LT(contract.PayAsYouGoCost(aa.Nonce).Sub(paid)) {
+			return http.StatusPaymentRequired, false, fmt.Errorf("delegate limit reached")
+		}
+		// members of a group draw on the deposit of the group, which the
+		// other providers of the group are paid from too
+		if contract.IsGroupMember() {
+			group, err := p.MemStore.GetGroup(contract.GroupId)
+			if err != nil {
+				return http.StatusInternalServerError, false, fmt.Errorf("internal server error: %w", err)
+			}
+			if group.Remaining().LT(contract.PayAsYouGoCost(aa.Nonce).Sub(paid)) {
+				return http.StatusPaymentRequired, false, fmt.Errorf("contract group spent")
+			}
 		}
 	}
 
 	// Enforce per-contract paid tier rate limiting.
-	if ok := p.isRateLimited(contract.Id, remoteAddr, int(contract.QueriesPerMinute), 60); ok {
-		return http.StatusTooManyRequests, fmt.Errorf("paid client is rate limited (%s)", http.StatusText(429))
+	if ok := p.isRateLimited(contract.Id, remoteAddr, int(contract.QueriesPerMinute), 60, header); ok {
+		return http.StatusTooManyRequests, false, fmt.Errorf("paid client is rate limited (%s)", http.StatusText(429))
 	}
 
 	// For open authorization (subscription) contracts, skip PAYG nonce/signature tracking.
@@ -512,7 +578,24 @@ func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
 			"service", contract.Service.String(),
 			"spender", aa.Spender.String(),
 		)
-		return http.StatusOK, nil
+		return http.StatusOK, false, nil
+	}
+
+	code, err = p.storeClaim(aa, contract, cost)
+	return code, err == nil, err
+}
+
+// storeClaim verifies the client signature of the arkauth nonce and records it
+// as the latest claim of the pay-as-you-go contract. The nonce has to be at
+// least cost above the previous one.
+func (p Proxy) storeClaim(aa ArkAuth, contract types.Contract, cost int64) (code int, err error) {
+	key := strconv.FormatUint(aa.ContractId, 10)
+	// the nonces are claimed with the signature of the contract spender, a
+	// request signed by anyone else isn't paid for by the contract
+	if aa.Spender.IsEmpty() {
+		aa.Spender = contract.GetSpender()
+	} else if !aa.Spender.Equals(contract.GetSpender()) {
+		return http.StatusUnauthorized, fmt.Errorf("arkauth spender is not the contract spender")
 	}
 
 	// Optional self-verify so only claimable entries are stored.
@@ -562,6 +645,7 @@ func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
 	// - If the incoming nonce is not strictly greater than the stored nonce, reject the request (prevent replay or duplicate).
 	sig := hex.EncodeToString(aa.Signature)
 	claim := NewClaim(aa.ContractId, aa.Spender, aa.Nonce, sig)
+	previous := contract.Nonce
 	if p.ClaimStore.Has(key) {
 		var err error
 		claim, err = p.ClaimStore.Get(key)
@@ -571,6 +655,10 @@ func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
 		if claim.Nonce >= aa.Nonce {
 			return http.StatusBadRequest, fmt.Errorf("bad nonce (%d/%d)", aa.Nonce, claim.Nonce)
 		}
+		previous = claim.Nonce
+	}
+	if aa.Nonce-previous < cost {
+		return http.StatusPaymentRequired, fmt.Errorf("nonce %d does not cover the %d calls of the request, expected at least %d", aa.Nonce, cost, previous+cost)
 	}
 
 	// Update claim and contract state for Pay-As-You-Go contracts.
@@ -602,7 +690,7 @@ func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
 	contract.Nonce = aa.Nonce
 	p.MemStore.Put(contract)
 
-	used := contract.Nonce * contract.Rate.Amount.Int64()
+	used := contract.PayAsYouGoCost(contract.Nonce).Int64()
 	remaining := contract.Deposit.Int64() - used
 
 	p.logger.Debug("Contract Usage: ",
@@ -0,0 +1,372 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	defaultSettlementInterval  = 10 * time.Minute
+	defaultSettlementBatchSize = 20
+	defaultSettlementGasLimit  = 200000 // per claim in a batch
+	defaultSettlementRetries   = 5
+	defaultSettlementBackoff   = 2 * time.Second
+	defaultSettlementTxTimeout = 30 * time.Second
+	defaultSettlementMargin    = 10
+	maxSettlementBackoff       = time.Minute
+	settlementMemo             = "sentinel claim settlement"
+)
+
+// ClaimSettler periodically submits the stored pay-as-you-go claims of the
+// provider to the arkeo chain. A claim is only marked as claimed once the tx
+// carrying it has been included in a block.
+type ClaimSettler struct {
+	config    conf.SettlementConfiguration
+	provider  common.PubKey
+	claims    *ClaimStore
+	contracts *MemStore
+	chain     ChainClient
+	signer    *TxSigner
+	fees      cosmos.Coins
+	logger    log.Logger
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+func NewClaimSettler(config conf.Configuration, claims *ClaimStore, contracts *MemStore, chain ChainClient, logger log.Logger) (*ClaimSettler, error) {
+	settlement := config.Settlement
+	if len(settlement.Mnemonic) == 0 {
+		return nil, fmt.Errorf("claim settlement requires the provider mnemonic")
+	}
+	if len(settlement.ChainId) == 0 {
+		return nil, fmt.Errorf("claim settlement requires a chain id")
+	}
+	privKey, err := privKeyFromMnemonic(settlement.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := common.NewPubKeyFromCrypto(privKey.PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("fail to convert provider pubkey: %w", err)
+	}
+	if !pk.Equals(config.ProviderPubKey) {
+		return nil, fmt.Errorf("settlement mnemonic does not belong to provider %s", config.ProviderPubKey)
+	}
+	fees, err := cosmos.ParseCoins(settlement.Fees)
+	if err != nil {
+		return nil, fmt.Errorf("bad settlement fees %q: %w", settlement.Fees, err)
+	}
+
+	if settlement.Interval <= 0 {
+		settlement.Interval = defaultSettlementInterval
+	}
+	if settlement.BatchSize <= 0 {
+		settlement.BatchSize = defaultSettlementBatchSize
+	}
+	if settlement.GasLimit == 0 {
+		settlement.GasLimit = defaultSettlementGasLimit
+	}
+	if settlement.MaxRetries <= 0 {
+		settlement.MaxRetries = defaultSettlementRetries
+	}
+	if settlement.RetryBackoff <= 0 {
+		settlement.RetryBackoff = defaultSettlementBackoff
+	}
+	if settlement.TxTimeout <= 0 {
+		settlement.TxTimeout = defaultSettlementTxTimeout
+	}
+	if settlement.SettlementMargin <= 0 {
+		settlement.SettlementMargin = defaultSettlementMargin
+	}
+
+	return &ClaimSettler{
+		config:    settlement,
+		provider:  config.ProviderPubKey,
+		claims:    claims,
+		contracts: contracts,
+		chain:     chain,
+		signer:    NewTxSigner(privKey, settlement.ChainId),
+		fees:      fees,
+		logger:    logger,
+		sleep:     sleepContext,
+	}, nil
+}
+
+// Run submits claims every interval until the context is cancelled
+func (s *ClaimSettler) Run(ctx context.Context) {
+	s.logger.Info("starting claim settlement", "interval", s.config.Interval, "min_claim_value", s.config.MinClaimValue)
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Settle(ctx); err != nil {
+				s.logger.Error("fail to settle claims", "error", err)
+			}
+		}
+	}
+}
+
+// Settle submits every claim that is due, in batches
+func (s *ClaimSettler) Settle(ctx context.Context) error {
+	pending := s.pendingClaims()
+	if len(pending) == 0 {
+		return nil
+	}
+	s.logger.Info("settling claims", "count", len(pending))
+
+	var lastErr error
+	for start := 0; start < len(pending); start += s.config.BatchSize {
+		end := start + s.config.BatchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		if err := s.submitBatch(ctx, pending[start:end]); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// pendingClaims returns the unclaimed claims worth submitting, the ones
+// closest to the end of their settlement period first.
+func (s *ClaimSettler) pendingClaims() []Claim {
+	height := s.contracts.GetHeight()
+	type pending struct {
+		claim    Claim
+		deadline int64
+	}
+	var due []pending
+	for _, claim := range s.claims.List() {
+		if claim.Claimed {
+			continue
+		}
+		contract, err := s.contracts.Get(claim.Key())
+		if err != nil {
+			s.logger.Error("settlement: fail to fetch contract", "contract_id", claim.ContractId, "error", err)
+			continue
+		}
+		if !contract.IsPayAsYouGo() || !contract.Provider.Equals(s.provider) {
+			continue
+		}
+		if contract.Nonce >= claim.Nonce {
+			// the chain already has this nonce, nothing left to claim
+			s.markClaimed(claim.ContractId, claim.Nonce)
+			continue
+		}
+		if contract.IsSettled(height) {
+			s.logger.Error("settlement: contract settled before claim was submitted", "contract_id", claim.ContractId, "nonce", claim.Nonce)
+			continue
+		}
+
+		deadline := contract.SettlementPeriodEnd()
+		value := contract.PayAsYouGoCost(claim.Nonce).Sub(contract.PayAsYouGoCost(contract.Nonce))
+		urgent := contract.IsExpired(height) || height >= deadline-s.config.SettlementMargin
+		if !urgent && value.LT(cosmos.NewInt(s.config.MinClaimValue)) {
+			continue
+		}
+		due = append(due, pending{claim: claim, deadline: deadline})
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].deadline < due[j].deadline
+	})
+	claims := make([]Claim, len(due))
+	for i := range due {
+		claims[i] = due[i].claim
+	}
+	return claims
+}
+
+// submitBatch submits the claims in a single tx. When the tx is rejected for a
+// reason other than sequence or fees, the claims are retried one by one so a
+// single bad claim does not block the rest of the batch.
+func (s *ClaimSettler) submitBatch(ctx context.Context, claims []Claim) error {
+	res, err := s.submit(ctx, claims)
+	if err == nil {
+		for _, claim := range claims {
+			s.markClaimed(claim.ContractId, claim.Nonce)
+		}
+		s.logger.Info("claims settled", "count", len(claims), "txhash", res.Hash, "height", res.Height)
+		return nil
+	}
+	if isAlreadyClaimed(res) && len(claims) == 1 {
+		s.markClaimed(claims[0].ContractId, claims[0].Nonce)
+		return nil
+	}
+	if res.Code == 0 || len(claims) == 1 {
+		return err
+	}
+
+	s.logger.Error("batch claim rejected, submitting claims individually", "count", len(claims), "error", err)
+	var lastErr error
+	for _, claim := range claims {
+		if err := s.submitBatch(ctx, []Claim{claim}); err != nil {
+			s.logger.Error("fail to settle claim", "contract_id", claim.ContractId, "nonce", claim.Nonce, "error", err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// submit signs, broadcasts and waits for the tx carrying the claims, retrying
+// with backoff on sequence mismatches, low fees and transport errors. The
+// returned result has a non zero code when the chain rejected the tx.
+func (s *ClaimSettler) submit(ctx context.Context, claims []Claim) (TxResult, error) {
+	msgs := make([]cosmos.Msg, 0, len(claims))
+	for _, claim := range claims {
+		sig, err := hex.DecodeString(claim.Signature)
+		if err != nil {
+			return TxResult{}, fmt.Errorf("bad signature for contract %d: %w", claim.ContractId, err)
+		}
+		msgs = append(msgs, types.NewMsgClaimContractIncome(s.signer.Address(), claim.ContractId, claim.Nonce, sig))
+	}
+
+	fees := s.fees
+	gas := s.config.GasLimit * uint64(len(msgs))
+	var lastErr error
+	for attempt := 0; attempt <= s.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := s.sleep(ctx, s.backoff(attempt)); err != nil {
+				return TxResult{}, err
+			}
+		}
+
+		accNum, seq, err := s.chain.GetAccount(ctx, s.signer.Address().String())
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		txBytes, err := s.signer.Sign(msgs, accNum, seq, fees, gas, settlementMemo)
+		if err != nil {
+			return TxResult{}, err
+		}
+		res, err := s.chain.BroadcastTx(ctx, txBytes)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if res.Code == 0 {
+			res, err = s.waitForTx(ctx, res.Hash)
+			if err != nil {
+				// the tx may still land, don't resubmit it in this round
+				return TxResult{}, err
+			}
+		}
+
+		switch {
+		case res.Code == 0:
+			return res, nil
+		case isSequenceMismatch(res):
+			lastErr = txError(res)
+			s.logger.Info("settlement: account sequence mismatch, retrying", "attempt", attempt)
+		case isInsufficientFee(res):
+			lastErr = txError(res)
+			fees = bumpCoins(fees)
+			s.logger.Info("settlement: fees too low, retrying", "attempt", attempt, "fees", fees.String())
+		case isOutOfGas(res):
+			lastErr = txError(res)
+			gas += gas / 2
+			s.logger.Info("settlement: out of gas, retrying", "attempt", attempt, "gas", gas)
+		default:
+			return res, txError(res)
+		}
+	}
+	return TxResult{}, fmt.Errorf("fail to submit claims after %d attempts: %w", s.config.MaxRetries+1, lastErr)
+}
+
+func (s *ClaimSettler) waitForTx(ctx context.Context, hash string) (TxResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.TxTimeout)
+	defer cancel()
+	for {
+		res, found, err := s.chain.GetTx(ctx, hash)
+		if err != nil {
+			s.logger.Error("settlement: fail to fetch tx", "txhash", hash, "error", err)
+		} else if found {
+			return res, nil
+		}
+		if err := s.sleep(ctx, time.Second); err != nil {
+			return TxResult{}, fmt.Errorf("tx %s not included in a block: %w", hash, err)
+		}
+	}
+}
+
+// markClaimed flags the stored claim as claimed, unless newer requests bumped
+// its nonce in the meantime.
+func (s *ClaimSettler) markClaimed(contractId uint64, nonce int64) {
+	key := NewClaim(contractId, nil, 0, "").Key()
+	claim, err := s.claims.Get(key)
+	if err != nil {
+		s.logger.Error("settlement: fail to fetch claim", "contract_id", contractId, "error", err)
+		return
+	}
+	if claim.Nonce != nonce || claim.Claimed {
+		return
+	}
+	claim.Claimed = true
+	if err := s.claims.Set(claim); err != nil {
+		s.logger.Error("settlement: fail to mark claim as claimed", "contract_id", contractId, "nonce", nonce, "error", err)
+	}
+}
+
+func (s *ClaimSettler) backoff(attempt int) time.Duration {
+	d := s.config.RetryBackoff << (attempt - 1)
+	if d <= 0 || d > maxSettlementBackoff {
+		return maxSettlementBackoff
+	}
+	return d
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func txError(res TxResult) error {
+	return fmt.Errorf("tx %s failed (%s/%d): %s", res.Hash, res.Codespace, res.Code, res.RawLog)
+}
+
+func isSequenceMismatch(res TxResult) bool {
+	return res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+func isInsufficientFee(res TxResult) bool {
+	return res.Codespace == sdkerrors.ErrInsufficientFee.Codespace() && res.Code == sdkerrors.ErrInsufficientFee.ABCICode()
+}
+
+func isOutOfGas(res TxResult) bool {
+	return res.Codespace == sdkerrors.ErrOutOfGas.Codespace() && res.Code == sdkerrors.ErrOutOfGas.ABCICode()
+}
+
+// isAlreadyClaimed is true when the chain rejected the claim because it
+// already holds the same or a higher nonce for the contract.
+func isAlreadyClaimed(res TxResult) bool {
+	return res.Codespace == types.ErrClaimContractIncomeBadNonce.Codespace() && res.Code == types.ErrClaimContractIncomeBadNonce.ABCICode()
+}
+
+// bumpCoins raises every amount by half
+func bumpCoins(coins cosmos.Coins) cosmos.Coins {
+	bumped := make(cosmos.Coins, 0, len(coins))
+	for _, coin := range coins {
+		bumped = append(bumped, cosmos.NewCoin(coin.Denom, coin.Amount.Add(coin.Amount.QuoRaw(2)).AddRaw(1)))
+	}
+	return bumped
+}
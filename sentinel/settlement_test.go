@@ -0,0 +1,202 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+type fakeChainClient struct {
+	signer    *TxSigner
+	sequence  uint64
+	responses []TxResult // results handed out by BroadcastTx, in order
+	broadcast [][]*types.MsgClaimContractIncome
+	fees      []cosmos.Coins
+}
+
+func (c *fakeChainClient) GetAccount(_ context.Context, _ string) (uint64, uint64, error) {
+	return 7, c.sequence, nil
+}
+
+func (c *fakeChainClient) BroadcastTx(_ context.Context, txBytes []byte) (TxResult, error) {
+	tx, err := c.signer.TxConfig().TxDecoder()(txBytes)
+	if err != nil {
+		return TxResult{}, err
+	}
+	var msgs []*types.MsgClaimContractIncome
+	for _, msg := range tx.GetMsgs() {
+		msgs = append(msgs, msg.(*types.MsgClaimContractIncome))
+	}
+	c.broadcast = append(c.broadcast, msgs)
+	c.fees = append(c.fees, tx.(interface{ GetFee() cosmos.Coins }).GetFee())
+
+	res := TxResult{}
+	if len(c.responses) > 0 {
+		res, c.responses = c.responses[0], c.responses[1:]
+	}
+	res.Hash = fmt.Sprintf("HASH%d", len(c.broadcast))
+	if res.Code == 0 {
+		c.sequence++
+	}
+	return res, nil
+}
+
+func (c *fakeChainClient) GetTx(_ context.Context, hash string) (TxResult, bool, error) {
+	return TxResult{Hash: hash, Height: 101}, true, nil
+}
+
+func newTestSettler(t *testing.T) (*ClaimSettler, *fakeChainClient) {
+	privKey, err := privKeyFromMnemonic(testMnemonic)
+	require.NoError(t, err)
+	provider, err := common.NewPubKeyFromCrypto(privKey.PubKey())
+	require.NoError(t, err)
+
+	claims, err := NewClaimStore("")
+	require.NoError(t, err)
+	memStore := NewMemStore("", nil, log.NewNopLogger())
+	memStore.SetHeight(100)
+
+	config := conf.Configuration{
+		ProviderPubKey: provider,
+		Settlement: conf.SettlementConfiguration{
+			Enabled:       true,
+			Mnemonic:      testMnemonic,
+			ChainId:       testChainId,
+			MinClaimValue: 5,
+			Fees:          "100uarkeo",
+			RetryBackoff:  time.Millisecond,
+		},
+	}
+	chain := &fakeChainClient{}
+	settler, err := NewClaimSettler(config, claims, memStore, chain, log.NewNopLogger())
+	require.NoError(t, err)
+	chain.signer = settler.signer
+	settler.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return settler, chain
+}
+
+func addTestClaim(t *testing.T, settler *ClaimSettler, id uint64, contractNonce, claimNonce, expiration int64) Claim {
+	contract := types.NewContract(settler.provider, common.BTCService, types.GetRandomPubKey())
+	contract.Id = id
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Height = 1
+	contract.Duration = expiration - 1
+	contract.SettlementDuration = 10
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 1)
+	contract.Deposit = cosmos.NewInt(1000)
+	contract.Nonce = contractNonce
+	settler.contracts.Put(contract)
+
+	claim := NewClaim(id, contract.Client, claimNonce, hex.EncodeToString(make([]byte, 64)))
+	claim.Provider = settler.provider
+	require.NoError(t, settler.claims.Set(claim))
+	return claim
+}
+
+func TestNewClaimSettler(t *testing.T) {
+	config := conf.Configuration{
+		ProviderPubKey: types.GetRandomPubKey(),
+		Settlement:     conf.SettlementConfiguration{Mnemonic: testMnemonic, ChainId: testChainId},
+	}
+	_, err := NewClaimSettler(config, nil, nil, nil, log.NewNopLogger())
+	require.ErrorContains(t, err, "does not belong to provider")
+
+	config.Settlement.ChainId = ""
+	_, err = NewClaimSettler(config, nil, nil, nil, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestClaimSettlerSettle(t *testing.T) {
+	settler, chain := newTestSettler(t)
+	valuable := addTestClaim(t, settler, 1, 0, 10, 150)
+	cheap := addTestClaim(t, settler, 2, 0, 2, 150)
+	// below the minimum value, but the settlement period is about to end
+	urgent := addTestClaim(t, settler, 3, 0, 1, 100)
+
+	require.NoError(t, settler.Settle(context.Background()))
+	require.Len(t, chain.broadcast, 1)
+	msgs := chain.broadcast[0]
+	require.Len(t, msgs, 2)
+	require.Equal(t, urgent.ContractId, msgs[0].ContractId)
+	require.Equal(t, valuable.ContractId, msgs[1].ContractId)
+	require.Equal(t, valuable.Nonce, msgs[1].Nonce)
+	require.Equal(t, settler.signer.Address().String(), msgs[1].Creator)
+
+	claim, err := settler.claims.Get(valuable.Key())
+	require.NoError(t, err)
+	require.True(t, claim.Claimed)
+	claim, err = settler.claims.Get(urgent.Key())
+	require.NoError(t, err)
+	require.True(t, claim.Claimed)
+	claim, err = settler.claims.Get(cheap.Key())
+	require.NoError(t, err)
+	require.False(t, claim.Claimed)
+
+	// nothing left to submit
+	require.NoError(t, settler.Settle(context.Background()))
+	require.Len(t, chain.broadcast, 1)
+}
+
+func TestClaimSettlerRetries(t *testing.T) {
+	settler, chain := newTestSettler(t)
+	claim := addTestClaim(t, settler, 1, 0, 10, 150)
+	chain.responses = []TxResult{
+		{Code: sdkerrors.ErrWrongSequence.ABCICode(), Codespace: sdkerrors.ErrWrongSequence.Codespace()},
+		{Code: sdkerrors.ErrInsufficientFee.ABCICode(), Codespace: sdkerrors.ErrInsufficientFee.Codespace()},
+	}
+
+	require.NoError(t, settler.Settle(context.Background()))
+	require.Len(t, chain.broadcast, 3)
+	require.Equal(t, "100uarkeo", chain.fees[1].String())
+	require.Equal(t, "151uarkeo", chain.fees[2].String())
+
+	stored, err := settler.claims.Get(claim.Key())
+	require.NoError(t, err)
+	require.True(t, stored.Claimed)
+}
+
+func TestClaimSettlerSplitsRejectedBatch(t *testing.T) {
+	settler, chain := newTestSettler(t)
+	good := addTestClaim(t, settler, 1, 0, 10, 150)
+	stale := addTestClaim(t, settler, 2, 0, 20, 150)
+	alreadyClaimed := TxResult{Code: types.ErrClaimContractIncomeBadNonce.ABCICode(), Codespace: types.ErrClaimContractIncomeBadNonce.Codespace()}
+	chain.responses = []TxResult{alreadyClaimed, {}, alreadyClaimed}
+
+	require.NoError(t, settler.Settle(context.Background()))
+	require.Len(t, chain.broadcast, 3)
+	require.Len(t, chain.broadcast[0], 2)
+	require.Len(t, chain.broadcast[1], 1)
+	require.Len(t, chain.broadcast[2], 1)
+
+	for _, c := range []Claim{good, stale} {
+		stored, err := settler.claims.Get(c.Key())
+		require.NoError(t, err)
+		require.True(t, stored.Claimed)
+	}
+}
+
+func TestClaimSettlerKeepsNewerNonce(t *testing.T) {
+	settler, _ := newTestSettler(t)
+	claim := addTestClaim(t, settler, 1, 0, 10, 150)
+
+	// a request came in while the tx was in flight
+	claim.Nonce = 11
+	require.NoError(t, settler.claims.Set(claim))
+	settler.markClaimed(claim.ContractId, 10)
+
+	stored, err := settler.claims.Get(claim.Key())
+	require.NoError(t, err)
+	require.False(t, stored.Claimed)
+	require.Equal(t, int64(11), stored.Nonce)
+}
@@ -0,0 +1,151 @@
+package sentinel
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// names of the sentinel stores, used as table names (with the "sentinel_"
+// prefix) by the SQL backends
+const (
+	StoreClaims          = "claims"
+	StoreContractConfigs = "contract_configs"
+	StoreProviderConfigs = "provider_configs"
+	StoreNonces          = "nonces"
+
+	sqlTablePrefix = "sentinel_"
+)
+
+// Storage opens the KVStores of the sentinel for the configured backend. With
+// LevelDB each store keeps its own folder, with SQL every store is a table in
+// the same database, so several sentinels can share their state.
+type Storage struct {
+	backend string
+	db      *sql.DB
+}
+
+func NewStorage(config conf.StorageConfiguration) (*Storage, error) {
+	switch config.Backend {
+	case "", StorageBackendLevelDB:
+		return &Storage{backend: StorageBackendLevelDB}, nil
+	case StorageBackendPostgres, StorageBackendSQLite:
+		db, err := OpenSQLDB(config.Backend, config.DSN)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{backend: config.Backend, db: db}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", config.Backend)
+	}
+}
+
+// Open returns the store with the given name, levelDbFolder is only used by
+// the LevelDB backend.
+func (s *Storage) Open(name, levelDbFolder string) (KVStore, error) {
+	if s.backend == StorageBackendLevelDB {
+		return NewLevelDBStore(levelDbFolder)
+	}
+	return NewSQLStore(s.db, s.backend, sqlTablePrefix+name)
+}
+
+func (s *Storage) Backend() string {
+	return s.backend
+}
+
+func (s *Storage) Close() error {
+	if s.db != nil {
+		return s.db.Close()
+	}
+	return nil
+}
+
+// storeLocation maps a store name to its LevelDB folder setting
+func storeLocation(config conf.Configuration, name string) string {
+	switch name {
+	case StoreClaims:
+		return config.ClaimStoreLocation
+	case StoreContractConfigs:
+		return config.ContractConfigStoreLocation
+	case StoreProviderConfigs:
+		return config.ProviderConfigStoreLocation
+	case StoreNonces:
+		return config.ArkeoAuthNonceStore
+	}
+	return ""
+}
+
+// MigrationResult is the number of items copied per store
+type MigrationResult map[string]int
+
+// MigrateStorage copies the LevelDB stores of the configuration into the
+// configured SQL backend. Stores without a LevelDB folder are skipped, items
+// already in the destination are overwritten, so it is safe to run again.
+func MigrateStorage(config conf.Configuration) (MigrationResult, error) {
+	if config.Storage.Backend == "" || config.Storage.Backend == StorageBackendLevelDB {
+		return nil, fmt.Errorf("storage backend is %s, set a sql backend to migrate to", StorageBackendLevelDB)
+	}
+	storage, err := NewStorage(config.Storage)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+
+	result := MigrationResult{}
+	for _, name := range []string{StoreClaims, StoreContractConfigs, StoreProviderConfigs, StoreNonces} {
+		folder := storeLocation(config, name)
+		if len(folder) == 0 {
+			continue
+		}
+		src, err := NewLevelDBStore(folder)
+		if err != nil {
+			return result, err
+		}
+		dst, err := storage.Open(name, "")
+		if err != nil {
+			_ = src.Close()
+			return result, err
+		}
+		count, err := copyKVStore(src, dst)
+		_ = src.Close()
+		if err != nil {
+			return result, fmt.Errorf("fail to migrate %s: %w", name, err)
+		}
+		result[name] = count
+	}
+	return result, nil
+}
+
+// copyKVStore copies every item of src into dst, in batches
+func copyKVStore(src, dst KVStore) (int, error) {
+	const batchSize = 500
+	count := 0
+	batch := make([]KVItem, 0, batchSize)
+	err := src.Iterate(func(key, value []byte) error {
+		if len(value) == 0 {
+			return nil
+		}
+		// the iterator reuses its buffers
+		batch = append(batch, KVItem{Key: append([]byte(nil), key...), Value: append([]byte(nil), value...)})
+		if len(batch) < batchSize {
+			return nil
+		}
+		if err := dst.PutBatch(batch); err != nil {
+			return err
+		}
+		count += len(batch)
+		batch = batch[:0]
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	if len(batch) > 0 {
+		if err := dst.PutBatch(batch); err != nil {
+			return count, err
+		}
+		count += len(batch)
+	}
+	return count, nil
+}
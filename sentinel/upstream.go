@@ -0,0 +1,536 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	LoadBalancingRoundRobin   = "round_robin"
+	LoadBalancingLeastLatency = "least_latency"
+
+	defaultHealthCheckInterval = 15 * time.Second
+	defaultHealthCheckTimeout  = 5 * time.Second
+	defaultMaxBlockLag         = 5
+	defaultMaxFailures         = 3
+	defaultEjectDuration       = 30 * time.Second
+	latencySmoothing           = 0.3 // weight of the latest sample in the latency average
+)
+
+// errNoHeightProbe is returned by the probe of service types with no way to
+// tell the height, only passive checks apply to them
+var errNoHeightProbe = errors.New("no height probe")
+
+// UpstreamHealth is the health of an upstream, as shown in /metadata.json
+type UpstreamHealth struct {
+	Url                 string `json:"url"`
+	Weight              int    `json:"weight"`
+	Healthy             bool   `json:"healthy"`
+	Height              int64  `json:"height,omitempty"`
+	BlockLag            int64  `json:"block_lag"`
+	LatencyMs           int64  `json:"latency_ms"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+	EjectedUntil        string `json:"ejected_until,omitempty"`
+	LastError           string `json:"last_error,omitempty"`
+	LastCheck           string `json:"last_check,omitempty"`
+}
+
+// upstream is a node of an UpstreamPool, its state is guarded by the pool
+type upstream struct {
+	url    *url.URL
+	weight int
+
+	current      int           // smooth weighted round robin counter
+	latency      time.Duration // moving average of the response time
+	sampled      bool          // whether latency holds a measured response time
+	failures     int           // consecutive failed requests
+	ejectedUntil time.Time
+	probeHealthy bool
+	height       int64
+	lag          int64
+	lastError    string
+	lastCheck    time.Time
+}
+
+// UpstreamPool balances the requests of a service across its upstreams,
+// skipping the ones failing their health checks or ejected after consecutive
+// failures.
+type UpstreamPool struct {
+	service   string
+	kind      string
+	strategy  string
+	config    conf.HealthCheckConfig
+	client    *http.Client
+	logger    log.Logger
+	now       func() time.Time
+	mu        sync.Mutex
+	upstreams []*upstream
+	done      chan struct{} // closed by Close to stop Run
+	closeOnce sync.Once
+}
+
+func NewUpstreamPool(service conf.ServiceConfig, logger log.Logger) (*UpstreamPool, error) {
+	strategy := service.LoadBalancing
+	switch strategy {
+	case "":
+		strategy = LoadBalancingRoundRobin
+	case LoadBalancingRoundRobin, LoadBalancingLeastLatency:
+	default:
+		return nil, fmt.Errorf("service %s: unsupported load balancing %q", service.Name, service.LoadBalancing)
+	}
+
+	config := service.HealthCheck
+	if config.Interval == 0 {
+		config.Interval = defaultHealthCheckInterval
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultHealthCheckTimeout
+	}
+	if config.MaxBlockLag <= 0 {
+		config.MaxBlockLag = defaultMaxBlockLag
+	}
+	if config.MaxFailures <= 0 {
+		config.MaxFailures = defaultMaxFailures
+	}
+	if config.EjectDuration <= 0 {
+		config.EjectDuration = defaultEjectDuration
+	}
+
+	pool := &UpstreamPool{
+		service:  service.Name,
+		kind:     strings.ToLower(service.Type),
+		strategy: strategy,
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+		logger:   logger,
+		now:      time.Now,
+		done:     make(chan struct{}),
+	}
+	for _, u := range service.GetUpstreams() {
+		parsed, err := url.Parse(upstreamURL(u.Url, u.RpcUser, u.RpcPass))
+		if err != nil || len(parsed.Host) == 0 {
+			return nil, fmt.Errorf("service %s: bad upstream url %q", service.Name, u.Url)
+		}
+		weight := u.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		// the latency starts at the penalty until the upstream is measured
+		pool.upstreams = append(pool.upstreams, &upstream{url: parsed, weight: weight, probeHealthy: true, latency: config.Timeout})
+	}
+	if len(pool.upstreams) == 0 {
+		return nil, fmt.Errorf("service %s has no upstream", service.Name)
+	}
+	return pool, nil
+}
+
+// upstreamURL adds the rpc credentials, if any, to the url of an upstream
+func upstreamURL(rawURL, user, pass string) string {
+	if len(user) == 0 || len(pass) == 0 {
+		return rawURL
+	}
+	if strings.HasPrefix(rawURL, "https://") {
+		return fmt.Sprintf("https://%s:%s@%s", user, pass, strings.TrimPrefix(rawURL, "https://"))
+	}
+	return fmt.Sprintf("http://%s:%s@%s", user, pass, strings.TrimPrefix(rawURL, "http://"))
+}
+
+func (u *upstream) available(now time.Time) bool {
+	return u.probeHealthy && !now.Before(u.ejectedUntil)
+}
+
+// record adds a response time to the latency average of the upstream
+func (u *upstream) record(latency time.Duration) {
+	if !u.sampled {
+		u.latency, u.sampled = latency, true
+		return
+	}
+	u.latency = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(u.latency))
+}
+
+// Next returns the url requests should be sent to. When every upstream is
+// unhealthy they are all used, a degraded node beats no node at all.
+func (p *UpstreamPool) Next() *url.URL {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	candidates := make([]*upstream, 0, len(p.upstreams))
+	for _, u := range p.upstreams {
+		if u.available(now) {
+			candidates = append(candidates, u)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = p.upstreams
+	}
+
+	var best *upstream
+	if p.strategy == LoadBalancingLeastLatency {
+		// only measured upstreams are ranked, the health probes give the new
+		// ones their first sample
+		for _, u := range candidates {
+			if u.sampled && (best == nil || u.latency < best.latency) {
+				best = u
+			}
+		}
+	}
+	if best == nil {
+		best = roundRobin(candidates)
+	}
+	// callers may modify the url
+	target := *best.url
+	return &target
+}
+
+// roundRobin picks an upstream by smooth weighted round robin, as nginx does
+func roundRobin(candidates []*upstream) *upstream {
+	var best *upstream
+	total := 0
+	for _, u := range candidates {
+		u.current += u.weight
+		total += u.weight
+		if best == nil || u.current > best.current {
+			best = u
+		}
+	}
+	best.current -= total
+	return best
+}
+
+func (p *UpstreamPool) find(target *url.URL) *upstream {
+	for _, u := range p.upstreams {
+		if u.url.Host == target.Host && u.url.Path == target.Path {
+			return u
+		}
+	}
+	return nil
+}
+
+// Report records the outcome of a request sent to target, an upstream is
+// ejected after MaxFailures consecutive failures (5xx responses or timeouts).
+// A failure counts as the health check timeout in the latency average.
+func (p *UpstreamPool) Report(target *url.URL, latency time.Duration, failed bool, reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	u := p.find(target)
+	if u == nil {
+		return
+	}
+	if !failed {
+		u.failures = 0
+		u.record(latency)
+		return
+	}
+	u.record(p.config.Timeout)
+	u.failures++
+	u.lastError = reason
+	if u.failures >= p.config.MaxFailures {
+		u.ejectedUntil = p.now().Add(p.config.EjectDuration)
+		u.failures = 0
+		p.logger.Error("ejecting upstream", "service", p.service, "upstream", u.url.Host, "until", u.ejectedUntil, "reason", reason)
+	}
+}
+
+// Healthy returns true when at least one upstream is available
+func (p *UpstreamPool) Healthy() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	for _, u := range p.upstreams {
+		if u.available(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// Height returns the highest block of the upstreams, 0 when unknown
+func (p *UpstreamPool) Height() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var height int64
+	for _, u := range p.upstreams {
+		if u.probeHealthy && u.height > height {
+			height = u.height
+		}
+	}
+	return height
+}
+
+// Health returns the health of every upstream
+func (p *UpstreamPool) Health() []UpstreamHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	health := make([]UpstreamHealth, 0, len(p.upstreams))
+	for _, u := range p.upstreams {
+		// never expose the rpc credentials, nor api keys in the path
+		h := UpstreamHealth{
+			Url:                 u.url.Scheme + "://" + u.url.Host,
+			Weight:              u.weight,
+			Healthy:             u.available(now),
+			Height:              u.height,
+			BlockLag:            u.lag,
+			LatencyMs:           u.latency.Milliseconds(),
+			ConsecutiveFailures: u.failures,
+			LastError:           u.lastError,
+		}
+		if now.Before(u.ejectedUntil) {
+			h.EjectedUntil = u.ejectedUntil.UTC().Format(time.RFC3339)
+		}
+		if !u.lastCheck.IsZero() {
+			h.LastCheck = u.lastCheck.UTC().Format(time.RFC3339)
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+// Run probes the upstreams every interval until the context is cancelled or
+// the pool is closed
+func (p *UpstreamPool) Run(ctx context.Context) {
+	if p.config.Interval < 0 {
+		return
+	}
+	p.Probe(ctx)
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.Probe(ctx)
+		}
+	}
+}
+
+// Close stops the health checks of a pool replaced by a config reload
+func (p *UpstreamPool) Close() {
+	p.closeOnce.Do(func() { close(p.done) })
+}
+
+// Probe checks every upstream once. Upstreams that fail to answer, or lag
+// more than MaxBlockLag blocks behind the highest one, are marked unhealthy.
+func (p *UpstreamPool) Probe(ctx context.Context) {
+	type result struct {
+		height  int64
+		lag     int64 // lag reported by the node itself (ie block download)
+		latency time.Duration
+		err     error
+	}
+	results := make([]result, len(p.upstreams))
+	var wg sync.WaitGroup
+	for i, u := range p.upstreams {
+		wg.Add(1)
+		go func(i int, target url.URL) {
+			defer wg.Done()
+			start := time.Now()
+			height, lag, err := p.probe(ctx, &target)
+			results[i] = result{height: height, lag: lag, latency: time.Since(start), err: err}
+		}(i, *u.url)
+	}
+	wg.Wait()
+
+	var best int64
+	for _, r := range results {
+		if r.err == nil && r.height > best {
+			best = r.height
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	for i, u := range p.upstreams {
+		r := results[i]
+		u.lastCheck = now
+		if errors.Is(r.err, errNoHeightProbe) {
+			continue
+		}
+		if r.err != nil {
+			if u.probeHealthy {
+				p.logger.Error("upstream failed health check", "service", p.service, "upstream", u.url.Host, "error", r.err)
+			}
+			u.probeHealthy = false
+			u.lastError = r.err.Error()
+			continue
+		}
+		u.record(r.latency)
+		u.height = r.height
+		u.lag = r.lag
+		if best-r.height > u.lag {
+			u.lag = best - r.height
+		}
+		healthy := u.lag <= p.config.MaxBlockLag
+		if !healthy {
+			u.lastError = fmt.Sprintf("%d blocks behind", u.lag)
+			if u.probeHealthy {
+				p.logger.Error("upstream is lagging", "service", p.service, "upstream", u.url.Host, "lag", u.lag)
+			}
+		}
+		u.probeHealthy = healthy
+	}
+}
+
+// probe returns the block height of an upstream, depending on the service type
+func (p *UpstreamPool) probe(ctx context.Context, target *url.URL) (height, lag int64, err error) {
+	switch p.kind {
+	case "evm", "eth", "ethereum":
+		var blockNumber string
+		if err := p.callJSONRPC(ctx, target, "eth_blockNumber", &blockNumber); err != nil {
+			return 0, 0, err
+		}
+		height, err := strconv.ParseInt(strings.TrimPrefix(blockNumber, "0x"), 16, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("bad block number %q: %w", blockNumber, err)
+		}
+		return height, 0, nil
+	case "cosmos", "tendermint", "cometbft":
+		var status struct {
+			Result struct {
+				SyncInfo struct {
+					LatestBlockHeight string `json:"latest_block_height"`
+					CatchingUp        bool   `json:"catching_up"`
+				} `json:"sync_info"`
+			} `json:"result"`
+		}
+		if err := p.get(ctx, target, "/status", &status); err != nil {
+			return 0, 0, err
+		}
+		if status.Result.SyncInfo.CatchingUp {
+			return 0, 0, fmt.Errorf("node is catching up")
+		}
+		height, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("bad block height %q: %w", status.Result.SyncInfo.LatestBlockHeight, err)
+		}
+		return height, 0, nil
+	case "btc", "bitcoin", "utxo":
+		var info struct {
+			Blocks  int64 `json:"blocks"`
+			Headers int64 `json:"headers"`
+		}
+		if err := p.callJSONRPC(ctx, target, "getblockchaininfo", &info); err != nil {
+			return 0, 0, err
+		}
+		return info.Blocks, info.Headers - info.Blocks, nil
+	default:
+		return 0, 0, errNoHeightProbe
+	}
+}
+
+func (p *UpstreamPool) callJSONRPC(ctx context.Context, target *url.URL, method string, result any) error {
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%q,"params":[]}`, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.String(), bytes.NewBufferString(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	var reply struct {
+		Result json.RawMessage `json:"result"`
+		Error  *jsonRPCError   `json:"error"`
+	}
+	if err := p.do(req, &reply); err != nil {
+		return err
+	}
+	if reply.Error != nil {
+		return fmt.Errorf("%s failed: %s", method, reply.Error.Message)
+	}
+	return json.Unmarshal(reply.Result, result)
+}
+
+func (p *UpstreamPool) get(ctx context.Context, target *url.URL, path string, result any) error {
+	endpoint := *target
+	endpoint.Path = strings.TrimRight(endpoint.Path, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return err
+	}
+	return p.do(req, result)
+}
+
+func (p *UpstreamPool) do(req *http.Request, result any) error {
+	if req.URL.User != nil {
+		passwd, _ := req.URL.User.Password()
+		req.SetBasicAuth(req.URL.User.Username(), passwd)
+		req.URL.User = nil
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMeteredBodySize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return json.Unmarshal(body, result)
+}
+
+// loadUpstreamPools creates the upstream pool of every configured service
+func loadUpstreamPools(config conf.Configuration, logger log.Logger) (map[string]*UpstreamPool, error) {
+	pools := make(map[string]*UpstreamPool)
+	for _, svc := range config.Services {
+		if len(svc.GetUpstreams()) == 0 {
+			continue
+		}
+		pool, err := NewUpstreamPool(svc, logger)
+		if err != nil {
+			return nil, err
+		}
+		pools[svc.Name] = pool
+	}
+	return pools, nil
+}
+
+// upstreamPool returns the pool of the given service, nil when the service
+// is served from the env config
+func (p *Proxy) upstreamPool(serviceName string) *UpstreamPool {
+	p.proxyMu.RLock()
+	defer p.proxyMu.RUnlock()
+	return p.pools[serviceName]
+}
+
+// runHealthChecks probes the upstreams of every service until the context is
+// cancelled
+func (p *Proxy) runHealthChecks(ctx context.Context) {
+	p.proxyMu.RLock()
+	pools := make([]*UpstreamPool, 0, len(p.pools))
+	for _, pool := range p.pools {
+		pools = append(pools, pool)
+	}
+	p.proxyMu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, pool := range pools {
+		wg.Add(1)
+		go func(pool *UpstreamPool) {
+			defer wg.Done()
+			pool.Run(ctx)
+		}(pool)
+	}
+	wg.Wait()
+}
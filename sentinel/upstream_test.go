@@ -0,0 +1,217 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newTestNode answers health probes with the given height
+func newTestNode(t *testing.T, height *int64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status" {
+			_, _ = fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":"%d","catching_up":false}}}`, *height)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), "eth_blockNumber"):
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, *height)
+		case strings.Contains(string(body), "getblockchaininfo"):
+			_, _ = fmt.Fprintf(w, `{"result":{"blocks":%d,"headers":%d},"error":null,"id":1}`, *height, *height)
+		default:
+			_, _ = w.Write(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestPool(t *testing.T, service conf.ServiceConfig) *UpstreamPool {
+	pool, err := NewUpstreamPool(service, log.NewNopLogger())
+	require.NoError(t, err)
+	return pool
+}
+
+func TestUpstreamPoolRoundRobin(t *testing.T) {
+	pool := newTestPool(t, conf.ServiceConfig{
+		Name: "eth-mainnet-fullnode",
+		Upstreams: []conf.UpstreamConfig{
+			{Url: "http://a:8545", Weight: 3},
+			{Url: "http://b:8545"},
+		},
+	})
+
+	counts := map[string]int{}
+	for i := 0; i < 8; i++ {
+		counts[pool.Next().Host]++
+	}
+	require.Equal(t, map[string]int{"a:8545": 6, "b:8545": 2}, counts)
+}
+
+func TestUpstreamPoolLeastLatency(t *testing.T) {
+	pool := newTestPool(t, conf.ServiceConfig{
+		Name:          "eth-mainnet-fullnode",
+		LoadBalancing: LoadBalancingLeastLatency,
+		Upstreams:     []conf.UpstreamConfig{{Url: "http://a:8545"}, {Url: "http://b:8545"}},
+	})
+
+	// round robin until an upstream is measured
+	a := pool.Next()
+	require.Equal(t, "a:8545", a.Host)
+	pool.Report(a, 50*time.Millisecond, false, "")
+	require.Equal(t, "a:8545", pool.Next().Host)
+	b := pool.upstreams[1].url
+	pool.Report(b, 10*time.Millisecond, false, "")
+	require.Equal(t, "b:8545", pool.Next().Host)
+
+	// failures count as the health check timeout
+	pool.Report(b, time.Millisecond, true, "timeout")
+	require.Equal(t, "a:8545", pool.Next().Host)
+
+	// an upstream that only ever failed is not picked
+	pool = newTestPool(t, conf.ServiceConfig{
+		Name:          "eth-mainnet-fullnode",
+		LoadBalancing: LoadBalancingLeastLatency,
+		Upstreams:     []conf.UpstreamConfig{{Url: "http://a:8545"}, {Url: "http://b:8545"}},
+		HealthCheck:   conf.HealthCheckConfig{MaxFailures: 10},
+	})
+	pool.Report(pool.upstreams[0].url, time.Millisecond, true, "status 502")
+	pool.Report(pool.upstreams[1].url, 100*time.Millisecond, false, "")
+	for i := 0; i < 3; i++ {
+		require.Equal(t, "b:8545", pool.Next().Host)
+	}
+
+	_, err := NewUpstreamPool(conf.ServiceConfig{Name: "x", LoadBalancing: "random", RpcUrl: "http://a"}, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestUpstreamPoolPassiveEjection(t *testing.T) {
+	now := time.Now()
+	pool := newTestPool(t, conf.ServiceConfig{
+		Name:        "eth-mainnet-fullnode",
+		Upstreams:   []conf.UpstreamConfig{{Url: "http://a:8545"}, {Url: "http://b:8545"}},
+		HealthCheck: conf.HealthCheckConfig{MaxFailures: 2, EjectDuration: time.Minute},
+	})
+	pool.now = func() time.Time { return now }
+
+	a := pool.upstreams[0].url
+	pool.Report(a, time.Millisecond, true, "status 502")
+	pool.Report(a, time.Millisecond, false, "")
+	pool.Report(a, time.Millisecond, true, "status 502")
+	require.True(t, pool.Health()[0].Healthy, "failures must be consecutive")
+	pool.Report(a, time.Millisecond, true, "status 502")
+	require.False(t, pool.Health()[0].Healthy)
+	for i := 0; i < 4; i++ {
+		require.Equal(t, "b:8545", pool.Next().Host)
+	}
+
+	// every upstream down: keep serving rather than failing
+	b := pool.upstreams[1].url
+	pool.Report(b, time.Millisecond, true, "timeout")
+	pool.Report(b, time.Millisecond, true, "timeout")
+	require.False(t, pool.Healthy())
+	require.NotNil(t, pool.Next())
+
+	now = now.Add(2 * time.Minute)
+	require.True(t, pool.Healthy())
+	require.ElementsMatch(t, []string{"a:8545", "b:8545"}, []string{pool.Next().Host, pool.Next().Host})
+}
+
+func TestUpstreamPoolProbe(t *testing.T) {
+	for _, kind := range []string{"evm", "cosmos", "btc"} {
+		t.Run(kind, func(t *testing.T) {
+			heightA, heightB := int64(100), int64(90)
+			nodeA, nodeB := newTestNode(t, &heightA), newTestNode(t, &heightB)
+			pool := newTestPool(t, conf.ServiceConfig{
+				Name:        "test-service",
+				Type:        kind,
+				Upstreams:   []conf.UpstreamConfig{{Url: nodeA.URL}, {Url: nodeB.URL, RpcUser: "user", RpcPass: "pass"}},
+				HealthCheck: conf.HealthCheckConfig{MaxBlockLag: 5},
+			})
+
+			pool.Probe(context.Background())
+			health := pool.Health()
+			require.True(t, health[0].Healthy)
+			require.Equal(t, int64(100), health[0].Height)
+			require.False(t, health[1].Healthy)
+			require.Equal(t, int64(10), health[1].BlockLag)
+			require.NotContains(t, health[1].Url, "pass")
+
+			heightB = 98
+			pool.Probe(context.Background())
+			require.True(t, pool.Health()[1].Healthy)
+
+			nodeB.Close()
+			pool.Probe(context.Background())
+			require.False(t, pool.Health()[1].Healthy)
+		})
+	}
+}
+
+func TestProxyUpstreamFailover(t *testing.T) {
+	var height int64 = 1
+	good := newTestNode(t, &height)
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer bad.Close()
+
+	config := newTestConfig()
+	config.Services = []conf.ServiceConfig{{
+		Name:        "btc-mainnet-fullnode",
+		Upstreams:   []conf.UpstreamConfig{{Url: bad.URL}, {Url: good.URL}},
+		HealthCheck: conf.HealthCheckConfig{MaxFailures: 1, EjectDuration: time.Minute},
+	}}
+	env := newPaidTestEnv(t, config, types.ContractType_SUBSCRIPTION, 100, bad.URL)
+	pools, err := loadUpstreamPools(config, log.NewNopLogger())
+	require.NoError(t, err)
+	env.proxy.pools = pools
+	env.proxy.Metadata = NewMetadata(config)
+
+	statuses := map[int]int{}
+	for nonce := int64(1); nonce <= 4; nonce++ {
+		url := env.server.URL + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + env.arkAuth(t, nonce)
+		resp, err := http.Post(url, "application/json", strings.NewReader(`{"id":1,"method":"getblockcount"}`))
+		require.NoError(t, err)
+		resp.Body.Close()
+		statuses[resp.StatusCode]++
+	}
+	// the failing upstream is ejected after its first 502
+	require.Equal(t, map[int]int{http.StatusBadGateway: 1, http.StatusOK: 3}, statuses)
+
+	resp, err := http.Get(env.server.URL + RoutesMetaData)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var metadata struct {
+		Config struct {
+			Services []struct {
+				Name   string `json:"name"`
+				Health struct {
+					Healthy   bool             `json:"healthy"`
+					Upstreams []UpstreamHealth `json:"upstreams"`
+				} `json:"health"`
+			} `json:"services"`
+		} `json:"config"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&metadata))
+	require.Len(t, metadata.Config.Services, 1)
+	health := metadata.Config.Services[0].Health
+	require.True(t, health.Healthy)
+	require.Len(t, health.Upstreams, 2)
+	require.False(t, health.Upstreams[0].Healthy)
+	require.NotEmpty(t, health.Upstreams[0].EjectedUntil)
+	require.True(t, health.Upstreams[1].Healthy)
+}
@@ -0,0 +1,437 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	defaultWSMessagesPerNonce = 100
+	defaultWSNonceTimeout     = 30 * time.Second
+	defaultWSPingInterval     = 30 * time.Second
+	defaultWSMaxMessageSize   = 1 << 20
+	wsWriteWait               = 10 * time.Second
+
+	// WSMethodAuth is the control message a client sends to hand over a fresh
+	// arkauth nonce, ie {"jsonrpc":"2.0","id":1,"method":"arkeo_auth","params":["<arkauth>"]}
+	WSMethodAuth = "arkeo_auth"
+	// WSMethodNonceRequired is the notification sent by the sentinel when the
+	// client has to sign a nonce covering the messages served so far
+	WSMethodNonceRequired = "arkeo_nonceRequired"
+)
+
+func wsConfigWithDefaults(config conf.WebSocketConfiguration) conf.WebSocketConfiguration {
+	if config.MessagesPerNonce <= 0 {
+		config.MessagesPerNonce = defaultWSMessagesPerNonce
+	}
+	if config.NonceTimeout <= 0 {
+		config.NonceTimeout = defaultWSNonceTimeout
+	}
+	if config.PingInterval <= 0 {
+		config.PingInterval = defaultWSPingInterval
+	}
+	if config.MaxMessageSize <= 0 {
+		config.MaxMessageSize = defaultWSMaxMessageSize
+	}
+	return config
+}
+
+// wsSession proxies a single websocket connection. The client authenticated
+// with arkauth during the handshake (see auth), from then on every message it
+// sends is rate limited against the contract, and pay-as-you-go contracts pay
+// for every client call (see requestCost) and every notification pushed by the
+// upstream.
+type wsSession struct {
+	proxy      *Proxy
+	config     conf.WebSocketConfiguration
+	client     *websocket.Conn
+	upstream   *websocket.Conn
+	remoteAddr string
+	contract   types.Contract // empty for the free tier
+	policy     methodPolicy
+
+	writeMu sync.Mutex // serialize writes to the client
+
+	mu         sync.Mutex
+	owed       int64 // messages served but not yet covered by a signed nonce
+	nonceTimer *time.Timer
+	pending    map[string]int // ids of client requests waiting for a response
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func (s *wsSession) payAsYouGo() bool {
+	return s.contract.Id != 0 && s.contract.IsPayAsYouGo()
+}
+
+func (s *wsSession) writeClient(messageType int, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.client.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return s.client.WriteMessage(messageType, data)
+}
+
+func (s *wsSession) close(code int, reason string) {
+	s.closeOnce.Do(func() {
+		deadline := time.Now().Add(wsWriteWait)
+		_ = s.client.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+		_ = s.upstream.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+		_ = s.client.Close()
+		_ = s.upstream.Close()
+		s.mu.Lock()
+		if s.nonceTimer != nil {
+			s.nonceTimer.Stop()
+		}
+		s.mu.Unlock()
+		close(s.done)
+	})
+}
+
+// closeCode returns the close code to forward for a read error
+func closeCode(err error) (int, string) {
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		if closeErr.Code == websocket.CloseNoStatusReceived {
+			return websocket.CloseNormalClosure, ""
+		}
+		return closeErr.Code, closeErr.Text
+	}
+	return websocket.CloseGoingAway, ""
+}
+
+// trackRequest records the ids of a client request forwarded to the upstream
+func (s *wsSession) trackRequest(msg []byte) {
+	ids := jsonRPCIds(msg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		s.pending[id]++
+	}
+}
+
+// isResponse reports whether an upstream message answers a client request, as
+// opposed to a notification pushed by the upstream (eth_subscription, tendermint
+// events reuse the id of the subscribe request once it has been answered).
+func (s *wsSession) isResponse(msg []byte) bool {
+	ids := jsonRPCIds(msg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	response := false
+	for _, id := range ids {
+		if s.pending[id] > 0 {
+			response = true
+			if s.pending[id]--; s.pending[id] == 0 {
+				delete(s.pending, id)
+			}
+		}
+	}
+	return response
+}
+
+// rateLimited consumes one message from the rate limit of the session
+func (s *wsSession) rateLimited() bool {
+	if s.contract.Id != 0 {
+		return s.proxy.isRateLimited(s.contract.Id, s.remoteAddr, int(s.contract.QueriesPerMinute), 60, nil)
+	}
+	return s.proxy.isRateLimited(0, s.remoteAddr, s.proxy.currentConfig().FreeTierRateLimit, 60, nil)
+}
+
+// meter charges cost nonces to a pay-as-you-go session. It returns false when
+// the session has been closed because the contract can't pay for it.
+func (s *wsSession) meter(cost int64) bool {
+	if !s.payAsYouGo() {
+		return true
+	}
+	contract, err := s.proxy.MemStore.Get(strconv.FormatUint(s.contract.Id, 10))
+	if err != nil {
+		s.proxy.logger.Error("websocket: fail to fetch contract", "contract_id", s.contract.Id, "error", err)
+		contract = s.contract
+	}
+	if contract.IsExpired(s.proxy.MemStore.GetHeight()) {
+		s.close(websocket.ClosePolicyViolation, "contract expired")
+		return false
+	}
+
+	s.mu.Lock()
+	s.owed += cost
+	required := contract.Nonce + s.owed
+	notify := s.owed >= int64(s.config.MessagesPerNonce) && s.nonceTimer == nil
+	if notify {
+		s.nonceTimer = time.AfterFunc(s.config.NonceTimeout, func() {
+			s.close(websocket.ClosePolicyViolation, "payment required: no fresh arkauth nonce received")
+		})
+	}
+	s.mu.Unlock()
+
+	if contract.Deposit.IsNil() || contract.Deposit.LT(contract.PayAsYouGoCost(required)) {
+		s.close(websocket.ClosePolicyViolation, "contract spent")
+		return false
+	}
+	if notify {
+		params, _ := json.Marshal(map[string]any{
+			"contract_id": contract.Id,
+			"nonce":       required,
+			"deadline":    time.Now().Add(s.config.NonceTimeout).Unix(),
+		})
+		notice, _ := json.Marshal(jsonRPCMessage{JSONRPC: "2.0", Method: WSMethodNonceRequired, Params: params})
+		if err := s.writeClient(websocket.TextMessage, notice); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// handleControl answers the control messages of the sentinel, it returns false
+// for any message that has to be forwarded to the upstream.
+func (s *wsSession) handleControl(msg []byte) bool {
+	var req jsonRPCMessage
+	if err := json.Unmarshal(msg, &req); err != nil || req.Method != WSMethodAuth {
+		return false
+	}
+	reply := func(result any, err error) {
+		var buf []byte
+		if err != nil {
+			buf = newJSONRPCError(req.Id, JSONRPCErrUnauthorized, err.Error())
+		} else {
+			buf, _ = json.Marshal(jsonRPCMessage{JSONRPC: "2.0", Id: req.Id, Result: result})
+		}
+		_ = s.writeClient(websocket.TextMessage, buf)
+	}
+
+	var params []string
+	if err := json.Unmarshal(req.Params, &params); err != nil || len(params) != 1 {
+		reply(nil, fmt.Errorf("params must be a single arkauth string"))
+		return true
+	}
+	if !s.payAsYouGo() {
+		reply(nil, fmt.Errorf("session is not metered"))
+		return true
+	}
+	aa, err := parseArkAuth(params[0], s.proxy.Config.SourceChain)
+	if err != nil {
+		reply(nil, fmt.Errorf("bad arkauth: %w", err))
+		return true
+	}
+	if aa.ContractId != s.contract.Id {
+		reply(nil, fmt.Errorf("arkauth is for contract %d, session is for %d", aa.ContractId, s.contract.Id))
+		return true
+	}
+	contract, err := s.proxy.MemStore.Get(strconv.FormatUint(s.contract.Id, 10))
+	if err != nil {
+		reply(nil, fmt.Errorf("fail to fetch contract: %w", err))
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if required := contract.Nonce + s.owed; aa.Nonce < required {
+		reply(nil, fmt.Errorf("nonce %d does not cover the messages served, expected at least %d", aa.Nonce, required))
+		return true
+	}
+	if contract.Deposit.IsNil() || contract.Deposit.LT(contract.PayAsYouGoCost(aa.Nonce)) {
+		reply(nil, fmt.Errorf("contract spent"))
+		return true
+	}
+	// the nonce has to cover every message served since the last claim
+	if _, err := s.proxy.storeClaim(aa, contract, s.owed); err != nil {
+		reply(nil, err)
+		return true
+	}
+	s.owed -= aa.Nonce - contract.Nonce
+	if s.nonceTimer != nil && s.owed < int64(s.config.MessagesPerNonce) {
+		s.nonceTimer.Stop()
+		s.nonceTimer = nil
+	}
+	reply(map[string]int64{"nonce": aa.Nonce}, nil)
+	return true
+}
+
+// clientLoop forwards client messages to the upstream
+func (s *wsSession) clientLoop() {
+	for {
+		messageType, msg, err := s.client.ReadMessage()
+		if err != nil {
+			s.close(closeCode(err))
+			return
+		}
+		_ = s.client.SetReadDeadline(time.Now().Add(2 * s.config.PingInterval))
+		if messageType == websocket.TextMessage && s.handleControl(msg) {
+			continue
+		}
+		if s.rateLimited() {
+			var req jsonRPCMessage
+			_ = json.Unmarshal(msg, &req)
+			_ = s.writeClient(websocket.TextMessage, newJSONRPCError(req.Id, JSONRPCErrRateLimited, "rate limited"))
+			continue
+		}
+		calls := parseRPCCalls(msg)
+		if code, err := s.proxy.checkMethods(calls, s.policy, s.contract.Id, s.remoteAddr); err != nil {
+			_ = s.writeClient(websocket.TextMessage, jsonRPCErrorBody(calls, code, err.Error()))
+			continue
+		}
+		if !s.meter(s.policy.requestCost(calls)) {
+			return
+		}
+		s.trackRequest(msg)
+		_ = s.upstream.SetWriteDeadline(time.Now().Add(wsWriteWait))
+		if err := s.upstream.WriteMessage(messageType, msg); err != nil {
+			s.close(websocket.CloseGoingAway, "upstream unavailable")
+			return
+		}
+	}
+}
+
+// upstreamLoop forwards upstream messages to the client
+func (s *wsSession) upstreamLoop() {
+	for {
+		messageType, msg, err := s.upstream.ReadMessage()
+		if err != nil {
+			s.close(closeCode(err))
+			return
+		}
+		if !s.isResponse(msg) && !s.meter(1) {
+			return
+		}
+		if err := s.writeClient(messageType, msg); err != nil {
+			s.close(websocket.CloseGoingAway, "")
+			return
+		}
+	}
+}
+
+func (s *wsSession) pingLoop() {
+	ticker := time.NewTicker(s.config.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.client.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				s.close(websocket.CloseGoingAway, "")
+				return
+			}
+		}
+	}
+}
+
+// handleWebSocket upgrades the client connection and proxies it to target.
+// The auth middleware has already validated the arkauth of the handshake
+// (and charged one nonce for it).
+func (p *Proxy) handleWebSocket(w http.ResponseWriter, r *http.Request, target url.URL, aa ArkAuth, service conf.ServiceConfig) {
+	config := wsConfigWithDefaults(p.Config.WebSocket)
+
+	var contract types.Contract
+	if w.Header().Get("tier") == "paid" {
+		contractId := aa.ContractId
+		if contractId == 0 {
+			contractId, _ = strconv.ParseUint(r.URL.Query().Get("contract_id"), 10, 64)
+		}
+		if contractId > 0 {
+			var err error
+			contract, err = p.MemStore.Get(strconv.FormatUint(contractId, 10))
+			if err != nil {
+				p.logger.Error("websocket: fail to fetch contract", "contract_id", contractId, "error", err)
+				respondWithError(w, "could not fetch contract", http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	policy := methodPolicy{service: service}
+	if contract.Id > 0 {
+		if contractConfig, err := p.ContractConfigStore.Get(contract.Id); err == nil {
+			policy.contract = contractConfig.Methods
+		}
+	}
+
+	switch target.Scheme {
+	case "https", "wss":
+		target.Scheme = "wss"
+	default:
+		target.Scheme = "ws"
+	}
+	// the dialer rejects credentials in the url, send them as basic auth
+	upstreamHeader := http.Header{}
+	if target.User != nil {
+		passwd, _ := target.User.Password()
+		req := http.Request{Header: upstreamHeader}
+		req.SetBasicAuth(target.User.Username(), passwd)
+		target.User = nil
+	}
+	if protocols := r.Header.Values("Sec-WebSocket-Protocol"); len(protocols) > 0 {
+		upstreamHeader["Sec-WebSocket-Protocol"] = protocols
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		upstreamHeader.Set("Origin", origin)
+	}
+	upstreamHeader.Set(forwardHeaderName, p.getRemoteAddr(r))
+
+	upstream, resp, err := websocket.DefaultDialer.DialContext(r.Context(), target.String(), upstreamHeader)
+	if err != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		p.logger.Error("websocket: fail to dial upstream", "target", target.Redacted(), "status", status, "error", err)
+		respondWithError(w, "could not connect to upstream", http.StatusBadGateway)
+		return
+	}
+
+	responseHeader := http.Header{}
+	if protocol := upstream.Subprotocol(); protocol != "" {
+		responseHeader.Set("Sec-WebSocket-Protocol", protocol)
+	}
+	for _, name := range []string{HeaderRateLimitLimit, HeaderRateLimitRemaining, HeaderRateLimitReset, "tier"} {
+		if v := w.Header().Get(name); v != "" {
+			responseHeader.Set(name, v)
+		}
+	}
+
+	// connections outlive the server write timeout, deadlines are handled per message
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool { return true }, // CORS is enforced per contract by auth
+	}
+	client, err := upgrader.Upgrade(w, r, responseHeader)
+	if err != nil {
+		p.logger.Error("websocket: fail to upgrade client connection", "error", err)
+		_ = upstream.Close()
+		return
+	}
+	client.SetReadLimit(config.MaxMessageSize)
+	upstream.SetReadLimit(config.MaxMessageSize)
+	_ = client.SetReadDeadline(time.Now().Add(2 * config.PingInterval))
+	client.SetPongHandler(func(string) error {
+		return client.SetReadDeadline(time.Now().Add(2 * config.PingInterval))
+	})
+
+	session := &wsSession{
+		proxy:      p,
+		config:     config,
+		client:     client,
+		upstream:   upstream,
+		remoteAddr: p.getRemoteAddr(r),
+		contract:   contract,
+		policy:     policy,
+		pending:    make(map[string]int),
+		done:       make(chan struct{}),
+	}
+	p.logger.Info("websocket: session opened", "target", target.Redacted(), "contract_id", contract.Id)
+	go session.upstreamLoop()
+	go session.pingLoop()
+	session.clientLoop()
+	<-session.done
+	p.logger.Info("websocket: session closed", "contract_id", contract.Id)
+}
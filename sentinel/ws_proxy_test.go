@@ -0,0 +1,228 @@
+package sentinel
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newTestWSUpstream echoes every message, and pushes a notification after a
+// "subscribe" request
+func newTestWSUpstream(t *testing.T) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(messageType, msg); err != nil {
+				return
+			}
+			if strings.Contains(string(msg), "subscribe") {
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"subscription","params":{}}`))
+			}
+		}
+	}))
+}
+
+// paidTestEnv is a sentinel serving a single contract of a random client
+type paidTestEnv struct {
+	proxy    *Proxy
+	server   *httptest.Server
+	client   *secp256k1.PrivKey
+	contract types.Contract
+}
+
+func newPaidTestEnv(t *testing.T, config conf.Configuration, contractType types.ContractType, queriesPerMinute int64, upstreamURL string) *paidTestEnv {
+	claims, err := NewClaimStore("")
+	require.NoError(t, err)
+	contractConfigs, err := NewContractConfigurationStore("")
+	require.NoError(t, err)
+
+	clientKey := secp256k1.GenPrivKey()
+	clientPubKey, err := common.NewPubKeyFromCrypto(clientKey.PubKey())
+	require.NoError(t, err)
+
+	contract := types.NewContract(config.ProviderPubKey, common.BTCService, clientPubKey)
+	contract.Id = 1
+	contract.Type = contractType
+	contract.Height = 1
+	contract.Duration = 100
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 1)
+	contract.Deposit = cosmos.NewInt(1000)
+	contract.QueriesPerMinute = queriesPerMinute
+
+	proxy := &Proxy{
+		Config:              config,
+		MemStore:            NewMemStore("", nil, log.NewNopLogger()),
+		ClaimStore:          claims,
+		ContractConfigStore: contractConfigs,
+		logger:              log.NewNopLogger(),
+		proxies:             map[string]*url.URL{"btc-mainnet-fullnode": common.MustParseURL(upstreamURL)},
+		rateLimiter:         NewMemoryRateLimiter(time.Minute),
+	}
+	proxy.MemStore.Put(contract)
+
+	server := httptest.NewServer(proxy.getRouter())
+	t.Cleanup(server.Close)
+	return &paidTestEnv{proxy: proxy, server: server, client: clientKey, contract: contract}
+}
+
+func newWSTestEnv(t *testing.T, contractType types.ContractType, queriesPerMinute int64, wsConfig conf.WebSocketConfiguration) *paidTestEnv {
+	config := newTestConfig()
+	config.WebSocket = wsConfig
+
+	upstream := newTestWSUpstream(t)
+	t.Cleanup(upstream.Close)
+	return newPaidTestEnv(t, config, contractType, queriesPerMinute, upstream.URL)
+}
+
+func (e *paidTestEnv) arkAuth(t *testing.T, nonce int64) string {
+	sig, err := e.client.Sign([]byte(GenerateMessageToSign(e.contract.Id, nonce, "")))
+	require.NoError(t, err)
+	return fmt.Sprintf("%d:%d:%s", e.contract.Id, nonce, hex.EncodeToString(sig))
+}
+
+func (e *paidTestEnv) dial(t *testing.T, arkauth string) *websocket.Conn {
+	wsURL := "ws" + strings.TrimPrefix(e.server.URL, "http") + "/btc-mainnet-fullnode?" + QueryArkAuth + "=" + arkauth
+	conn, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	require.Equal(t, "paid", resp.Header.Get("tier"))
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func readJSONRPC(t *testing.T, conn *websocket.Conn) jsonRPCMessage {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	var m jsonRPCMessage
+	require.NoError(t, json.Unmarshal(msg, &m))
+	return m
+}
+
+func sendJSONRPC(t *testing.T, conn *websocket.Conn, id int, method string, params ...string) {
+	buf, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, buf))
+}
+
+func TestWebSocketPayAsYouGoMetering(t *testing.T) {
+	env := newWSTestEnv(t, types.ContractType_PAY_AS_YOU_GO, 100, conf.WebSocketConfiguration{
+		MessagesPerNonce: 3,
+		NonceTimeout:     300 * time.Millisecond,
+	})
+	conn := env.dial(t, env.arkAuth(t, 1))
+
+	claim, err := env.proxy.ClaimStore.Get("1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), claim.Nonce)
+
+	// a request and the notification it triggers are metered, the response is not
+	sendJSONRPC(t, conn, 1, "subscribe")
+	require.Equal(t, "1", string(readJSONRPC(t, conn).Id))
+	require.Equal(t, "subscription", readJSONRPC(t, conn).Method)
+
+	sendJSONRPC(t, conn, 2, "getblockcount")
+	notice := readJSONRPC(t, conn)
+	require.Equal(t, WSMethodNonceRequired, notice.Method)
+	var params map[string]int64
+	require.NoError(t, json.Unmarshal(notice.Params, &params))
+	require.Equal(t, int64(4), params["nonce"])
+	require.Equal(t, "2", string(readJSONRPC(t, conn).Id))
+
+	// a nonce that doesn't cover the messages served is refused
+	sendJSONRPC(t, conn, 3, WSMethodAuth, env.arkAuth(t, 3))
+	reply := readJSONRPC(t, conn)
+	require.NotNil(t, reply.Error)
+	require.Equal(t, JSONRPCErrUnauthorized, reply.Error.Code)
+
+	sendJSONRPC(t, conn, 4, WSMethodAuth, env.arkAuth(t, 4))
+	reply = readJSONRPC(t, conn)
+	require.Nil(t, reply.Error)
+	claim, err = env.proxy.ClaimStore.Get("1")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), claim.Nonce)
+
+	// the session outlives the nonce timeout once paid
+	time.Sleep(500 * time.Millisecond)
+	sendJSONRPC(t, conn, 5, "getblockcount")
+	require.Equal(t, "5", string(readJSONRPC(t, conn).Id))
+}
+
+func TestWebSocketNonceTimeout(t *testing.T) {
+	env := newWSTestEnv(t, types.ContractType_PAY_AS_YOU_GO, 100, conf.WebSocketConfiguration{
+		MessagesPerNonce: 1,
+		NonceTimeout:     100 * time.Millisecond,
+	})
+	conn := env.dial(t, env.arkAuth(t, 1))
+
+	sendJSONRPC(t, conn, 1, "getblockcount")
+	require.Equal(t, WSMethodNonceRequired, readJSONRPC(t, conn).Method)
+	require.Equal(t, "1", string(readJSONRPC(t, conn).Id))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, _, err := conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), fmt.Sprintf("unexpected error: %v", err))
+}
+
+func TestWebSocketRateLimit(t *testing.T) {
+	// the handshake consumes one request of the quota
+	env := newWSTestEnv(t, types.ContractType_SUBSCRIPTION, 3, conf.WebSocketConfiguration{})
+	conn := env.dial(t, env.arkAuth(t, 1))
+
+	sendJSONRPC(t, conn, 1, "getblockcount")
+	require.Equal(t, "1", string(readJSONRPC(t, conn).Id))
+	sendJSONRPC(t, conn, 2, "getblockcount")
+	require.Equal(t, "2", string(readJSONRPC(t, conn).Id))
+
+	sendJSONRPC(t, conn, 3, "getblockcount")
+	reply := readJSONRPC(t, conn)
+	require.Equal(t, "3", string(reply.Id))
+	require.NotNil(t, reply.Error)
+	require.Equal(t, JSONRPCErrRateLimited, reply.Error.Code)
+
+	// the session is not metered
+	sendJSONRPC(t, conn, 4, WSMethodAuth, env.arkAuth(t, 2))
+	require.NotNil(t, readJSONRPC(t, conn).Error)
+}
+
+func TestWebSocketMethodDenied(t *testing.T) {
+	env := newWSTestEnv(t, types.ContractType_SUBSCRIPTION, 100, conf.WebSocketConfiguration{})
+	env.proxy.Config.Services = []conf.ServiceConfig{{
+		Name:    "btc-mainnet-fullnode",
+		Methods: conf.MethodPolicy{Deny: []string{"stop"}},
+	}}
+	conn := env.dial(t, env.arkAuth(t, 1))
+
+	sendJSONRPC(t, conn, 1, "stop")
+	reply := readJSONRPC(t, conn)
+	require.Equal(t, "1", string(reply.Id))
+	require.NotNil(t, reply.Error)
+	require.Equal(t, JSONRPCErrMethodDenied, reply.Error.Code)
+
+	sendJSONRPC(t, conn, 2, "getblockcount")
+	require.Nil(t, readJSONRPC(t, conn).Error)
+}
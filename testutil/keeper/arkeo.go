@@ -21,11 +21,13 @@ import (
 	storemetrics "cosmossdk.io/store/metrics"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -49,6 +51,7 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	keyMint := cosmos.NewKVStoreKey(minttypes.StoreKey)
 	keydist := cosmos.NewKVStoreKey(disttypes.StoreKey)
+	keyAuthz := cosmos.NewKVStoreKey(authzkeeper.StoreKey)
 	logger := log.NewNopLogger()
 
 	db := tmdb.NewMemDB()
@@ -56,6 +59,7 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 	stateStore.MountStoreWithDB(keyStake, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(keyAuthz, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -130,6 +134,8 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 		govModuleAddr,
 	)
 
+	azk := authzkeeper.NewKeeper(runtime.NewKVStoreService(keyAuthz), cdc, baseapp.NewMsgServiceRouter(), ak)
+
 	k := keeper.NewKVStore(
 		cdc,
 		storeKey,
@@ -142,6 +148,7 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 		logger,
 		mk,
 		dk,
+		azk,
 	)
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
@@ -24,11 +24,15 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryConfigs())
 	cmd.AddCommand(CmdActiveContract())
 	cmd.AddCommand(CmdListContracts())
+	cmd.AddCommand(CmdContractsExpiring())
 	cmd.AddCommand(CmdListProviders())
 	cmd.AddCommand(CmdShowContract())
+	cmd.AddCommand(CmdShowContractGroup())
 	cmd.AddCommand(CmdShowProvider())
+	cmd.AddCommand(CmdProviderUnbondings())
 	cmd.AddCommand(CmdAllServices())
 
 	// this line is used by starport scaffolding # 1
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryConfigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configs",
+		Short: "shows the config values in effect and whether they are overridden",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Configs(context.Background(), &types.QueryConfigsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
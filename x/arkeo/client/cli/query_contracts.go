@@ -14,7 +14,7 @@ import (
 func CmdListContracts() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-contracts",
-		Short: "list all contracts",
+		Short: "list all contracts, optionally filtered",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -28,6 +28,24 @@ func CmdListContracts() *cobra.Command {
 			params := &types.QueryAllContractRequest{
 				Pagination: pageReq,
 			}
+			if params.Provider, err = cmd.Flags().GetString("provider"); err != nil {
+				return err
+			}
+			if params.Client, err = cmd.Flags().GetString("client"); err != nil {
+				return err
+			}
+			if params.Delegate, err = cmd.Flags().GetString("delegate"); err != nil {
+				return err
+			}
+			if params.Service, err = cmd.Flags().GetString("service"); err != nil {
+				return err
+			}
+			if params.Type, err = cmd.Flags().GetString("type"); err != nil {
+				return err
+			}
+			if params.State, err = cmd.Flags().GetString("state"); err != nil {
+				return err
+			}
 
 			res, err := queryClient.ContractAll(context.Background(), params)
 			if err != nil {
@@ -38,6 +56,57 @@ func CmdListContracts() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("provider", "", "only list the contracts of the given provider pubkey")
+	cmd.Flags().String("client", "", "only list the contracts of the given client pubkey")
+	cmd.Flags().String("delegate", "", "only list the contracts of the given delegate pubkey")
+	cmd.Flags().String("service", "", "only list the contracts of the given service")
+	cmd.Flags().String("type", "", "only list the contracts of the given type (subscription or pay-as-you-go)")
+	cmd.Flags().String("state", "", "only list the active or expired contracts")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdContractsExpiring() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-expiring [from-height] [to-height]",
+		Short: "list the contracts expiring within a range of heights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			argFromHeight, err := cast.ToInt64E(args[0])
+			if err != nil {
+				return err
+			}
+			argToHeight, err := cast.ToInt64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryContractsExpiringRequest{
+				FromHeight: argFromHeight,
+				ToHeight:   argToHeight,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ContractsExpiring(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -76,3 +145,36 @@ func CmdShowContract() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowContractGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-contract-group [group-id]",
+		Short: "shows a contract group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argGroupId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFetchContractGroupRequest{
+				GroupId: argGroupId,
+			}
+
+			res, err := queryClient.FetchContractGroup(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
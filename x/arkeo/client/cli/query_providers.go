@@ -13,7 +13,7 @@ import (
 func CmdListProviders() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-providers",
-		Short: "list all providers",
+		Short: "list all providers, optionally of a single service",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -27,6 +27,9 @@ func CmdListProviders() *cobra.Command {
 			params := &types.QueryAllProviderRequest{
 				Pagination: pageReq,
 			}
+			if params.Service, err = cmd.Flags().GetString("service"); err != nil {
+				return err
+			}
 
 			res, err := queryClient.ProviderAll(context.Background(), params)
 			if err != nil {
@@ -37,6 +40,7 @@ func CmdListProviders() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("service", "", "only list the providers of the given service")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -74,3 +78,34 @@ func CmdShowProvider() *cobra.Command {
 
 	return cmd
 }
+
+func CmdProviderUnbondings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-unbondings [pubkey] [service]",
+		Short: "shows the pending bond withdrawals of a provider",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProviderUnbondingsRequest{
+				Pubkey: args[0],
+			}
+			if len(args) > 1 {
+				params.Service = args[1]
+			}
+
+			res, err := queryClient.ProviderUnbondings(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -34,12 +34,21 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdBondProvider())
 	cmd.AddCommand(CmdModProvider())
 	cmd.AddCommand(CmdOpenContract())
+	cmd.AddCommand(CmdOpenContractGroup())
+	cmd.AddCommand(CmdSetDelegateLimit())
 	cmd.AddCommand(CmdCloseContract())
+	cmd.AddCommand(CmdTopUpContract())
+	cmd.AddCommand(CmdSetContractRenewal())
 	cmd.AddCommand(CmdClaimContractIncome())
 	cmd.AddCommand(CmdSetVersion())
 	cmd.AddCommand(CmdRegisterService())
 	cmd.AddCommand(CmdUpdateService())
 	cmd.AddCommand(CmdRemoveService())
+	cmd.AddCommand(CmdSubmitEvidence())
+	cmd.AddCommand(CmdSubmitUnsignedNonceEvidence())
+	cmd.AddCommand(CmdSetConfig())
+	cmd.AddCommand(CmdSubmitAttestation())
+	cmd.AddCommand(CmdSetProber())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"os"
 	"strings"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -81,6 +82,20 @@ func CmdModProvider() *cobra.Command {
 				argSettlementDuration,
 			)
 
+			rateCardFile, err := cmd.Flags().GetString("rate-card")
+			if err != nil {
+				return err
+			}
+			if rateCardFile != "" {
+				bz, err := os.ReadFile(rateCardFile)
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &msg.RateCard); err != nil {
+					return err
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -88,6 +103,7 @@ func CmdModProvider() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("rate-card", "", "json file of the rate card, its pricing tiers and duration discounts")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -81,6 +81,21 @@ func CmdOpenContract() *cobra.Command {
 				}
 			}
 
+			autoRenew, err := cmd.Flags().GetBool("auto-renew")
+			if err != nil {
+				return err
+			}
+
+			limit, err := delegateLimitFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			payer, err := cmd.Flags().GetString("payer")
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -105,6 +120,9 @@ func CmdOpenContract() *cobra.Command {
 				types.ContractAuthorization(argContractAuth),
 				argQPM,
 			)
+			msg.AutoRenew = autoRenew
+			msg.DelegateLimit = limit
+			msg.Payer = payer
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -112,6 +130,9 @@ func CmdOpenContract() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool("auto-renew", false, "renew the subscription with the same terms when it expires")
+	cmd.Flags().String("payer", "", "account paying for the contract, it has to grant the client MsgOpenContract through authz")
+	addDelegateLimitFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdOpenContractGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-contract-group [provider_pubkeys] [rates] [service] [client_pubkey] [deposit] [duration] [queries-per-minute] [authorization-optional] [delegation-optional]",
+		Short: "Broadcast message openContractGroup, providers and their pay-as-you-go rates are comma separated",
+		Args:  cobra.MinimumNArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var providers []common.PubKey
+			for _, arg := range strings.Split(args[0], ",") {
+				pubkey, err := common.NewPubKey(strings.TrimSpace(arg))
+				if err != nil {
+					return err
+				}
+				providers = append(providers, pubkey)
+			}
+
+			var rates []cosmos.Coin
+			for _, arg := range strings.Split(args[1], ",") {
+				rate, err := cosmos.ParseCoin(strings.TrimSpace(arg))
+				if err != nil {
+					return err
+				}
+				rates = append(rates, rate)
+			}
+
+			argService := strings.ToLower(args[2])
+			cl, err := common.NewPubKey(args[3])
+			if err != nil {
+				return err
+			}
+
+			deposit, ok := cosmos.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("bad deposit amount: %s", args[4])
+			}
+
+			argDuration, err := cast.ToInt64E(args[5])
+			if err != nil {
+				return err
+			}
+
+			argQPM, err := cast.ToInt64E(args[6])
+			if err != nil {
+				return err
+			}
+
+			argContractAuth := int32(0)
+			if len(args) > 7 {
+				argContractAuth, err = cast.ToInt32E(args[7])
+				if err != nil {
+					return err
+				}
+			}
+
+			delegate := common.EmptyPubKey
+			if len(args) > 8 {
+				delegate, err = common.NewPubKey(args[8])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenContractGroup(
+				clientCtx.GetFromAddress(),
+				providers,
+				argService,
+				cl,
+				delegate,
+				argDuration,
+				rates,
+				deposit,
+				types.ContractAuthorization(argContractAuth),
+				argQPM,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
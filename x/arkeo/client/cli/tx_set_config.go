@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-config [name] [int64|bool|string] [value]",
+		Short: "Override a config value, or remove the override with --remove (authority only)",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool("remove")
+			if err != nil {
+				return err
+			}
+
+			config := types.ConfigOverride{Name: args[0]}
+			if !remove {
+				if len(args) != 3 {
+					return fmt.Errorf("a config type and value are required")
+				}
+				configType, ok := types.ConfigType_value[strings.ToUpper(args[1])]
+				if !ok {
+					return fmt.Errorf("invalid config type: %s", args[1])
+				}
+				config.Type = types.ConfigType(configType)
+				switch config.Type {
+				case types.ConfigType_INT64:
+					config.Int64Value, err = cast.ToInt64E(args[2])
+				case types.ConfigType_BOOL:
+					config.BoolValue, err = cast.ToBoolE(args[2])
+				case types.ConfigType_STRING:
+					config.StringValue = args[2]
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetConfig(clientCtx.GetFromAddress(), config, remove)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool("remove", false, "remove the override, the compiled value applies again")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
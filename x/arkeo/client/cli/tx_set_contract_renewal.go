@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetContractRenewal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-renewal [contract-id] [auto-renew] [escrow-optional]",
+		Short: "Broadcast message setContractRenewal",
+		Long:  "Enable or cancel the auto-renewal of a subscription contract, and add to its renewal escrow. Cancelling refunds the escrow.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAutoRenew, err := cast.ToBoolE(args[1])
+			if err != nil {
+				return err
+			}
+
+			escrow := cosmos.ZeroInt()
+			if len(args) > 2 {
+				var ok bool
+				escrow, ok = cosmos.NewIntFromString(args[2])
+				if !ok {
+					return fmt.Errorf("bad escrow amount: %s", args[2])
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetContractRenewal(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				argAutoRenew,
+				escrow,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetDelegateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-delegate-limit [contract-id]",
+		Short: "Broadcast message setDelegateLimit",
+		Long:  "Limit what the spender of a pay-as-you-go contract can use. Only the account that paid the contract can set its limit.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := delegateLimitFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDelegateLimit(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				limit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDelegateLimitFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addDelegateLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64("delegate-max-nonce", 0, "highest nonce of a pay-as-you-go contract paid for, 0 for no limit")
+	cmd.Flags().String("delegate-max-value", "0", "value of a pay-as-you-go contract paid per period, 0 for no limit")
+	cmd.Flags().Int64("delegate-limit-period", 0, "length in blocks of a period of the value limit, 0 for the life of the contract")
+}
+
+func delegateLimitFromFlags(cmd *cobra.Command) (types.DelegateLimit, error) {
+	maxNonce, err := cmd.Flags().GetInt64("delegate-max-nonce")
+	if err != nil {
+		return types.DelegateLimit{}, err
+	}
+	argMaxValue, err := cmd.Flags().GetString("delegate-max-value")
+	if err != nil {
+		return types.DelegateLimit{}, err
+	}
+	maxValue, ok := cosmos.NewIntFromString(argMaxValue)
+	if !ok {
+		return types.DelegateLimit{}, fmt.Errorf("bad delegate max value: %s", argMaxValue)
+	}
+	period, err := cmd.Flags().GetInt64("delegate-limit-period")
+	if err != nil {
+		return types.DelegateLimit{}, err
+	}
+	return types.NewDelegateLimit(maxNonce, maxValue, period), nil
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProber() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-prober [address]",
+		Short: "Register a prober attesting providers, or remove it with --remove (authority only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			prober, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool("remove")
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProber(clientCtx.GetFromAddress(), prober, remove)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool("remove", false, "remove the prober")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
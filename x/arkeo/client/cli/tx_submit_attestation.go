@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSubmitAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-attestation [provider-pubkey] [service] [availability-bps] [latency-ms]",
+		Short: "Broadcast message submitAttestation",
+		Long:  "Attest the availability, in basis points of the requests served, and the latency of a provider. Clients attest with --contract-id of their contract with the provider, registered probers without.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			provider, err := common.NewPubKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAvailability, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argLatency, err := cast.ToInt64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			contractId, err := cmd.Flags().GetUint64("contract-id")
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitAttestation(
+				clientCtx.GetFromAddress(),
+				provider,
+				args[1],
+				contractId,
+				argAvailability,
+				argLatency,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64("contract-id", 0, "contract of the client with the provider, leave empty for a prober")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
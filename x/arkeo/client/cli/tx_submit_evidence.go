@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// parseReceipt reads a receipt in the JSON form of the sentinel receipt header
+func parseReceipt(raw string) (types.ResponseReceipt, error) {
+	var receipt types.ResponseReceipt
+	if err := json.Unmarshal([]byte(raw), &receipt); err != nil {
+		return receipt, fmt.Errorf("bad receipt %q: %w", raw, err)
+	}
+	return receipt, nil
+}
+
+func CmdSubmitEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [receipt-1] [receipt-2]",
+		Short: "Submit two conflicting provider receipts for the same contract nonce",
+		Long:  "Submit two conflicting provider receipts for the same contract nonce, receipts are given as returned in the X-Arkeo-Receipt header of the sentinel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receipts := make([]types.ResponseReceipt, 2)
+			for i := range receipts {
+				if receipts[i], err = parseReceipt(args[i]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSubmitEvidence(
+				clientCtx.GetFromAddress(),
+				receipts[0],
+				receipts[1],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitUnsignedNonceEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-unsigned-nonce-evidence [receipt]",
+		Short: "Submit a provider receipt for a contract nonce the client did not sign",
+		Long:  "Submit a provider receipt for a contract nonce the client did not sign, the receipt is given as returned in the X-Arkeo-Receipt header of the sentinel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receipt, err := parseReceipt(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitUnsignedNonceEvidence(clientCtx.GetFromAddress(), receipt)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -70,6 +70,29 @@ func (cv *ConfigVals) GetStringValue(name ConfigName) string {
 	return ""
 }
 
+// GetValueType returns the type of a config, false if it has no value
+func (cv *ConfigVals) GetValueType(name ConfigName) (ValueType, bool) {
+	if _, ok := cv.int64values[name]; ok {
+		return Int64Type, true
+	}
+	if _, ok := int64Overrides[name]; ok {
+		return Int64Type, true
+	}
+	if _, ok := cv.boolValues[name]; ok {
+		return BoolType, true
+	}
+	if _, ok := boolOverrides[name]; ok {
+		return BoolType, true
+	}
+	if _, ok := cv.stringValues[name]; ok {
+		return StringType, true
+	}
+	if _, ok := stringOverrides[name]; ok {
+		return StringType, true
+	}
+	return 0, false
+}
+
 func (cv *ConfigVals) String() string {
 	// get all the keys
 	int64Keys := make([]ConfigName, 0, len(cv.int64values))
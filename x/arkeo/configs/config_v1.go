@@ -6,21 +6,35 @@ import "github.com/arkeonetwork/arkeo/common"
 func NewConfigValue010() *ConfigVals {
 	return &ConfigVals{
 		int64values: map[ConfigName]int64{
-			HandlerBondProvider:        0,                          // enable/disable bond provider handler
-			HandlerModProvider:         0,                          // enable/disable mod provider handler
-			HandlerOpenContract:        0,                          // enable/disable open contract handler
-			HandlerCloseContract:       0,                          // enable/disable close contract handler
-			HandlerClaimContractIncome: 0,                          // enable/disable claim contract income handler
-			HandlerSetVersion:          0,                          // enable/disable set version handler
-			MaxContractLength:          5256000,                    // one year
-			MaxSupply:                  common.Tokens(121_000_000), // max supply of tokens
-			OpenContractCost:           20_000_000,                 // cost to open a contract (was common.Tokens(1))
-			MinProviderBond:            common.Tokens(1),           // min bond for a data provider to be able to open contracts with
-			ReserveTax:                 1000,                       // reserve income off provider income, in basis points
-			BlocksPerYear:              6311520,                    // blocks per year
-			EmissionCurve:              10,                         // rate in which the reserve is depleted to pay validators
-			ValidatorPayoutCycle:       1,                          // how often validators are paid out rewards
-			VersionConsensus:           90,                         // out of 100, percentage of nodes on a specific version before it is accepted
+			HandlerBondProvider:           0,                          // enable/disable bond provider handler
+			HandlerModProvider:            0,                          // enable/disable mod provider handler
+			HandlerOpenContract:           0,                          // enable/disable open contract handler
+			HandlerCloseContract:          0,                          // enable/disable close contract handler
+			HandlerClaimContractIncome:    0,                          // enable/disable claim contract income handler
+			HandlerSetVersion:             0,                          // enable/disable set version handler
+			MaxContractLength:             5256000,                    // one year
+			MaxSupply:                     common.Tokens(121_000_000), // max supply of tokens
+			OpenContractCost:              20_000_000,                 // cost to open a contract (was common.Tokens(1))
+			MinProviderBond:               common.Tokens(1),           // min bond for a data provider to be able to open contracts with
+			ReserveTax:                    1000,                       // reserve income off provider income, in basis points
+			BlocksPerYear:                 6311520,                    // blocks per year
+			EmissionCurve:                 10,                         // rate in which the reserve is depleted to pay validators
+			ValidatorPayoutCycle:          1,                          // how often validators are paid out rewards
+			VersionConsensus:              90,                         // out of 100, percentage of nodes on a specific version before it is accepted
+			HandlerSubmitEvidence:         0,                          // enable/disable submit evidence handler
+			ProviderSlashFraction:         1000,                       // share of a provider bond slashed for proven misbehavior, in basis points
+			ProviderJailDuration:          100800,                     // blocks a slashed provider can't open contracts (one week)
+			ProviderUnbondingPeriod:       100800,                     // blocks withdrawn bond stays slashable before it is released (one week)
+			HandlerTopUpContract:          0,                          // enable/disable top up contract handler
+			HandlerSetContractRenewal:     0,                          // enable/disable set contract renewal handler
+			HandlerOpenContractGroup:      0,                          // enable/disable open contract group handler
+			MaxContractGroupProviders:     5,                          // maximum number of providers in a contract group
+			HandlerSetDelegateLimit:       0,                          // enable/disable set delegate limit handler
+			HandlerSubmitAttestation:      0,                          // enable/disable submit attestation handler
+			ReputationHalfLife:            100800,                     // blocks after which the weight of an attestation halves (one week)
+			ReputationAttestationInterval: 14400,                      // blocks between two attestations of a provider by the same account (one day)
+			ReputationLatencyTarget:       500,                        // latency in milliseconds up to which an attestation isn't penalized
+			ReputationProberWeight:        common.Tokens(100),         // weight of an attestation by a registered prober
 		},
 		boolValues:   map[ConfigName]bool{},
 		stringValues: map[ConfigName]string{},
@@ -8,6 +8,7 @@ import "github.com/arkeonetwork/arkeo/common"
 
 func init() {
 	int64Overrides = map[ConfigName]int64{
-		MaxSupply: common.Tokens(1_000_000_000),
+		MaxSupply:               common.Tokens(1_000_000_000),
+		ProviderUnbondingPeriod: 10,
 	}
 }
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"sort"
 )
 
 // ConfigName the name we used to get constant values
@@ -23,24 +24,52 @@ const (
 	EmissionCurve
 	ValidatorPayoutCycle
 	VersionConsensus
+	HandlerSubmitEvidence
+	ProviderSlashFraction
+	ProviderJailDuration
+	ProviderUnbondingPeriod
+	HandlerTopUpContract
+	HandlerSetContractRenewal
+	HandlerOpenContractGroup
+	MaxContractGroupProviders
+	HandlerSetDelegateLimit
+	HandlerSubmitAttestation
+	ReputationHalfLife
+	ReputationAttestationInterval
+	ReputationLatencyTarget
+	ReputationProberWeight
 )
 
 var nameToString = map[ConfigName]string{
-	HandlerBondProvider:        "HandlerBondProvider",
-	HandlerModProvider:         "HandlerModProvider",
-	HandlerOpenContract:        "HandlerOpenContract",
-	HandlerCloseContract:       "HandlerCloseContract",
-	HandlerClaimContractIncome: "HandlerClaimContractIncome",
-	HandlerSetVersion:          "HandlerSetVersion",
-	MaxSupply:                  "MaxSupply",
-	MaxContractLength:          "MaxContractLength",
-	OpenContractCost:           "OpenContractCost",
-	MinProviderBond:            "MinProviderBond",
-	ReserveTax:                 "ReserveTax",
-	BlocksPerYear:              "BlocksPerYear",
-	EmissionCurve:              "EmissionCurve",
-	ValidatorPayoutCycle:       "ValidatorPayoutCycle",
-	VersionConsensus:           "VersionConsensus",
+	HandlerBondProvider:           "HandlerBondProvider",
+	HandlerModProvider:            "HandlerModProvider",
+	HandlerOpenContract:           "HandlerOpenContract",
+	HandlerCloseContract:          "HandlerCloseContract",
+	HandlerClaimContractIncome:    "HandlerClaimContractIncome",
+	HandlerSetVersion:             "HandlerSetVersion",
+	MaxSupply:                     "MaxSupply",
+	MaxContractLength:             "MaxContractLength",
+	OpenContractCost:              "OpenContractCost",
+	MinProviderBond:               "MinProviderBond",
+	ReserveTax:                    "ReserveTax",
+	BlocksPerYear:                 "BlocksPerYear",
+	EmissionCurve:                 "EmissionCurve",
+	ValidatorPayoutCycle:          "ValidatorPayoutCycle",
+	VersionConsensus:              "VersionConsensus",
+	HandlerSubmitEvidence:         "HandlerSubmitEvidence",
+	ProviderSlashFraction:         "ProviderSlashFraction",
+	ProviderJailDuration:          "ProviderJailDuration",
+	ProviderUnbondingPeriod:       "ProviderUnbondingPeriod",
+	HandlerTopUpContract:          "HandlerTopUpContract",
+	HandlerSetContractRenewal:     "HandlerSetContractRenewal",
+	HandlerOpenContractGroup:      "HandlerOpenContractGroup",
+	MaxContractGroupProviders:     "MaxContractGroupProviders",
+	HandlerSetDelegateLimit:       "HandlerSetDelegateLimit",
+	HandlerSubmitAttestation:      "HandlerSubmitAttestation",
+	ReputationHalfLife:            "ReputationHalfLife",
+	ReputationAttestationInterval: "ReputationAttestationInterval",
+	ReputationLatencyTarget:       "ReputationLatencyTarget",
+	ReputationProberWeight:        "ReputationProberWeight",
 }
 
 // String implement fmt.stringer
@@ -52,12 +81,68 @@ func (cn ConfigName) String() string {
 	return val
 }
 
+// GetConfigName returns the config with the given name
+func GetConfigName(name string) (ConfigName, bool) {
+	for cn, str := range nameToString {
+		if str == name {
+			return cn, true
+		}
+	}
+	return 0, false
+}
+
+// ConfigNames returns all configs, sorted by name
+func ConfigNames() []ConfigName {
+	names := make([]ConfigName, 0, len(nameToString))
+	for cn := range nameToString {
+		names = append(names, cn)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].String() < names[j].String()
+	})
+	return names
+}
+
+// ValueType is the type of a config value
+type ValueType int
+
+const (
+	Int64Type ValueType = iota
+	BoolType
+	StringType
+)
+
 // ConfigValues define methods used to get constant values
 type ConfigValues interface {
 	fmt.Stringer
 	GetInt64Value(name ConfigName) int64
 	GetBoolValue(name ConfigName) bool
 	GetStringValue(name ConfigName) string
+	GetValueType(name ConfigName) (ValueType, bool)
+}
+
+// ValidateInt64Value checks a value can be used for an int64 config, configs
+// that are used as divisors or shares must stay in range
+func ValidateInt64Value(name ConfigName, value int64) error {
+	switch name {
+	case ReserveTax, ProviderSlashFraction:
+		if value < 0 || value > MaxBasisPoints {
+			return fmt.Errorf("%s must be between 0 and %d basis points", name, MaxBasisPoints)
+		}
+	case VersionConsensus:
+		if value < 0 || value > 100 {
+			return fmt.Errorf("%s must be between 0 and 100", name)
+		}
+	case ValidatorPayoutCycle:
+		if value <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	default:
+		if value < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
 }
 
 // GetConfigValues will return an  implementation of ConfigValues which provide ways to get constant values
@@ -61,6 +61,37 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, unbondingSet := range genState.ProviderUnbondingSets {
+		if err := k.SetProviderUnbondingSet(ctx, unbondingSet); err != nil {
+			ctx.Logger().Error("unable to set provider unbonding set", "height", unbondingSet.Height, "error", err)
+		}
+	}
+
+	for _, override := range genState.ConfigOverrides {
+		if err := k.SetConfigOverride(ctx, override); err != nil {
+			ctx.Logger().Error("unable to set config override", "name", override.Name, "error", err)
+		}
+	}
+
+	for _, group := range genState.ContractGroups {
+		if err := k.SetContractGroup(ctx, group); err != nil {
+			ctx.Logger().Error("unable to set contract group", "id", group.Id, "error", err)
+		}
+	}
+
+	for _, prober := range genState.Probers {
+		addr, err := sdk.AccAddressFromBech32(prober)
+		if err != nil {
+			ctx.Logger().Error("invalid prober address in genesis", "prober", prober, "error", err)
+			continue
+		}
+		k.AddProber(ctx, addr)
+	}
+
+	for _, evidence := range genState.Evidence {
+		k.SetEvidence(ctx, evidence.ContractId, evidence.Nonce)
+	}
+
 	for _, vv := range genState.ValidatorVersions {
 		valAddr, err := sdk.ValAddressFromBech32(vv.ValidatorAddress)
 		if err != nil {
@@ -132,6 +163,61 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.UserContractSets = append(genesis.UserContractSets, userContractSet)
 	}
 
+	// provider unbonding sets
+	iter = k.GetProviderUnbondingSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var unbondingSet types.ProviderUnbondingSet
+		if err := k.Cdc().Unmarshal(iter.Value(), &unbondingSet); err != nil {
+			ctx.Logger().Error("unable to get provider unbonding set", "key", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ProviderUnbondingSets = append(genesis.ProviderUnbondingSets, unbondingSet)
+	}
+	iter.Close()
+
+	// config overrides
+	iter = k.GetConfigOverrideIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var override types.ConfigOverride
+		if err := k.Cdc().Unmarshal(iter.Value(), &override); err != nil {
+			ctx.Logger().Error("unable to get config override", "key", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ConfigOverrides = append(genesis.ConfigOverrides, override)
+	}
+	iter.Close()
+
+	// contract groups
+	iter = k.GetContractGroupIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var group types.ContractGroup
+		if err := k.Cdc().Unmarshal(iter.Value(), &group); err != nil {
+			ctx.Logger().Error("unable to get contract group", "key", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ContractGroups = append(genesis.ContractGroups, group)
+	}
+	iter.Close()
+
+	// probers
+	iter = k.GetProberIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		genesis.Probers = append(genesis.Probers, sdk.AccAddress(iter.Value()).String())
+	}
+	iter.Close()
+
+	// evidence
+	iter = k.GetEvidenceIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.EvidenceRecord
+		if err := k.Cdc().Unmarshal(iter.Value(), &evidence); err != nil {
+			ctx.Logger().Error("unable to get evidence", "key", iter.Key(), "error", err)
+			continue
+		}
+		genesis.Evidence = append(genesis.Evidence, evidence)
+	}
+	iter.Close()
+
 	// export validator versions
 	validators, err := k.GetActiveValidators(ctx)
 	if err != nil {
@@ -55,37 +55,46 @@ func TestGenesisWithContracts(t *testing.T) {
 	// create contracts
 	contracts := []types.Contract{
 		{
-			Provider: providerPubkey,
-			Service:  common.BTCService,
-			Client:   user1PubKey,
-			Duration: 100,
-			Rate:     rate,
-			Id:       0,
-			Deposit:  cosmos.NewInt(500),
-			Paid:     cosmos.ZeroInt(),
-			Height:   100,
+			Provider:      providerPubkey,
+			Service:       common.BTCService,
+			Client:        user1PubKey,
+			Duration:      100,
+			Rate:          rate,
+			Id:            0,
+			Deposit:       cosmos.NewInt(500),
+			Paid:          cosmos.ZeroInt(),
+			Height:        100,
+			RenewalEscrow: cosmos.ZeroInt(),
+			DelegateLimit: types.NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+			DelegateSpent: cosmos.ZeroInt(),
 		},
 		{
-			Provider: providerPubkey,
-			Service:  common.ETHService,
-			Client:   user1PubKey,
-			Duration: 100,
-			Rate:     rate,
-			Id:       1,
-			Deposit:  cosmos.NewInt(500),
-			Paid:     cosmos.ZeroInt(),
-			Height:   100,
+			Provider:      providerPubkey,
+			Service:       common.ETHService,
+			Client:        user1PubKey,
+			Duration:      100,
+			Rate:          rate,
+			Id:            1,
+			Deposit:       cosmos.NewInt(500),
+			Paid:          cosmos.ZeroInt(),
+			Height:        100,
+			RenewalEscrow: cosmos.ZeroInt(),
+			DelegateLimit: types.NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+			DelegateSpent: cosmos.ZeroInt(),
 		},
 		{
-			Provider: providerPubkey,
-			Service:  common.BTCService,
-			Client:   user2PubKey,
-			Duration: 150,
-			Rate:     rate,
-			Id:       2,
-			Deposit:  cosmos.NewInt(200),
-			Paid:     cosmos.ZeroInt(),
-			Height:   100,
+			Provider:      providerPubkey,
+			Service:       common.BTCService,
+			Client:        user2PubKey,
+			Duration:      150,
+			Rate:          rate,
+			Id:            2,
+			Deposit:       cosmos.NewInt(200),
+			Paid:          cosmos.ZeroInt(),
+			Height:        100,
+			RenewalEscrow: cosmos.ZeroInt(),
+			DelegateLimit: types.NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+			DelegateSpent: cosmos.ZeroInt(),
 		},
 	}
 
@@ -133,6 +142,21 @@ func TestGenesisWithContracts(t *testing.T) {
 	err = k.SetContractExpirationSet(ctx, contractExpirationSet2)
 	require.NoError(t, err)
 
+	unbondingSet := types.ProviderUnbondingSet{
+		Height: 200,
+		Unbondings: []types.ProviderUnbonding{
+			{Provider: providerPubkey, Service: common.BTCService, Amount: cosmos.NewInt(50)},
+		},
+	}
+	err = k.SetProviderUnbondingSet(ctx, unbondingSet)
+	require.NoError(t, err)
+
+	override := types.ConfigOverride{Name: "ReserveTax", Type: types.ConfigType_INT64, Int64Value: 500}
+	err = k.SetConfigOverride(ctx, override)
+	require.NoError(t, err)
+
+	k.SetEvidence(ctx, 2, 7)
+
 	exportedGenesis := arkeo.ExportGenesis(ctx, k)
 	require.NotNil(t, exportedGenesis)
 
@@ -141,6 +165,10 @@ func TestGenesisWithContracts(t *testing.T) {
 	require.ElementsMatch(t, exportedGenesis.Contracts, contracts)
 	require.ElementsMatch(t, exportedGenesis.UserContractSets, []types.UserContractSet{user1ContractSet, user2ContractSet})
 	require.ElementsMatch(t, exportedGenesis.ContractExpirationSets, []types.ContractExpirationSet{contractExpirationSet1, contractExpirationSet2})
+	require.ElementsMatch(t, exportedGenesis.ProviderUnbondingSets, []types.ProviderUnbondingSet{unbondingSet})
+	require.ElementsMatch(t, exportedGenesis.ConfigOverrides, []types.ConfigOverride{override})
+	require.ElementsMatch(t, exportedGenesis.Evidence, []types.EvidenceRecord{{ContractId: 2, Nonce: 7}})
+	require.NoError(t, exportedGenesis.Validate())
 
 	ctx, freshKeeper := keepertest.ArkeoKeeper(t)
 	contract, err := freshKeeper.GetContract(ctx, 0)
@@ -157,4 +185,8 @@ func TestGenesisWithContracts(t *testing.T) {
 	require.ElementsMatch(t, exportedGenesis2.Contracts, contracts)
 	require.ElementsMatch(t, exportedGenesis2.UserContractSets, []types.UserContractSet{user1ContractSet, user2ContractSet})
 	require.ElementsMatch(t, exportedGenesis2.ContractExpirationSets, []types.ContractExpirationSet{contractExpirationSet1, contractExpirationSet2})
+	require.ElementsMatch(t, exportedGenesis2.ProviderUnbondingSets, []types.ProviderUnbondingSet{unbondingSet})
+	require.ElementsMatch(t, exportedGenesis2.ConfigOverrides, []types.ConfigOverride{override})
+	require.True(t, freshKeeper.HasEvidence(ctx, 2, 7))
+	require.ElementsMatch(t, exportedGenesis2.Evidence, []types.EvidenceRecord{{ContractId: 2, Nonce: 7}})
 }
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// configValues layers the config overrides set on chain over the config
+// values compiled in the binary
+type configValues struct {
+	configs.ConfigValues
+	ctx    cosmos.Context
+	keeper KVStore
+}
+
+// GetConfigValues returns the config values in effect for the current version
+func (k KVStore) GetConfigValues(ctx cosmos.Context) configs.ConfigValues {
+	return configValues{
+		ConfigValues: configs.GetConfigValues(k.GetVersion(ctx)),
+		ctx:          ctx,
+		keeper:       k,
+	}
+}
+
+// override returns the override of a config, overrides of another type than
+// the compiled value are ignored
+func (cv configValues) override(name configs.ConfigName, configType types.ConfigType) (types.ConfigOverride, bool) {
+	override, ok := cv.keeper.GetConfigOverride(cv.ctx, name.String())
+	return override, ok && override.Type == configType
+}
+
+func (cv configValues) GetInt64Value(name configs.ConfigName) int64 {
+	if override, ok := cv.override(name, types.ConfigType_INT64); ok {
+		return override.Int64Value
+	}
+	return cv.ConfigValues.GetInt64Value(name)
+}
+
+func (cv configValues) GetBoolValue(name configs.ConfigName) bool {
+	if override, ok := cv.override(name, types.ConfigType_BOOL); ok {
+		return override.BoolValue
+	}
+	return cv.ConfigValues.GetBoolValue(name)
+}
+
+func (cv configValues) GetStringValue(name configs.ConfigName) string {
+	if override, ok := cv.override(name, types.ConfigType_STRING); ok {
+		return override.StringValue
+	}
+	return cv.ConfigValues.GetStringValue(name)
+}
+
+func (k KVStore) getConfigOverrideKey(ctx cosmos.Context, name string) string {
+	return k.GetKey(ctx, prefixConfigOverride, name)
+}
+
+func (k KVStore) GetConfigOverrideIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixConfigOverride)
+}
+
+// GetConfigOverride get the override of a config, false if there is none
+func (k KVStore) GetConfigOverride(ctx cosmos.Context, name string) (types.ConfigOverride, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(k.getConfigOverrideKey(ctx, name)))
+	if bz == nil {
+		return types.ConfigOverride{}, false
+	}
+	var override types.ConfigOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+func (k KVStore) SetConfigOverride(ctx cosmos.Context, override types.ConfigOverride) error {
+	if len(override.Name) == 0 {
+		return errors.New("cannot save a config override without a name")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getConfigOverrideKey(ctx, override.Name)), k.cdc.MustMarshal(&override))
+	return nil
+}
+
+func (k KVStore) RemoveConfigOverride(ctx cosmos.Context, name string) {
+	k.del(ctx, k.getConfigOverrideKey(ctx, name))
+}
@@ -15,10 +15,18 @@ func (k KVStore) setContract(ctx cosmos.Context, contract types.Contract) {
 	store := ctx.KVStore(k.storeKey)
 	key := k.GetContractKey(ctx, contract.Id)
 	buf := k.cdc.MustMarshal(&contract)
+
+	// an extension or renewal moves the expiration, drop the stale entries
+	var old types.Contract
+	if found, err := k.getContract(ctx, contract.Id, &old); found && err == nil {
+		k.removeContractIndexes(ctx, old)
+	}
+
 	if buf == nil {
 		store.Delete([]byte(key))
 	} else {
 		store.Set([]byte(key), buf)
+		k.setContractIndexes(ctx, contract)
 	}
 }
 
@@ -63,6 +71,10 @@ func (k KVStore) ContractExists(ctx cosmos.Context, id uint64) bool {
 }
 
 func (k KVStore) RemoveContract(ctx cosmos.Context, id uint64) {
+	var contract types.Contract
+	if found, err := k.getContract(ctx, id, &contract); found && err == nil {
+		k.removeContractIndexes(ctx, contract)
+	}
 	k.del(ctx, k.GetContractKey(ctx, id))
 }
 
@@ -248,3 +260,38 @@ func (k KVStore) RemoveFromUserContractSet(ctx cosmos.Context, user common.PubKe
 func (k KVStore) GetUserContractSetIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixUserContractSet)
 }
+
+func (k KVStore) getContractGroupKey(ctx cosmos.Context, id uint64) string {
+	return k.GetKey(ctx, prefixContractGroup, strconv.FormatUint(id, 10))
+}
+
+// GetContractGroupIterator iterate contract groups
+func (k KVStore) GetContractGroupIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixContractGroup)
+}
+
+// GetContractGroup get a contract group by id
+func (k KVStore) GetContractGroup(ctx cosmos.Context, id uint64) (types.ContractGroup, error) {
+	record := types.ContractGroup{}
+	store := ctx.KVStore(k.storeKey)
+	key := k.getContractGroupKey(ctx, id)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &record)
+	return record, err
+}
+
+// SetContractGroup save a contract group
+func (k KVStore) SetContractGroup(ctx cosmos.Context, record types.ContractGroup) error {
+	if record.Id == 0 || record.Client.IsEmpty() {
+		return errors.New("cannot save a contract group with an empty id or client")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getContractGroupKey(ctx, record.Id)), k.cdc.MustMarshal(&record))
+	return nil
+}
+
+func (k KVStore) RemoveContractGroup(ctx cosmos.Context, id uint64) {
+	k.del(ctx, k.getContractGroupKey(ctx, id))
+}
@@ -52,11 +52,12 @@ func (k msgServer) EmitModProviderEvent(ctx cosmos.Context, msg *types.MsgModPro
 			PayAsYouGoRate:      provider.PayAsYouGoRate,
 			Bond:                provider.Bond,
 			SettlementDuration:  provider.SettlementDuration,
+			RateCard:            provider.RateCard,
 		},
 	)
 }
 
-func (k msgServer) EmitOpenContractEvent(ctx cosmos.Context, openCost int64, contract *types.Contract) error {
+func (mgr Manager) EmitOpenContractEvent(ctx cosmos.Context, openCost int64, contract *types.Contract) error {
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventOpenContract{
 			Provider:           contract.Provider,
@@ -73,6 +74,11 @@ func (k msgServer) EmitOpenContractEvent(ctx cosmos.Context, openCost int64, con
 			SettlementDuration: contract.SettlementDuration,
 			Authorization:      contract.Authorization,
 			QueriesPerMinute:   contract.QueriesPerMinute,
+			PayAsYouGoTiers:    contract.PayAsYouGoTiers,
+			DiscountBps:        contract.DiscountBps,
+			GroupId:            contract.GroupId,
+			DelegateLimit:      contract.DelegateLimit,
+			Payer:              contract.Payer,
 		},
 	)
 }
@@ -102,3 +108,185 @@ func (mgr Manager) EmitValidatorPayoutEvent(ctx cosmos.Context, acc cosmos.AccAd
 		},
 	)
 }
+
+func (k msgServer) EmitProviderUnbondingEvent(ctx cosmos.Context, unbonding types.ProviderUnbonding, releaseHeight int64) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventProviderUnbonding{
+			Provider:      unbonding.Provider,
+			Service:       unbonding.Service.String(),
+			Amount:        unbonding.Amount,
+			ReleaseHeight: releaseHeight,
+		},
+	)
+}
+
+// EmitBondSlashedEvent emits the bond left to a provider after a slash, as a bond change
+func (mgr Manager) EmitBondSlashedEvent(ctx cosmos.Context, provider types.Provider, slashed cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventBondProvider{
+			Provider: provider.PubKey,
+			Service:  provider.Service.String(),
+			BondRel:  slashed.Neg(),
+			BondAbs:  provider.Bond,
+		},
+	)
+}
+
+func (mgr Manager) EmitProviderUnbondedEvent(ctx cosmos.Context, unbonding types.ProviderUnbonding) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventProviderUnbonded{
+			Provider: unbonding.Provider,
+			Service:  unbonding.Service.String(),
+			Amount:   unbonding.Amount,
+		},
+	)
+}
+
+func (k msgServer) EmitProviderSlashedEvent(ctx cosmos.Context, reporter cosmos.AccAddress, contract *types.Contract, nonce int64, slashed cosmos.Int, jailedUntil int64) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventProviderSlashed{
+			Provider:    contract.Provider,
+			Service:     contract.Service.String(),
+			ContractId:  contract.Id,
+			Nonce:       nonce,
+			Slashed:     slashed,
+			JailedUntil: jailedUntil,
+			Reporter:    reporter,
+		},
+	)
+}
+
+func (k msgServer) EmitSetConfigEvent(ctx cosmos.Context, msg *types.MsgSetConfig) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetConfig{
+			Creator: msg.Creator,
+			Config:  msg.Config,
+			Remove:  msg.Remove,
+		},
+	)
+}
+
+func (k msgServer) EmitTopUpContractEvent(ctx cosmos.Context, msg *types.MsgTopUpContract, contract types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTopUpContract{
+			Provider:      contract.Provider,
+			ContractId:    contract.Id,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			Delegate:      contract.Delegate,
+			Type:          contract.Type,
+			AddedDeposit:  msg.Deposit,
+			AddedDuration: msg.Duration,
+			Deposit:       contract.Deposit,
+			Duration:      contract.Duration,
+		},
+	)
+}
+
+func (k msgServer) EmitSetContractRenewalEvent(ctx cosmos.Context, contract types.Contract, added, refunded cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetContractRenewal{
+			Provider:      contract.Provider,
+			ContractId:    contract.Id,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			AutoRenew:     contract.AutoRenew,
+			AddedEscrow:   added,
+			Refunded:      refunded,
+			RenewalEscrow: contract.GetRenewalEscrow(),
+		},
+	)
+}
+
+func (mgr Manager) EmitContractRenewedEvent(ctx cosmos.Context, contract types.Contract, newContractId uint64, fromEscrow, fromAccount cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventContractRenewed{
+			Provider:        contract.Provider,
+			Service:         contract.Service.String(),
+			Client:          contract.Client,
+			ContractId:      contract.Id,
+			NewContractId:   newContractId,
+			PaidFromEscrow:  fromEscrow,
+			PaidFromAccount: fromAccount,
+		},
+	)
+}
+
+func (mgr Manager) EmitContractRenewalFailedEvent(ctx cosmos.Context, contract types.Contract, reason string, refunded cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventContractRenewalFailed{
+			Provider:   contract.Provider,
+			Service:    contract.Service.String(),
+			Client:     contract.Client,
+			ContractId: contract.Id,
+			Reason:     reason,
+			Refunded:   refunded,
+		},
+	)
+}
+
+func (k msgServer) EmitOpenContractGroupEvent(ctx cosmos.Context, openCost int64, group types.ContractGroup) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventOpenContractGroup{
+			GroupId:     group.Id,
+			Service:     group.Service.String(),
+			Client:      group.Client,
+			Delegate:    group.Delegate,
+			Height:      group.Height,
+			Duration:    group.Duration,
+			Deposit:     cosmos.NewCoin(group.Denom, group.Deposit),
+			OpenCost:    openCost,
+			ContractIds: group.ContractIds,
+		},
+	)
+}
+
+func (mgr Manager) EmitSettleContractGroupEvent(ctx cosmos.Context, group types.ContractGroup, refunded cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSettleContractGroup{
+			GroupId:  group.Id,
+			Service:  group.Service.String(),
+			Client:   group.Client,
+			Denom:    group.Denom,
+			Paid:     group.Paid,
+			Refunded: refunded,
+		},
+	)
+}
+
+func (k msgServer) EmitSetDelegateLimitEvent(ctx cosmos.Context, contract types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetDelegateLimit{
+			Provider:      contract.Provider,
+			ContractId:    contract.Id,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			Delegate:      contract.Delegate,
+			DelegateLimit: contract.DelegateLimit,
+		},
+	)
+}
+
+func (k msgServer) EmitSubmitAttestationEvent(ctx cosmos.Context, msg *types.MsgSubmitAttestation, provider types.Provider, weight cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSubmitAttestation{
+			Provider:     provider.PubKey,
+			Service:      provider.Service.String(),
+			Attester:     msg.Creator,
+			ContractId:   msg.ContractId,
+			Availability: msg.Availability,
+			LatencyMs:    msg.LatencyMs,
+			Weight:       weight,
+			Reputation:   provider.Reputation,
+		},
+	)
+}
+
+func (k msgServer) EmitSetProberEvent(ctx cosmos.Context, msg *types.MsgSetProber) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetProber{
+			Prober: msg.Prober,
+			Remove: msg.Remove,
+		},
+	)
+}
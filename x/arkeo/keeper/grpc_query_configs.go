@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k KVStore) Configs(c context.Context, req *types.QueryConfigsRequest) (*types.QueryConfigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	compiled := configs.GetConfigValues(k.GetVersion(ctx))
+	result := make([]types.EffectiveConfig, 0)
+	for _, name := range configs.ConfigNames() {
+		valueType, ok := compiled.GetValueType(name)
+		if !ok {
+			continue
+		}
+		configType := types.ConfigTypeOf(valueType)
+		if override, ok := k.GetConfigOverride(ctx, name.String()); ok && override.Type == configType {
+			result = append(result, types.EffectiveConfig{Config: override, Source: types.ConfigSource_OVERRIDE})
+			continue
+		}
+
+		config := types.ConfigOverride{Name: name.String(), Type: configType}
+		switch valueType {
+		case configs.BoolType:
+			config.BoolValue = compiled.GetBoolValue(name)
+		case configs.StringType:
+			config.StringValue = compiled.GetStringValue(name)
+		default:
+			config.Int64Value = compiled.GetInt64Value(name)
+		}
+		result = append(result, types.EffectiveConfig{Config: config, Source: types.ConfigSource_COMPILED})
+	}
+
+	return &types.QueryConfigsResponse{Configs: result}, nil
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
 	"cosmossdk.io/store/prefix"
@@ -13,6 +15,93 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// contractFilter holds the optional filters of a contract listing
+type contractFilter struct {
+	provider common.PubKey
+	client   common.PubKey
+	delegate common.PubKey
+	service  *common.Service
+	kind     *types.ContractType
+	state    string
+}
+
+func (k KVStore) newContractFilter(ctx cosmos.Context, req *types.QueryAllContractRequest) (contractFilter, error) {
+	var filter contractFilter
+	var err error
+	if req.Provider != "" {
+		if filter.provider, err = common.NewPubKey(req.Provider); err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid provider pubkey")
+		}
+	}
+	if req.Client != "" {
+		if filter.client, err = common.NewPubKey(req.Client); err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid client pubkey")
+		}
+	}
+	if req.Delegate != "" {
+		if filter.delegate, err = common.NewPubKey(req.Delegate); err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid delegate pubkey")
+		}
+	}
+	if req.Service != "" {
+		service, _, err := k.ResolveServiceEnum(ctx, req.Service)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid service")
+		}
+		filter.service = &service
+	}
+	if req.Type != "" {
+		kind, ok := types.ContractType_value[strings.ToUpper(strings.ReplaceAll(req.Type, "-", "_"))]
+		if !ok {
+			return filter, status.Error(codes.InvalidArgument, "invalid contract type")
+		}
+		contractType := types.ContractType(kind)
+		filter.kind = &contractType
+	}
+	switch strings.ToLower(req.State) {
+	case "", contractStateActive, contractStateExpired:
+		filter.state = strings.ToLower(req.State)
+	default:
+		return filter, status.Error(codes.InvalidArgument, "invalid contract state, must be active or expired")
+	}
+	return filter, nil
+}
+
+const (
+	contractStateActive  = "active"
+	contractStateExpired = "expired"
+)
+
+func (filter contractFilter) isEmpty() bool {
+	return filter.provider.IsEmpty() && filter.client.IsEmpty() && filter.delegate.IsEmpty() &&
+		filter.service == nil && filter.kind == nil && filter.state == ""
+}
+
+func (filter contractFilter) matches(contract types.Contract, height int64) bool {
+	if !filter.provider.IsEmpty() && !contract.Provider.Equals(filter.provider) {
+		return false
+	}
+	if !filter.client.IsEmpty() && !contract.Client.Equals(filter.client) {
+		return false
+	}
+	if !filter.delegate.IsEmpty() && !contract.Delegate.Equals(filter.delegate) {
+		return false
+	}
+	if filter.service != nil && contract.Service != *filter.service {
+		return false
+	}
+	if filter.kind != nil && contract.Type != *filter.kind {
+		return false
+	}
+	switch filter.state {
+	case contractStateActive:
+		return contract.IsOpen(height)
+	case contractStateExpired:
+		return contract.IsExpired(height)
+	}
+	return true
+}
+
 func (k KVStore) ContractAll(c context.Context, req *types.QueryAllContractRequest) (*types.QueryAllContractResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -21,18 +110,74 @@ func (k KVStore) ContractAll(c context.Context, req *types.QueryAllContractReque
 	var contracts []types.Contract
 	ctx := sdk.UnwrapSDKContext(c)
 
+	filter, err := k.newContractFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	store := ctx.KVStore(k.storeKey)
-	contractStore := prefix.NewStore(store, types.KeyPrefix(prefixContract.String()))
+	if filter.isEmpty() {
+		contractStore := prefix.NewStore(store, types.KeyPrefix(prefixContract.String()))
+
+		pageRes, err := query.Paginate(contractStore, req.Pagination, func(key, value []byte) error {
+			var contract types.Contract
+			if err := k.cdc.Unmarshal(value, &contract); err != nil {
+				return err
+			}
 
-	pageRes, err := query.Paginate(contractStore, req.Pagination, func(key, value []byte) error {
-		var contract types.Contract
-		if err := k.cdc.Unmarshal(value, &contract); err != nil {
-			return err
+			contracts = append(contracts, contract)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		contracts = append(contracts, contract)
-		return nil
-	})
+		return &types.QueryAllContractResponse{Contract: contracts, Pagination: pageRes}, nil
+	}
+
+	// page through the narrowest index available, or every contract when
+	// none of the filters is indexed
+	var pageRes *query.PageResponse
+	height := ctx.BlockHeight()
+	collect := func(contract types.Contract, accumulate bool) bool {
+		if !filter.matches(contract, height) {
+			return false
+		}
+		if accumulate {
+			contracts = append(contracts, contract)
+		}
+		return true
+	}
+
+	var indexPrefix string
+	switch {
+	case !filter.provider.IsEmpty():
+		indexPrefix = k.getContractPubKeyIndexPrefix(ctx, prefixContractProviderIndex, filter.provider)
+	case !filter.client.IsEmpty():
+		indexPrefix = k.getContractPubKeyIndexPrefix(ctx, prefixContractClientIndex, filter.client)
+	case !filter.delegate.IsEmpty():
+		indexPrefix = k.getContractPubKeyIndexPrefix(ctx, prefixContractDelegateIndex, filter.delegate)
+	}
+
+	if indexPrefix != "" {
+		indexStore := prefix.NewStore(store, types.KeyPrefix(indexPrefix))
+		pageRes, err = query.FilteredPaginate(indexStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+			contract, err := k.getIndexedContract(ctx, value)
+			if err != nil {
+				return false, err
+			}
+			return collect(contract, accumulate), nil
+		})
+	} else {
+		contractStore := prefix.NewStore(store, types.KeyPrefix(prefixContract.String()))
+		pageRes, err = query.FilteredPaginate(contractStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+			var contract types.Contract
+			if err := k.cdc.Unmarshal(value, &contract); err != nil {
+				return false, err
+			}
+			return collect(contract, accumulate), nil
+		})
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -40,6 +185,54 @@ func (k KVStore) ContractAll(c context.Context, req *types.QueryAllContractReque
 	return &types.QueryAllContractResponse{Contract: contracts, Pagination: pageRes}, nil
 }
 
+func (k KVStore) ContractsExpiring(c context.Context, req *types.QueryContractsExpiringRequest) (*types.QueryContractsExpiringResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < req.FromHeight {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the height range bounds the iteration, pages continue from the key of
+	// the next entry
+	start := []byte(contractExpiryIndexHeight(req.FromHeight))
+	end := []byte(contractExpiryIndexHeight(req.ToHeight + 1))
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+		}
+		if len(req.Pagination.Key) > 0 {
+			start = req.Pagination.Key
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.KeyPrefix(k.getContractExpiryIndexPrefix(ctx)))
+	iterator := indexStore.Iterator(start, end)
+	defer iterator.Close()
+
+	var contracts []types.Contract
+	pageRes := &query.PageResponse{}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(contracts)) == limit {
+			pageRes.NextKey = iterator.Key()
+			break
+		}
+		contract, err := k.getIndexedContract(ctx, iterator.Value())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		contracts = append(contracts, contract)
+	}
+
+	return &types.QueryContractsExpiringResponse{Contract: contracts, Pagination: pageRes}, nil
+}
+
 func (k KVStore) FetchContract(c context.Context, req *types.QueryFetchContractRequest) (*types.QueryFetchContractResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -58,6 +251,24 @@ func (k KVStore) FetchContract(c context.Context, req *types.QueryFetchContractR
 	return &types.QueryFetchContractResponse{Contract: val}, nil
 }
 
+func (k KVStore) FetchContractGroup(c context.Context, req *types.QueryFetchContractGroupRequest) (*types.QueryFetchContractGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.GetContractGroup(ctx, req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if val.IsEmpty() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryFetchContractGroupResponse{ContractGroup: val}, nil
+}
+
 func (k KVStore) ActiveContract(goCtx context.Context, req *types.QueryActiveContractRequest) (*types.QueryActiveContractResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestContractAllFilters(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	providerA := types.GetRandomPubKey()
+	providerB := types.GetRandomPubKey()
+	client := types.GetRandomPubKey()
+	delegate := types.GetRandomPubKey()
+
+	newContract := func(id uint64, provider common.PubKey, service common.Service, height int64) types.Contract {
+		contract := types.NewContract(provider, service, client)
+		contract.Id = id
+		contract.Height = height
+		contract.Duration = 50
+		require.NoError(t, k.SetContract(ctx, contract))
+		return contract
+	}
+	newContract(1, providerA, common.BTCService, 90)
+	newContract(2, providerA, common.ETHService, 10)
+	newContract(3, providerB, common.BTCService, 90)
+	c4 := newContract(4, providerB, common.BTCService, 90)
+	c4.Delegate = delegate
+	c4.Type = types.ContractType_PAY_AS_YOU_GO
+	require.NoError(t, k.SetContract(ctx, c4))
+
+	ids := func(req *types.QueryAllContractRequest) []uint64 {
+		res, err := k.ContractAll(ctx, req)
+		require.NoError(t, err)
+		result := make([]uint64, 0, len(res.Contract))
+		for _, contract := range res.Contract {
+			result = append(result, contract.Id)
+		}
+		return result
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4}, ids(&types.QueryAllContractRequest{}))
+	require.Equal(t, []uint64{1, 2}, ids(&types.QueryAllContractRequest{Provider: providerA.String()}))
+	require.Equal(t, []uint64{1, 2, 3, 4}, ids(&types.QueryAllContractRequest{Client: client.String()}))
+	require.Equal(t, []uint64{4}, ids(&types.QueryAllContractRequest{Delegate: delegate.String()}))
+	require.Equal(t, []uint64{1}, ids(&types.QueryAllContractRequest{Provider: providerA.String(), Service: common.BTCService.String()}))
+	require.Equal(t, []uint64{1, 3, 4}, ids(&types.QueryAllContractRequest{Service: common.BTCService.String()}))
+	require.Equal(t, []uint64{4}, ids(&types.QueryAllContractRequest{Type: "pay-as-you-go"}))
+	require.Equal(t, []uint64{1, 3, 4}, ids(&types.QueryAllContractRequest{Client: client.String(), State: "active"}))
+	require.Equal(t, []uint64{2}, ids(&types.QueryAllContractRequest{Client: client.String(), State: "expired"}))
+
+	// pages only count the matching contracts
+	res, err := k.ContractAll(ctx, &types.QueryAllContractRequest{Client: client.String(), State: "active", Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = k.ContractAll(ctx, &types.QueryAllContractRequest{Client: client.String(), State: "active", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 1)
+	require.EqualValues(t, 4, res.Contract[0].Id)
+
+	// stale entries are dropped when a contract changes or is removed
+	c4.Delegate = common.EmptyPubKey
+	require.NoError(t, k.SetContract(ctx, c4))
+	require.Empty(t, ids(&types.QueryAllContractRequest{Delegate: delegate.String()}))
+	k.RemoveContract(ctx, 1)
+	require.Equal(t, []uint64{2}, ids(&types.QueryAllContractRequest{Provider: providerA.String()}))
+
+	_, err = k.ContractAll(ctx, &types.QueryAllContractRequest{Provider: "bogus"})
+	require.Error(t, err)
+	_, err = k.ContractAll(ctx, &types.QueryAllContractRequest{Type: "bogus"})
+	require.Error(t, err)
+	_, err = k.ContractAll(ctx, &types.QueryAllContractRequest{State: "bogus"})
+	require.Error(t, err)
+}
+
+func TestContractsExpiring(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	for i, height := range []int64{10, 20, 20, 30, 40} {
+		contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+		contract.Id = uint64(i + 1)
+		contract.Height = height
+		contract.Duration = 100
+		require.NoError(t, k.SetContract(ctx, contract))
+	}
+
+	res, err := k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 120, ToHeight: 130})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 3)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 110, ToHeight: 140, Pagination: &query.PageRequest{Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 3)
+	require.EqualValues(t, 1, res.Contract[0].Id)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 110, ToHeight: 140, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 2)
+	require.EqualValues(t, 5, res.Contract[1].Id)
+
+	// an extension moves the contract in the index
+	contract, err := k.GetContract(ctx, 1)
+	require.NoError(t, err)
+	contract.Duration = 200
+	require.NoError(t, k.SetContract(ctx, contract))
+	res, err = k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 110, ToHeight: 110})
+	require.NoError(t, err)
+	require.Empty(t, res.Contract)
+	res, err = k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 210, ToHeight: 210})
+	require.NoError(t, err)
+	require.Len(t, res.Contract, 1)
+
+	_, err = k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 20, ToHeight: 10})
+	require.Error(t, err)
+}
+
+func TestProviderAllByService(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	for _, service := range []common.Service{common.BTCService, common.BTCService, common.ETHService} {
+		provider := types.NewProvider(types.GetRandomPubKey(), service)
+		provider.Bond = cosmos.NewInt(100)
+		require.NoError(t, k.SetProvider(ctx, provider))
+	}
+
+	res, err := k.ProviderAll(ctx, &types.QueryAllProviderRequest{Service: common.BTCService.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Provider, 2)
+	for _, provider := range res.Provider {
+		require.True(t, provider.Service.Equals(common.BTCService))
+	}
+
+	// unbonded providers leave the index with the record
+	provider := res.Provider[0]
+	provider.Bond = cosmos.ZeroInt()
+	require.NoError(t, k.SetProvider(ctx, provider))
+	res, err = k.ProviderAll(ctx, &types.QueryAllProviderRequest{Service: common.BTCService.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Provider, 1)
+
+	res, err = k.ProviderAll(ctx, &types.QueryAllProviderRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Provider, 2)
+}
+
+func TestRebuildIndexes(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+	contract.Id = 1
+	contract.Height = 10
+	contract.Duration = 10
+	require.NoError(t, k.SetContract(ctx, contract))
+	provider := types.NewProvider(contract.Provider, common.BTCService)
+	provider.Bond = cosmos.NewInt(100)
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	// drop the index entries as if written before the indexes existed
+	kvStore := k.(KVStore)
+	store := ctx.KVStore(kvStore.storeKey)
+	for _, key := range kvStore.contractIndexKeys(ctx, contract) {
+		store.Delete([]byte(key))
+	}
+	store.Delete([]byte(kvStore.getProviderServiceIndexKey(ctx, provider)))
+
+	contracts, err := k.ContractAll(ctx, &types.QueryAllContractRequest{Provider: contract.Provider.String()})
+	require.NoError(t, err)
+	require.Empty(t, contracts.Contract)
+
+	require.NoError(t, k.RebuildIndexes(ctx))
+
+	contracts, err = k.ContractAll(ctx, &types.QueryAllContractRequest{Provider: contract.Provider.String()})
+	require.NoError(t, err)
+	require.Len(t, contracts.Contract, 1)
+	expiring, err := k.ContractsExpiring(ctx, &types.QueryContractsExpiringRequest{FromHeight: 20, ToHeight: 20})
+	require.NoError(t, err)
+	require.Len(t, expiring.Contract, 1)
+	providers, err := k.ProviderAll(ctx, &types.QueryAllProviderRequest{Service: common.BTCService.String()})
+	require.NoError(t, err)
+	require.Len(t, providers.Provider, 1)
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
 	"cosmossdk.io/store/prefix"
@@ -20,8 +22,35 @@ func (k KVStore) ProviderAll(c context.Context, req *types.QueryAllProviderReque
 
 	var providers []types.Provider
 	ctx := sdk.UnwrapSDKContext(c)
+	// reputations are returned as of the current block
+	halfLife := k.GetConfigValues(ctx).GetInt64Value(configs.ReputationHalfLife)
 
 	store := ctx.KVStore(k.storeKey)
+	if req.Service != "" {
+		service, _, err := k.ResolveServiceEnum(ctx, req.Service)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid service")
+		}
+
+		// the service index points to the keys of the provider records
+		indexStore := prefix.NewStore(store, types.KeyPrefix(k.getProviderServiceIndexPrefix(ctx, service)))
+		pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, value []byte) error {
+			var provider types.Provider
+			if _, err := k.getProvider(ctx, string(value), &provider); err != nil {
+				return err
+			}
+
+			provider.Reputation = provider.Reputation.Decay(ctx.BlockHeight(), halfLife)
+			providers = append(providers, provider)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryAllProviderResponse{Provider: providers, Pagination: pageRes}, nil
+	}
+
 	providerStore := prefix.NewStore(store, types.KeyPrefix(prefixProvider.String()))
 
 	pageRes, err := query.Paginate(providerStore, req.Pagination, func(key, value []byte) error {
@@ -30,6 +59,7 @@ func (k KVStore) ProviderAll(c context.Context, req *types.QueryAllProviderReque
 			return err
 		}
 
+		provider.Reputation = provider.Reputation.Decay(ctx.BlockHeight(), halfLife)
 		providers = append(providers, provider)
 		return nil
 	})
@@ -65,5 +95,53 @@ func (k KVStore) FetchProvider(c context.Context, req *types.QueryFetchProviderR
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
+	val.Reputation = val.Reputation.Decay(ctx.BlockHeight(), k.GetConfigValues(ctx).GetInt64Value(configs.ReputationHalfLife))
 	return &types.QueryFetchProviderResponse{Provider: val}, nil
 }
+
+func (k KVStore) ProviderUnbondings(c context.Context, req *types.QueryProviderUnbondingsRequest) (*types.QueryProviderUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pk, err := common.NewPubKey(req.Pubkey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pubkey")
+	}
+
+	var service common.Service
+	if len(req.Service) > 0 {
+		service, _, err = k.ResolveServiceEnum(ctx, req.Service)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "service not found")
+		}
+	}
+
+	var unbondings []types.ProviderUnbondingSet
+	iter := k.GetProviderUnbondingSetIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var set types.ProviderUnbondingSet
+		if err := k.cdc.Unmarshal(iter.Value(), &set); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pending := types.ProviderUnbondingSet{Height: set.Height}
+		for _, unbonding := range set.Unbondings {
+			if !unbonding.Provider.Equals(pk) || (len(req.Service) > 0 && unbonding.Service != service) {
+				continue
+			}
+			pending.Append(unbonding)
+		}
+		if len(pending.Unbondings) > 0 {
+			unbondings = append(unbondings, pending)
+		}
+	}
+
+	// store keys don't sort by height
+	sort.Slice(unbondings, func(i, j int) bool {
+		return unbondings[i].Height < unbondings[j].Height
+	})
+
+	return &types.QueryProviderUnbondingsResponse{Unbondings: unbondings}, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// Secondary indexes are value-less lookups kept next to the records they
+// point to, so that queries don't have to page through every contract or
+// provider. Ids and heights are zero padded for the keys to sort in order.
+
+func (k KVStore) getProviderServiceIndexPrefix(ctx cosmos.Context, service common.Service) string {
+	return k.GetKey(ctx, prefixProviderServiceIndex, fmt.Sprintf("%d/", service))
+}
+
+func (k KVStore) getProviderServiceIndexKey(ctx cosmos.Context, provider types.Provider) string {
+	return k.getProviderServiceIndexPrefix(ctx, provider.Service) + provider.PubKey.String()
+}
+
+func (k KVStore) getContractPubKeyIndexPrefix(ctx cosmos.Context, prefix dbPrefix, pubkey common.PubKey) string {
+	return k.GetKey(ctx, prefix, fmt.Sprintf("%s/", pubkey))
+}
+
+func (k KVStore) getContractExpiryIndexPrefix(ctx cosmos.Context) string {
+	return k.GetKey(ctx, prefixContractExpiryIndex, "")
+}
+
+func contractExpiryIndexHeight(height int64) string {
+	return fmt.Sprintf("%020d/", height)
+}
+
+// contractIndexKeys returns the index keys of the given contract
+func (k KVStore) contractIndexKeys(ctx cosmos.Context, contract types.Contract) []string {
+	id := fmt.Sprintf("%020d", contract.Id)
+	keys := []string{
+		k.getContractPubKeyIndexPrefix(ctx, prefixContractProviderIndex, contract.Provider) + id,
+		k.getContractPubKeyIndexPrefix(ctx, prefixContractClientIndex, contract.Client) + id,
+		k.getContractExpiryIndexPrefix(ctx) + contractExpiryIndexHeight(contract.Expiration()) + id,
+	}
+	if !contract.Delegate.IsEmpty() {
+		keys = append(keys, k.getContractPubKeyIndexPrefix(ctx, prefixContractDelegateIndex, contract.Delegate)+id)
+	}
+	return keys
+}
+
+func (k KVStore) setContractIndexes(ctx cosmos.Context, contract types.Contract) {
+	store := ctx.KVStore(k.storeKey)
+	value := []byte(strconv.FormatUint(contract.Id, 10))
+	for _, key := range k.contractIndexKeys(ctx, contract) {
+		store.Set([]byte(key), value)
+	}
+}
+
+func (k KVStore) removeContractIndexes(ctx cosmos.Context, contract types.Contract) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range k.contractIndexKeys(ctx, contract) {
+		store.Delete([]byte(key))
+	}
+}
+
+// getIndexedContract gets the contract an index entry points to
+func (k KVStore) getIndexedContract(ctx cosmos.Context, value []byte) (types.Contract, error) {
+	id, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return types.Contract{}, err
+	}
+	return k.GetContract(ctx, id)
+}
+
+// RebuildIndexes writes the index entries of every stored contract and
+// provider, for stores written before the indexes existed
+func (k KVStore) RebuildIndexes(ctx cosmos.Context) error {
+	// collect the records first, the store can't be written while iterated
+	contracts := make([]types.Contract, 0)
+	contractIter := k.GetContractIterator(ctx)
+	for ; contractIter.Valid(); contractIter.Next() {
+		var contract types.Contract
+		if err := k.cdc.Unmarshal(contractIter.Value(), &contract); err != nil {
+			contractIter.Close()
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+	contractIter.Close()
+
+	providers := make([]types.Provider, 0)
+	providerKeys := make([]string, 0)
+	providerIter := k.GetProviderIterator(ctx)
+	for ; providerIter.Valid(); providerIter.Next() {
+		var provider types.Provider
+		if err := k.cdc.Unmarshal(providerIter.Value(), &provider); err != nil {
+			providerIter.Close()
+			return err
+		}
+		providers = append(providers, provider)
+		providerKeys = append(providerKeys, string(providerIter.Key()))
+	}
+	providerIter.Close()
+
+	for _, contract := range contracts {
+		k.setContractIndexes(ctx, contract)
+	}
+	store := ctx.KVStore(k.storeKey)
+	for i, provider := range providers {
+		store.Set([]byte(k.getProviderServiceIndexKey(ctx, provider)), []byte(providerKeys[i]))
+	}
+	return nil
+}
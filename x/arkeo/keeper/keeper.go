@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -56,6 +57,7 @@ type Keeper interface {
 	GetModuleAccAddress(module string) cosmos.AccAddress
 	GetBalance(ctx cosmos.Context, addr cosmos.AccAddress) cosmos.Coins
 	HasCoins(ctx cosmos.Context, addr cosmos.AccAddress, coins cosmos.Coins) bool
+	HasGrant(ctx cosmos.Context, grantee, granter cosmos.AccAddress, msgType string) bool
 
 	// passthrough funcs
 	SendCoins(ctx cosmos.Context, from, to cosmos.AccAddress, coins cosmos.Coins) error
@@ -75,6 +77,10 @@ type Keeper interface {
 	FetchContract(c context.Context, req *types.QueryFetchContractRequest) (*types.QueryFetchContractResponse, error)
 	ContractAll(c context.Context, req *types.QueryAllContractRequest) (*types.QueryAllContractResponse, error)
 	ActiveContract(goCtx context.Context, req *types.QueryActiveContractRequest) (*types.QueryActiveContractResponse, error)
+	ProviderUnbondings(c context.Context, req *types.QueryProviderUnbondingsRequest) (*types.QueryProviderUnbondingsResponse, error)
+	Configs(c context.Context, req *types.QueryConfigsRequest) (*types.QueryConfigsResponse, error)
+	FetchContractGroup(c context.Context, req *types.QueryFetchContractGroupRequest) (*types.QueryFetchContractGroupResponse, error)
+	ContractsExpiring(c context.Context, req *types.QueryContractsExpiringRequest) (*types.QueryContractsExpiringResponse, error)
 
 	// Keeper Interfaces
 	KeeperProvider
@@ -92,6 +98,16 @@ type Keeper interface {
 	EnsureServiceRegistrySeeded(ctx cosmos.Context)
 	GetAuthority() string
 
+	// Configs
+	GetConfigValues(ctx cosmos.Context) configs.ConfigValues
+	GetConfigOverrideIterator(ctx cosmos.Context) cosmos.Iterator
+	GetConfigOverride(ctx cosmos.Context, name string) (types.ConfigOverride, bool)
+	SetConfigOverride(ctx cosmos.Context, override types.ConfigOverride) error
+	RemoveConfigOverride(ctx cosmos.Context, name string)
+
+	// Indexes
+	RebuildIndexes(ctx cosmos.Context) error
+
 	//Upgrade Plan Emission Curve
 	UpgradeEmissionCurve(ctx context.Context, newValue uint64) (bool, error)
 }
@@ -102,6 +118,19 @@ type KeeperProvider interface {
 	SetProvider(_ cosmos.Context, _ types.Provider) error
 	ProviderExists(_ cosmos.Context, _ common.PubKey, _ common.Service) bool
 	RemoveProvider(_ cosmos.Context, _ common.PubKey, _ common.Service)
+	GetProviderUnbondingSetIterator(_ cosmos.Context) cosmos.Iterator
+	GetProviderUnbondingSet(_ cosmos.Context, _ int64) (types.ProviderUnbondingSet, error)
+	SetProviderUnbondingSet(_ cosmos.Context, _ types.ProviderUnbondingSet) error
+	RemoveProviderUnbondingSet(_ cosmos.Context, _ int64)
+	HasEvidence(_ cosmos.Context, _ uint64, _ int64) bool
+	SetEvidence(_ cosmos.Context, _ uint64, _ int64)
+	GetEvidenceIterator(_ cosmos.Context) cosmos.Iterator
+	GetProberIterator(_ cosmos.Context) cosmos.Iterator
+	IsProber(_ cosmos.Context, _ cosmos.AccAddress) bool
+	AddProber(_ cosmos.Context, _ cosmos.AccAddress)
+	RemoveProber(_ cosmos.Context, _ cosmos.AccAddress)
+	GetLastAttestation(_ cosmos.Context, _ common.PubKey, _ common.Service, _ cosmos.AccAddress) int64
+	SetLastAttestation(_ cosmos.Context, _ common.PubKey, _ common.Service, _ cosmos.AccAddress, _ int64)
 }
 
 type KeeperContract interface {
@@ -122,6 +151,10 @@ type KeeperContract interface {
 	SetUserContractSet(ctx cosmos.Context, contractSet types.UserContractSet) error
 	GetUserContractSet(ctx cosmos.Context, pubkey common.PubKey) (types.UserContractSet, error)
 	GetActiveContractForUser(ctx cosmos.Context, user, provider common.PubKey, service common.Service) (types.Contract, error)
+	GetContractGroupIterator(_ cosmos.Context) cosmos.Iterator
+	GetContractGroup(_ cosmos.Context, _ uint64) (types.ContractGroup, error)
+	SetContractGroup(_ cosmos.Context, _ types.ContractGroup) error
+	RemoveContractGroup(_ cosmos.Context, _ uint64)
 }
 
 const (
@@ -131,6 +164,17 @@ const (
 	prefixContractNextId        dbPrefix = "cni/"
 	prefixContractExpirationSet dbPrefix = "ces/"
 	prefixUserContractSet       dbPrefix = "ucs/"
+	prefixProviderUnbondingSet  dbPrefix = "pus/"
+	prefixEvidence              dbPrefix = "ev/"
+	prefixConfigOverride        dbPrefix = "cfg/"
+	prefixContractGroup         dbPrefix = "cg/"
+	prefixProviderServiceIndex  dbPrefix = "psi/"
+	prefixContractProviderIndex dbPrefix = "cpi/"
+	prefixContractClientIndex   dbPrefix = "cci/"
+	prefixContractDelegateIndex dbPrefix = "cdi/"
+	prefixContractExpiryIndex   dbPrefix = "cei/"
+	prefixProber                dbPrefix = "pb/"
+	prefixAttestation           dbPrefix = "att/"
 )
 
 type KVStore struct {
@@ -145,6 +189,7 @@ type KVStore struct {
 	logger             log.Logger
 	mintKeeper         minttypes.Keeper
 	distributionKeeper distkeeper.Keeper
+	authzKeeper        authzkeeper.Keeper
 }
 
 func NewKVStore(
@@ -159,6 +204,7 @@ func NewKVStore(
 	logger log.Logger,
 	mintKeeper minttypes.Keeper,
 	distributionKeeper distkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
 ) *KVStore {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -177,6 +223,7 @@ func NewKVStore(
 		logger:             logger,
 		mintKeeper:         mintKeeper,
 		distributionKeeper: distributionKeeper,
+		authzKeeper:        authzKeeper,
 	}
 }
 
@@ -224,7 +271,7 @@ func (k KVStore) GetComputedVersion(ctx cosmos.Context) int64 {
 	}
 
 	currentVersion := k.GetVersion(ctx)
-	minNum := configs.GetConfigValues(currentVersion).GetInt64Value(configs.VersionConsensus)
+	minNum := k.GetConfigValues(ctx).GetInt64Value(configs.VersionConsensus)
 	min := int64(len(validators)) * minNum / 100
 
 	for _, val := range validators {
@@ -341,6 +388,12 @@ func (k KVStore) SendFromAccountToModule(ctx cosmos.Context, from cosmos.AccAddr
 	return k.coinKeeper.SendCoinsFromAccountToModule(ctx, from, to, coins)
 }
 
+// HasGrant tells if granter authorized grantee to send msgType through authz
+func (k KVStore) HasGrant(ctx cosmos.Context, grantee, granter cosmos.AccAddress, msgType string) bool {
+	authorization, _ := k.authzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	return authorization != nil
+}
+
 // SendFromModuleToAccount transfer fund from module to an account
 func (k KVStore) SendFromModuleToAccount(ctx cosmos.Context, from string, to cosmos.AccAddress, coins cosmos.Coins) error {
 	return k.coinKeeper.SendCoinsFromModuleToAccount(ctx, from, to, coins)
@@ -102,6 +102,10 @@ func (k KVStoreDummy) HasCoins(ctx cosmos.Context, addr cosmos.AccAddress, coins
 	return false
 }
 
+func (k KVStoreDummy) HasGrant(ctx cosmos.Context, grantee, granter cosmos.AccAddress, msgType string) bool {
+	return false
+}
+
 func (k KVStoreDummy) GetActiveValidators(ctx cosmos.Context) []stakingtypes.Validator {
 	return nil
 }
@@ -20,10 +20,12 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -62,6 +64,7 @@ func SetupKeeper(t testing.TB) (cosmos.Context, Keeper) {
 	tkeyParams := cosmos.NewTransientStoreKey(typesparams.TStoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	keydist := cosmos.NewKVStoreKey(disttypes.StoreKey)
+	keyAuthz := cosmos.NewKVStoreKey(authzkeeper.StoreKey)
 
 	cfg := sdk.GetConfig()
 
@@ -78,6 +81,7 @@ func SetupKeeper(t testing.TB) (cosmos.Context, Keeper) {
 	stateStore.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(tkeyParams, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(keyAuthz, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	encodingConfig := arekoappParams.MakeEncodingConfig()
@@ -154,6 +158,8 @@ func SetupKeeper(t testing.TB) (cosmos.Context, Keeper) {
 		govModuleAddr,
 	)
 
+	azk := authzkeeper.NewKeeper(runtime.NewKVStoreService(keyAuthz), cdc, baseapp.NewMsgServiceRouter(), ak)
+
 	k := NewKVStore(
 		cdc,
 		storeKey,
@@ -166,6 +172,7 @@ func SetupKeeper(t testing.TB) (cosmos.Context, Keeper) {
 		logger,
 		mk,
 		dk,
+		azk,
 	)
 	k.SetVersion(ctx, common.GetCurrentVersion())
 
@@ -186,6 +193,7 @@ func SetupKeeperWithStaking(t testing.TB) (cosmos.Context, Keeper, stakingkeeper
 	tkeyParams := cosmos.NewTransientStoreKey(typesparams.TStoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	keydist := cosmos.NewKVStoreKey(disttypes.StoreKey)
+	keyAuthz := cosmos.NewKVStoreKey(authzkeeper.StoreKey)
 
 	cfg := sdk.GetConfig()
 
@@ -203,6 +211,7 @@ func SetupKeeperWithStaking(t testing.TB) (cosmos.Context, Keeper, stakingkeeper
 	stateStore.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(tkeyParams, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(keyAuthz, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keydist, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
@@ -282,6 +291,8 @@ func SetupKeeperWithStaking(t testing.TB) (cosmos.Context, Keeper, stakingkeeper
 	)
 	_ = dk.FeePool.Set(ctx, disttypes.FeePool{CommunityPool: []sdk.DecCoin{sdk.NewDecCoin(configs.Denom, math.NewInt(10000))}})
 
+	azk := authzkeeper.NewKeeper(runtime.NewKVStoreService(keyAuthz), cdc, baseapp.NewMsgServiceRouter(), ak)
+
 	k := NewKVStore(
 		cdc,
 		storeKey,
@@ -294,6 +305,7 @@ func SetupKeeperWithStaking(t testing.TB) (cosmos.Context, Keeper, stakingkeeper
 		logger,
 		mk,
 		dk,
+		azk,
 	)
 	k.SetVersion(ctx, common.GetCurrentVersion())
 
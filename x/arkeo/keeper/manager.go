@@ -100,6 +100,9 @@ func (mgr Manager) EndBlock(ctx cosmos.Context) error {
 	if err := mgr.ContractEndBlock(ctx); err != nil {
 		mgr.keeper.Logger().Error("unable to settle contracts", "error", err)
 	}
+	if err := mgr.ProviderUnbondingEndBlock(ctx); err != nil {
+		mgr.keeper.Logger().Error("unable to release provider unbondings", "error", err)
+	}
 
 	// invariant checks
 	if err := mgr.invariantBondModule(ctx); err != nil {
@@ -115,7 +118,7 @@ func (mgr Manager) EndBlock(ctx cosmos.Context) error {
 }
 
 func (mgr Manager) Configs(ctx cosmos.Context) configs.ConfigValues {
-	return configs.GetConfigValues(mgr.keeper.GetVersion(ctx))
+	return mgr.keeper.GetConfigValues(ctx)
 }
 
 // test that the bond module has enough bond in it
@@ -139,6 +142,24 @@ func (mgr Manager) invariantBondModule(ctx cosmos.Context) error {
 		sum = sum.Add(provider.Bond)
 	}
 
+	unbondings := mgr.keeper.GetProviderUnbondingSetIterator(ctx)
+	defer func(iter cosmos.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			mgr.keeper.Logger().Error("fail to iter", "error", err)
+		}
+	}(unbondings)
+	for ; unbondings.Valid(); unbondings.Next() {
+		var set types.ProviderUnbondingSet
+		if err := mgr.keeper.Cdc().Unmarshal(unbondings.Value(), &set); err != nil {
+			mgr.keeper.Logger().Error("fail to unmarshal provider unbonding set", "error", err)
+			continue
+		}
+		for _, unbonding := range set.Unbondings {
+			sum = sum.Add(unbonding.Amount)
+		}
+	}
+
 	if sum.GT(balance) {
 		// TODO: instead of returning an error and causing a panic, pause the bond provider handler and allow the chain to continue to function
 		return errors.Wrapf(types.ErrInvariantBondModule, "bond module does not have enough token in it to back the bond records (%s/%s)", sum.String(), balance.String())
@@ -166,7 +187,30 @@ func (mgr Manager) invariantContractModule(ctx cosmos.Context) error {
 		if contract.IsSettled(ctx.BlockHeight()) {
 			continue
 		}
-		sums = sums.Add(cosmos.NewCoin(contract.Rate.Denom, contract.Deposit.Sub(contract.Paid)))
+		// the deposit of group members is backed by their group
+		if contract.IsGroupMember() {
+			continue
+		}
+		sums = sums.Add(cosmos.NewCoin(contract.Rate.Denom, contract.Deposit.Sub(contract.Paid).Add(contract.GetRenewalEscrow())))
+	}
+
+	groupIter := mgr.keeper.GetContractGroupIterator(ctx)
+	defer func(iter cosmos.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			mgr.keeper.Logger().Error("fail to iter", "error", err)
+		}
+	}(groupIter)
+	for ; groupIter.Valid(); groupIter.Next() {
+		var group types.ContractGroup
+		if err := mgr.keeper.Cdc().Unmarshal(groupIter.Value(), &group); err != nil {
+			ctx.Logger().Error("fail to unmarshal contract group", "error", err)
+			continue
+		}
+		if group.SettlementHeight > 0 {
+			continue
+		}
+		sums = sums.Add(cosmos.NewCoin(group.Denom, group.Remaining()))
 	}
 
 	for _, sum := range sums {
@@ -214,12 +258,24 @@ func (mgr Manager) ContractEndBlock(ctx cosmos.Context) error {
 			continue
 		}
 
-		_, err = mgr.SettleContract(ctx, contract, 0, true)
+		// the renewal escrow is kept for the successor rather than refunded
+		// by the settlement
+		renew, escrow := contract.AutoRenew, contract.GetRenewalEscrow()
+		if renew {
+			contract.AutoRenew = false
+			contract.RenewalEscrow = cosmos.ZeroInt()
+		}
+
+		contract, err = mgr.SettleContract(ctx, contract, 0, true)
 		if err != nil {
 			ctx.Logger().Error("unable to settle contract", "id", contractId, "error", err)
 			continue
 		}
 		mgr.keeper.RemoveContractExpirationSet(ctx, contract.Expiration())
+
+		if renew {
+			mgr.RenewContract(ctx, contract, escrow)
+		}
 	}
 
 	return nil
@@ -336,9 +392,9 @@ func (mgr Manager) calcBlockReward(ctx cosmos.Context, totalReserve, emissionCur
 	return sdk.NewDecCoin(configs.Denom, blockReward)
 }
 
+// FetchConfig returns the value of a config, overrides set with MsgSetConfig
+// take precedence over the compiled values
 func (mgr Manager) FetchConfig(ctx cosmos.Context, name configs.ConfigName) int64 {
-	// TODO: create a handler for admins to be able to change configs on the
-	// fly and check them here before returning
 	return mgr.Configs(ctx).GetInt64Value(name)
 }
 
@@ -353,6 +409,8 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 		"contract.Nonce:", contract.Nonce,
 	)
 
+	// the spender isn't charged past the nonce limit
+	nonce = contract.CapNonce(nonce)
 	if nonce > contract.Nonce {
 		contract.Nonce = nonce
 	}
@@ -395,14 +453,19 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 	)
 
 	contract.Paid = contract.Paid.Add(totalDebt)
+	contract.AddDelegateSpent(ctx.BlockHeight(), totalDebt)
 	if isFinal {
+		// the remainder of a group member is refunded with its group
 		remainder := contract.Deposit.Sub(contract.Paid)
+		if contract.IsGroupMember() {
+			remainder = cosmos.ZeroInt()
+		}
 		if !remainder.IsZero() {
-			client, err := contract.Client.GetMyAddress()
+			payer, err := contract.PayerAddress()
 			if err != nil {
 				return contract, err
 			}
-			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, client, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, remainder))); err != nil {
+			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, payer, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, remainder))); err != nil {
 				return contract, err
 			}
 			// now that the user has some of their funds refunded, the deposit
@@ -416,11 +479,24 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 				"contract_id (contract.Id):", contract.Id,
 				"isFinal (isFinal):", isFinal,
 				"remainder (remainder):", remainder.String(),
-				"client (client):", client.String(),
+				"payer (payer):", payer.String(),
 				"contract.Paid (contract.Paid):", contract.Paid.String(),
 			)
 
 		}
+		// a settled contract is no longer renewed, the renewal escrow goes
+		// back to the payer
+		if escrow := contract.GetRenewalEscrow(); escrow.IsPositive() {
+			payer, err := contract.PayerAddress()
+			if err != nil {
+				return contract, err
+			}
+			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, payer, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, escrow))); err != nil {
+				return contract, err
+			}
+			contract.RenewalEscrow = cosmos.ZeroInt()
+		}
+		contract.AutoRenew = false
 		contract.SettlementHeight = ctx.BlockHeight()
 		// this contract can now be removed from the users list of contracts
 		err = mgr.keeper.RemoveFromUserContractSet(ctx, contract.GetSpender(), contract.Id)
@@ -441,6 +517,12 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 		return contract, err
 	}
 
+	if contract.IsGroupMember() {
+		if err := mgr.settleContractGroup(ctx, contract.GroupId, totalDebt); err != nil {
+			return contract, err
+		}
+	}
+
 	mgr.keeper.Logger().Info(
 		"SettleContract Debug Values (6)",
 		"totalDebt:", totalDebt,
@@ -473,11 +555,9 @@ func (mgr Manager) contractDebt(ctx cosmos.Context, contract types.Contract) (co
 		if height > contract.SettlementPeriodEnd() {
 			height = contract.SettlementPeriodEnd()
 		}
-		// debt = contract.Rate.Amount.MulRaw(height - contract.Height).Sub(contract.Paid)
-		// Missing QPM in the formula.
-		debt = contract.Rate.Amount.MulRaw(height - contract.Height).MulRaw(contract.QueriesPerMinute).Sub(contract.Paid)
+		debt = contract.SubscriptionCost(height - contract.Height).Sub(contract.Paid)
 	case types.ContractType_PAY_AS_YOU_GO:
-		debt = contract.Rate.Amount.MulRaw(contract.Nonce).Sub(contract.Paid)
+		debt = contract.PayAsYouGoCost(contract.Nonce).Sub(contract.Paid)
 	default:
 		return cosmos.ZeroInt(), errors.Wrapf(types.ErrInvalidContractType, "%s", contract.Type.String())
 	}
@@ -488,12 +568,83 @@ func (mgr Manager) contractDebt(ctx cosmos.Context, contract types.Contract) (co
 
 	// sanity check, ensure provider cannot take more than deposited into the contract
 	if contract.Paid.Add(debt).GT(contract.Deposit) {
-		return contract.Deposit.Sub(contract.Paid), nil
+		debt = contract.Deposit.Sub(contract.Paid)
+	}
+
+	// the spender can't use more than its limit allows in the period
+	if allowance, limited := contract.DelegateAllowance(ctx.BlockHeight()); limited && debt.GT(allowance) {
+		debt = allowance
+	}
+
+	// group members share one deposit, paid first come first served
+	if contract.IsGroupMember() {
+		group, err := mgr.keeper.GetContractGroup(ctx, contract.GroupId)
+		if err != nil {
+			return cosmos.ZeroInt(), err
+		}
+		if remaining := group.Remaining(); debt.GT(remaining) {
+			debt = remaining
+		}
 	}
 
 	return debt, nil
 }
 
+// settleContractGroup accounts the debt paid to a member of the group. The
+// deposit of the members still open is lowered to what they were paid plus
+// what is left to the group, so the sentinels of the other providers stop
+// serving once the shared deposit is spent. Once every member is settled, what
+// is left of the deposit is refunded to the client.
+func (mgr Manager) settleContractGroup(ctx cosmos.Context, id uint64, debt cosmos.Int) error {
+	group, err := mgr.keeper.GetContractGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	if group.IsEmpty() {
+		return errors.Wrapf(types.ErrContractGroupInvalid, "group %d not found", id)
+	}
+	group.Paid = group.Paid.Add(debt)
+
+	settled := group.SettlementHeight == 0
+	for _, contractId := range group.ContractIds {
+		member, err := mgr.keeper.GetContract(ctx, contractId)
+		if err != nil {
+			return err
+		}
+		if member.SettlementHeight > 0 {
+			continue
+		}
+		settled = false
+		member.Deposit = member.Paid.Add(group.Remaining())
+		if err := mgr.keeper.SetContract(ctx, member); err != nil {
+			return err
+		}
+	}
+
+	refunded := cosmos.ZeroInt()
+	if settled {
+		refunded = group.Remaining()
+		if refunded.IsPositive() {
+			client, err := group.Client.GetMyAddress()
+			if err != nil {
+				return err
+			}
+			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, client, cosmos.NewCoins(cosmos.NewCoin(group.Denom, refunded))); err != nil {
+				return err
+			}
+		}
+		group.SettlementHeight = ctx.BlockHeight()
+	}
+
+	if err := mgr.keeper.SetContractGroup(ctx, group); err != nil {
+		return err
+	}
+	if settled {
+		return mgr.EmitSettleContractGroupEvent(ctx, group, refunded)
+	}
+	return nil
+}
+
 func (mgr Manager) reserveSupply(ctx cosmos.Context) (sdk.DecCoin, error) {
 	reserveSupply := mgr.keeper.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom)
 
@@ -29,7 +29,6 @@ func NewMsgServerImpl(keeper Keeper, sk stakingkeeper.Keeper) types.MsgServer {
 var _ types.MsgServer = msgServer{}
 
 func (k msgServer) FetchConfig(ctx cosmos.Context, name configs.ConfigName) int64 {
-	// TODO: use ctx to fetch config overrides from the chain state
 	return k.mgr.Configs(ctx).GetInt64Value(name)
 }
 
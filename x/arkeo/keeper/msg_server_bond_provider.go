@@ -86,14 +86,39 @@ func (k msgServer) BondProviderHandle(ctx cosmos.Context, msg *types.MsgBondProv
 		if provider.Bond.LT(coins[0].Amount) {
 			return errors.Wrapf(types.ErrInsufficientFunds, "not enough bond to satisfy bond request: %d/%d", coins[0].Amount.Int64(), provider.Bond.Int64())
 		}
-		if err := k.SendFromModuleToAccount(ctx, types.ProviderName, addr, coins); err != nil {
+		// the withdrawal is held, and can be slashed, for the unbonding
+		// period so that a provider can't pull its bond ahead of evidence
+		period := k.FetchConfig(ctx, configs.ProviderUnbondingPeriod)
+		if period <= 0 {
+			if err := k.SendFromModuleToAccount(ctx, types.ProviderName, addr, coins); err != nil {
+				return err
+			}
+			break
+		}
+		set, err := k.GetProviderUnbondingSet(ctx, ctx.BlockHeight()+period)
+		if err != nil {
+			return err
+		}
+		unbonding := types.ProviderUnbonding{
+			Provider: provider.PubKey,
+			Service:  provider.Service,
+			Amount:   coins[0].Amount,
+		}
+		set.Append(unbonding)
+		if err := k.SetProviderUnbondingSet(ctx, set); err != nil {
+			return err
+		}
+		if err := k.EmitProviderUnbondingEvent(ctx, unbonding, set.Height); err != nil {
 			return err
 		}
 	default:
 		return fmt.Errorf("dev error: bond is neither positive or negative")
 	}
 	provider.Bond = provider.Bond.Add(msg.Bond)
-	if provider.Bond.IsZero() {
+	if !provider.IsJailed(ctx.BlockHeight()) {
+		provider.JailedUntil = 0
+	}
+	if provider.Bond.IsZero() && provider.JailedUntil == 0 {
 		k.RemoveProvider(ctx, provider.PubKey, provider.Service)
 		return k.EmitBondProviderEvent(ctx, provider.Bond, msg)
 	}
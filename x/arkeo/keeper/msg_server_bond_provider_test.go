@@ -57,8 +57,41 @@ func TestHandle(t *testing.T) {
 	msg.Bond = cosmos.NewInt(common.Tokens(-8))
 	err = s.BondProviderHandle(ctx, &msg)
 	require.NoError(t, err)
+	require.False(t, k.ProviderExists(ctx, providerPubKey, common.BTCService)) // should be removed
 
+	// bond is held for the unbonding period
+	bal = k.GetBalance(ctx, acct)
+	require.Equal(t, bal.AmountOf(configs.Denom).Int64(), common.Tokens(2))
+	release := ctx.BlockHeight() + s.FetchConfig(ctx, configs.ProviderUnbondingPeriod)
+	set, err := k.GetProviderUnbondingSet(ctx, release)
+	require.NoError(t, err)
+	require.Len(t, set.Unbondings, 1)
+	require.Equal(t, set.Unbondings[0].Amount.Int64(), common.Tokens(8))
+	require.NoError(t, s.mgr.invariantBondModule(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeProviderUnbonding))
+
+	res, err := k.ProviderUnbondings(ctx, &types.QueryProviderUnbondingsRequest{Pubkey: providerPubKey.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProviderUnbondingSet{set}, res.Unbondings)
+	res, err = k.ProviderUnbondings(ctx, &types.QueryProviderUnbondingsRequest{Pubkey: providerPubKey.String(), Service: common.ETHService.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Unbondings)
+
+	ctx = ctx.WithEventManager(cosmos.NewEventManager())
+	require.NoError(t, s.mgr.ProviderUnbondingEndBlock(ctx.WithBlockHeight(release)))
+	require.True(t, hasEvent(ctx, types.EventTypeProviderUnbonded))
 	bal = k.GetBalance(ctx, acct) // check balance
 	require.Equal(t, bal.AmountOf(configs.Denom).Int64(), common.Tokens(10))
-	require.False(t, k.ProviderExists(ctx, providerPubKey, common.BTCService)) // should be removed
+	set, err = k.GetProviderUnbondingSet(ctx, release)
+	require.NoError(t, err)
+	require.Empty(t, set.Unbondings)
+}
+
+func hasEvent(ctx cosmos.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
 }
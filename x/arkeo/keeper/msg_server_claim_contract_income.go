@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"fmt"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
@@ -58,6 +60,10 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 		return errors.Wrapf(types.ErrClaimContractIncomeClosed, "settled on block: %d", contract.SettlementPeriodEnd())
 	}
 
+	if maxNonce := contract.DelegateLimit.MaxNonce; maxNonce > 0 && contract.Nonce >= maxNonce {
+		return errors.Wrapf(types.ErrDelegateLimitExceeded, "contract nonce (%d) reached the limit (%d)", contract.Nonce, maxNonce)
+	}
+
 	// open subscription contracts do NOT need to verify the signature
 	if !(contract.IsSubscription() && contract.IsOpenAuthorization()) {
 		// Verify with the contract's spender (client) pubkey using preimage "<cid>:<nonce>:<chain_id>".
@@ -105,30 +111,12 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 			"sig_hex", sigHexFull,
 		)
 
-		preNoChain := fmt.Sprintf("%d:%d:", msg.ContractId, msg.Nonce)
-
-		// Try multiple verification paths for compatibility:
-		// 1) raw preimage with chain-id
-		// 2) sha256(preimage with chain-id)
-		// 3) raw preimage without chain-id
-		// 4) sha256(preimage without chain-id)
-		preNoChainDigest := sha256.Sum256([]byte(preNoChain))
-		ok := pk.VerifySignature([]byte(pre), msg.Signature) ||
-			pk.VerifySignature(digest[:], msg.Signature) ||
-			pk.VerifySignature([]byte(preNoChain), msg.Signature) ||
-			pk.VerifySignature(preNoChainDigest[:], msg.Signature)
+		ok := verifyClientSignature(pk, msg.ContractId, msg.Nonce, ctx.ChainID(), msg.Signature)
 
 		if !ok && highS {
 			// normalize to low-S for dev/local testing only
-			s.Sub(secpN, s)
-			rb := r.FillBytes(make([]byte, 32))
-			sb := s.FillBytes(make([]byte, 32))
-			norm := append(rb, sb...)
 			ctx.Logger().Info("claim sig normalized to low-S", "nonce", msg.Nonce)
-			ok = pk.VerifySignature([]byte(pre), norm) ||
-				pk.VerifySignature(digest[:], norm) ||
-				pk.VerifySignature([]byte(preNoChain), norm) ||
-				pk.VerifySignature(preNoChainDigest[:], norm)
+			ok = verifyClientSignature(pk, msg.ContractId, msg.Nonce, ctx.ChainID(), normalizeLowS(msg.Signature))
 			if ok {
 				ctx.Logger().Info("claim sig normalized verification succeeded",
 					"contract_id", msg.ContractId,
@@ -136,7 +124,7 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 					"preimage", pre,
 					"digest_hex", fmt.Sprintf("%x", digest[:]),
 					"r_hex", fmt.Sprintf("%064x", r),
-					"s_hex", fmt.Sprintf("%064x", s),
+					"s_hex", fmt.Sprintf("%064x", new(big.Int).Sub(secpN, s)),
 					"s_high", true,
 					"normalized", true,
 				)
@@ -166,3 +154,33 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 	}
 	return nil
 }
+
+// verifyClientSignature tells if sig is a signature of the contract nonce by
+// pk, in one of the forms accepted for compatibility:
+// 1) raw preimage with chain-id
+// 2) sha256(preimage with chain-id)
+// 3) raw preimage without chain-id
+// 4) sha256(preimage without chain-id)
+func verifyClientSignature(pk cryptotypes.PubKey, contractId uint64, nonce int64, chainId string, sig []byte) bool {
+	pre := fmt.Sprintf("%d:%d:%s", contractId, nonce, chainId)
+	preNoChain := fmt.Sprintf("%d:%d:", contractId, nonce)
+	digest := sha256.Sum256([]byte(pre))
+	preNoChainDigest := sha256.Sum256([]byte(preNoChain))
+	return pk.VerifySignature([]byte(pre), sig) ||
+		pk.VerifySignature(digest[:], sig) ||
+		pk.VerifySignature([]byte(preNoChain), sig) ||
+		pk.VerifySignature(preNoChainDigest[:], sig)
+}
+
+// normalizeLowS returns the low-S form of a 64 bytes (r||s) signature
+func normalizeLowS(sig []byte) []byte {
+	if len(sig) != 64 {
+		return sig
+	}
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(secpHalfN) <= 0 {
+		return sig
+	}
+	s.Sub(secpN, s)
+	return append(append([]byte{}, sig[:32]...), s.FillBytes(make([]byte, 32))...)
+}
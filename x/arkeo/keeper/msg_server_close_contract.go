@@ -47,6 +47,9 @@ func (k msgServer) CloseContractValidate(ctx cosmos.Context, msg *types.MsgClose
 	if contract.IsEmpty() {
 		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
 	}
+	if contract.IsGroupMember() {
+		return errors.Wrapf(types.ErrContractGroupInvalid, "members of group %d can't be closed", contract.GroupId)
+	}
 
 	contractClientAddress, err := contract.Client.GetMyAddress()
 	if err != nil {
@@ -104,6 +104,7 @@ func (k msgServer) ModProviderHandle(ctx cosmos.Context, msg *types.MsgModProvid
 	provider.SubscriptionRate = msg.SubscriptionRate
 	provider.PayAsYouGoRate = msg.PayAsYouGoRate
 	provider.SettlementDuration = msg.SettlementDuration
+	provider.RateCard = msg.RateCard
 
 	provider.LastUpdate = ctx.BlockHeight()
 
@@ -75,6 +75,10 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 		return errors.Wrapf(types.ErrOpenContractBadProviderStatus, "has status %s", provider.Status.String())
 	}
 
+	if provider.IsJailed(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrProviderJailed, "jailed until block %d", provider.JailedUntil)
+	}
+
 	if msg.Duration > provider.MaxContractDuration {
 		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds allowed maximum duration from provider")
 	}
@@ -85,14 +89,18 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 
 	switch msg.ContractType {
 	case types.ContractType_SUBSCRIPTION:
-		if cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(msg.Rate.Denom).IsZero() {
+		// the rate is the one of the highest subscription tier reached by the
+		// queries per minute, the cost is discounted for long durations
+		rate := provider.SubscriptionRateOf(msg.QueriesPerMinute, msg.Rate.Denom)
+		if rate.IsZero() {
 			return errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rates is 0, client sent %d", msg.Rate.Amount.Int64())
 		}
-		if !msg.Rate.Amount.Equal(cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(msg.Rate.Denom)) {
-			return errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rates is %d, client sent %d", cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(msg.Rate.Denom).Int64(), msg.Rate.Amount.Int64())
+		if !msg.Rate.Amount.Equal(rate) {
+			return errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rates is %d, client sent %d", rate.Int64(), msg.Rate.Amount.Int64())
 		}
-		if !cosmos.NewInt(msg.Rate.Amount.Int64() * msg.Duration * msg.QueriesPerMinute).Equal(msg.Deposit) {
-			return errors.Wrapf(types.ErrOpenContractMismatchRate, "mismatch of rate*duration*queriesPerMinute and deposit: %d * %d * %d != %d", msg.Rate.Amount.Int64(), msg.Duration, msg.QueriesPerMinute, msg.Deposit.Int64())
+		discount := provider.RateCard.GetDurationDiscount(msg.Duration)
+		if cost := types.SubscriptionCost(msg.Rate.Amount, msg.Duration, msg.QueriesPerMinute, discount); !cost.Equal(msg.Deposit) {
+			return errors.Wrapf(types.ErrOpenContractMismatchRate, "mismatch of rate*duration*queriesPerMinute less %d bps discount and deposit: %d != %d", discount, cost.Int64(), msg.Deposit.Int64())
 		}
 	case types.ContractType_PAY_AS_YOU_GO:
 		if cosmos.NewCoins(provider.PayAsYouGoRate...).AmountOf(msg.Rate.Denom).IsZero() {
@@ -108,6 +116,14 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 		return errors.Wrapf(types.ErrInvalidContractType, "%s", msg.ContractType.String())
 	}
 
+	// a payer other than the client consents by granting the client
+	// MsgOpenContract through authz
+	if payer := msg.PayerAddress(); !payer.Equals(msg.MustGetSigner()) {
+		if !k.HasGrant(ctx, msg.MustGetSigner(), payer, sdk.MsgTypeURL(msg)) {
+			return errors.Wrapf(types.ErrContractPayerUnauthorized, "payer %s did not grant %s to open contracts", payer, msg.Creator)
+		}
+	}
+
 	spender, err := msg.GetSpender()
 	if err != nil {
 		return err
@@ -136,12 +152,12 @@ func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenCont
 
 	openCost := k.FetchConfig(ctx, configs.OpenContractCost)
 	if openCost > 0 {
-		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ReserveName, getCoins(openCost)); err != nil {
+		if err := k.SendFromAccountToModule(ctx, msg.PayerAddress(), types.ReserveName, getCoins(openCost)); err != nil {
 			return errors.Wrapf(err, "failed to send open contract costs openCost=%d", openCost)
 		}
 	}
 
-	if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(msg.Rate.Denom, msg.Deposit))); err != nil {
+	if err := k.SendFromAccountToModule(ctx, msg.PayerAddress(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(msg.Rate.Denom, msg.Deposit))); err != nil {
 		return errors.Wrapf(err, "failed to send deposit=%d", msg.Deposit.Int64())
 	}
 
@@ -165,6 +181,19 @@ func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenCont
 		return types.ErrInvalidPubKey
 	}
 
+	// the contract keeps the pricing of the rate card it was opened with
+	provider, err := k.GetProvider(ctx, providerPubKey, service)
+	if err != nil {
+		return err
+	}
+	var payAsYouGoTiers []types.RateTier
+	discount := int64(0)
+	if msg.ContractType == types.ContractType_PAY_AS_YOU_GO {
+		payAsYouGoTiers = provider.RateCard.PayAsYouGoTiersOf(msg.Rate.Denom)
+	} else {
+		discount = provider.RateCard.GetDurationDiscount(msg.Duration)
+	}
+
 	contract := types.Contract{
 		Provider:           providerPubKey,
 		Id:                 k.Keeper.GetAndIncrementNextContractId(ctx),
@@ -180,6 +209,16 @@ func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenCont
 		SettlementDuration: msg.SettlementDuration,
 		Authorization:      msg.Authorization,
 		QueriesPerMinute:   msg.QueriesPerMinute,
+		AutoRenew:          msg.AutoRenew,
+		RenewalEscrow:      cosmos.ZeroInt(),
+		PayAsYouGoTiers:    payAsYouGoTiers,
+		DiscountBps:        discount,
+		DelegateLimit:      types.NewDelegateLimit(msg.DelegateLimit.MaxNonce, msg.DelegateLimit.GetMaxValue(), msg.DelegateLimit.Period),
+		DelegateSpent:      cosmos.ZeroInt(),
+	}
+	// a contract paid by another account refunds it rather than the client
+	if payer := msg.PayerAddress(); !payer.Equals(msg.MustGetSigner()) {
+		contract.Payer = payer
 	}
 
 	// create expiration set
@@ -227,5 +266,5 @@ func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenCont
 		"service", svcRecord.Name,
 	)
 
-	return k.EmitOpenContractEvent(ctx, openCost, &contract)
+	return k.mgr.EmitOpenContractEvent(ctx, openCost, &contract)
 }
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) OpenContractGroup(goCtx context.Context, msg *types.MsgOpenContractGroup) (*types.MsgOpenContractGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgOpenContractGroup",
+		"providers", msg.Providers,
+		"service", msg.Service,
+		"client", msg.Client,
+		"delegate", msg.Delegate,
+		"duration", msg.Duration,
+		"rates", msg.Rates,
+		"deposit", msg.Deposit,
+		"authorization", msg.Authorization,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.OpenContractGroupValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed open contract group validation", "err", err)
+		return nil, err
+	}
+
+	group, err := k.OpenContractGroupHandle(cacheCtx, msg)
+	if err != nil {
+		ctx.Logger().Error("failed open contract group handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgOpenContractGroupResponse{GroupId: group.Id}, nil
+}
+
+func (k msgServer) OpenContractGroupValidate(ctx cosmos.Context, msg *types.MsgOpenContractGroup) error {
+	if k.FetchConfig(ctx, configs.HandlerOpenContractGroup) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "open contract group")
+	}
+
+	maxProviders := k.FetchConfig(ctx, configs.MaxContractGroupProviders)
+	if int64(len(msg.Providers)) > maxProviders {
+		return errors.Wrapf(types.ErrContractGroupInvalid, "too many providers (%d/%d)", len(msg.Providers), maxProviders)
+	}
+
+	service, _, err := k.ResolveServiceEnum(ctx, msg.Service)
+	if err != nil {
+		return err
+	}
+
+	minBond := k.FetchConfig(ctx, configs.MinProviderBond)
+	for i, pubkey := range msg.Providers {
+		providerPubKey, err := common.NewPubKey(pubkey)
+		if err != nil {
+			return err
+		}
+		provider, err := k.GetProvider(ctx, providerPubKey, service)
+		if err != nil {
+			return err
+		}
+		if provider.LastUpdate == 0 {
+			return errors.Wrapf(types.ErrProviderNotFound, "provider %s for service %s not found", pubkey, msg.Service)
+		}
+		if provider.Bond.LT(cosmos.NewInt(minBond)) {
+			return errors.Wrapf(types.ErrInvalidBond, "not enough provider bond to open a contract (%d/%d)", provider.Bond.Int64(), minBond)
+		}
+		if provider.Status != types.ProviderStatus_ONLINE {
+			return errors.Wrapf(types.ErrOpenContractBadProviderStatus, "provider %s has status %s", pubkey, provider.Status.String())
+		}
+		if provider.IsJailed(ctx.BlockHeight()) {
+			return errors.Wrapf(types.ErrProviderJailed, "provider %s jailed until block %d", pubkey, provider.JailedUntil)
+		}
+		if msg.Duration > provider.MaxContractDuration || msg.Duration < provider.MinContractDuration {
+			return errors.Wrapf(types.ErrOpenContractDuration, "duration %d is outside of the range %d-%d of provider %s", msg.Duration, provider.MinContractDuration, provider.MaxContractDuration, pubkey)
+		}
+
+		rate := cosmos.NewCoins(provider.PayAsYouGoRate...).AmountOf(msg.Rates[i].Denom)
+		if rate.IsZero() || !msg.Rates[i].Amount.Equal(rate) {
+			return errors.Wrapf(types.ErrOpenContractMismatchRate, "pay-as-you-go rate of provider %s is %d, client sent %d", pubkey, rate.Int64(), msg.Rates[i].Amount.Int64())
+		}
+	}
+
+	return nil
+}
+
+func (k msgServer) OpenContractGroupHandle(ctx cosmos.Context, msg *types.MsgOpenContractGroup) (types.ContractGroup, error) {
+	// set back client as delegate if delegate is empty
+	if msg.Delegate == "" {
+		msg.Delegate = msg.Client
+	}
+
+	// a single open contract cost is paid for the whole group
+	openCost := k.FetchConfig(ctx, configs.OpenContractCost)
+	if openCost > 0 {
+		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ReserveName, getCoins(openCost)); err != nil {
+			return types.ContractGroup{}, errors.Wrapf(err, "failed to send open contract costs openCost=%d", openCost)
+		}
+	}
+
+	if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(msg.Denom(), msg.Deposit))); err != nil {
+		return types.ContractGroup{}, errors.Wrapf(err, "failed to send deposit=%d", msg.Deposit.Int64())
+	}
+
+	service, _, err := k.ResolveServiceEnum(ctx, msg.Service)
+	if err != nil {
+		return types.ContractGroup{}, err
+	}
+	clientPubKey, err := common.NewPubKey(msg.Client)
+	if err != nil {
+		return types.ContractGroup{}, types.ErrInvalidPubKey
+	}
+	delegatePubKey, err := common.NewPubKey(msg.Delegate)
+	if err != nil {
+		return types.ContractGroup{}, types.ErrInvalidPubKey
+	}
+
+	group := types.ContractGroup{
+		Id:       k.Keeper.GetAndIncrementNextContractId(ctx),
+		Service:  service,
+		Client:   clientPubKey,
+		Delegate: delegatePubKey,
+		Height:   ctx.BlockHeight(),
+		Duration: msg.Duration,
+		Denom:    msg.Denom(),
+		Deposit:  msg.Deposit,
+		Paid:     cosmos.ZeroInt(),
+	}
+
+	userSet, err := k.GetUserContractSet(ctx, group.Delegate)
+	if err != nil {
+		return types.ContractGroup{}, err
+	}
+	if userSet.ContractSet == nil {
+		userSet.ContractSet = &types.ContractSet{}
+	}
+
+	// every provider gets a pay-as-you-go contract of its own, which nonces
+	// and settlements are tracked separately, drawing on the shared deposit
+	contracts := make([]types.Contract, 0, len(msg.Providers))
+	for i, pubkey := range msg.Providers {
+		providerPubKey, err := common.NewPubKey(pubkey)
+		if err != nil {
+			return types.ContractGroup{}, types.ErrInvalidPubKey
+		}
+		provider, err := k.GetProvider(ctx, providerPubKey, service)
+		if err != nil {
+			return types.ContractGroup{}, err
+		}
+
+		contract := types.Contract{
+			Provider:           providerPubKey,
+			Id:                 k.Keeper.GetAndIncrementNextContractId(ctx),
+			Service:            service,
+			Type:               types.ContractType_PAY_AS_YOU_GO,
+			Client:             clientPubKey,
+			Delegate:           delegatePubKey,
+			Duration:           msg.Duration,
+			Rate:               msg.Rates[i],
+			Deposit:            msg.Deposit,
+			Paid:               cosmos.ZeroInt(),
+			Height:             ctx.BlockHeight(),
+			SettlementDuration: provider.SettlementDuration,
+			Authorization:      msg.Authorization,
+			QueriesPerMinute:   msg.QueriesPerMinute,
+			RenewalEscrow:      cosmos.ZeroInt(),
+			PayAsYouGoTiers:    provider.RateCard.PayAsYouGoTiersOf(msg.Rates[i].Denom),
+			GroupId:            group.Id,
+			DelegateLimit:      types.NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+			DelegateSpent:      cosmos.ZeroInt(),
+		}
+
+		expirationSet, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+		if err != nil {
+			return types.ContractGroup{}, err
+		}
+		expirationSet.Append(contract.Id)
+		if err := k.SetContractExpirationSet(ctx, expirationSet); err != nil {
+			return types.ContractGroup{}, err
+		}
+
+		if err := k.SetContract(ctx, contract); err != nil {
+			return types.ContractGroup{}, err
+		}
+		userSet.ContractSet.ContractIds = append(userSet.ContractSet.ContractIds, contract.Id)
+		group.ContractIds = append(group.ContractIds, contract.Id)
+		contracts = append(contracts, contract)
+	}
+
+	if err := k.SetUserContractSet(ctx, userSet); err != nil {
+		return types.ContractGroup{}, err
+	}
+	if err := k.SetContractGroup(ctx, group); err != nil {
+		return types.ContractGroup{}, err
+	}
+
+	ctx.Logger().Info("contract group opened",
+		"group_id", group.Id,
+		"contracts", group.ContractIds,
+	)
+
+	for i := range contracts {
+		if err := k.mgr.EmitOpenContractEvent(ctx, 0, &contracts[i]); err != nil {
+			return types.ContractGroup{}, err
+		}
+	}
+	return group, k.EmitOpenContractGroupEvent(ctx, openCost, group)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// setupContractGroup opens a group of two providers, charging 10 and 20
+// uarkeo per query, sharing a deposit of 500
+func setupContractGroup(t *testing.T) (cosmos.Context, Keeper, *msgServer, types.ContractGroup) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	clientPubKey := types.GetRandomPubKey()
+	clientAccount, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+
+	var providers []common.PubKey
+	var rates []cosmos.Coin
+	for _, amount := range []int64{10, 20} {
+		rate := cosmos.NewInt64Coin(configs.Denom, amount)
+		provider := types.NewProvider(types.GetRandomPubKey(), service)
+		provider.Bond = cosmos.NewInt(500_00000000)
+		provider.Status = types.ProviderStatus_ONLINE
+		provider.MaxContractDuration = 1000
+		provider.MinContractDuration = 10
+		provider.PayAsYouGoRate = cosmos.NewCoins(rate)
+		provider.SettlementDuration = 10
+		provider.LastUpdate = 1
+		require.NoError(t, k.SetProvider(ctx, provider))
+		providers = append(providers, provider.PubKey)
+		rates = append(rates, rate)
+	}
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAccount, getCoin(common.Tokens(10))))
+
+	msg := types.NewMsgOpenContractGroup(clientAccount, providers, service.String(), clientPubKey, common.EmptyPubKey, 100, rates, cosmos.NewInt(500), types.ContractAuthorization_STRICT, 10)
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, s.OpenContractGroupValidate(ctx, msg))
+	group, err := s.OpenContractGroupHandle(ctx, msg)
+	require.NoError(t, err)
+	return ctx, k, s, group
+}
+
+func TestOpenContractGroup(t *testing.T) {
+	ctx, k, s, group := setupContractGroup(t)
+	require.True(t, hasEvent(ctx, types.EventTypeOpenContractGroup))
+
+	stored, err := k.GetContractGroup(ctx, group.Id)
+	require.NoError(t, err)
+	require.Equal(t, group, stored)
+	require.Len(t, group.ContractIds, 2)
+	require.Equal(t, int64(500), group.Deposit.Int64())
+	require.Equal(t, int64(500), k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).Int64())
+
+	for _, id := range group.ContractIds {
+		contract, err := k.GetContract(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, group.Id, contract.GroupId)
+		require.True(t, contract.IsPayAsYouGo())
+		require.Equal(t, group.Client, contract.Client)
+		require.Equal(t, group.Deposit, contract.Deposit)
+	}
+	require.NoError(t, s.mgr.invariantContractModule(ctx))
+
+	res, err := k.FetchContractGroup(ctx, &types.QueryFetchContractGroupRequest{GroupId: group.Id})
+	require.NoError(t, err)
+	require.Equal(t, group, res.ContractGroup)
+
+	// members are closed and topped up with their group
+	member, err := k.GetContract(ctx, group.ContractIds[0])
+	require.NoError(t, err)
+	client := member.ClientAddress()
+	require.ErrorIs(t, s.CloseContractValidate(ctx, types.NewMsgCloseContract(client, member.Id, member.Client, common.EmptyPubKey)), types.ErrContractGroupInvalid)
+	require.ErrorIs(t, s.TopUpContractValidate(ctx, types.NewMsgTopUpContract(client, member.Id, cosmos.NewInt(100), 0)), types.ErrContractGroupInvalid)
+
+	// providers must offer the rate of the group
+	msg := types.NewMsgOpenContractGroup(client, []common.PubKey{member.Provider, types.GetRandomPubKey()}, member.Service.String(), member.Client, common.EmptyPubKey, 100, []cosmos.Coin{member.Rate, member.Rate}, cosmos.NewInt(500), types.ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, s.OpenContractGroupValidate(ctx, msg), types.ErrProviderNotFound)
+	msg.Rates[0] = cosmos.NewInt64Coin(configs.Denom, 11)
+	msg.Providers[1] = msg.Providers[0]
+	require.ErrorIs(t, s.OpenContractGroupValidate(ctx, msg), types.ErrOpenContractMismatchRate)
+}
+
+func TestSettleContractGroup(t *testing.T) {
+	ctx, k, s, group := setupContractGroup(t)
+	first, err := k.GetContract(ctx, group.ContractIds[0])
+	require.NoError(t, err)
+	second, err := k.GetContract(ctx, group.ContractIds[1])
+	require.NoError(t, err)
+	balance := k.GetBalance(ctx, first.ClientAddress()).AmountOf(configs.Denom)
+
+	// each provider settles its own nonce from the shared deposit
+	_, err = s.mgr.SettleContract(ctx, first, 20, false)
+	require.NoError(t, err)
+	group, err = k.GetContractGroup(ctx, group.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), group.Paid.Int64())
+
+	// the deposit of the other member is what is left to the group
+	second, err = k.GetContract(ctx, second.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), second.Deposit.Int64())
+
+	// the second provider can only take what is left of the deposit
+	second, err = s.mgr.SettleContract(ctx, second, 20, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), second.Paid.Int64())
+	first, err = k.GetContract(ctx, first.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), first.Deposit.Int64())
+	group, err = k.GetContractGroup(ctx, group.Id)
+	require.NoError(t, err)
+	require.True(t, group.Remaining().IsZero())
+	require.NoError(t, s.mgr.invariantContractModule(ctx))
+
+	// the group is settled with its last member
+	ctx = ctx.WithBlockHeight(first.SettlementPeriodEnd())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeSettleContractGroup))
+	group, err = k.GetContractGroup(ctx, group.Id)
+	require.NoError(t, err)
+	require.Greater(t, group.SettlementHeight, int64(0))
+	require.Equal(t, balance, k.GetBalance(ctx, first.ClientAddress()).AmountOf(configs.Denom))
+}
+
+func TestRefundContractGroup(t *testing.T) {
+	ctx, k, s, group := setupContractGroup(t)
+	first, err := k.GetContract(ctx, group.ContractIds[0])
+	require.NoError(t, err)
+	client := first.ClientAddress()
+	balance := k.GetBalance(ctx, client).AmountOf(configs.Denom)
+
+	_, err = s.mgr.SettleContract(ctx, first, 5, false)
+	require.NoError(t, err)
+
+	// the remainder of the deposit is refunded once, at the expiry of the
+	// group
+	ctx = ctx.WithBlockHeight(first.SettlementPeriodEnd())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	group, err = k.GetContractGroup(ctx, group.Id)
+	require.NoError(t, err)
+	require.Greater(t, group.SettlementHeight, int64(0))
+	require.Equal(t, int64(50), group.Paid.Int64())
+	require.Equal(t, balance.AddRaw(450), k.GetBalance(ctx, client).AmountOf(configs.Denom))
+	require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).IsZero())
+	require.NoError(t, s.mgr.invariantContractModule(ctx))
+}
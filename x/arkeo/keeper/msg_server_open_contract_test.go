@@ -163,6 +163,76 @@ func TestOpenContractHandle(t *testing.T) {
 	require.Len(t, userSet.ContractSet.ContractIds, 1)
 }
 
+func TestOpenContractRateCard(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MaxContractDuration = 2000
+	provider.MinContractDuration = 10
+	provider.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	provider.PayAsYouGoRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 10))
+	provider.RateCard = types.RateCard{
+		PayAsYouGoTiers: []types.RateTier{
+			{Threshold: 100, Rate: cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 5))},
+		},
+		SubscriptionTiers: []types.RateTier{
+			{Threshold: 10, Rate: cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 12))},
+		},
+		DurationDiscounts: []types.DurationDiscount{
+			{MinDuration: 1000, DiscountBps: 1000},
+		},
+	}
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	// the subscription tier reached by the queries per minute sets the rate
+	clientPubKey := types.GetRandomPubKey()
+	acc, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acc, getCoin(common.Tokens(10))))
+	msg := types.NewMsgOpenContract(acc, providerPubKey, service.String(), clientPubKey, common.EmptyPubKey, types.ContractType_SUBSCRIPTION, 1000, 0, cosmos.NewInt64Coin(configs.Denom, 15), cosmos.NewInt(15*1000*10), types.ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, s.OpenContractValidate(ctx, msg), types.ErrOpenContractMismatchRate)
+	msg.Rate = cosmos.NewInt64Coin(configs.Denom, 12)
+	msg.Deposit = cosmos.NewInt(12 * 1000 * 10)
+	// long subscriptions are discounted
+	require.ErrorIs(t, s.OpenContractValidate(ctx, msg), types.ErrOpenContractMismatchRate)
+	msg.Deposit = cosmos.NewInt(12 * 1000 * 10 * 9 / 10)
+	require.NoError(t, s.OpenContractValidate(ctx, msg))
+	require.NoError(t, s.OpenContractHandle(ctx, msg))
+
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), contract.DiscountBps)
+	ctx = ctx.WithBlockHeight(contract.Height + 500)
+	debt, err := s.mgr.contractDebt(ctx, contract)
+	require.NoError(t, err)
+	require.Equal(t, int64(12*500*10*9/10), debt.Int64())
+
+	// pay-as-you-go contracts keep the volume tiers they were opened with
+	clientPubKey = types.GetRandomPubKey()
+	acc, err = clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acc, getCoin(common.Tokens(10))))
+	msg = types.NewMsgOpenContract(acc, providerPubKey, service.String(), clientPubKey, common.EmptyPubKey, types.ContractType_PAY_AS_YOU_GO, 100, 0, cosmos.NewInt64Coin(configs.Denom, 10), cosmos.NewInt(5000), types.ContractAuthorization_STRICT, 10)
+	require.NoError(t, s.OpenContractValidate(ctx, msg))
+	require.NoError(t, s.OpenContractHandle(ctx, msg))
+	require.NoError(t, k.SetProvider(ctx, types.NewProvider(providerPubKey, service)))
+
+	contract, err = k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	require.Len(t, contract.PayAsYouGoTiers, 1)
+	contract.Nonce = 300
+	debt, err = s.mgr.contractDebt(ctx, contract)
+	require.NoError(t, err)
+	require.Equal(t, int64(100*10+200*5), debt.Int64())
+}
+
 func TestOpenContract(t *testing.T) {
 	ctx, k, sk := SetupKeeperWithStaking(t)
 	ctx = ctx.WithBlockHeight(10)
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetConfig(goCtx context.Context, msg *types.MsgSetConfig) (*types.MsgSetConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetConfig",
+		"name", msg.Config.Name,
+		"value", msg.Config.Value(),
+		"remove", msg.Remove,
+	)
+
+	if err := k.SetConfigValidate(ctx, msg); err != nil {
+		ctx.Logger().Error("failed set config validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetConfigHandle(ctx, msg); err != nil {
+		ctx.Logger().Error("failed set config handle", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgSetConfigResponse{}, nil
+}
+
+func (k msgServer) SetConfigValidate(ctx cosmos.Context, msg *types.MsgSetConfig) error {
+	if !types.IsAuthorityAllowed(k.GetAuthority(), msg.Creator) {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.Remove {
+		if _, ok := k.GetConfigOverride(ctx, msg.Config.Name); !ok {
+			return errors.Wrapf(types.ErrInvalidConfig, "%s is not overridden", msg.Config.Name)
+		}
+		return nil
+	}
+
+	// an override must have the type of the compiled value to be used
+	name, _ := configs.GetConfigName(msg.Config.Name)
+	valueType, ok := configs.GetConfigValues(k.GetVersion(ctx)).GetValueType(name)
+	if !ok {
+		return errors.Wrapf(types.ErrInvalidConfig, "%s has no value in version %d", msg.Config.Name, k.GetVersion(ctx))
+	}
+	if configType := types.ConfigTypeOf(valueType); msg.Config.Type != configType {
+		return errors.Wrapf(types.ErrInvalidConfig, "%s is of type %s", msg.Config.Name, configType)
+	}
+
+	return nil
+}
+
+func (k msgServer) SetConfigHandle(ctx cosmos.Context, msg *types.MsgSetConfig) error {
+	if msg.Remove {
+		k.RemoveConfigOverride(ctx, msg.Config.Name)
+	} else if err := k.SetConfigOverride(ctx, msg.Config); err != nil {
+		return err
+	}
+
+	return k.EmitSetConfigEvent(ctx, msg)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSetConfig(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	s := newMsgServer(k, sk)
+
+	compiled := configs.GetConfigValues(k.GetVersion(ctx)).GetInt64Value(configs.ReserveTax)
+	require.Equal(t, compiled, s.FetchConfig(ctx, configs.ReserveTax))
+
+	msg := types.MsgSetConfig{
+		Creator: k.GetAuthority(),
+		Config: types.ConfigOverride{
+			Name:       configs.ReserveTax.String(),
+			Type:       types.ConfigType_INT64,
+			Int64Value: 500,
+		},
+	}
+
+	// only the authority can set configs
+	unauthorized := msg
+	unauthorized.Creator = types.GetRandomBech32Addr().String()
+	require.ErrorIs(t, s.SetConfigValidate(ctx, &unauthorized), sdkerrors.ErrUnauthorized)
+
+	// values are checked against the config type and range
+	bad := msg
+	bad.Config.Type = types.ConfigType_BOOL
+	require.ErrorIs(t, s.SetConfigValidate(ctx, &bad), types.ErrInvalidConfig)
+	bad = msg
+	bad.Config.Int64Value = configs.MaxBasisPoints + 1
+	require.ErrorIs(t, s.SetConfigValidate(ctx, &bad), types.ErrInvalidConfig)
+	bad = msg
+	bad.Config.Name = "NotAConfig"
+	require.ErrorIs(t, s.SetConfigValidate(ctx, &bad), types.ErrInvalidConfig)
+
+	// overrides take precedence over the compiled value
+	_, err := s.SetConfig(ctx, &msg)
+	require.NoError(t, err)
+	require.EqualValues(t, 500, s.FetchConfig(ctx, configs.ReserveTax))
+	require.EqualValues(t, 500, s.mgr.FetchConfig(ctx, configs.ReserveTax))
+	require.True(t, hasEvent(ctx, types.EventTypeSetConfig))
+
+	res, err := k.Configs(ctx, &types.QueryConfigsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Configs, len(configs.ConfigNames()))
+	for _, config := range res.Configs {
+		if config.Config.Name == configs.ReserveTax.String() {
+			require.Equal(t, types.ConfigSource_OVERRIDE, config.Source)
+			require.EqualValues(t, 500, config.Config.Int64Value)
+		} else {
+			require.Equal(t, types.ConfigSource_COMPILED, config.Source)
+		}
+	}
+
+	// removing the override restores the compiled value
+	msg.Remove = true
+	_, err = s.SetConfig(ctx, &msg)
+	require.NoError(t, err)
+	require.Equal(t, compiled, s.FetchConfig(ctx, configs.ReserveTax))
+	_, err = s.SetConfig(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidConfig)
+}
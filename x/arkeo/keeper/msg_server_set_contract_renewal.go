@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetContractRenewal(goCtx context.Context, msg *types.MsgSetContractRenewal) (*types.MsgSetContractRenewalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetContractRenewal",
+		"contract_id", msg.ContractId,
+		"auto_renew", msg.AutoRenew,
+		"escrow", msg.Escrow,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SetContractRenewalValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set contract renewal validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetContractRenewalHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set contract renewal handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgSetContractRenewalResponse{}, nil
+}
+
+func (k msgServer) SetContractRenewalValidate(ctx cosmos.Context, msg *types.MsgSetContractRenewal) error {
+	if k.FetchConfig(ctx, configs.HandlerSetContractRenewal) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "set contract renewal")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	payer, err := contract.PayerAddress()
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidPubKey, "client: %s", contract.Client.String())
+	}
+	if !payer.Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrContractRenewalUnauthorized, "only the payer can set the renewal of the contract")
+	}
+
+	if !contract.IsSubscription() {
+		return errors.Wrap(types.ErrContractRenewalInvalid, "only subscriptions can be renewed")
+	}
+	if contract.IsExpired(ctx.BlockHeight()) || contract.SettlementHeight > 0 {
+		return errors.Wrapf(types.ErrContractRenewalInvalid, "contract is closed")
+	}
+
+	return nil
+}
+
+func (k msgServer) SetContractRenewalHandle(ctx cosmos.Context, msg *types.MsgSetContractRenewal) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	escrow := contract.GetRenewalEscrow()
+	if msg.Escrow.IsPositive() {
+		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, msg.Escrow))); err != nil {
+			return errors.Wrapf(err, "failed to send escrow=%d", msg.Escrow.Int64())
+		}
+		escrow = escrow.Add(msg.Escrow)
+	}
+
+	// cancelling refunds the escrow
+	refunded := cosmos.ZeroInt()
+	if !msg.AutoRenew && escrow.IsPositive() {
+		if err := k.SendFromModuleToAccount(ctx, types.ContractName, msg.MustGetSigner(), cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, escrow))); err != nil {
+			return errors.Wrapf(err, "failed to refund escrow=%d", escrow.Int64())
+		}
+		refunded = escrow
+		escrow = cosmos.ZeroInt()
+	}
+
+	contract.AutoRenew = msg.AutoRenew
+	contract.RenewalEscrow = escrow
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitSetContractRenewalEvent(ctx, contract, msg.Escrow, refunded)
+}
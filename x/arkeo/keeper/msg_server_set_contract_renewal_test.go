@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// setupRenewal opens an auto-renewing subscription of 100 blocks at 5uarkeo
+func setupRenewal(t *testing.T) (cosmos.Context, Keeper, *msgServer, types.Provider, types.Contract) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	clientPubKey := types.GetRandomPubKey()
+	clientAccount, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+
+	rate := cosmos.NewInt64Coin(configs.Denom, 5)
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MaxContractDuration = 1000
+	provider.MinContractDuration = 10
+	provider.SubscriptionRate = cosmos.NewCoins(rate)
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAccount, getCoin(common.Tokens(10))))
+
+	msg := types.NewMsgOpenContract(clientAccount, providerPubKey, service.String(), clientPubKey, common.EmptyPubKey, types.ContractType_SUBSCRIPTION, 100, 0, rate, cosmos.NewInt(500), types.ContractAuthorization_STRICT, 1)
+	msg.AutoRenew = true
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, s.OpenContractHandle(ctx, msg))
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	require.True(t, contract.AutoRenew)
+	return ctx, k, s, provider, contract
+}
+
+func TestSetContractRenewal(t *testing.T) {
+	ctx, k, s, _, contract := setupRenewal(t)
+	client := contract.ClientAddress()
+	balance := k.GetBalance(ctx, client).AmountOf(configs.Denom)
+
+	// fund the escrow
+	msg := types.NewMsgSetContractRenewal(client, contract.Id, true, cosmos.NewInt(300))
+	require.NoError(t, msg.ValidateBasic())
+	_, err := s.SetContractRenewal(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, types.EventTypeSetContractRenewal))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), contract.GetRenewalEscrow().Int64())
+	require.Equal(t, balance.SubRaw(300), k.GetBalance(ctx, client).AmountOf(configs.Denom))
+
+	// only the client
+	msg = types.NewMsgSetContractRenewal(types.GetRandomBech32Addr(), contract.Id, false, cosmos.ZeroInt())
+	require.ErrorIs(t, s.SetContractRenewalValidate(ctx, msg), types.ErrContractRenewalUnauthorized)
+
+	// cancelling refunds the escrow
+	msg = types.NewMsgSetContractRenewal(client, contract.Id, false, cosmos.ZeroInt())
+	_, err = s.SetContractRenewal(ctx, msg)
+	require.NoError(t, err)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.False(t, contract.AutoRenew)
+	require.True(t, contract.GetRenewalEscrow().IsZero())
+	require.Equal(t, balance, k.GetBalance(ctx, client).AmountOf(configs.Denom))
+
+	// a cancelled subscription is settled without a successor
+	ctx = ctx.WithBlockHeight(contract.Expiration())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.False(t, hasEvent(ctx, types.EventTypeContractRenewed))
+	require.Equal(t, contract.Id+1, k.GetNextContractId(ctx))
+
+	// closed contracts can't be renewed
+	msg = types.NewMsgSetContractRenewal(client, contract.Id, true, cosmos.ZeroInt())
+	require.ErrorIs(t, s.SetContractRenewalValidate(ctx.WithBlockHeight(contract.Expiration()+1), msg), types.ErrContractRenewalInvalid)
+}
+
+func TestContractRenewal(t *testing.T) {
+	ctx, k, s, _, contract := setupRenewal(t)
+	client := contract.ClientAddress()
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+
+	// the escrow pays for part of the renewal, the client account for the
+	// rest
+	_, err := s.SetContractRenewal(ctx, types.NewMsgSetContractRenewal(client, contract.Id, true, cosmos.NewInt(300)))
+	require.NoError(t, err)
+	balance := k.GetBalance(ctx, client).AmountOf(configs.Denom)
+
+	ctx = ctx.WithBlockHeight(contract.Expiration())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeContractRenewed))
+	require.True(t, hasEvent(ctx, types.EventTypeOpenContract))
+
+	old, err := k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Greater(t, old.SettlementHeight, int64(0))
+	require.False(t, old.AutoRenew)
+	require.True(t, old.GetRenewalEscrow().IsZero())
+
+	renewed, err := k.GetActiveContractForUser(ctx, contract.Client, contract.Provider, contract.Service)
+	require.NoError(t, err)
+	require.NotEqual(t, contract.Id, renewed.Id)
+	require.Equal(t, ctx.BlockHeight(), renewed.Height)
+	require.Equal(t, contract.Duration, renewed.Duration)
+	require.Equal(t, contract.Rate, renewed.Rate)
+	require.Equal(t, contract.QueriesPerMinute, renewed.QueriesPerMinute)
+	require.Equal(t, int64(500), renewed.Deposit.Int64())
+	require.True(t, renewed.AutoRenew)
+	require.True(t, renewed.GetRenewalEscrow().IsZero())
+	// the remaining open cost and deposit come from the account, the
+	// settlement refunds nothing on a fully used subscription
+	require.Equal(t, balance.Sub(cosmos.NewInt(openCost+500-300)), k.GetBalance(ctx, client).AmountOf(configs.Denom))
+
+	expirationSet, err := k.GetContractExpirationSet(ctx, renewed.Expiration())
+	require.NoError(t, err)
+	require.Contains(t, expirationSet.ContractSet.ContractIds, renewed.Id)
+}
+
+func TestContractRenewalFailure(t *testing.T) {
+	ctx, k, s, provider, contract := setupRenewal(t)
+	client := contract.ClientAddress()
+
+	_, err := s.SetContractRenewal(ctx, types.NewMsgSetContractRenewal(client, contract.Id, true, cosmos.NewInt(300)))
+	require.NoError(t, err)
+	balance := k.GetBalance(ctx, client).AmountOf(configs.Denom)
+
+	// the provider raised its rate
+	provider.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 6))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	ctx = ctx.WithBlockHeight(contract.Expiration())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeContractRenewalFailed))
+	require.False(t, hasEvent(ctx, types.EventTypeContractRenewed))
+
+	// no successor, the escrow is back on the client account
+	require.Equal(t, contract.Id+1, k.GetNextContractId(ctx))
+	require.Equal(t, balance.AddRaw(300), k.GetBalance(ctx, client).AmountOf(configs.Denom))
+	renewed, err := k.GetActiveContractForUser(ctx, contract.Client, contract.Provider, contract.Service)
+	require.NoError(t, err)
+	require.True(t, renewed.IsEmpty())
+}
+
+func TestContractRenewalRevokedPayerGrant(t *testing.T) {
+	ctx, k, s, _, contract := setupRenewal(t)
+	client := contract.ClientAddress()
+
+	// the contract is paid by a treasury, which revoked the grant of the client
+	treasury := types.GetRandomBech32Addr()
+	require.NoError(t, k.MintAndSendToAccount(ctx, treasury, getCoin(common.Tokens(10))))
+	contract.Payer = treasury
+	require.NoError(t, k.SetContract(ctx, contract))
+	msgType := sdk.MsgTypeURL(&types.MsgOpenContract{})
+	require.NoError(t, k.(KVStore).authzKeeper.SaveGrant(ctx, client, treasury, authz.NewGenericAuthorization(msgType), nil))
+	require.NoError(t, k.(KVStore).authzKeeper.DeleteGrant(ctx, client, treasury, msgType))
+	balance := k.GetBalance(ctx, treasury).AmountOf(configs.Denom)
+
+	ctx = ctx.WithBlockHeight(contract.Expiration())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeContractRenewalFailed))
+	require.False(t, hasEvent(ctx, types.EventTypeContractRenewed))
+
+	// the treasury isn't charged
+	require.Equal(t, contract.Id+1, k.GetNextContractId(ctx))
+	require.Equal(t, balance, k.GetBalance(ctx, treasury).AmountOf(configs.Denom))
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetDelegateLimit(goCtx context.Context, msg *types.MsgSetDelegateLimit) (*types.MsgSetDelegateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetDelegateLimit",
+		"contract_id", msg.ContractId,
+		"max_nonce", msg.DelegateLimit.MaxNonce,
+		"max_value", msg.DelegateLimit.MaxValue,
+		"period", msg.DelegateLimit.Period,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SetDelegateLimitValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set delegate limit validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetDelegateLimitHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set delegate limit handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgSetDelegateLimitResponse{}, nil
+}
+
+func (k msgServer) SetDelegateLimitValidate(ctx cosmos.Context, msg *types.MsgSetDelegateLimit) error {
+	if k.FetchConfig(ctx, configs.HandlerSetDelegateLimit) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "set delegate limit")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	// the limit protects the deposit, it is up to whoever paid it
+	payer, err := contract.PayerAddress()
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidPubKey, "client: %s", contract.Client.String())
+	}
+	if !payer.Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrContractPayerUnauthorized, "only the payer can limit the contract")
+	}
+
+	if !contract.IsPayAsYouGo() {
+		return errors.Wrap(types.ErrDelegateLimitInvalid, "only pay-as-you-go contracts can be limited")
+	}
+	if contract.IsExpired(ctx.BlockHeight()) || contract.SettlementHeight > 0 {
+		return errors.Wrap(types.ErrDelegateLimitInvalid, "contract is closed")
+	}
+
+	return nil
+}
+
+func (k msgServer) SetDelegateLimitHandle(ctx cosmos.Context, msg *types.MsgSetDelegateLimit) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	// the value tracked in the current period, if any, counts against the new
+	// limit
+	contract.DelegateLimit = types.NewDelegateLimit(msg.DelegateLimit.MaxNonce, msg.DelegateLimit.GetMaxValue(), msg.DelegateLimit.Period)
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitSetDelegateLimitEvent(ctx, contract)
+}
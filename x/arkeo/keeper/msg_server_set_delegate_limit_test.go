@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// setupTreasuryContract opens a pay-as-you-go contract of 1000 at 10uarkeo
+// per query, paid by a treasury for a client, limited to nonce 50 and 300
+// per 20 blocks
+func setupTreasuryContract(t *testing.T) (cosmos.Context, Keeper, *msgServer, cosmos.AccAddress, types.Contract) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	treasury := types.GetRandomBech32Addr()
+	clientPubKey := types.GetRandomPubKey()
+	service := common.BTCService
+
+	rate := cosmos.NewInt64Coin(configs.Denom, 10)
+	provider := types.NewProvider(types.GetRandomPubKey(), service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MaxContractDuration = 1000
+	provider.MinContractDuration = 10
+	provider.PayAsYouGoRate = cosmos.NewCoins(rate)
+	provider.SettlementDuration = 10
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintAndSendToAccount(ctx, treasury, getCoin(common.Tokens(10))))
+
+	client, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	msg := types.NewMsgOpenContract(client, provider.PubKey, service.String(), clientPubKey, common.EmptyPubKey, types.ContractType_PAY_AS_YOU_GO, 100, 10, rate, cosmos.NewInt(1000), types.ContractAuthorization_STRICT, 10)
+	msg.DelegateLimit = types.NewDelegateLimit(50, cosmos.NewInt(300), 20)
+	msg.Payer = treasury.String()
+	require.NoError(t, msg.ValidateBasic())
+
+	// the treasury has to consent to pay for the client
+	require.ErrorIs(t, s.OpenContractValidate(ctx, msg), types.ErrContractPayerUnauthorized)
+	require.NoError(t, k.(KVStore).authzKeeper.SaveGrant(ctx, client, treasury, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), nil))
+	require.NoError(t, s.OpenContractValidate(ctx, msg))
+	require.NoError(t, s.OpenContractHandle(ctx, msg))
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, provider.PubKey, service)
+	require.NoError(t, err)
+	return ctx, k, s, treasury, contract
+}
+
+func TestOpenContractForClient(t *testing.T) {
+	ctx, k, s, treasury, contract := setupTreasuryContract(t)
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+
+	// the treasury pays, the client doesn't need any funds
+	require.Equal(t, treasury, contract.Payer)
+	require.Equal(t, int64(50), contract.DelegateLimit.MaxNonce)
+	require.True(t, k.GetBalance(ctx, contract.ClientAddress()).IsZero())
+	require.Equal(t, common.Tokens(10)-openCost-1000, k.GetBalance(ctx, treasury).AmountOf(configs.Denom).Int64())
+
+	// the client has to sign the contract
+	msg := types.NewMsgOpenContract(treasury, contract.Provider, contract.Service.String(), contract.Client, common.EmptyPubKey, types.ContractType_PAY_AS_YOU_GO, 100, 10, contract.Rate, cosmos.NewInt(1000), types.ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidPubKey)
+
+	// limits are for pay-as-you-go contracts
+	msg = types.NewMsgOpenContract(contract.ClientAddress(), contract.Provider, contract.Service.String(), contract.Client, common.EmptyPubKey, types.ContractType_SUBSCRIPTION, 100, 0, contract.Rate, cosmos.NewInt(1000), types.ContractAuthorization_STRICT, 10)
+	msg.DelegateLimit = types.NewDelegateLimit(50, cosmos.ZeroInt(), 0)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrDelegateLimitInvalid)
+}
+
+func TestDelegateLimitSettlement(t *testing.T) {
+	ctx, k, s, treasury, contract := setupTreasuryContract(t)
+	provider, err := contract.Provider.GetMyAddress()
+	require.NoError(t, err)
+	balance := k.GetBalance(ctx, treasury).AmountOf(configs.Denom)
+
+	// 400 used, only 300 paid in the period
+	contract, err = s.mgr.SettleContract(ctx, contract, 40, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), contract.Paid.Int64())
+	contract, err = s.mgr.SettleContract(ctx, contract, 45, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), contract.Paid.Int64())
+
+	// the next period pays up to the nonce limit
+	ctx = ctx.WithBlockHeight(30)
+	contract, err = s.mgr.SettleContract(ctx, contract, 60, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), contract.Nonce)
+	require.Equal(t, int64(500), contract.Paid.Int64())
+	require.Equal(t, int64(200), contract.DelegateSpent.Int64())
+	require.True(t, k.GetBalance(ctx, provider).AmountOf(configs.Denom).IsPositive())
+
+	// nothing can be claimed past the nonce limit
+	claim := types.MsgClaimContractIncome{ContractId: contract.Id, Creator: provider.String(), Nonce: 51}
+	require.ErrorIs(t, s.HandlerClaimContractIncome(ctx, &claim), types.ErrDelegateLimitExceeded)
+
+	// the rest of the deposit goes back to the treasury
+	ctx = ctx.WithBlockHeight(contract.SettlementPeriodEnd())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.Equal(t, balance.AddRaw(500), k.GetBalance(ctx, treasury).AmountOf(configs.Denom))
+	require.True(t, k.GetBalance(ctx, contract.ClientAddress()).IsZero())
+}
+
+func TestSetDelegateLimit(t *testing.T) {
+	ctx, k, s, treasury, contract := setupTreasuryContract(t)
+
+	// the limit is up to the payer
+	msg := types.NewMsgSetDelegateLimit(contract.ClientAddress(), contract.Id, types.NewDelegateLimit(0, cosmos.ZeroInt(), 0))
+	require.NoError(t, msg.ValidateBasic())
+	require.ErrorIs(t, s.SetDelegateLimitValidate(ctx, msg), types.ErrContractPayerUnauthorized)
+
+	msg = types.NewMsgSetDelegateLimit(treasury, contract.Id, types.NewDelegateLimit(100, cosmos.ZeroInt(), 0))
+	_, err := s.SetDelegateLimit(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, types.EventTypeSetDelegateLimit))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), contract.DelegateLimit.MaxNonce)
+	require.True(t, contract.DelegateLimit.GetMaxValue().IsZero())
+
+	msg = types.NewMsgSetDelegateLimit(treasury, contract.Id, types.NewDelegateLimit(0, cosmos.NewInt(10), -1))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrDelegateLimitInvalid)
+
+	// closed contracts can't be limited
+	msg = types.NewMsgSetDelegateLimit(treasury, contract.Id, types.NewDelegateLimit(0, cosmos.ZeroInt(), 0))
+	require.ErrorIs(t, s.SetDelegateLimitValidate(ctx.WithBlockHeight(contract.Expiration()+1), msg), types.ErrDelegateLimitInvalid)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetProber(goCtx context.Context, msg *types.MsgSetProber) (*types.MsgSetProberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetProber",
+		"prober", msg.Prober,
+		"remove", msg.Remove,
+	)
+
+	if err := k.SetProberValidate(ctx, msg); err != nil {
+		ctx.Logger().Error("failed set prober validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetProberHandle(ctx, msg); err != nil {
+		ctx.Logger().Error("failed set prober handle", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgSetProberResponse{}, nil
+}
+
+func (k msgServer) SetProberValidate(ctx cosmos.Context, msg *types.MsgSetProber) error {
+	if !types.IsAuthorityAllowed(k.GetAuthority(), msg.Creator) {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.Remove && !k.IsProber(ctx, sdk.MustAccAddressFromBech32(msg.Prober)) {
+		return errors.Wrapf(types.ErrAttestationUnauthorized, "%s is not a prober", msg.Prober)
+	}
+
+	return nil
+}
+
+func (k msgServer) SetProberHandle(ctx cosmos.Context, msg *types.MsgSetProber) error {
+	prober := sdk.MustAccAddressFromBech32(msg.Prober)
+	if msg.Remove {
+		k.RemoveProber(ctx, prober)
+	} else {
+		k.AddProber(ctx, prober)
+	}
+
+	return k.EmitSetProberEvent(ctx, msg)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SubmitAttestation(goCtx context.Context, msg *types.MsgSubmitAttestation) (*types.MsgSubmitAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSubmitAttestation",
+		"creator", msg.Creator,
+		"provider", msg.Provider,
+		"service", msg.Service,
+		"contract_id", msg.ContractId,
+		"availability", msg.Availability,
+		"latency_ms", msg.LatencyMs,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SubmitAttestationValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit attestation validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SubmitAttestationHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit attestation handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgSubmitAttestationResponse{}, nil
+}
+
+func (k msgServer) SubmitAttestationValidate(ctx cosmos.Context, msg *types.MsgSubmitAttestation) error {
+	if k.FetchConfig(ctx, configs.HandlerSubmitAttestation) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "submit attestation")
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	provider, err := k.getAttestedProvider(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if provider.LastUpdate == 0 {
+		return errors.Wrapf(types.ErrProviderNotFound, "provider %s for service %s not found", msg.Provider, msg.Service)
+	}
+
+	if _, err := k.attestationWeight(ctx, msg, provider); err != nil {
+		return err
+	}
+
+	last := k.GetLastAttestation(ctx, provider.PubKey, provider.Service, msg.MustGetSigner())
+	interval := k.FetchConfig(ctx, configs.ReputationAttestationInterval)
+	if last > 0 && ctx.BlockHeight() < last+interval {
+		return errors.Wrapf(types.ErrAttestationTooSoon, "next attestation at block %d", last+interval)
+	}
+
+	return nil
+}
+
+func (k msgServer) SubmitAttestationHandle(ctx cosmos.Context, msg *types.MsgSubmitAttestation) error {
+	provider, err := k.getAttestedProvider(ctx, msg)
+	if err != nil {
+		return err
+	}
+	weight, err := k.attestationWeight(ctx, msg, provider)
+	if err != nil {
+		return err
+	}
+
+	score := types.AttestationScore(msg.Availability, msg.LatencyMs, k.FetchConfig(ctx, configs.ReputationLatencyTarget))
+	provider.Reputation.Attest(ctx.BlockHeight(), k.FetchConfig(ctx, configs.ReputationHalfLife), score, weight)
+	if err := k.SetProvider(ctx, provider); err != nil {
+		return err
+	}
+	k.SetLastAttestation(ctx, provider.PubKey, provider.Service, msg.MustGetSigner(), ctx.BlockHeight())
+
+	return k.EmitSubmitAttestationEvent(ctx, msg, provider, weight)
+}
+
+func (k msgServer) getAttestedProvider(ctx cosmos.Context, msg *types.MsgSubmitAttestation) (types.Provider, error) {
+	pubkey, err := common.NewPubKey(msg.Provider)
+	if err != nil {
+		return types.Provider{}, errors.Wrapf(types.ErrInvalidPubKey, "invalid provider pubkey (%s)", err)
+	}
+	service, _, err := k.ResolveServiceEnum(ctx, msg.Service)
+	if err != nil {
+		return types.Provider{}, err
+	}
+	return k.GetProvider(ctx, pubkey, service)
+}
+
+// attestationWeight returns the weight of an attestation, the volume paid
+// through the contract of the client or the weight of a registered prober
+func (k msgServer) attestationWeight(ctx cosmos.Context, msg *types.MsgSubmitAttestation, provider types.Provider) (cosmos.Int, error) {
+	if msg.ContractId == 0 {
+		if !k.IsProber(ctx, msg.MustGetSigner()) {
+			return cosmos.ZeroInt(), errors.Wrap(types.ErrAttestationUnauthorized, "only registered probers can attest without a contract")
+		}
+		return cosmos.NewInt(k.FetchConfig(ctx, configs.ReputationProberWeight)), nil
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return cosmos.ZeroInt(), err
+	}
+	if contract.IsEmpty() {
+		return cosmos.ZeroInt(), errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+	if !contract.Provider.Equals(provider.PubKey) || contract.Service != provider.Service {
+		return cosmos.ZeroInt(), errors.Wrap(types.ErrAttestationInvalid, "contract is not with the attested provider")
+	}
+	client, err := contract.Client.GetMyAddress()
+	if err != nil {
+		return cosmos.ZeroInt(), errors.Wrapf(types.ErrInvalidPubKey, "client: %s", contract.Client.String())
+	}
+	if !client.Equals(msg.MustGetSigner()) {
+		return cosmos.ZeroInt(), errors.Wrap(types.ErrAttestationUnauthorized, "only the client of the contract can attest the provider")
+	}
+	if contract.Paid.IsNil() || !contract.Paid.IsPositive() {
+		return cosmos.ZeroInt(), errors.Wrap(types.ErrAttestationInvalid, "nothing was paid through the contract")
+	}
+	return contract.Paid, nil
+}
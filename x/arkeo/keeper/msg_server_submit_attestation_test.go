@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSubmitAttestation(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(100)
+	s := newMsgServer(k, sk)
+
+	service := common.BTCService
+	provider := types.NewProvider(types.GetRandomPubKey(), service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	clientPubKey := types.GetRandomPubKey()
+	client, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	contract := types.NewContract(provider.PubKey, service, clientPubKey)
+	contract.Id = 1
+	contract.Height = 10
+	contract.Duration = 100
+	contract.Paid = cosmos.NewInt(300)
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	// the client of the contract attests, weighted by what it paid
+	msg := types.NewMsgSubmitAttestation(client, provider.PubKey, service.String(), contract.Id, 9000, 100)
+	require.NoError(t, s.SubmitAttestationValidate(ctx, msg))
+	require.NoError(t, s.SubmitAttestationHandle(ctx, msg))
+	provider, err = k.GetProvider(ctx, provider.PubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, int64(9000), provider.Reputation.Score)
+	require.Equal(t, int64(300), provider.Reputation.GetWeight().Int64())
+
+	// once per interval
+	require.ErrorIs(t, s.SubmitAttestationValidate(ctx, msg), types.ErrAttestationTooSoon)
+	interval := s.FetchConfig(ctx, configs.ReputationAttestationInterval)
+	require.NoError(t, s.SubmitAttestationValidate(ctx.WithBlockHeight(100+interval), msg))
+
+	// only the client can attest with the contract
+	other := types.NewMsgSubmitAttestation(types.GetRandomBech32Addr(), provider.PubKey, service.String(), contract.Id, 9000, 100)
+	require.ErrorIs(t, s.SubmitAttestationValidate(ctx, other), types.ErrAttestationUnauthorized)
+
+	// and only for the provider of the contract
+	wrong := types.NewMsgSubmitAttestation(client, types.GetRandomPubKey(), service.String(), contract.Id, 9000, 100)
+	require.ErrorIs(t, s.SubmitAttestationValidate(ctx, wrong), types.ErrProviderNotFound)
+
+	// without a contract, only registered probers can attest
+	prober := types.GetRandomBech32Addr()
+	probe := types.NewMsgSubmitAttestation(prober, provider.PubKey, service.String(), 0, 5000, 100)
+	require.ErrorIs(t, s.SubmitAttestationValidate(ctx, probe), types.ErrAttestationUnauthorized)
+
+	setProber := types.NewMsgSetProber(prober, prober, false)
+	require.ErrorIs(t, s.SetProberValidate(ctx, setProber), sdkerrors.ErrUnauthorized)
+	setProber.Creator = k.GetAuthority()
+	require.NoError(t, s.SetProberValidate(ctx, setProber))
+	require.NoError(t, s.SetProberHandle(ctx, setProber))
+	require.True(t, k.IsProber(ctx, prober))
+
+	require.NoError(t, s.SubmitAttestationValidate(ctx, probe))
+	require.NoError(t, s.SubmitAttestationHandle(ctx, probe))
+	provider, err = k.GetProvider(ctx, provider.PubKey, service)
+	require.NoError(t, err)
+	proberWeight := s.FetchConfig(ctx, configs.ReputationProberWeight)
+	require.Equal(t, (9000*300+5000*proberWeight)/(300+proberWeight), provider.Reputation.Score)
+	require.Equal(t, uint64(2), provider.Reputation.Attestations)
+
+	// the reputation decays when it is queried, providers without metadata
+	// aren't returned
+	provider.MetadataNonce = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+	halfLife := s.FetchConfig(ctx, configs.ReputationHalfLife)
+	res, err := k.FetchProvider(ctx.WithBlockHeight(100+halfLife), &types.QueryFetchProviderRequest{Pubkey: provider.PubKey.String(), Service: service.String()})
+	require.NoError(t, err)
+	require.Equal(t, provider.Reputation.Score/2, res.Provider.Reputation.Score)
+
+	setProber.Remove = true
+	require.NoError(t, s.SetProberHandle(ctx, setProber))
+	require.False(t, k.IsProber(ctx, prober))
+	require.ErrorIs(t, s.SetProberValidate(ctx, setProber), types.ErrAttestationUnauthorized)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SubmitEvidence(goCtx context.Context, msg *types.MsgSubmitEvidence) (*types.MsgSubmitEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSubmitEvidence",
+		"contract_id", msg.First.ContractId,
+		"nonce", msg.First.Nonce,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SubmitEvidenceValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit evidence validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SubmitEvidenceHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit evidence handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgSubmitEvidenceResponse{}, nil
+}
+
+func (k msgServer) SubmitEvidenceValidate(ctx cosmos.Context, msg *types.MsgSubmitEvidence) error {
+	if k.FetchConfig(ctx, configs.HandlerSubmitEvidence) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "submit evidence")
+	}
+
+	contract, err := k.GetContract(ctx, msg.First.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "contract %d", msg.First.ContractId)
+	}
+	// only the nonces of pay-as-you-go contracts are signed by the client and tracked by
+	// the provider, a nonce of any other contract can be served any number of times
+	if !contract.IsPayAsYouGo() || contract.IsOpenAuthorization() {
+		return errors.Wrap(types.ErrInvalidEvidence, "only client signed nonces of pay-as-you-go contracts can be double signed")
+	}
+
+	if k.HasEvidence(ctx, msg.First.ContractId, msg.First.Nonce) {
+		return errors.Wrapf(types.ErrEvidenceAlreadySubmitted, "contract %d nonce %d", msg.First.ContractId, msg.First.Nonce)
+	}
+
+	// both receipts must be signed by the provider of the contract
+	pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, contract.Provider.String())
+	if err != nil {
+		return err
+	}
+	for _, receipt := range []types.ResponseReceipt{msg.First, msg.Second} {
+		if !pk.VerifySignature(receipt.GetBytesToSign(ctx.ChainID()), receipt.Signature) {
+			return errors.Wrap(types.ErrInvalidEvidence, "receipt not signed by the contract provider")
+		}
+	}
+
+	return nil
+}
+
+func (k msgServer) SubmitEvidenceHandle(ctx cosmos.Context, msg *types.MsgSubmitEvidence) error {
+	contract, err := k.GetContract(ctx, msg.First.ContractId)
+	if err != nil {
+		return err
+	}
+
+	slashed, jailedUntil, err := k.mgr.SlashProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	k.SetEvidence(ctx, msg.First.ContractId, msg.First.Nonce)
+
+	return k.EmitProviderSlashedEvent(ctx, msg.MustGetSigner(), &contract, msg.First.Nonce, slashed, jailedUntil)
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSubmitEvidence(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("provider", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	providerPubKey, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("other", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	// bond 8, then withdraw 2 which stays slashable while unbonding
+	acct, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acct, getCoin(common.Tokens(10))))
+	bond := types.NewMsgBondProvider(acct, providerPubKey, common.BTCService.String(), cosmos.NewInt(common.Tokens(8)))
+	require.NoError(t, s.BondProviderHandle(ctx, bond))
+	bond.Bond = cosmos.NewInt(common.Tokens(-2))
+	require.NoError(t, s.BondProviderHandle(ctx, bond))
+
+	contract := types.NewContract(providerPubKey, common.BTCService, types.GetRandomPubKey())
+	contract.Id = 1
+	contract.Height = 10
+	contract.Duration = 100
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	receipt := func(key string, hash string) types.ResponseReceipt {
+		r := types.ResponseReceipt{ContractId: contract.Id, Nonce: 5, ResponseHash: []byte(hash)}
+		r.Signature, _, err = kb.Sign(key, r.GetBytesToSign(ctx.ChainID()), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return r
+	}
+	reporter := types.GetRandomBech32Addr()
+
+	// the nonces of subscription and open contracts aren't tracked, serving one twice isn't an offense
+	msg := types.NewMsgSubmitEvidence(reporter, receipt("provider", "a"), receipt("provider", "b"))
+	require.ErrorIs(t, s.SubmitEvidenceValidate(ctx, msg), types.ErrInvalidEvidence)
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Authorization = types.ContractAuthorization_OPEN
+	require.NoError(t, k.SetContract(ctx, contract))
+	require.ErrorIs(t, s.SubmitEvidenceValidate(ctx, msg), types.ErrInvalidEvidence)
+	contract.Authorization = types.ContractAuthorization_STRICT
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	// receipts must be signed by the contract provider
+	msg = types.NewMsgSubmitEvidence(reporter, receipt("provider", "a"), receipt("other", "b"))
+	require.NoError(t, msg.ValidateBasic())
+	require.ErrorIs(t, s.SubmitEvidenceValidate(ctx, msg), types.ErrInvalidEvidence)
+
+	msg = types.NewMsgSubmitEvidence(reporter, receipt("provider", "a"), receipt("provider", "b"))
+	ctx = ctx.WithEventManager(cosmos.NewEventManager())
+	_, err = s.SubmitEvidence(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, types.EventTypeProviderSlashed))
+	// the bond left after the slash is emitted as a bond change
+	require.True(t, hasEvent(ctx, types.EventTypeBondProvider))
+
+	// 10% of the bond and of the unbonding goes to the reserve
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(6)*9/10, provider.Bond.Int64())
+	require.Equal(t, ctx.BlockHeight()+s.FetchConfig(ctx, configs.ProviderJailDuration), provider.JailedUntil)
+	require.True(t, provider.IsJailed(ctx.BlockHeight()))
+	set, err := k.GetProviderUnbondingSet(ctx, ctx.BlockHeight()+s.FetchConfig(ctx, configs.ProviderUnbondingPeriod))
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(2)*9/10, set.Unbondings[0].Amount.Int64())
+	require.Equal(t, common.Tokens(8)/10, k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom).Int64())
+	require.NoError(t, s.mgr.invariantBondModule(ctx))
+
+	// an offense is slashed once
+	_, err = s.SubmitEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrEvidenceAlreadySubmitted)
+
+	// jailed providers can't take new contracts
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.LastUpdate = ctx.BlockHeight()
+	require.NoError(t, k.SetProvider(ctx, provider))
+	open := types.MsgOpenContract{
+		Creator:      acct.String(),
+		Provider:     providerPubKey.String(),
+		Service:      common.BTCService.String(),
+		Client:       providerPubKey.String(),
+		ContractType: types.ContractType_SUBSCRIPTION,
+	}
+	require.ErrorIs(t, s.OpenContractValidate(ctx, &open), types.ErrProviderJailed)
+
+	// unbonding everything does not clear the jail
+	bond.Bond = provider.Bond.Neg()
+	require.NoError(t, s.BondProviderHandle(ctx, bond))
+	provider, err = k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.True(t, provider.Bond.IsZero())
+	require.True(t, provider.IsJailed(ctx.BlockHeight()))
+}
+
+func TestSubmitUnsignedNonceEvidence(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	kb := cKeys.NewInMemory(codec.NewProtoCodec(interfaceRegistry))
+	pubKey := func(name string) common.PubKey {
+		info, _, err := kb.NewMnemonic(name, cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+		require.NoError(t, err)
+		pk, err := info.GetPubKey()
+		require.NoError(t, err)
+		pubKey, err := common.NewPubKeyFromCrypto(pk)
+		require.NoError(t, err)
+		return pubKey
+	}
+	providerPubKey, clientPubKey := pubKey("provider"), pubKey("client")
+
+	acct, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acct, getCoin(common.Tokens(10))))
+	bond := types.NewMsgBondProvider(acct, providerPubKey, common.BTCService.String(), cosmos.NewInt(common.Tokens(10)))
+	require.NoError(t, s.BondProviderHandle(ctx, bond))
+
+	contract := types.NewContract(providerPubKey, common.BTCService, clientPubKey)
+	contract.Id = 1
+	contract.Height = 10
+	contract.Duration = 100
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	receipt := func(nonce int64, clientSignature []byte) types.ResponseReceipt {
+		r := types.ResponseReceipt{ContractId: contract.Id, Nonce: nonce, ResponseHash: []byte("a"), ClientSignature: clientSignature}
+		r.Signature, _, err = kb.Sign("provider", r.GetBytesToSign(ctx.ChainID()), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return r
+	}
+	clientSignature := func(nonce int64) []byte {
+		sig, _, err := kb.Sign("client", []byte(fmt.Sprintf("%d:%d:%s", contract.Id, nonce, ctx.ChainID())), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return sig
+	}
+	reporter := types.GetRandomBech32Addr()
+
+	// a nonce signed by the client is no evidence
+	msg := types.NewMsgSubmitUnsignedNonceEvidence(reporter, receipt(5, clientSignature(5)))
+	require.ErrorIs(t, s.SubmitUnsignedNonceEvidenceValidate(ctx, msg), types.ErrInvalidEvidence)
+
+	// the client signature must be for the nonce of the receipt
+	msg = types.NewMsgSubmitUnsignedNonceEvidence(reporter, receipt(6, clientSignature(5)))
+	_, err = s.SubmitUnsignedNonceEvidence(ctx, msg)
+	require.NoError(t, err)
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(10)*9/10, provider.Bond.Int64())
+	require.True(t, provider.IsJailed(ctx.BlockHeight()))
+
+	_, err = s.SubmitUnsignedNonceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrEvidenceAlreadySubmitted)
+
+	// open contracts don't carry client signatures
+	contract.Authorization = types.ContractAuthorization_OPEN
+	require.NoError(t, k.SetContract(ctx, contract))
+	msg = types.NewMsgSubmitUnsignedNonceEvidence(reporter, receipt(7, nil))
+	require.ErrorIs(t, s.SubmitUnsignedNonceEvidenceValidate(ctx, msg), types.ErrInvalidEvidence)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SubmitUnsignedNonceEvidence(goCtx context.Context, msg *types.MsgSubmitUnsignedNonceEvidence) (*types.MsgSubmitUnsignedNonceEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSubmitUnsignedNonceEvidence",
+		"contract_id", msg.Receipt.ContractId,
+		"nonce", msg.Receipt.Nonce,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SubmitUnsignedNonceEvidenceValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit unsigned nonce evidence validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SubmitUnsignedNonceEvidenceHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed submit unsigned nonce evidence handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgSubmitUnsignedNonceEvidenceResponse{}, nil
+}
+
+func (k msgServer) SubmitUnsignedNonceEvidenceValidate(ctx cosmos.Context, msg *types.MsgSubmitUnsignedNonceEvidence) error {
+	if k.FetchConfig(ctx, configs.HandlerSubmitEvidence) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "submit evidence")
+	}
+
+	receipt := msg.Receipt
+	contract, err := k.GetContract(ctx, receipt.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "contract %d", receipt.ContractId)
+	}
+	if contract.IsOpenAuthorization() {
+		return errors.Wrap(types.ErrInvalidEvidence, "nonces of open contracts are not signed by the client")
+	}
+
+	if k.HasEvidence(ctx, receipt.ContractId, receipt.Nonce) {
+		return errors.Wrapf(types.ErrEvidenceAlreadySubmitted, "contract %d nonce %d", receipt.ContractId, receipt.Nonce)
+	}
+
+	providerPk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, contract.Provider.String())
+	if err != nil {
+		return err
+	}
+	if !providerPk.VerifySignature(receipt.GetBytesToSign(ctx.ChainID()), receipt.Signature) {
+		return errors.Wrap(types.ErrInvalidEvidence, "receipt not signed by the contract provider")
+	}
+
+	clientPk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, contract.GetSpender().String())
+	if err != nil {
+		return err
+	}
+	if clientSignedNonce(clientPk, receipt.ContractId, receipt.Nonce, ctx.ChainID(), receipt.ClientSignature) {
+		return errors.Wrap(types.ErrInvalidEvidence, "nonce signed by the client")
+	}
+
+	return nil
+}
+
+func (k msgServer) SubmitUnsignedNonceEvidenceHandle(ctx cosmos.Context, msg *types.MsgSubmitUnsignedNonceEvidence) error {
+	contract, err := k.GetContract(ctx, msg.Receipt.ContractId)
+	if err != nil {
+		return err
+	}
+
+	slashed, jailedUntil, err := k.mgr.SlashProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	k.SetEvidence(ctx, msg.Receipt.ContractId, msg.Receipt.Nonce)
+
+	return k.EmitProviderSlashedEvent(ctx, msg.MustGetSigner(), &contract, msg.Receipt.Nonce, slashed, jailedUntil)
+}
+
+// clientSignedNonce tells if sig is a signature of the contract nonce by the
+// client, in any of the forms claims accept, or the ethereum style ones the
+// sentinel accepts, a provider serving those is not at fault
+func clientSignedNonce(pk cryptotypes.PubKey, contractId uint64, nonce int64, chainId string, sig []byte) bool {
+	if verifyClientSignature(pk, contractId, nonce, chainId, sig) ||
+		verifyClientSignature(pk, contractId, nonce, chainId, normalizeLowS(sig)) {
+		return true
+	}
+	pre := fmt.Sprintf("%d:%d:", contractId, nonce)
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write([]byte(pre))
+	if pk.VerifySignature(keccak.Sum(nil), sig) {
+		return true
+	}
+	keccak = sha3.NewLegacyKeccak256()
+	keccak.Write([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(pre))))
+	keccak.Write([]byte(pre))
+	return pk.VerifySignature(keccak.Sum(nil), sig)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) TopUpContract(goCtx context.Context, msg *types.MsgTopUpContract) (*types.MsgTopUpContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgTopUpContract",
+		"contract_id", msg.ContractId,
+		"deposit", msg.Deposit,
+		"duration", msg.Duration,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.TopUpContractValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed top up contract validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.TopUpContractHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed top up contract handle", "err", err)
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgTopUpContractResponse{}, nil
+}
+
+func (k msgServer) TopUpContractValidate(ctx cosmos.Context, msg *types.MsgTopUpContract) error {
+	if k.FetchConfig(ctx, configs.HandlerTopUpContract) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "top up contract")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+	if contract.IsGroupMember() {
+		return errors.Wrapf(types.ErrContractGroupInvalid, "members of group %d can't be topped up", contract.GroupId)
+	}
+
+	payer, err := contract.PayerAddress()
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidPubKey, "client: %s", contract.Client.String())
+	}
+	if !payer.Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrTopUpContractUnauthorized, "only the payer can top up the contract")
+	}
+
+	if contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrTopUpContractInvalid, "contract expired at %d", contract.Expiration())
+	}
+
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	if provider.Status != types.ProviderStatus_ONLINE {
+		return errors.Wrapf(types.ErrOpenContractBadProviderStatus, "has status %s", provider.Status.String())
+	}
+	if provider.IsJailed(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrProviderJailed, "jailed until block %d", provider.JailedUntil)
+	}
+	if contract.Duration+msg.Duration > provider.MaxContractDuration {
+		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds allowed maximum duration from provider")
+	}
+	if maxLength := k.FetchConfig(ctx, configs.MaxContractLength); contract.Duration+msg.Duration > maxLength {
+		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds the maximum contract length %d", maxLength)
+	}
+
+	// the contract is extended at its rate, which must still be the rate of
+	// the provider
+	rate := cosmos.NewCoins(provider.PayAsYouGoRate...).AmountOf(contract.Rate.Denom)
+	if contract.IsSubscription() {
+		rate = provider.SubscriptionRateOf(contract.QueriesPerMinute, contract.Rate.Denom)
+	}
+	if !rate.Equal(contract.Rate.Amount) {
+		return errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rate is %d, contract rate is %d", rate.Int64(), contract.Rate.Amount.Int64())
+	}
+
+	// the extension keeps the discount the subscription was opened with
+	if contract.IsSubscription() {
+		cost := contract.SubscriptionCost(contract.Duration + msg.Duration).Sub(contract.SubscriptionCost(contract.Duration))
+		if msg.Duration == 0 || !cost.Equal(msg.Deposit) {
+			return errors.Wrapf(types.ErrOpenContractMismatchRate, "mismatch of the cost of %d blocks and deposit: %d != %d", msg.Duration, cost.Int64(), msg.Deposit.Int64())
+		}
+	}
+
+	return nil
+}
+
+func (k msgServer) TopUpContractHandle(ctx cosmos.Context, msg *types.MsgTopUpContract) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if msg.Deposit.IsPositive() {
+		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, msg.Deposit))); err != nil {
+			return errors.Wrapf(err, "failed to send deposit=%d", msg.Deposit.Int64())
+		}
+		contract.Deposit = contract.Deposit.Add(msg.Deposit)
+	}
+
+	// move the contract to the expiration set of its new settlement height
+	if msg.Duration > 0 {
+		expirationSet, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+		if err != nil {
+			return err
+		}
+		expirationSet.Remove(contract.Id)
+		if len(expirationSet.ContractSet.ContractIds) == 0 {
+			k.RemoveContractExpirationSet(ctx, expirationSet.Height)
+		} else if err := k.SetContractExpirationSet(ctx, expirationSet); err != nil {
+			return err
+		}
+
+		contract.Duration += msg.Duration
+		expirationSet, err = k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+		if err != nil {
+			return err
+		}
+		expirationSet.Append(contract.Id)
+		if err := k.SetContractExpirationSet(ctx, expirationSet); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitTopUpContractEvent(ctx, msg, contract)
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestTopUpContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	// setup
+	providerPubKey := types.GetRandomPubKey()
+	clientPubKey := types.GetRandomPubKey()
+	clientAccount, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+
+	rate := cosmos.NewInt64Coin(configs.Denom, 5)
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MaxContractDuration = 1000
+	provider.MinContractDuration = 10
+	provider.SubscriptionRate = cosmos.NewCoins(rate)
+	provider.PayAsYouGoRate = cosmos.NewCoins(rate)
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAccount, getCoin(common.Tokens(10))))
+
+	require.NoError(t, s.OpenContractHandle(ctx, &types.MsgOpenContract{
+		Creator:          clientAccount.String(),
+		Client:           clientPubKey.String(),
+		Service:          service.String(),
+		Provider:         providerPubKey.String(),
+		Deposit:          cosmos.NewInt(500),
+		Rate:             rate,
+		Duration:         100,
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		QueriesPerMinute: 1,
+	}))
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	oldEnd := contract.SettlementPeriodEnd()
+
+	// extend the subscription by 50 blocks
+	msg := types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(250), 50)
+	require.NoError(t, msg.ValidateBasic())
+	_, err = s.TopUpContract(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, types.EventTypeTopUpContract))
+
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(750), contract.Deposit.Int64())
+	require.Equal(t, int64(150), contract.Duration)
+	require.Equal(t, int64(750), k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).Int64())
+
+	expirationSet, err := k.GetContractExpirationSet(ctx, oldEnd)
+	require.NoError(t, err)
+	require.NotContains(t, expirationSet.ContractSet.ContractIds, contract.Id)
+	expirationSet, err = k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+	require.NoError(t, err)
+	require.Contains(t, expirationSet.ContractSet.ContractIds, contract.Id)
+
+	// subscriptions must pay exactly for the extension
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(100), 50)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(100), 0)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+
+	// only the client can top up
+	other := types.GetRandomBech32Addr()
+	msg = types.NewMsgTopUpContract(other, contract.Id, cosmos.NewInt(250), 50)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrTopUpContractUnauthorized)
+
+	// no extension past the maximum duration of the provider
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(5*900), 900)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrOpenContractDuration)
+
+	// nor past the maximum contract length of the chain
+	maxLength := s.FetchConfig(ctx, configs.MaxContractLength)
+	provider.MaxContractDuration = 2 * maxLength
+	require.NoError(t, k.SetProvider(ctx, provider))
+	extension := maxLength - contract.Duration + 1
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, contract.SubscriptionCost(contract.Duration+extension).Sub(contract.SubscriptionCost(contract.Duration)), extension)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrOpenContractDuration)
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, contract.SubscriptionCost(contract.Duration+extension-1).Sub(contract.SubscriptionCost(contract.Duration)), extension-1)
+	require.NoError(t, s.TopUpContractValidate(ctx, msg))
+
+	// nor at an outdated rate
+	provider.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 6))
+	require.NoError(t, k.SetProvider(ctx, provider))
+	msg = types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(250), 50)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+
+	// expired contracts can't be topped up
+	ctx = ctx.WithBlockHeight(contract.Expiration() + 1)
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrTopUpContractInvalid)
+}
+
+func TestTopUpPayAsYouGoContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	// setup
+	providerPubKey := types.GetRandomPubKey()
+	clientPubKey := types.GetRandomPubKey()
+	clientAccount, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+
+	rate := cosmos.NewInt64Coin(configs.Denom, 2)
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(500_00000000)
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MaxContractDuration = 1000
+	provider.MinContractDuration = 10
+	provider.PayAsYouGoRate = cosmos.NewCoins(rate)
+	provider.LastUpdate = 1
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAccount, getCoin(common.Tokens(10))))
+
+	require.NoError(t, s.OpenContractHandle(ctx, &types.MsgOpenContract{
+		Creator:          clientAccount.String(),
+		Client:           clientPubKey.String(),
+		Service:          service.String(),
+		Provider:         providerPubKey.String(),
+		Deposit:          cosmos.NewInt(100),
+		Rate:             rate,
+		Duration:         100,
+		ContractType:     types.ContractType_PAY_AS_YOU_GO,
+		QueriesPerMinute: 10,
+	}))
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, service)
+	require.NoError(t, err)
+
+	// deposit only, the expiration is unchanged
+	msg := types.NewMsgTopUpContract(clientAccount, contract.Id, cosmos.NewInt(40), 0)
+	_, err = s.TopUpContract(ctx, msg)
+	require.NoError(t, err)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(140), contract.Deposit.Int64())
+	require.Equal(t, int64(100), contract.Duration)
+
+	// disabled handler
+	require.NoError(t, k.SetConfigOverride(ctx, types.ConfigOverride{Name: configs.HandlerTopUpContract.String(), Type: types.ConfigType_INT64, Int64Value: 1}))
+	err = s.TopUpContractValidate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDisabledHandler)
+}
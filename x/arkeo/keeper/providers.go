@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+
+	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -11,10 +15,14 @@ import (
 func (k KVStore) setProvider(ctx cosmos.Context, key string, record types.Provider) {
 	store := ctx.KVStore(k.storeKey)
 	buf := k.cdc.MustMarshal(&record)
-	if buf == nil || record.Bond.IsZero() {
+	indexKey := k.getProviderServiceIndexKey(ctx, record)
+	// jailed providers are kept so that re-bonding does not clear the jail
+	if buf == nil || (record.Bond.IsZero() && record.JailedUntil == 0) {
 		store.Delete([]byte(key))
+		store.Delete([]byte(indexKey))
 	} else {
 		store.Set([]byte(key), buf)
+		store.Set([]byte(indexKey), []byte(key))
 	}
 }
 
@@ -62,4 +70,118 @@ func (k KVStore) ProviderExists(ctx cosmos.Context, pubkey common.PubKey, servic
 func (k KVStore) RemoveProvider(ctx cosmos.Context, pubkey common.PubKey, service common.Service) {
 	record := types.NewProvider(pubkey, service)
 	k.del(ctx, k.GetKey(ctx, prefixProvider, record.Key()))
+	k.del(ctx, k.getProviderServiceIndexKey(ctx, record))
+}
+
+func (k KVStore) getProviderUnbondingSetKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixProviderUnbondingSet, strconv.FormatInt(height, 10))
+}
+
+// GetProviderUnbondingSetIterator iterate provider unbonding sets
+func (k KVStore) GetProviderUnbondingSetIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixProviderUnbondingSet)
+}
+
+// GetProviderUnbondingSet get the provider unbondings released at the given height
+func (k KVStore) GetProviderUnbondingSet(ctx cosmos.Context, height int64) (types.ProviderUnbondingSet, error) {
+	record := types.ProviderUnbondingSet{
+		Height: height,
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.getProviderUnbondingSetKey(ctx, height)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &record)
+	return record, err
+}
+
+// SetProviderUnbondingSet save the provider unbondings released at a height
+func (k KVStore) SetProviderUnbondingSet(ctx cosmos.Context, record types.ProviderUnbondingSet) error {
+	if record.Height <= 0 {
+		return errors.New("cannot save a provider unbonding set with an invalid height (less than or equal to zero)")
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.getProviderUnbondingSetKey(ctx, record.Height)
+	if len(record.Unbondings) == 0 {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), k.cdc.MustMarshal(&record))
+	}
+	return nil
+}
+
+func (k KVStore) RemoveProviderUnbondingSet(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.getProviderUnbondingSetKey(ctx, height))
+}
+
+func (k KVStore) getEvidenceKey(ctx cosmos.Context, contractId uint64, nonce int64) string {
+	return k.GetKey(ctx, prefixEvidence, fmt.Sprintf("%d/%d", contractId, nonce))
+}
+
+// HasEvidence check whether evidence was already accepted for a contract nonce
+func (k KVStore) HasEvidence(ctx cosmos.Context, contractId uint64, nonce int64) bool {
+	return k.has(ctx, k.getEvidenceKey(ctx, contractId, nonce))
+}
+
+// SetEvidence records that evidence was accepted for a contract nonce, so that
+// a provider is slashed once per offense
+func (k KVStore) SetEvidence(ctx cosmos.Context, contractId uint64, nonce int64) {
+	record := types.EvidenceRecord{ContractId: contractId, Nonce: nonce}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getEvidenceKey(ctx, contractId, nonce)), k.cdc.MustMarshal(&record))
+}
+
+// GetEvidenceIterator iterate evidence records
+func (k KVStore) GetEvidenceIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixEvidence)
+}
+
+func (k KVStore) getProberKey(ctx cosmos.Context, addr cosmos.AccAddress) string {
+	return k.GetKey(ctx, prefixProber, addr.String())
+}
+
+// GetProberIterator iterate probers, the values are their addresses
+func (k KVStore) GetProberIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixProber)
+}
+
+// IsProber check whether the given account is a registered prober
+func (k KVStore) IsProber(ctx cosmos.Context, addr cosmos.AccAddress) bool {
+	return k.has(ctx, k.getProberKey(ctx, addr))
+}
+
+// AddProber registers an account allowed to attest providers without a
+// contract
+func (k KVStore) AddProber(ctx cosmos.Context, addr cosmos.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getProberKey(ctx, addr)), addr)
+}
+
+func (k KVStore) RemoveProber(ctx cosmos.Context, addr cosmos.AccAddress) {
+	k.del(ctx, k.getProberKey(ctx, addr))
+}
+
+func (k KVStore) getAttestationKey(ctx cosmos.Context, pubkey common.PubKey, service common.Service, attester cosmos.AccAddress) string {
+	return k.GetKey(ctx, prefixAttestation, fmt.Sprintf("%s/%d/%s", pubkey, service, attester))
+}
+
+// GetLastAttestation get the height the given account last attested the
+// provider at, zero if it never did
+func (k KVStore) GetLastAttestation(ctx cosmos.Context, pubkey common.PubKey, service common.Service, attester cosmos.AccAddress) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(k.getAttestationKey(ctx, pubkey, service, attester)))
+	if bz == nil {
+		return 0
+	}
+	var height gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &height)
+	return height.Value
+}
+
+// SetLastAttestation records the height the given account attested the
+// provider at
+func (k KVStore) SetLastAttestation(ctx cosmos.Context, pubkey common.PubKey, service common.Service, attester cosmos.AccAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.getAttestationKey(ctx, pubkey, service, attester)), k.cdc.MustMarshal(&gogotypes.Int64Value{Value: height}))
 }
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// RenewContract opens the successor of an expired auto-renewing subscription.
+// When it can't be renewed, the escrow is refunded to the client and
+// EventContractRenewalFailed is emitted.
+func (mgr Manager) RenewContract(ctx cosmos.Context, contract types.Contract, escrow cosmos.Int) {
+	cacheCtx, commit := ctx.CacheContext()
+	renewed, fromEscrow, fromAccount, err := mgr.renewContract(cacheCtx, contract, escrow)
+	if err == nil {
+		commit()
+		if err := mgr.EmitContractRenewedEvent(ctx, contract, renewed.Id, fromEscrow, fromAccount); err != nil {
+			ctx.Logger().Error("unable to emit contract renewed event", "id", contract.Id, "error", err)
+		}
+		return
+	}
+	ctx.Logger().Info("unable to renew contract", "id", contract.Id, "error", err)
+
+	refunded := cosmos.ZeroInt()
+	if escrow.IsPositive() {
+		payer, addrErr := contract.PayerAddress()
+		if addrErr == nil {
+			addrErr = mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, payer, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, escrow)))
+		}
+		if addrErr != nil {
+			ctx.Logger().Error("unable to refund renewal escrow", "id", contract.Id, "error", addrErr)
+		} else {
+			refunded = escrow
+		}
+	}
+	if err := mgr.EmitContractRenewalFailedEvent(ctx, contract, err.Error(), refunded); err != nil {
+		ctx.Logger().Error("unable to emit contract renewal failed event", "id", contract.Id, "error", err)
+	}
+}
+
+// renewContract opens a contract with the terms of the given one, if the
+// provider still offers them. The open contract cost and the deposit are paid
+// from the escrow, which the contract module holds, then from the payer
+// account. Returns the new contract and the amounts, in the rate denom, paid
+// from the escrow and the account.
+func (mgr Manager) renewContract(ctx cosmos.Context, contract types.Contract, escrow cosmos.Int) (types.Contract, cosmos.Int, cosmos.Int, error) {
+	fromEscrow, fromAccount := cosmos.ZeroInt(), cosmos.ZeroInt()
+	if mgr.FetchConfig(ctx, configs.HandlerOpenContract) > 0 {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrDisabledHandler, "open contract")
+	}
+
+	provider, err := mgr.keeper.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+	if provider.LastUpdate == 0 {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrProviderNotFound, "provider %s for service %s not found", contract.Provider, contract.Service)
+	}
+	minBond := mgr.FetchConfig(ctx, configs.MinProviderBond)
+	if provider.Bond.LT(cosmos.NewInt(minBond)) {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrInvalidBond, "not enough provider bond to open a contract (%d/%d)", provider.Bond.Int64(), minBond)
+	}
+	if provider.Status != types.ProviderStatus_ONLINE {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrOpenContractBadProviderStatus, "has status %s", provider.Status.String())
+	}
+	if provider.IsJailed(ctx.BlockHeight()) {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrProviderJailed, "jailed until block %d", provider.JailedUntil)
+	}
+	if contract.Duration > provider.MaxContractDuration || contract.Duration < provider.MinContractDuration {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrOpenContractDuration, "duration %d is outside of the provider range %d-%d", contract.Duration, provider.MinContractDuration, provider.MaxContractDuration)
+	}
+	if rate := provider.SubscriptionRateOf(contract.QueriesPerMinute, contract.Rate.Denom); !rate.Equal(contract.Rate.Amount) {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rate is %d, contract rate is %d", rate.Int64(), contract.Rate.Amount.Int64())
+	}
+
+	payer, err := contract.PayerAddress()
+	if err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+	// a payer other than the client has to still grant the client MsgOpenContract
+	client, err := contract.Client.GetMyAddress()
+	if err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+	if !payer.Equals(client) && !mgr.keeper.HasGrant(ctx, client, payer, sdk.MsgTypeURL(&types.MsgOpenContract{})) {
+		return types.Contract{}, fromEscrow, fromAccount, errors.Wrapf(types.ErrContractPayerUnauthorized, "payer %s revoked the grant of %s to open contracts", payer, client)
+	}
+	pay := func(module string, coin cosmos.Coin) error {
+		paidFromEscrow := cosmos.ZeroInt()
+		if coin.Denom == contract.Rate.Denom {
+			paidFromEscrow = sdkmath.MinInt(escrow, coin.Amount)
+		}
+		paidFromAccount := coin.Amount.Sub(paidFromEscrow)
+		if paidFromEscrow.IsPositive() && module != types.ContractName {
+			if err := mgr.keeper.SendFromModuleToModule(ctx, types.ContractName, module, cosmos.NewCoins(cosmos.NewCoin(coin.Denom, paidFromEscrow))); err != nil {
+				return err
+			}
+		}
+		if paidFromAccount.IsPositive() {
+			if err := mgr.keeper.SendFromAccountToModule(ctx, payer, module, cosmos.NewCoins(cosmos.NewCoin(coin.Denom, paidFromAccount))); err != nil {
+				return errors.Wrapf(err, "failed to send %s from payer account", coin.String())
+			}
+		}
+		escrow = escrow.Sub(paidFromEscrow)
+		if coin.Denom == contract.Rate.Denom {
+			fromEscrow = fromEscrow.Add(paidFromEscrow)
+			fromAccount = fromAccount.Add(paidFromAccount)
+		}
+		return nil
+	}
+
+	openCost := mgr.FetchConfig(ctx, configs.OpenContractCost)
+	if openCost > 0 {
+		if err := pay(types.ReserveName, getCoin(openCost)); err != nil {
+			return types.Contract{}, fromEscrow, fromAccount, err
+		}
+	}
+	// the discount is the one the provider offers at renewal
+	discount := provider.RateCard.GetDurationDiscount(contract.Duration)
+	deposit := types.SubscriptionCost(contract.Rate.Amount, contract.Duration, contract.QueriesPerMinute, discount)
+	if err := pay(types.ContractName, cosmos.NewCoin(contract.Rate.Denom, deposit)); err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+
+	renewed := types.Contract{
+		Provider:           contract.Provider,
+		Id:                 mgr.keeper.GetAndIncrementNextContractId(ctx),
+		Service:            contract.Service,
+		Type:               contract.Type,
+		Client:             contract.Client,
+		Delegate:           contract.Delegate,
+		Duration:           contract.Duration,
+		Rate:               contract.Rate,
+		Deposit:            deposit,
+		Paid:               cosmos.ZeroInt(),
+		Height:             ctx.BlockHeight(),
+		SettlementDuration: contract.SettlementDuration,
+		Authorization:      contract.Authorization,
+		QueriesPerMinute:   contract.QueriesPerMinute,
+		AutoRenew:          true,
+		RenewalEscrow:      escrow,
+		DiscountBps:        discount,
+		DelegateLimit:      types.NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+		DelegateSpent:      cosmos.ZeroInt(),
+		Payer:              contract.Payer,
+	}
+
+	expirationSet, err := mgr.keeper.GetContractExpirationSet(ctx, renewed.SettlementPeriodEnd())
+	if err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+	expirationSet.Append(renewed.Id)
+	if err := mgr.keeper.SetContractExpirationSet(ctx, expirationSet); err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+
+	userSet, err := mgr.keeper.GetUserContractSet(ctx, renewed.GetSpender())
+	if err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+	if userSet.ContractSet == nil {
+		userSet.ContractSet = &types.ContractSet{}
+	}
+	userSet.ContractSet.ContractIds = append(userSet.ContractSet.ContractIds, renewed.Id)
+	if err := mgr.keeper.SetUserContractSet(ctx, userSet); err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+
+	if err := mgr.keeper.SetContract(ctx, renewed); err != nil {
+		return types.Contract{}, fromEscrow, fromAccount, err
+	}
+
+	return renewed, fromEscrow, fromAccount, mgr.EmitOpenContractEvent(ctx, openCost, &renewed)
+}
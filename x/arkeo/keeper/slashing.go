@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// SlashProvider takes the slash fraction off the bond of a provider and off
+// its pending unbondings, sends it to the reserve and jails the provider.
+// Returns the slashed amount and the height the jail ends.
+func (mgr Manager) SlashProvider(ctx cosmos.Context, pubkey common.PubKey, service common.Service) (cosmos.Int, int64, error) {
+	fraction := cosmos.NewInt(mgr.FetchConfig(ctx, configs.ProviderSlashFraction))
+	share := func(amt cosmos.Int) cosmos.Int {
+		return amt.Mul(fraction).QuoRaw(configs.MaxBasisPoints)
+	}
+
+	provider, err := mgr.keeper.GetProvider(ctx, pubkey, service)
+	if err != nil {
+		return cosmos.ZeroInt(), 0, err
+	}
+	bondSlashed := share(provider.Bond)
+	provider.Bond = provider.Bond.Sub(bondSlashed)
+	slashed := bondSlashed
+
+	// withdrawn bond is slashable until it is released
+	var sets []types.ProviderUnbondingSet
+	iter := mgr.keeper.GetProviderUnbondingSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var set types.ProviderUnbondingSet
+		if err := mgr.keeper.Cdc().Unmarshal(iter.Value(), &set); err != nil {
+			ctx.Logger().Error("fail to unmarshal provider unbonding set", "error", err)
+			continue
+		}
+		changed := false
+		for i, unbonding := range set.Unbondings {
+			if !unbonding.Provider.Equals(pubkey) || unbonding.Service != service {
+				continue
+			}
+			amt := share(unbonding.Amount)
+			set.Unbondings[i].Amount = unbonding.Amount.Sub(amt)
+			slashed = slashed.Add(amt)
+			changed = true
+		}
+		if changed {
+			sets = append(sets, set)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return cosmos.ZeroInt(), 0, err
+	}
+	for _, set := range sets {
+		if err := mgr.keeper.SetProviderUnbondingSet(ctx, set); err != nil {
+			return cosmos.ZeroInt(), 0, err
+		}
+	}
+
+	jailedUntil := ctx.BlockHeight() + mgr.FetchConfig(ctx, configs.ProviderJailDuration)
+	if jailedUntil > provider.JailedUntil {
+		provider.JailedUntil = jailedUntil
+	}
+	provider.LastUpdate = ctx.BlockHeight()
+	if err := mgr.keeper.SetProvider(ctx, provider); err != nil {
+		return cosmos.ZeroInt(), 0, err
+	}
+	// the bond change is emitted like any other, for the indexers tracking bonds
+	if bondSlashed.IsPositive() {
+		if err := mgr.EmitBondSlashedEvent(ctx, provider, bondSlashed); err != nil {
+			return cosmos.ZeroInt(), 0, err
+		}
+	}
+
+	if slashed.IsPositive() {
+		if err := mgr.keeper.SendFromModuleToModule(ctx, types.ProviderName, types.ReserveName, getCoins(slashed.Int64())); err != nil {
+			return cosmos.ZeroInt(), 0, err
+		}
+	}
+	return slashed, provider.JailedUntil, nil
+}
+
+// ProviderUnbondingEndBlock releases the bond withdrawals whose unbonding
+// period ends at the current height
+func (mgr Manager) ProviderUnbondingEndBlock(ctx cosmos.Context) error {
+	set, err := mgr.keeper.GetProviderUnbondingSet(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	// unbondings that can't be paid out are retried on the next block
+	retry := types.ProviderUnbondingSet{Height: ctx.BlockHeight() + 1}
+	for _, unbonding := range set.Unbondings {
+		if !unbonding.Amount.IsPositive() {
+			continue
+		}
+		addr, err := unbonding.Provider.GetMyAddress()
+		if err != nil {
+			ctx.Logger().Error("unable to get provider address", "provider", unbonding.Provider, "error", err)
+			continue
+		}
+		if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ProviderName, addr, getCoins(unbonding.Amount.Int64())); err != nil {
+			ctx.Logger().Error("unable to release provider unbonding", "provider", unbonding.Provider, "error", err)
+			retry.Append(unbonding)
+			continue
+		}
+		if err := mgr.EmitProviderUnbondedEvent(ctx, unbonding); err != nil {
+			ctx.Logger().Error("unable to emit provider unbonded event", "provider", unbonding.Provider, "error", err)
+		}
+	}
+	mgr.keeper.RemoveProviderUnbondingSet(ctx, set.Height)
+
+	if len(retry.Unbondings) == 0 {
+		return nil
+	}
+	next, err := mgr.keeper.GetProviderUnbondingSet(ctx, retry.Height)
+	if err != nil {
+		return err
+	}
+	for _, unbonding := range retry.Unbondings {
+		next.Append(unbonding)
+	}
+	return mgr.keeper.SetProviderUnbondingSet(ctx, next)
+}
@@ -18,6 +18,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterService{}, "arkeo/RegisterService", nil)
 	cdc.RegisterConcrete(&MsgUpdateService{}, "arkeo/UpdateService", nil)
 	cdc.RegisterConcrete(&MsgRemoveService{}, "arkeo/RemoveService", nil)
+	cdc.RegisterConcrete(&MsgSubmitEvidence{}, "arkeo/SubmitEvidence", nil)
+	cdc.RegisterConcrete(&MsgSubmitUnsignedNonceEvidence{}, "arkeo/SubmitUnsignedNonceEvidence", nil)
+	cdc.RegisterConcrete(&MsgSetConfig{}, "arkeo/SetConfig", nil)
+	cdc.RegisterConcrete(&MsgTopUpContract{}, "arkeo/TopUpContract", nil)
+	cdc.RegisterConcrete(&MsgSetContractRenewal{}, "arkeo/SetContractRenewal", nil)
+	cdc.RegisterConcrete(&MsgOpenContractGroup{}, "arkeo/OpenContractGroup", nil)
+	cdc.RegisterConcrete(&MsgSetDelegateLimit{}, "arkeo/SetDelegateLimit", nil)
+	cdc.RegisterConcrete(&MsgSubmitAttestation{}, "arkeo/SubmitAttestation", nil)
+	cdc.RegisterConcrete(&MsgSetProber{}, "arkeo/SetProber", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,6 +41,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRegisterService{},
 		&MsgUpdateService{},
 		&MsgRemoveService{},
+		&MsgSubmitEvidence{},
+		&MsgSubmitUnsignedNonceEvidence{},
+		&MsgSetConfig{},
+		&MsgTopUpContract{},
+		&MsgSetContractRenewal{},
+		&MsgOpenContractGroup{},
+		&MsgSetDelegateLimit{},
+		&MsgSubmitAttestation{},
+		&MsgSetProber{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func NewDelegateLimit(maxNonce int64, maxValue cosmos.Int, period int64) DelegateLimit {
+	return DelegateLimit{
+		MaxNonce: maxNonce,
+		MaxValue: maxValue,
+		Period:   period,
+	}
+}
+
+// GetMaxValue returns the value paid per period, zero for no limit
+func (limit DelegateLimit) GetMaxValue() cosmos.Int {
+	if limit.MaxValue.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	return limit.MaxValue
+}
+
+func (limit DelegateLimit) IsEmpty() bool {
+	return limit.MaxNonce == 0 && limit.GetMaxValue().IsZero()
+}
+
+func (limit DelegateLimit) Validate() error {
+	if limit.MaxNonce < 0 {
+		return errors.Wrapf(ErrDelegateLimitInvalid, "max nonce cannot be negative")
+	}
+	if limit.GetMaxValue().IsNegative() {
+		return errors.Wrapf(ErrDelegateLimitInvalid, "max value cannot be negative")
+	}
+	if limit.Period < 0 {
+		return errors.Wrapf(ErrDelegateLimitInvalid, "period cannot be negative")
+	}
+	if limit.Period > 0 && limit.GetMaxValue().IsZero() {
+		return errors.Wrapf(ErrDelegateLimitInvalid, "a period needs a max value")
+	}
+	return nil
+}
+
+// CapNonce returns the highest nonce of the given one the spender can be
+// charged for
+func (contract Contract) CapNonce(nonce int64) int64 {
+	if contract.DelegateLimit.MaxNonce > 0 && nonce > contract.DelegateLimit.MaxNonce {
+		return contract.DelegateLimit.MaxNonce
+	}
+	return nonce
+}
+
+// delegatePeriod returns the start of the period of the limit at the given
+// height, and the value spent in it
+func (contract Contract) delegatePeriod(height int64) (int64, cosmos.Int) {
+	spent := contract.DelegateSpent
+	if spent.IsNil() {
+		spent = cosmos.ZeroInt()
+	}
+	period := contract.DelegateLimit.Period
+	start := contract.DelegatePeriodStart
+	if start == 0 {
+		start = contract.Height
+	}
+	if period > 0 && height >= start+period {
+		return height - (height-start)%period, cosmos.ZeroInt()
+	}
+	return start, spent
+}
+
+// DelegateAllowance returns what the spender can still use in the period of
+// the given height, false when the value isn't limited
+func (contract Contract) DelegateAllowance(height int64) (cosmos.Int, bool) {
+	max := contract.DelegateLimit.GetMaxValue()
+	if max.IsZero() {
+		return cosmos.ZeroInt(), false
+	}
+	_, spent := contract.delegatePeriod(height)
+	if spent.GTE(max) {
+		return cosmos.ZeroInt(), true
+	}
+	return max.Sub(spent), true
+}
+
+// AddDelegateSpent records the value paid for the spender at the given height
+func (contract *Contract) AddDelegateSpent(height int64, amt cosmos.Int) {
+	if contract.DelegateLimit.GetMaxValue().IsZero() {
+		return
+	}
+	start, spent := contract.delegatePeriod(height)
+	contract.DelegatePeriodStart = start
+	contract.DelegateSpent = spent.Add(amt)
+}
+
+// PayerAddress returns the account that paid the deposit of the contract,
+// which gets its refunds
+func (contract Contract) PayerAddress() (cosmos.AccAddress, error) {
+	if !contract.Payer.Empty() {
+		return contract.Payer, nil
+	}
+	return contract.Client.GetMyAddress()
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestDelegateLimitValidate(t *testing.T) {
+	require.NoError(t, NewDelegateLimit(0, cosmos.ZeroInt(), 0).Validate())
+	require.NoError(t, DelegateLimit{}.Validate())
+	require.True(t, DelegateLimit{}.IsEmpty())
+	require.NoError(t, NewDelegateLimit(100, cosmos.NewInt(50), 10).Validate())
+	require.ErrorIs(t, NewDelegateLimit(-1, cosmos.ZeroInt(), 0).Validate(), ErrDelegateLimitInvalid)
+	require.ErrorIs(t, NewDelegateLimit(0, cosmos.NewInt(-1), 0).Validate(), ErrDelegateLimitInvalid)
+	require.ErrorIs(t, NewDelegateLimit(0, cosmos.NewInt(50), -1).Validate(), ErrDelegateLimitInvalid)
+	// a period limits a value
+	require.ErrorIs(t, NewDelegateLimit(100, cosmos.ZeroInt(), 10).Validate(), ErrDelegateLimitInvalid)
+}
+
+func TestDelegateAllowance(t *testing.T) {
+	contract := NewContract(GetRandomPubKey(), 10, GetRandomPubKey())
+	contract.Height = 100
+	require.Equal(t, int64(250), contract.CapNonce(250))
+	_, limited := contract.DelegateAllowance(100)
+	require.False(t, limited)
+
+	contract.DelegateLimit = NewDelegateLimit(200, cosmos.NewInt(50), 10)
+	require.Equal(t, int64(200), contract.CapNonce(250))
+	require.Equal(t, int64(150), contract.CapNonce(150))
+
+	allowance, limited := contract.DelegateAllowance(100)
+	require.True(t, limited)
+	require.Equal(t, int64(50), allowance.Int64())
+	contract.AddDelegateSpent(105, cosmos.NewInt(30))
+	allowance, _ = contract.DelegateAllowance(109)
+	require.Equal(t, int64(20), allowance.Int64())
+
+	// the next periods start from scratch
+	allowance, _ = contract.DelegateAllowance(110)
+	require.Equal(t, int64(50), allowance.Int64())
+	contract.AddDelegateSpent(125, cosmos.NewInt(60))
+	require.Equal(t, int64(120), contract.DelegatePeriodStart)
+	allowance, _ = contract.DelegateAllowance(129)
+	require.True(t, allowance.IsZero())
+
+	// without a period, the limit is for the life of the contract
+	contract.DelegateLimit.Period = 0
+	allowance, _ = contract.DelegateAllowance(1000)
+	require.True(t, allowance.IsZero())
+}
+
+func TestContractPayer(t *testing.T) {
+	contract := NewContract(GetRandomPubKey(), 10, GetRandomPubKey())
+	payer, err := contract.PayerAddress()
+	require.NoError(t, err)
+	require.Equal(t, contract.ClientAddress(), payer)
+
+	contract.Payer = GetRandomBech32Addr()
+	payer, err = contract.PayerAddress()
+	require.NoError(t, err)
+	require.Equal(t, contract.Payer, payer)
+}
@@ -43,4 +43,20 @@ var (
 	ErrInvalidVersion                         = errors.Register(ModuleName, 34, "version cannot be zero or lower")
 	ErrInvalidBlocksPerYear                   = errors.Register(ModuleName, 37, "blocks per year cannot be zero or lower")
 	ErrInvalidEmissionCurve                   = errors.Register(ModuleName, 38, "emissionCurve set is invalid")
+	ErrProviderJailed                         = errors.Register(ModuleName, 39, "provider is jailed")
+	ErrInvalidEvidence                        = errors.Register(ModuleName, 40, "invalid evidence")
+	ErrEvidenceAlreadySubmitted               = errors.Register(ModuleName, 41, "evidence already submitted")
+	ErrInvalidConfig                          = errors.Register(ModuleName, 42, "invalid config")
+	ErrTopUpContractUnauthorized              = errors.Register(ModuleName, 43, "unauthorized to top up contract")
+	ErrTopUpContractInvalid                   = errors.Register(ModuleName, 44, "invalid contract top up")
+	ErrContractRenewalUnauthorized            = errors.Register(ModuleName, 45, "unauthorized to set contract renewal")
+	ErrContractRenewalInvalid                 = errors.Register(ModuleName, 46, "invalid contract renewal")
+	ErrInvalidModProviderRateCard             = errors.Register(ModuleName, 47, "invalid provider rate card")
+	ErrContractGroupInvalid                   = errors.Register(ModuleName, 48, "invalid contract group")
+	ErrDelegateLimitInvalid                   = errors.Register(ModuleName, 49, "invalid delegate limit")
+	ErrDelegateLimitExceeded                  = errors.Register(ModuleName, 50, "delegate limit exceeded")
+	ErrContractPayerUnauthorized              = errors.Register(ModuleName, 51, "only the payer of the contract is authorized")
+	ErrAttestationInvalid                     = errors.Register(ModuleName, 52, "invalid attestation")
+	ErrAttestationUnauthorized                = errors.Register(ModuleName, 53, "unauthorized to attest provider")
+	ErrAttestationTooSoon                     = errors.Register(ModuleName, 54, "provider already attested recently")
 )
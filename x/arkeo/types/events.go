@@ -9,12 +9,30 @@ const (
 	EventTypeBondProvider    = "arkeo.arkeo.EventBondProvider"
 	EventTypeModProvider     = "arkeo.arkeo.EventModProvider"
 	EventTypeOpenContract    = "arkeo.arkeo.EventOpenContract"
+	EventTypeTopUpContract   = "arkeo.arkeo.EventTopUpContract"
 	EventTypeSettleContract  = "arkeo.arkeo.EventSettleContract"
 	EventTypeCloseContract   = "arkeo.arkeo.EventCloseContract"
 	EventTypeValidatorPayout = "arkeo.arkeo.EventValidatorPayout"
 	EventTypeRegisterService = "arkeo.arkeo.EventRegisterService"
 	EventTypeUpdateService   = "arkeo.arkeo.EventUpdateService"
 	EventTypeRemoveService   = "arkeo.arkeo.EventRemoveService"
+	EventTypeProviderSlashed = "arkeo.arkeo.EventProviderSlashed"
+
+	EventTypeProviderUnbonding = "arkeo.arkeo.EventProviderUnbonding"
+	EventTypeProviderUnbonded  = "arkeo.arkeo.EventProviderUnbonded"
+	EventTypeSetConfig         = "arkeo.arkeo.EventSetConfig"
+
+	EventTypeSetContractRenewal    = "arkeo.arkeo.EventSetContractRenewal"
+	EventTypeContractRenewed       = "arkeo.arkeo.EventContractRenewed"
+	EventTypeContractRenewalFailed = "arkeo.arkeo.EventContractRenewalFailed"
+
+	EventTypeOpenContractGroup   = "arkeo.arkeo.EventOpenContractGroup"
+	EventTypeSettleContractGroup = "arkeo.arkeo.EventSettleContractGroup"
+
+	EventTypeSetDelegateLimit = "arkeo.arkeo.EventSetDelegateLimit"
+
+	EventTypeSubmitAttestation = "arkeo.arkeo.EventSubmitAttestation"
+	EventTypeSetProber         = "arkeo.arkeo.EventSetProber"
 )
 
 func NewOpenContractEvent(openCost int64, contract *Contract) EventOpenContract {
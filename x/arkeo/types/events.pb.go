@@ -97,6 +97,7 @@ type EventModProvider struct {
 	PayAsYouGoRate      []types.Coin                                  `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate"`
 	Bond                cosmossdk_io_math.Int                         `protobuf:"bytes,11,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
 	SettlementDuration  int64                                         `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	RateCard            RateCard                                      `protobuf:"bytes,13,opt,name=rate_card,json=rateCard,proto3" json:"rate_card"`
 }
 
 func (m *EventModProvider) Reset()         { *m = EventModProvider{} }
@@ -209,23 +210,35 @@ func (m *EventModProvider) GetSettlementDuration() int64 {
 	return 0
 }
 
+func (m *EventModProvider) GetRateCard() RateCard {
+	if m != nil {
+		return m.RateCard
+	}
+	return RateCard{}
+}
+
 // EventOpenContract is emitted when a contract is opened on chain.
 type EventOpenContract struct {
-	Provider           github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
-	ContractId         uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Service            string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client             github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
-	Delegate           github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
-	Type               ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Height             int64                                       `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	Duration           int64                                       `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               types.Coin                                  `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate"`
-	OpenCost           int64                                       `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
-	Deposit            cosmossdk_io_math.Int                       `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
-	SettlementDuration int64                                       `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization                       `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                                       `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
-	SettlementHeight   int64                                       `protobuf:"varint,15,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
+	Provider           github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId         uint64                                        `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service            string                                        `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client             github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate           github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Type               ContractType                                  `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Height             int64                                         `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Duration           int64                                         `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rate               types.Coin                                    `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate"`
+	OpenCost           int64                                         `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
+	Deposit            cosmossdk_io_math.Int                         `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	SettlementDuration int64                                         `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization      ContractAuthorization                         `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute   int64                                         `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	SettlementHeight   int64                                         `protobuf:"varint,15,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
+	PayAsYouGoTiers    []RateTier                                    `protobuf:"bytes,16,rep,name=pay_as_you_go_tiers,json=payAsYouGoTiers,proto3" json:"pay_as_you_go_tiers"`
+	DiscountBps        int64                                         `protobuf:"varint,17,opt,name=discount_bps,json=discountBps,proto3" json:"discount_bps,omitempty"`
+	GroupId            uint64                                        `protobuf:"varint,18,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DelegateLimit      DelegateLimit                                 `protobuf:"bytes,19,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	Payer              github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,20,opt,name=payer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payer,omitempty"`
 }
 
 func (m *EventOpenContract) Reset()         { *m = EventOpenContract{} }
@@ -359,6 +372,41 @@ func (m *EventOpenContract) GetSettlementHeight() int64 {
 	return 0
 }
 
+func (m *EventOpenContract) GetPayAsYouGoTiers() []RateTier {
+	if m != nil {
+		return m.PayAsYouGoTiers
+	}
+	return nil
+}
+
+func (m *EventOpenContract) GetDiscountBps() int64 {
+	if m != nil {
+		return m.DiscountBps
+	}
+	return 0
+}
+
+func (m *EventOpenContract) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventOpenContract) GetDelegateLimit() DelegateLimit {
+	if m != nil {
+		return m.DelegateLimit
+	}
+	return DelegateLimit{}
+}
+
+func (m *EventOpenContract) GetPayer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Payer
+	}
+	return nil
+}
+
 // EventSettleContract is emitted when a contract is settled.
 type EventSettleContract struct {
 	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
@@ -585,787 +633,6050 @@ func (m *EventValidatorPayout) GetValidator() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventBondProvider)(nil), "arkeo.arkeo.EventBondProvider")
-	proto.RegisterType((*EventModProvider)(nil), "arkeo.arkeo.EventModProvider")
-	proto.RegisterType((*EventOpenContract)(nil), "arkeo.arkeo.EventOpenContract")
-	proto.RegisterType((*EventSettleContract)(nil), "arkeo.arkeo.EventSettleContract")
-	proto.RegisterType((*EventCloseContract)(nil), "arkeo.arkeo.EventCloseContract")
-	proto.RegisterType((*EventValidatorPayout)(nil), "arkeo.arkeo.EventValidatorPayout")
+// EventProviderSlashed is emitted when evidence against a provider is
+// accepted.
+type EventProviderSlashed struct {
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service    string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	ContractId uint64                                      `protobuf:"varint,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64                                       `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// amount taken from the bond and the pending unbondings
+	Slashed     cosmossdk_io_math.Int                         `protobuf:"bytes,5,opt,name=slashed,proto3,customtype=cosmossdk.io/math.Int" json:"slashed"`
+	JailedUntil int64                                         `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	Reporter    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=reporter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"reporter,omitempty"`
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/events.proto", fileDescriptor_39b4417094f69f41) }
-
-var fileDescriptor_39b4417094f69f41 = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x36, 0x6d, 0x59, 0x92, 0x47, 0xb6, 0x7f, 0x7b, 0xed, 0xfc, 0xa0, 0x1d, 0x40, 0x52, 0x05,
-	0x14, 0x10, 0xe0, 0x9a, 0x82, 0xed, 0x07, 0x08, 0x24, 0xd7, 0x4d, 0x0c, 0x37, 0x8d, 0xc1, 0xb4,
-	0x05, 0xda, 0x0b, 0xb1, 0x22, 0x07, 0xd2, 0x42, 0x22, 0x97, 0xdd, 0x5d, 0x2a, 0x56, 0xaf, 0xbd,
-	0xf5, 0xd4, 0x63, 0x1f, 0xa2, 0xa7, 0xa2, 0xa7, 0x3e, 0x41, 0x8e, 0x41, 0x4f, 0x45, 0x0f, 0x46,
-	0x61, 0xbf, 0x45, 0x4e, 0x05, 0x97, 0x4b, 0x59, 0x4a, 0x82, 0x36, 0x12, 0xd2, 0xa2, 0x87, 0x5e,
-	0x44, 0xee, 0xcc, 0x7c, 0xc3, 0xdd, 0x6f, 0xbe, 0x4f, 0x24, 0xd8, 0x54, 0x0c, 0x90, 0xb7, 0xb2,
-	0x5f, 0x1c, 0x61, 0xa4, 0xa4, 0x13, 0x0b, 0xae, 0x38, 0xa9, 0xe8, 0x98, 0xa3, 0x7f, 0xf7, 0x77,
-	0x7b, 0xbc, 0xc7, 0x75, 0xbc, 0x95, 0xde, 0x65, 0x25, 0xfb, 0x7b, 0x3e, 0x97, 0x21, 0x97, 0x5e,
-	0x96, 0xc8, 0x16, 0x26, 0x55, 0xcd, 0x56, 0xad, 0x2e, 0x95, 0xd8, 0x1a, 0x1d, 0x75, 0x51, 0xd1,
-	0xa3, 0x96, 0xcf, 0x59, 0x64, 0xf2, 0x33, 0xcf, 0x1d, 0x20, 0xc6, 0x28, 0xb2, 0x4c, 0xe3, 0xdb,
-	0x65, 0xd8, 0x3e, 0x4b, 0x37, 0xd2, 0xe1, 0x51, 0x70, 0x29, 0xf8, 0x88, 0x05, 0x28, 0xc8, 0x05,
-	0x94, 0x63, 0x73, 0x6f, 0x5b, 0x75, 0xab, 0xb9, 0xde, 0x69, 0xbd, 0xbc, 0xae, 0x1d, 0xf4, 0x98,
-	0xea, 0x27, 0x5d, 0xc7, 0xe7, 0x61, 0xd6, 0x2a, 0x42, 0xf5, 0x8c, 0x8b, 0x81, 0xe9, 0xeb, 0xf3,
-	0x30, 0xe4, 0x91, 0x73, 0x99, 0x74, 0x2f, 0x70, 0xec, 0x4e, 0x1a, 0x10, 0x1b, 0x4a, 0x12, 0xc5,
-	0x88, 0xf9, 0x68, 0x2f, 0xd7, 0xad, 0xe6, 0x9a, 0x9b, 0x2f, 0xc9, 0x47, 0x50, 0xee, 0xf2, 0x28,
-	0xf0, 0x04, 0x0e, 0xed, 0x95, 0x34, 0xd5, 0x39, 0x78, 0x7e, 0x5d, 0x5b, 0xfa, 0xed, 0xba, 0x76,
-	0x2f, 0x3b, 0x90, 0x0c, 0x06, 0x0e, 0xe3, 0xad, 0x90, 0xaa, 0xbe, 0x73, 0x1e, 0xa9, 0x5f, 0x7e,
-	0x3a, 0x04, 0x73, 0xee, 0xf3, 0x48, 0xb9, 0xa5, 0x14, 0xec, 0xe2, 0x70, 0xd2, 0x87, 0x76, 0xa5,
-	0x5d, 0x58, 0xb0, 0x4f, 0xbb, 0x2b, 0x1b, 0x3f, 0xaf, 0xc2, 0x96, 0x26, 0xe3, 0x31, 0x9f, 0xe6,
-	0xa2, 0xe4, 0x0b, 0xa4, 0x8a, 0xe7, 0x54, 0x1c, 0xbd, 0xbc, 0xae, 0x1d, 0x4e, 0x51, 0x61, 0xb8,
-	0xcf, 0x2e, 0x87, 0x32, 0x18, 0xb4, 0xd4, 0x38, 0x46, 0xe9, 0xb4, 0x7d, 0xbf, 0x1d, 0x04, 0x02,
-	0xa5, 0x74, 0xf3, 0x0e, 0x33, 0xc4, 0x2e, 0xbf, 0x43, 0x62, 0x57, 0x66, 0x89, 0x7d, 0x0f, 0xd6,
-	0x43, 0x54, 0x34, 0xa0, 0x8a, 0x7a, 0x89, 0x60, 0x19, 0x29, 0x6e, 0x25, 0x8f, 0x7d, 0x26, 0x18,
-	0x79, 0x1f, 0x36, 0x27, 0x25, 0x11, 0x8f, 0x7c, 0xb4, 0x57, 0xeb, 0x56, 0xb3, 0xe0, 0x6e, 0xe4,
-	0xd1, 0x4f, 0xd2, 0x20, 0x39, 0x81, 0xa2, 0x54, 0x54, 0x25, 0xd2, 0x2e, 0xd6, 0xad, 0xe6, 0xe6,
-	0xf1, 0x7d, 0x67, 0x4a, 0xa8, 0x4e, 0x4e, 0xd2, 0x53, 0x5d, 0xe2, 0x9a, 0x52, 0x72, 0x0c, 0xf7,
-	0x42, 0x16, 0x79, 0x3e, 0x8f, 0x94, 0xa0, 0xbe, 0xf2, 0x82, 0x44, 0x50, 0xc5, 0x78, 0x64, 0x97,
-	0xea, 0x56, 0x73, 0xc5, 0xdd, 0x09, 0x59, 0x74, 0x6a, 0x72, 0x1f, 0x9a, 0x94, 0xc6, 0xd0, 0xab,
-	0x37, 0x60, 0xca, 0x06, 0x43, 0xaf, 0x5e, 0xc3, 0x7c, 0x0c, 0xdb, 0x32, 0xe9, 0x4a, 0x5f, 0xb0,
-	0x38, 0x5d, 0x7b, 0x82, 0x2a, 0xb4, 0xd7, 0xea, 0x2b, 0xcd, 0xca, 0xf1, 0x9e, 0x63, 0x06, 0x9c,
-	0x5a, 0xc2, 0x31, 0x96, 0x70, 0x4e, 0x39, 0x8b, 0x3a, 0x85, 0x54, 0x1b, 0xee, 0xd6, 0x34, 0xd2,
-	0xa5, 0x0a, 0xc9, 0x05, 0x90, 0x98, 0x8e, 0x3d, 0x2a, 0xbd, 0x31, 0x4f, 0xbc, 0x1e, 0xcf, 0xda,
-	0xc1, 0xdb, 0xb5, 0xdb, 0x8c, 0xe9, 0xb8, 0x2d, 0xbf, 0xe0, 0xc9, 0x43, 0xae, 0x9b, 0x3d, 0x80,
-	0x42, 0xaa, 0x2a, 0xbb, 0x32, 0xbf, 0x1c, 0x35, 0x90, 0xb4, 0x60, 0x47, 0xa2, 0x52, 0x43, 0x0c,
-	0x31, 0x9a, 0x62, 0x63, 0x5d, 0xb3, 0x41, 0xee, 0x52, 0x39, 0x19, 0x8d, 0x6f, 0x8a, 0xc6, 0xc9,
-	0x4f, 0x62, 0x9c, 0xd0, 0xfb, 0x6e, 0x9d, 0x5c, 0x83, 0xca, 0x64, 0x3e, 0x2c, 0xd0, 0x02, 0x2e,
-	0xb8, 0x90, 0x87, 0xce, 0x83, 0x3f, 0x51, 0xe4, 0x43, 0x28, 0xfa, 0x43, 0x86, 0x91, 0xd2, 0x5a,
-	0x5c, 0x60, 0x17, 0x06, 0x9e, 0x1e, 0x28, 0xc0, 0x21, 0xf6, 0xd2, 0xd9, 0xac, 0x2e, 0x78, 0xa0,
-	0xbc, 0x01, 0x39, 0x84, 0x42, 0xea, 0x55, 0xa3, 0xed, 0xbd, 0x19, 0x6d, 0xe7, 0x14, 0x7e, 0x3a,
-	0x8e, 0xd1, 0xd5, 0x65, 0xe4, 0xff, 0x50, 0xec, 0x23, 0xeb, 0xf5, 0x95, 0x11, 0xb2, 0x59, 0x91,
-	0x7d, 0x28, 0xbf, 0x22, 0xd7, 0xc9, 0x9a, 0x9c, 0x40, 0xc1, 0xc8, 0xd2, 0x7a, 0x1b, 0x1d, 0xe9,
-	0x62, 0x72, 0x1f, 0xd6, 0x78, 0x8c, 0xa9, 0x83, 0xa4, 0xb2, 0x21, 0xeb, 0xc8, 0xf5, 0x58, 0xa5,
-	0x22, 0x67, 0x50, 0x0a, 0x30, 0xe6, 0x92, 0xa9, 0x45, 0xd4, 0x95, 0x63, 0xe7, 0x16, 0x18, 0x79,
-	0x04, 0x1b, 0x34, 0x51, 0x7d, 0x2e, 0xd8, 0xd7, 0x59, 0xe9, 0x86, 0x66, 0xad, 0xf1, 0x46, 0xd6,
-	0xda, 0xd3, 0x95, 0xee, 0x2c, 0x90, 0x7c, 0x00, 0xe4, 0xab, 0x04, 0x05, 0x43, 0xe9, 0xc5, 0x28,
-	0xbc, 0x90, 0x45, 0x89, 0x42, 0x7b, 0x53, 0x3f, 0x79, 0xcb, 0x64, 0x2e, 0x51, 0x3c, 0xd6, 0x71,
-	0x72, 0x00, 0xdb, 0x53, 0x1b, 0x35, 0x03, 0xf8, 0x5f, 0x56, 0x7c, 0x97, 0x78, 0xa4, 0xe3, 0x8d,
-	0xef, 0x0b, 0xb0, 0xa3, 0x5d, 0xf0, 0x54, 0x67, 0xfe, 0xf3, 0xc1, 0xdf, 0xe1, 0x83, 0x5d, 0x58,
-	0xcd, 0x5e, 0x19, 0x99, 0x0d, 0xb2, 0xc5, 0x94, 0x3b, 0xca, 0x33, 0xee, 0x78, 0x00, 0x85, 0x98,
-	0xb2, 0x40, 0x3b, 0x60, 0xde, 0xbf, 0xc2, 0x14, 0x98, 0x0a, 0x5e, 0x60, 0x4a, 0x20, 0x6a, 0x2f,
-	0xcc, 0x2b, 0x78, 0x83, 0x6d, 0xfc, 0xb8, 0x0c, 0x44, 0x4b, 0xe3, 0x74, 0xc8, 0xe5, 0x9d, 0x32,
-	0x5e, 0x19, 0xa6, 0xf5, 0xda, 0x30, 0xff, 0xa1, 0x77, 0xf6, 0xbf, 0x52, 0x19, 0x8d, 0x1f, 0x2c,
-	0xd8, 0xd5, 0xa4, 0x7d, 0x4e, 0x87, 0x2c, 0x48, 0xbf, 0x61, 0x2e, 0xe9, 0x98, 0x27, 0x8a, 0x3c,
-	0x81, 0xb5, 0x51, 0x1e, 0x5a, 0xfc, 0xc3, 0xe8, 0xae, 0x07, 0x39, 0x85, 0xa2, 0xc0, 0x67, 0x54,
-	0x64, 0x7e, 0x9a, 0x73, 0xc8, 0x06, 0xda, 0x39, 0x7b, 0x7e, 0x53, 0xb5, 0x5e, 0xdc, 0x54, 0xad,
-	0xdf, 0x6f, 0xaa, 0xd6, 0x77, 0xb7, 0xd5, 0xa5, 0x17, 0xb7, 0xd5, 0xa5, 0x5f, 0x6f, 0xab, 0x4b,
-	0x5f, 0xfe, 0xc5, 0xf9, 0xaf, 0xcc, 0x55, 0xef, 0xb0, 0x5b, 0xd4, 0x1f, 0xc7, 0x27, 0x7f, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x16, 0x2f, 0xaf, 0x13, 0xb0, 0x0b, 0x00, 0x00,
+func (m *EventProviderSlashed) Reset()         { *m = EventProviderSlashed{} }
+func (m *EventProviderSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventProviderSlashed) ProtoMessage()    {}
+func (*EventProviderSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{6}
 }
-
-func (m *EventBondProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventProviderSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventProviderSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderSlashed.Merge(m, src)
+}
+func (m *EventProviderSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderSlashed.DiscardUnknown(m)
 }
 
-func (m *EventBondProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventProviderSlashed proto.InternalMessageInfo
+
+func (m *EventProviderSlashed) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
 }
 
-func (m *EventBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BondAbs.Size()
-		i -= size
-		if _, err := m.BondAbs.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventProviderSlashed) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.BondRel.Size()
-		i -= size
-		if _, err := m.BondRel.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventProviderSlashed) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventProviderSlashed) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventProviderSlashed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventModProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventProviderSlashed) GetReporter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Reporter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventModProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventProviderUnbonding is emitted when a provider withdraws bond, the
+// amount is released at release_height.
+type EventProviderUnbonding struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service       string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Amount        cosmossdk_io_math.Int                       `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	ReleaseHeight int64                                       `protobuf:"varint,4,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
 }
 
-func (m *EventModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
-	}
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventProviderUnbonding) Reset()         { *m = EventProviderUnbonding{} }
+func (m *EventProviderUnbonding) String() string { return proto.CompactTextString(m) }
+func (*EventProviderUnbonding) ProtoMessage()    {}
+func (*EventProviderUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{7}
+}
+func (m *EventProviderUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+}
+func (m *EventProviderUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderUnbonding.Merge(m, src)
+}
+func (m *EventProviderUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderUnbonding proto.InternalMessageInfo
+
+func (m *EventProviderUnbonding) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return nil
+}
+
+func (m *EventProviderUnbonding) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *EventProviderUnbonding) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
 	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+// EventProviderUnbonded is emitted when withdrawn bond is paid out to the
+// provider.
+type EventProviderUnbonded struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Amount   cosmossdk_io_math.Int                       `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EventProviderUnbonded) Reset()         { *m = EventProviderUnbonded{} }
+func (m *EventProviderUnbonded) String() string { return proto.CompactTextString(m) }
+func (*EventProviderUnbonded) ProtoMessage()    {}
+func (*EventProviderUnbonded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{8}
+}
+func (m *EventProviderUnbonded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderUnbonded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderUnbonded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *EventProviderUnbonded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderUnbonded.Merge(m, src)
+}
+func (m *EventProviderUnbonded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderUnbonded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderUnbonded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderUnbonded proto.InternalMessageInfo
+
+func (m *EventProviderUnbonded) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *EventProviderUnbonded) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// EventSetConfig is emitted when the authority sets or removes a config
+// override.
+type EventSetConfig struct {
+	Creator string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Config  ConfigOverride `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	Remove  bool           `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *EventSetConfig) Reset()         { *m = EventSetConfig{} }
+func (m *EventSetConfig) String() string { return proto.CompactTextString(m) }
+func (*EventSetConfig) ProtoMessage()    {}
+func (*EventSetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{9}
+}
+func (m *EventSetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventSetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetConfig.Merge(m, src)
+}
+func (m *EventSetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetConfig proto.InternalMessageInfo
+
+func (m *EventSetConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetConfig) GetConfig() ConfigOverride {
+	if m != nil {
+		return m.Config
 	}
-	return len(dAtA) - i, nil
+	return ConfigOverride{}
 }
 
-func (m *EventOpenContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetConfig) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventOpenContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventTopUpContract is emitted when deposit or duration is added to an open
+// contract. deposit and duration are the new totals of the contract.
+type EventTopUpContract struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId    uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Type          ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	AddedDeposit  cosmossdk_io_math.Int                       `protobuf:"bytes,7,opt,name=added_deposit,json=addedDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"added_deposit"`
+	AddedDuration int64                                       `protobuf:"varint,8,opt,name=added_duration,json=addedDuration,proto3" json:"added_duration,omitempty"`
+	Deposit       cosmossdk_io_math.Int                       `protobuf:"bytes,9,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	Duration      int64                                       `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *EventOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementHeight))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x70
+func (m *EventTopUpContract) Reset()         { *m = EventTopUpContract{} }
+func (m *EventTopUpContract) String() string { return proto.CompactTextString(m) }
+func (*EventTopUpContract) ProtoMessage()    {}
+func (*EventTopUpContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{10}
+}
+func (m *EventTopUpContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopUpContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopUpContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Authorization != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x68
+}
+func (m *EventTopUpContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopUpContract.Merge(m, src)
+}
+func (m *EventTopUpContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopUpContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopUpContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopUpContract proto.InternalMessageInfo
+
+func (m *EventTopUpContract) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *EventTopUpContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventTopUpContract) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	i--
-	dAtA[i] = 0x5a
-	if m.OpenCost != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OpenCost))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *EventTopUpContract) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *EventTopUpContract) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Duration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *EventTopUpContract) GetType() ContractType {
+	if m != nil {
+		return m.Type
 	}
-	if m.Height != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x38
+	return ContractType_SUBSCRIPTION
+}
+
+func (m *EventTopUpContract) GetAddedDuration() int64 {
+	if m != nil {
+		return m.AddedDuration
 	}
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *EventTopUpContract) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+// EventSetContractRenewal is emitted when the client enables, funds or cancels
+// the renewal of a contract. renewal_escrow is the new escrow of the contract.
+type EventSetContractRenewal struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId    uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	AutoRenew     bool                                        `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	AddedEscrow   cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=added_escrow,json=addedEscrow,proto3,customtype=cosmossdk.io/math.Int" json:"added_escrow"`
+	Refunded      cosmossdk_io_math.Int                       `protobuf:"bytes,7,opt,name=refunded,proto3,customtype=cosmossdk.io/math.Int" json:"refunded"`
+	RenewalEscrow cosmossdk_io_math.Int                       `protobuf:"bytes,8,opt,name=renewal_escrow,json=renewalEscrow,proto3,customtype=cosmossdk.io/math.Int" json:"renewal_escrow"`
+}
+
+func (m *EventSetContractRenewal) Reset()         { *m = EventSetContractRenewal{} }
+func (m *EventSetContractRenewal) String() string { return proto.CompactTextString(m) }
+func (*EventSetContractRenewal) ProtoMessage()    {}
+func (*EventSetContractRenewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{11}
+}
+func (m *EventSetContractRenewal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetContractRenewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetContractRenewal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventSetContractRenewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetContractRenewal.Merge(m, src)
+}
+func (m *EventSetContractRenewal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetContractRenewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetContractRenewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetContractRenewal proto.InternalMessageInfo
+
+func (m *EventSetContractRenewal) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventSetContractRenewal) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventSetContractRenewal) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetContractRenewal) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventSettleContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetContractRenewal) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventSettleContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventContractRenewed is emitted when an expired subscription is renewed by a
+// new contract, which also emits EventOpenContract.
+type EventContractRenewed struct {
+	Provider        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service         string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client          github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	ContractId      uint64                                      `protobuf:"varint,4,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	NewContractId   uint64                                      `protobuf:"varint,5,opt,name=new_contract_id,json=newContractId,proto3" json:"new_contract_id,omitempty"`
+	PaidFromEscrow  cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=paid_from_escrow,json=paidFromEscrow,proto3,customtype=cosmossdk.io/math.Int" json:"paid_from_escrow"`
+	PaidFromAccount cosmossdk_io_math.Int                       `protobuf:"bytes,7,opt,name=paid_from_account,json=paidFromAccount,proto3,customtype=cosmossdk.io/math.Int" json:"paid_from_account"`
 }
 
-func (m *EventSettleContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Reserve.Size()
-		i -= size
-		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Paid.Size()
-		i -= size
-		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventContractRenewed) Reset()         { *m = EventContractRenewed{} }
+func (m *EventContractRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventContractRenewed) ProtoMessage()    {}
+func (*EventContractRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{12}
+}
+func (m *EventContractRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Height != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventContractRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractRenewed.Merge(m, src)
+}
+func (m *EventContractRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractRenewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractRenewed proto.InternalMessageInfo
+
+func (m *EventContractRenewed) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventContractRenewed) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventContractRenewed) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventContractRenewed) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCloseContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractRenewed) GetNewContractId() uint64 {
+	if m != nil {
+		return m.NewContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCloseContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventContractRenewalFailed is emitted when an expired subscription can't be
+// renewed, its renewal escrow is refunded to the client.
+type EventContractRenewalFailed struct {
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service    string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	ContractId uint64                                      `protobuf:"varint,4,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string                                      `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Refunded   cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=refunded,proto3,customtype=cosmossdk.io/math.Int" json:"refunded"`
 }
 
-func (m *EventCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventContractRenewalFailed) Reset()         { *m = EventContractRenewalFailed{} }
+func (m *EventContractRenewalFailed) String() string { return proto.CompactTextString(m) }
+func (*EventContractRenewalFailed) ProtoMessage()    {}
+func (*EventContractRenewalFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{13}
+}
+func (m *EventContractRenewalFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractRenewalFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractRenewalFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventContractRenewalFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractRenewalFailed.Merge(m, src)
+}
+func (m *EventContractRenewalFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractRenewalFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractRenewalFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractRenewalFailed proto.InternalMessageInfo
+
+func (m *EventContractRenewalFailed) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventContractRenewalFailed) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventContractRenewalFailed) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *EventContractRenewalFailed) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventValidatorPayout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractRenewalFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventValidatorPayout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOpenContractGroup is emitted when a contract group is opened, each
+// member contract also emits EventOpenContract.
+type EventOpenContractGroup struct {
+	GroupId     uint64                                      `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Service     string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate    github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Height      int64                                       `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Duration    int64                                       `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Deposit     types.Coin                                  `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit"`
+	OpenCost    int64                                       `protobuf:"varint,8,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
+	ContractIds []uint64                                    `protobuf:"varint,9,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
 }
 
-func (m *EventValidatorPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Reward.Size()
-		i -= size
-		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventOpenContractGroup) Reset()         { *m = EventOpenContractGroup{} }
+func (m *EventOpenContractGroup) String() string { return proto.CompactTextString(m) }
+func (*EventOpenContractGroup) ProtoMessage()    {}
+func (*EventOpenContractGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{14}
+}
+func (m *EventOpenContractGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOpenContractGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOpenContractGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventOpenContractGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOpenContractGroup.Merge(m, src)
+}
+func (m *EventOpenContractGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOpenContractGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOpenContractGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOpenContractGroup proto.InternalMessageInfo
+
+func (m *EventOpenContractGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOpenContractGroup) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventBondProvider) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventOpenContractGroup) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventOpenContractGroup) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
 	}
-	l = m.BondRel.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.BondAbs.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return nil
 }
 
-func (m *EventModProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOpenContractGroup) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventOpenContractGroup) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventOpenContractGroup) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+func (m *EventOpenContractGroup) GetOpenCost() int64 {
+	if m != nil {
+		return m.OpenCost
 	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventOpenContractGroup) GetContractIds() []uint64 {
+	if m != nil {
+		return m.ContractIds
 	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovEvents(uint64(m.MetadataNonce))
+	return nil
+}
+
+// EventSettleContractGroup is emitted when the last member contract of a group
+// is settled, the remainder of the deposit is refunded to the client.
+type EventSettleContractGroup struct {
+	GroupId  uint64                                      `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Service  string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Denom    string                                      `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	Paid     cosmossdk_io_math.Int                       `protobuf:"bytes,5,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	Refunded cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=refunded,proto3,customtype=cosmossdk.io/math.Int" json:"refunded"`
+}
+
+func (m *EventSettleContractGroup) Reset()         { *m = EventSettleContractGroup{} }
+func (m *EventSettleContractGroup) String() string { return proto.CompactTextString(m) }
+func (*EventSettleContractGroup) ProtoMessage()    {}
+func (*EventSettleContractGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{15}
+}
+func (m *EventSettleContractGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSettleContractGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSettleContractGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+}
+func (m *EventSettleContractGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettleContractGroup.Merge(m, src)
+}
+func (m *EventSettleContractGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSettleContractGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettleContractGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettleContractGroup proto.InternalMessageInfo
+
+func (m *EventSettleContractGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovEvents(uint64(m.MinContractDuration))
+	return 0
+}
+
+func (m *EventSettleContractGroup) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovEvents(uint64(m.MaxContractDuration))
+	return ""
+}
+
+func (m *EventSettleContractGroup) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	return nil
+}
+
+func (m *EventSettleContractGroup) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventSetDelegateLimit is emitted when the spend limit of a contract is set.
+type EventSetDelegateLimit struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId    uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	DelegateLimit DelegateLimit                               `protobuf:"bytes,6,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+}
+
+func (m *EventSetDelegateLimit) Reset()         { *m = EventSetDelegateLimit{} }
+func (m *EventSetDelegateLimit) String() string { return proto.CompactTextString(m) }
+func (*EventSetDelegateLimit) ProtoMessage()    {}
+func (*EventSetDelegateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{16}
+}
+func (m *EventSetDelegateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetDelegateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetDelegateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.Bond.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovEvents(uint64(m.SettlementDuration))
-	}
-	return n
+}
+func (m *EventSetDelegateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetDelegateLimit.Merge(m, src)
+}
+func (m *EventSetDelegateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetDelegateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetDelegateLimit.DiscardUnknown(m)
 }
 
-func (m *EventOpenContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventSetDelegateLimit proto.InternalMessageInfo
+
+func (m *EventSetDelegateLimit) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+	return nil
+}
+
+func (m *EventSetDelegateLimit) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventSetDelegateLimit) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventSetDelegateLimit) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventSetDelegateLimit) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
 	}
-	if m.Type != 0 {
-		n += 1 + sovEvents(uint64(m.Type))
+	return nil
+}
+
+func (m *EventSetDelegateLimit) GetDelegateLimit() DelegateLimit {
+	if m != nil {
+		return m.DelegateLimit
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvents(uint64(m.Height))
+	return DelegateLimit{}
+}
+
+// EventSubmitAttestation is emitted when a provider is attested, with its
+// updated reputation.
+type EventSubmitAttestation struct {
+	Provider     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service      string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Attester     string                                      `protobuf:"bytes,3,opt,name=attester,proto3" json:"attester,omitempty"`
+	ContractId   uint64                                      `protobuf:"varint,4,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Availability uint32                                      `protobuf:"varint,5,opt,name=availability,proto3" json:"availability,omitempty"`
+	LatencyMs    int64                                       `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Weight       cosmossdk_io_math.Int                       `protobuf:"bytes,7,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight"`
+	Reputation   ProviderReputation                          `protobuf:"bytes,8,opt,name=reputation,proto3" json:"reputation"`
+}
+
+func (m *EventSubmitAttestation) Reset()         { *m = EventSubmitAttestation{} }
+func (m *EventSubmitAttestation) String() string { return proto.CompactTextString(m) }
+func (*EventSubmitAttestation) ProtoMessage()    {}
+func (*EventSubmitAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{17}
+}
+func (m *EventSubmitAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubmitAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubmitAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Duration != 0 {
-		n += 1 + sovEvents(uint64(m.Duration))
+}
+func (m *EventSubmitAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubmitAttestation.Merge(m, src)
+}
+func (m *EventSubmitAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubmitAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubmitAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubmitAttestation proto.InternalMessageInfo
+
+func (m *EventSubmitAttestation) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.OpenCost != 0 {
-		n += 1 + sovEvents(uint64(m.OpenCost))
+	return nil
+}
+
+func (m *EventSubmitAttestation) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	return ""
+}
+
+func (m *EventSubmitAttestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
 	}
-	if m.Authorization != 0 {
-		n += 1 + sovEvents(uint64(m.Authorization))
+	return ""
+}
+
+func (m *EventSubmitAttestation) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.QueriesPerMinute != 0 {
-		n += 1 + sovEvents(uint64(m.QueriesPerMinute))
+	return 0
+}
+
+func (m *EventSubmitAttestation) GetAvailability() uint32 {
+	if m != nil {
+		return m.Availability
 	}
-	if m.SettlementHeight != 0 {
-		n += 1 + sovEvents(uint64(m.SettlementHeight))
+	return 0
+}
+
+func (m *EventSubmitAttestation) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
 	}
-	return n
+	return 0
 }
 
-func (m *EventSettleContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSubmitAttestation) GetReputation() ProviderReputation {
+	if m != nil {
+		return m.Reputation
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ProviderReputation{}
+}
+
+// EventSetProber is emitted when the authority registers or removes a prober.
+type EventSetProber struct {
+	Prober string `protobuf:"bytes,1,opt,name=prober,proto3" json:"prober,omitempty"`
+	Remove bool   `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *EventSetProber) Reset()         { *m = EventSetProber{} }
+func (m *EventSetProber) String() string { return proto.CompactTextString(m) }
+func (*EventSetProber) ProtoMessage()    {}
+func (*EventSetProber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{18}
+}
+func (m *EventSetProber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetProber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetProber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+}
+func (m *EventSetProber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetProber.Merge(m, src)
+}
+func (m *EventSetProber) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetProber) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetProber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetProber proto.InternalMessageInfo
+
+func (m *EventSetProber) GetProber() string {
+	if m != nil {
+		return m.Prober
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventSetProber) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventBondProvider)(nil), "arkeo.arkeo.EventBondProvider")
+	proto.RegisterType((*EventModProvider)(nil), "arkeo.arkeo.EventModProvider")
+	proto.RegisterType((*EventOpenContract)(nil), "arkeo.arkeo.EventOpenContract")
+	proto.RegisterType((*EventSettleContract)(nil), "arkeo.arkeo.EventSettleContract")
+	proto.RegisterType((*EventCloseContract)(nil), "arkeo.arkeo.EventCloseContract")
+	proto.RegisterType((*EventValidatorPayout)(nil), "arkeo.arkeo.EventValidatorPayout")
+	proto.RegisterType((*EventProviderSlashed)(nil), "arkeo.arkeo.EventProviderSlashed")
+	proto.RegisterType((*EventProviderUnbonding)(nil), "arkeo.arkeo.EventProviderUnbonding")
+	proto.RegisterType((*EventProviderUnbonded)(nil), "arkeo.arkeo.EventProviderUnbonded")
+	proto.RegisterType((*EventSetConfig)(nil), "arkeo.arkeo.EventSetConfig")
+	proto.RegisterType((*EventTopUpContract)(nil), "arkeo.arkeo.EventTopUpContract")
+	proto.RegisterType((*EventSetContractRenewal)(nil), "arkeo.arkeo.EventSetContractRenewal")
+	proto.RegisterType((*EventContractRenewed)(nil), "arkeo.arkeo.EventContractRenewed")
+	proto.RegisterType((*EventContractRenewalFailed)(nil), "arkeo.arkeo.EventContractRenewalFailed")
+	proto.RegisterType((*EventOpenContractGroup)(nil), "arkeo.arkeo.EventOpenContractGroup")
+	proto.RegisterType((*EventSettleContractGroup)(nil), "arkeo.arkeo.EventSettleContractGroup")
+	proto.RegisterType((*EventSetDelegateLimit)(nil), "arkeo.arkeo.EventSetDelegateLimit")
+	proto.RegisterType((*EventSubmitAttestation)(nil), "arkeo.arkeo.EventSubmitAttestation")
+	proto.RegisterType((*EventSetProber)(nil), "arkeo.arkeo.EventSetProber")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/events.proto", fileDescriptor_39b4417094f69f41) }
+
+var fileDescriptor_39b4417094f69f41 = []byte{
+	// 1781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xcf, 0x7c, 0x78, 0x3e, 0x6a, 0x3c, 0x8e, 0xdd, 0x76, 0x96, 0x8e, 0x57, 0xd8, 0xde, 0x96,
+	0x16, 0x59, 0x0a, 0x9e, 0x51, 0x9c, 0x0b, 0x7b, 0x5a, 0x6c, 0xc7, 0xf1, 0x5a, 0xd9, 0x6c, 0xac,
+	0x76, 0x0c, 0x82, 0x4b, 0xab, 0xa6, 0xfb, 0x65, 0x5c, 0xb8, 0xbb, 0xab, 0xa9, 0xaa, 0xb6, 0x33,
+	0xfc, 0x09, 0x70, 0x41, 0x5c, 0xe0, 0xc4, 0x5f, 0xc0, 0x09, 0xed, 0x81, 0x0b, 0xe2, 0xc0, 0x65,
+	0x4f, 0x68, 0xb5, 0x27, 0xc4, 0x21, 0x42, 0xc9, 0x11, 0x71, 0x43, 0x1c, 0xf6, 0x80, 0x50, 0x7d,
+	0x74, 0x4f, 0xb7, 0x6d, 0x76, 0x33, 0xe3, 0xac, 0xb5, 0xac, 0x72, 0xb1, 0x5d, 0xaf, 0xea, 0xbd,
+	0xae, 0x7a, 0xef, 0xf7, 0x3e, 0x8d, 0x6c, 0xcc, 0x4e, 0x80, 0xf6, 0xf5, 0x4f, 0x38, 0x85, 0x58,
+	0xf0, 0x5e, 0xc2, 0xa8, 0xa0, 0x56, 0x47, 0xd1, 0x7a, 0xea, 0xe7, 0xf2, 0xd2, 0x90, 0x0e, 0xa9,
+	0xa2, 0xf7, 0xe5, 0x5f, 0xfa, 0xc8, 0xf2, 0x6d, 0x9f, 0xf2, 0x88, 0x72, 0x4f, 0x6f, 0xe8, 0x85,
+	0xd9, 0x5a, 0xd1, 0xab, 0xfe, 0x00, 0x73, 0xe8, 0x9f, 0xde, 0x1d, 0x80, 0xc0, 0x77, 0xfb, 0x3e,
+	0x25, 0xb1, 0xd9, 0x2f, 0x7d, 0xf7, 0x04, 0x20, 0x01, 0xa6, 0x77, 0x9c, 0x9f, 0x57, 0xd1, 0xc2,
+	0xae, 0xbc, 0xc8, 0x36, 0x8d, 0x83, 0x03, 0x46, 0x4f, 0x49, 0x00, 0xcc, 0x7a, 0x88, 0x5a, 0x89,
+	0xf9, 0xdb, 0xae, 0xac, 0x55, 0xd6, 0x67, 0xb7, 0xfb, 0x9f, 0x3f, 0x5f, 0xbd, 0x33, 0x24, 0xe2,
+	0x38, 0x1d, 0xf4, 0x7c, 0x1a, 0x69, 0x51, 0x31, 0x88, 0x33, 0xca, 0x4e, 0x8c, 0x5c, 0x9f, 0x46,
+	0x11, 0x8d, 0x7b, 0x07, 0xe9, 0xe0, 0x21, 0x8c, 0xdc, 0x5c, 0x80, 0x65, 0xa3, 0x26, 0x07, 0x76,
+	0x4a, 0x7c, 0xb0, 0xab, 0x6b, 0x95, 0xf5, 0xb6, 0x9b, 0x2d, 0xad, 0x07, 0xa8, 0x35, 0xa0, 0x71,
+	0xe0, 0x31, 0x08, 0xed, 0x9a, 0xdc, 0xda, 0xbe, 0xf3, 0xc9, 0xf3, 0xd5, 0x1b, 0x7f, 0x7b, 0xbe,
+	0x7a, 0x4b, 0x3f, 0x88, 0x07, 0x27, 0x3d, 0x42, 0xfb, 0x11, 0x16, 0xc7, 0xbd, 0xfd, 0x58, 0x7c,
+	0xf6, 0xf1, 0x06, 0x32, 0xef, 0xde, 0x8f, 0x85, 0xdb, 0x94, 0xcc, 0x2e, 0x84, 0xb9, 0x1c, 0x3c,
+	0xe0, 0x76, 0x7d, 0x4a, 0x39, 0x5b, 0x03, 0xee, 0xfc, 0x6b, 0x06, 0xcd, 0x2b, 0x65, 0x3c, 0xa2,
+	0x45, 0x5d, 0x34, 0x7d, 0x06, 0x58, 0xd0, 0x4c, 0x15, 0x77, 0x3f, 0x7f, 0xbe, 0xba, 0x51, 0x50,
+	0x85, 0xd1, 0xbd, 0xfe, 0xb5, 0xc1, 0x83, 0x93, 0xbe, 0x18, 0x25, 0xc0, 0x7b, 0x5b, 0xbe, 0xbf,
+	0x15, 0x04, 0x0c, 0x38, 0x77, 0x33, 0x09, 0x25, 0xc5, 0x56, 0x5f, 0xa3, 0x62, 0x6b, 0x65, 0xc5,
+	0xbe, 0x83, 0x66, 0x23, 0x10, 0x38, 0xc0, 0x02, 0x7b, 0x29, 0x23, 0x5a, 0x29, 0x6e, 0x27, 0xa3,
+	0x1d, 0x31, 0x62, 0xbd, 0x8b, 0xe6, 0xf2, 0x23, 0x31, 0x8d, 0x7d, 0xb0, 0x67, 0xd6, 0x2a, 0xeb,
+	0x75, 0xb7, 0x9b, 0x51, 0x3f, 0x92, 0x44, 0xeb, 0x1e, 0x6a, 0x70, 0x81, 0x45, 0xca, 0xed, 0xc6,
+	0x5a, 0x65, 0x7d, 0x6e, 0xf3, 0xed, 0x5e, 0x01, 0xa8, 0xbd, 0x4c, 0x49, 0x87, 0xea, 0x88, 0x6b,
+	0x8e, 0x5a, 0x9b, 0xe8, 0x56, 0x44, 0x62, 0xcf, 0xa7, 0xb1, 0x60, 0xd8, 0x17, 0x5e, 0x90, 0x32,
+	0x2c, 0x08, 0x8d, 0xed, 0xe6, 0x5a, 0x65, 0xbd, 0xe6, 0x2e, 0x46, 0x24, 0xde, 0x31, 0x7b, 0xf7,
+	0xcd, 0x96, 0xe2, 0xc1, 0xcf, 0x2e, 0xe1, 0x69, 0x19, 0x1e, 0xfc, 0xec, 0x02, 0xcf, 0x87, 0x68,
+	0x81, 0xa7, 0x03, 0xee, 0x33, 0x92, 0xc8, 0xb5, 0xc7, 0xb0, 0x00, 0xbb, 0xbd, 0x56, 0x5b, 0xef,
+	0x6c, 0xde, 0xee, 0x19, 0x03, 0x4b, 0x97, 0xe8, 0x19, 0x97, 0xe8, 0xed, 0x50, 0x12, 0x6f, 0xd7,
+	0x25, 0x36, 0xdc, 0xf9, 0x22, 0xa7, 0x8b, 0x05, 0x58, 0x0f, 0x91, 0x95, 0xe0, 0x91, 0x87, 0xb9,
+	0x37, 0xa2, 0xa9, 0x37, 0xa4, 0x5a, 0x1c, 0x7a, 0x35, 0x71, 0x73, 0x09, 0x1e, 0x6d, 0xf1, 0x1f,
+	0xd1, 0x74, 0x8f, 0x2a, 0x61, 0xef, 0xa3, 0xba, 0x44, 0x95, 0xdd, 0x99, 0x1c, 0x8e, 0x8a, 0xd1,
+	0xea, 0xa3, 0x45, 0x0e, 0x42, 0x84, 0x10, 0x41, 0x5c, 0xd0, 0xc6, 0xac, 0xd2, 0x86, 0x35, 0xde,
+	0xca, 0x95, 0xf1, 0x3d, 0xd4, 0x96, 0x17, 0xf6, 0x7c, 0xcc, 0x02, 0xbb, 0xbb, 0x56, 0x59, 0xef,
+	0x6c, 0xde, 0x2a, 0x19, 0x4b, 0xde, 0x6b, 0x07, 0xb3, 0xc0, 0xdc, 0xb8, 0xc5, 0xcc, 0xda, 0xf9,
+	0x6d, 0xcb, 0xc4, 0x80, 0xc7, 0x09, 0xe4, 0x86, 0x79, 0xbd, 0x31, 0x60, 0x15, 0x75, 0x72, 0xcb,
+	0x92, 0x40, 0x41, 0xbf, 0xee, 0xa2, 0x8c, 0xb4, 0x1f, 0x7c, 0x01, 0x96, 0xf7, 0x50, 0xc3, 0x0f,
+	0x09, 0xc4, 0xc2, 0xae, 0x4f, 0x77, 0x0b, 0xc3, 0x2e, 0x1f, 0x14, 0x40, 0x08, 0x43, 0x2c, 0x34,
+	0xd6, 0xa7, 0x79, 0x50, 0x26, 0xc0, 0xda, 0x40, 0x75, 0xe9, 0xe5, 0xc6, 0x2b, 0x6e, 0x97, 0x14,
+	0x9d, 0xa9, 0xf0, 0xc9, 0x28, 0x01, 0x57, 0x1d, 0xb3, 0xde, 0x42, 0x8d, 0x63, 0x20, 0xc3, 0x63,
+	0x61, 0x5c, 0xc0, 0xac, 0xac, 0x65, 0xd4, 0x3a, 0x07, 0xf4, 0x7c, 0x6d, 0xdd, 0x43, 0x75, 0x03,
+	0xe8, 0xca, 0xab, 0x20, 0x50, 0x1d, 0xb6, 0xde, 0x46, 0x6d, 0x9a, 0x80, 0xf4, 0x3d, 0x2e, 0x6c,
+	0xa4, 0x25, 0x52, 0x65, 0x56, 0x2e, 0xac, 0x5d, 0xd4, 0x0c, 0x20, 0xa1, 0x9c, 0x88, 0x69, 0x70,
+	0x99, 0xf1, 0x4e, 0x0e, 0xcd, 0x0f, 0x50, 0x17, 0xa7, 0xe2, 0x98, 0x32, 0xf2, 0x33, 0x7d, 0xb4,
+	0xab, 0xb4, 0xe6, 0x5c, 0xaa, 0xb5, 0xad, 0xe2, 0x49, 0xb7, 0xcc, 0x68, 0x7d, 0x17, 0x59, 0x3f,
+	0x4d, 0x81, 0x11, 0xe0, 0x5e, 0x02, 0xcc, 0x8b, 0x48, 0x9c, 0x0a, 0xb0, 0xe7, 0xd4, 0x97, 0xe7,
+	0xcd, 0xce, 0x01, 0xb0, 0x47, 0x8a, 0x6e, 0xdd, 0x41, 0x0b, 0x85, 0x8b, 0x1a, 0x03, 0xdc, 0xd4,
+	0x87, 0xc7, 0x1b, 0x1f, 0x68, 0x53, 0xec, 0xa3, 0xc5, 0xb2, 0xfb, 0x0b, 0x02, 0x8c, 0xdb, 0xf3,
+	0x6b, 0xb5, 0x4b, 0x3d, 0xe9, 0x09, 0x01, 0x66, 0x34, 0x7f, 0x73, 0xec, 0xfb, 0x92, 0xca, 0x65,
+	0xf8, 0x0d, 0x08, 0xf7, 0x69, 0x1a, 0x0b, 0x6f, 0x90, 0x70, 0x7b, 0x41, 0x7d, 0xb2, 0x93, 0xd1,
+	0xb6, 0x13, 0x6e, 0xdd, 0x46, 0xad, 0x21, 0xa3, 0x69, 0x22, 0xbd, 0xc1, 0x52, 0xde, 0xd0, 0x54,
+	0xeb, 0xfd, 0xc0, 0xda, 0x43, 0x73, 0x19, 0xcc, 0xbc, 0x90, 0x44, 0x44, 0xd8, 0x8b, 0x0a, 0x01,
+	0xcb, 0xa5, 0x3b, 0xdc, 0x37, 0x47, 0x3e, 0x94, 0x27, 0xcc, 0x45, 0xba, 0x41, 0x91, 0x68, 0xed,
+	0xa1, 0x99, 0x04, 0x8f, 0x80, 0xd9, 0x4b, 0xd3, 0xe6, 0x2d, 0xcd, 0xef, 0xfc, 0xa6, 0x8e, 0x16,
+	0x55, 0x80, 0x38, 0x54, 0x4a, 0x7b, 0x13, 0x22, 0xbe, 0x8a, 0x10, 0xb1, 0x84, 0x66, 0x74, 0x1e,
+	0xd6, 0x11, 0x42, 0x2f, 0x0a, 0x81, 0xa3, 0x55, 0x0a, 0x1c, 0xef, 0xa3, 0x7a, 0x82, 0x49, 0x60,
+	0xb7, 0x27, 0xf7, 0x63, 0xc5, 0x28, 0x63, 0x01, 0x03, 0xa9, 0x40, 0xb0, 0xd1, 0xe4, 0x32, 0x32,
+	0x5e, 0xe7, 0xf7, 0x55, 0x64, 0x29, 0x68, 0xec, 0x84, 0x94, 0x8f, 0x91, 0x71, 0xce, 0x98, 0x95,
+	0x0b, 0xc6, 0xbc, 0xa6, 0x42, 0xe8, 0x6b, 0x89, 0x0c, 0xe7, 0x77, 0x15, 0xb4, 0xa4, 0x94, 0xf6,
+	0x03, 0x1c, 0x92, 0x00, 0x0b, 0xca, 0x0e, 0xf0, 0x88, 0xa6, 0xc2, 0x7a, 0x8c, 0xda, 0xa7, 0x19,
+	0x69, 0xfa, 0x6a, 0x73, 0x2c, 0xc3, 0xda, 0x41, 0x0d, 0x06, 0x67, 0xb2, 0x22, 0xa8, 0x4e, 0x6e,
+	0x64, 0xc3, 0xea, 0xfc, 0xb3, 0x6a, 0xae, 0x9b, 0x97, 0x7b, 0x21, 0xe6, 0xc7, 0x10, 0x5c, 0x57,
+	0x9b, 0x70, 0x0e, 0x4c, 0xb5, 0x0b, 0x60, 0xca, 0x5d, 0xa7, 0x5e, 0x74, 0x9d, 0x5d, 0xd4, 0xe4,
+	0xfa, 0xa2, 0xf6, 0xcc, 0xe4, 0x8f, 0xcf, 0x78, 0x65, 0x30, 0xff, 0x09, 0x26, 0x21, 0x04, 0x5e,
+	0x1a, 0x0b, 0x12, 0x2a, 0x77, 0xae, 0xb9, 0x1d, 0x4d, 0x3b, 0x92, 0x24, 0xeb, 0x11, 0x6a, 0x31,
+	0x48, 0x28, 0x13, 0xc0, 0xec, 0xe6, 0xb4, 0x56, 0xcb, 0x45, 0x38, 0xff, 0xa8, 0xa0, 0xb7, 0x4a,
+	0xfa, 0x3e, 0x8a, 0x65, 0x49, 0x48, 0xe2, 0xe1, 0x75, 0x69, 0x7c, 0x07, 0x35, 0x70, 0x24, 0x53,
+	0xd5, 0x34, 0x6d, 0x99, 0x61, 0x95, 0x1d, 0x06, 0x83, 0x10, 0x30, 0x87, 0x2c, 0xf5, 0x6a, 0xf3,
+	0x74, 0x0d, 0x55, 0xe7, 0x5d, 0xe7, 0xcf, 0x15, 0x74, 0xeb, 0x92, 0xd7, 0x42, 0xf0, 0xff, 0xf4,
+	0x58, 0xe7, 0xd7, 0x15, 0x34, 0x97, 0xa5, 0xc8, 0x1d, 0x1a, 0x3f, 0x25, 0x43, 0x6b, 0xb3, 0xdc,
+	0x38, 0xb6, 0xb7, 0xed, 0xcf, 0x3e, 0xde, 0x58, 0x32, 0xbc, 0xc6, 0xea, 0x87, 0x82, 0x91, 0x78,
+	0x38, 0xee, 0x0f, 0xdf, 0x43, 0x0d, 0x5f, 0x71, 0xab, 0x4b, 0x76, 0xce, 0xb5, 0x5b, 0x5a, 0xf0,
+	0xe3, 0x53, 0x60, 0x8c, 0x04, 0x60, 0x92, 0xbe, 0x61, 0x90, 0x99, 0x82, 0x41, 0x44, 0x4f, 0x75,
+	0x0c, 0x6c, 0xb9, 0x66, 0xe5, 0xfc, 0xb1, 0x6e, 0x22, 0xf4, 0x13, 0x9a, 0x1c, 0x25, 0x6f, 0x72,
+	0xf7, 0x57, 0x91, 0xbb, 0x0f, 0x50, 0x17, 0x07, 0x01, 0x04, 0x5e, 0x56, 0x5e, 0x37, 0x27, 0x47,
+	0xd2, 0xac, 0x92, 0x70, 0xdf, 0xd4, 0xd8, 0xef, 0xa2, 0x39, 0x23, 0xb1, 0xdc, 0x1e, 0xe8, 0xef,
+	0xe4, 0x95, 0x75, 0xa1, 0xa2, 0x6f, 0x5f, 0xa1, 0xa2, 0x2f, 0xb6, 0x21, 0xa8, 0xdc, 0x86, 0x38,
+	0xff, 0xae, 0xa1, 0x6f, 0x15, 0x90, 0xad, 0x9e, 0xee, 0x42, 0x0c, 0x67, 0x38, 0xfc, 0xe6, 0x81,
+	0xe8, 0xdb, 0x08, 0xe1, 0x54, 0x50, 0x8f, 0xc9, 0x07, 0x2a, 0x18, 0xb5, 0xdc, 0xb6, 0xa4, 0xa8,
+	0x17, 0x5b, 0x1f, 0x21, 0x6d, 0x25, 0x0f, 0xb8, 0xcf, 0xe8, 0x99, 0xdd, 0x98, 0x5c, 0xe7, 0x1d,
+	0x25, 0x60, 0x57, 0xf1, 0x5b, 0x7b, 0x32, 0x71, 0x3c, 0x4d, 0x65, 0xb4, 0x9b, 0x06, 0x32, 0x39,
+	0xb3, 0xe5, 0xca, 0x58, 0xab, 0x6c, 0x92, 0x5d, 0xad, 0x35, 0xb9, 0xb8, 0xae, 0x11, 0xa1, 0x2f,
+	0xe7, 0xfc, 0xa9, 0x66, 0xd2, 0x7e, 0xc9, 0xea, 0xd7, 0x17, 0x97, 0xc7, 0x46, 0xad, 0x5d, 0xcd,
+	0xa8, 0xe7, 0x80, 0x55, 0xbf, 0x00, 0xac, 0xef, 0xa0, 0x9b, 0x31, 0x9c, 0x79, 0xc5, 0x43, 0x66,
+	0x18, 0x16, 0xc3, 0xd9, 0xce, 0xf8, 0xdc, 0x11, 0x9a, 0x97, 0xb5, 0xb3, 0xf7, 0x94, 0xd1, 0xe8,
+	0x0a, 0x10, 0x98, 0x93, 0x42, 0x1e, 0x30, 0x1a, 0x19, 0x14, 0xfc, 0x10, 0x2d, 0x8c, 0xc5, 0x62,
+	0x5f, 0xf5, 0x88, 0xd3, 0xc0, 0xe1, 0x66, 0x26, 0x77, 0x4b, 0xcb, 0x70, 0xfe, 0x52, 0x45, 0xcb,
+	0x17, 0x2d, 0x88, 0xc3, 0x07, 0xaa, 0x76, 0xf9, 0xe6, 0xd9, 0x51, 0xa5, 0x40, 0xcc, 0x69, 0xac,
+	0x0b, 0x3e, 0xd7, 0xac, 0x4a, 0x6e, 0xd6, 0xb8, 0x82, 0x9b, 0x39, 0xbf, 0xaa, 0xa1, 0xb7, 0x2e,
+	0x4c, 0xca, 0xf6, 0x64, 0xdf, 0x5e, 0x6a, 0xe8, 0x2b, 0xe5, 0x86, 0xfe, 0x1a, 0x54, 0x53, 0x4c,
+	0x7e, 0xf5, 0xab, 0x26, 0xbf, 0x71, 0xcf, 0x39, 0xf3, 0x3f, 0x87, 0x55, 0x8d, 0x73, 0xc3, 0xaa,
+	0xf7, 0xc6, 0x89, 0xa8, 0xf9, 0x6a, 0xf3, 0xaa, 0x3c, 0xf9, 0x94, 0x46, 0x56, 0xad, 0x73, 0x23,
+	0xab, 0x77, 0xd0, 0x6c, 0xc1, 0xe6, 0x5c, 0x4d, 0x77, 0xeb, 0x6e, 0x67, 0x6c, 0x74, 0xee, 0xfc,
+	0xa1, 0x8a, 0xec, 0x4b, 0xa6, 0x13, 0x5f, 0x07, 0xb3, 0x2c, 0xa1, 0x99, 0x00, 0x62, 0x1a, 0x99,
+	0x01, 0xbc, 0x5e, 0xe4, 0xbd, 0xfb, 0xcc, 0xb4, 0xbd, 0xfb, 0x6b, 0xc3, 0xf3, 0x7f, 0xaa, 0xa6,
+	0xf6, 0x3e, 0x04, 0x51, 0x1a, 0x28, 0xbd, 0x29, 0x0f, 0xbf, 0xd0, 0x43, 0x2e, 0x8e, 0xe8, 0x1a,
+	0x53, 0x8d, 0xe8, 0x9c, 0x5f, 0x64, 0x01, 0xe5, 0x30, 0x1d, 0x44, 0x44, 0x6c, 0x09, 0x01, 0x5c,
+	0x68, 0x8f, 0xba, 0xa6, 0xe8, 0xbc, 0x8c, 0x5a, 0x58, 0x7d, 0x15, 0x98, 0xd1, 0x7d, 0xbe, 0xfe,
+	0xf2, 0x80, 0xeb, 0xa0, 0x59, 0x7c, 0x8a, 0x49, 0x88, 0x07, 0x24, 0x24, 0x62, 0xa4, 0x14, 0xdb,
+	0x75, 0x4b, 0x34, 0x59, 0x52, 0x85, 0x58, 0x40, 0xec, 0x8f, 0xbc, 0x88, 0x9b, 0xb8, 0xd1, 0x36,
+	0x94, 0x47, 0x5c, 0x76, 0x5f, 0x67, 0xe3, 0xc9, 0xf8, 0xa4, 0xdd, 0x97, 0x66, 0xb5, 0x76, 0x11,
+	0x62, 0x90, 0xa4, 0x62, 0x5c, 0x29, 0x77, 0x36, 0x57, 0x2f, 0xfd, 0x4f, 0x95, 0x9b, 0x1f, 0x33,
+	0x06, 0x29, 0x30, 0x3a, 0xdf, 0x1f, 0xf7, 0x70, 0x07, 0x8c, 0x0e, 0x80, 0xc9, 0x50, 0x98, 0xa8,
+	0xbf, 0x74, 0x0b, 0xe7, 0x36, 0x92, 0x9c, 0x6e, 0x9a, 0xad, 0x6a, 0xb1, 0xd9, 0xda, 0xde, 0xfd,
+	0xe4, 0xc5, 0x4a, 0xe5, 0xd3, 0x17, 0x2b, 0x95, 0xbf, 0xbf, 0x58, 0xa9, 0xfc, 0xf2, 0xe5, 0xca,
+	0x8d, 0x4f, 0x5f, 0xae, 0xdc, 0xf8, 0xeb, 0xcb, 0x95, 0x1b, 0x3f, 0xfe, 0x12, 0xc3, 0x3d, 0x33,
+	0xbf, 0xd5, 0x58, 0x60, 0xd0, 0x50, 0xff, 0x9c, 0xbd, 0xf7, 0xdf, 0x01, 0x00, 0x86, 0x32, 0x43,
+	0x92, 0x30, 0x1e, 0x00, 0x00,
+}
+
+func (m *EventBondProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventBondProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondAbs.Size()
+		i -= size
+		if _, err := m.BondAbs.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.Type != 0 {
-		n += 1 + sovEvents(uint64(m.Type))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondRel.Size()
+		i -= size
+		if _, err := m.BondRel.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvents(uint64(m.Height))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Paid.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Reserve.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCloseContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventModProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+	{
+		size, err := m.RateCard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x6a
+	if m.SettlementDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOpenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DiscountBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DiscountBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.PayAsYouGoTiers) > 0 {
+		for iNdEx := len(m.PayAsYouGoTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SettlementHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.OpenCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OpenCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCloseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Slashed.Size()
+		i -= size
+		if _, err := m.Slashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderUnbonded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderUnbonded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderUnbonded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopUpContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopUpContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopUpContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.AddedDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AddedDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AddedDeposit.Size()
+		i -= size
+		if _, err := m.AddedDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetContractRenewal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetContractRenewal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetContractRenewal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RenewalEscrow.Size()
+		i -= size
+		if _, err := m.RenewalEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AddedEscrow.Size()
+		i -= size
+		if _, err := m.AddedEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PaidFromAccount.Size()
+		i -= size
+		if _, err := m.PaidFromAccount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PaidFromEscrow.Size()
+		i -= size
+		if _, err := m.PaidFromEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.NewContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewContractId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractRenewalFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractRenewalFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractRenewalFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOpenContractGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpenContractGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpenContractGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractIds) > 0 {
+		dAtA6 := make([]byte, len(m.ContractIds)*10)
+		var j5 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintEvents(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OpenCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OpenCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleContractGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleContractGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleContractGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetDelegateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetDelegateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetDelegateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubmitAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LatencyMs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Availability != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Availability))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetProber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetProber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetProber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prober) > 0 {
+		i -= len(m.Prober)
+		copy(dAtA[i:], m.Prober)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Prober)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBondProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BondRel.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.BondAbs.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventModProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovEvents(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovEvents(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovEvents(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	}
+	l = m.RateCard.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventOpenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.OpenCost != 0 {
+		n += 1 + sovEvents(uint64(m.OpenCost))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovEvents(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 1 + sovEvents(uint64(m.QueriesPerMinute))
+	}
+	if m.SettlementHeight != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementHeight))
+	}
+	if len(m.PayAsYouGoTiers) > 0 {
+		for _, e := range m.PayAsYouGoTiers {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.DiscountBps != 0 {
+		n += 2 + sovEvents(uint64(m.DiscountBps))
+	}
+	if m.GroupId != 0 {
+		n += 2 + sovEvents(uint64(m.GroupId))
+	}
+	l = m.DelegateLimit.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSettleContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = m.Paid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCloseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValidatorPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventProviderSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProviderUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *EventProviderUnbonded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventTopUpContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	l = m.AddedDeposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.AddedDuration != 0 {
+		n += 1 + sovEvents(uint64(m.AddedDuration))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *EventSetContractRenewal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = m.AddedEscrow.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RenewalEscrow.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventContractRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.NewContractId != 0 {
+		n += 1 + sovEvents(uint64(m.NewContractId))
+	}
+	l = m.PaidFromEscrow.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PaidFromAccount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventContractRenewalFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refunded.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventOpenContractGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.OpenCost != 0 {
+		n += 1 + sovEvents(uint64(m.OpenCost))
+	}
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventSettleContractGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Paid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetDelegateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.DelegateLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubmitAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.Availability != 0 {
+		n += 1 + sovEvents(uint64(m.Availability))
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovEvents(uint64(m.LatencyMs))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Reputation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetProber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prober)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBondProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondRel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondRel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondAbs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondAbs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventModProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventModProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateCard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOpenContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenCost", wireType)
+			}
+			m.OpenCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenCost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+			}
+			m.SettlementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoTiers = append(m.PayAsYouGoTiers, RateTier{})
+			if err := m.PayAsYouGoTiers[len(m.PayAsYouGoTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountBps", wireType)
+			}
+			m.DiscountBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscountBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderUnbonded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderUnbonded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderUnbonded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopUpContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopUpContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddedDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDuration", wireType)
+			}
+			m.AddedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventValidatorPayout) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Reward.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
+func (m *EventSetContractRenewal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1388,10 +6699,10 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBondProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetContractRenewal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetContractRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1429,6 +6740,25 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
@@ -1460,9 +6790,63 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondRel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedEscrow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1490,13 +6874,13 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondRel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddedEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondAbs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1524,7 +6908,41 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondAbs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalEscrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RenewalEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1549,7 +6967,7 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModProvider) Unmarshal(dAtA []byte) error {
+func (m *EventContractRenewed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,47 +6990,13 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractRenewed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
@@ -1646,7 +7030,7 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				m.Provider = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
@@ -1669,90 +7053,20 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
-			}
-			m.MetadataNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MinContractDuration = 0
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1762,16 +7076,31 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.MaxContractDuration = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1781,16 +7110,16 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContractId", wireType)
 			}
-			var msglen int
+			m.NewContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1800,31 +7129,16 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFromEscrow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1834,29 +7148,29 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PaidFromEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFromAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1884,29 +7198,10 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PaidFromAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1928,7 +7223,7 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
+func (m *EventContractRenewalFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1951,10 +7246,10 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOpenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractRenewalFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractRenewalFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1992,25 +7287,6 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
@@ -2042,7 +7318,7 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
@@ -2076,11 +7352,30 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				m.Client = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2090,31 +7385,29 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2124,16 +7417,81 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOpenContractGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOpenContractGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOpenContractGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Height = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2143,16 +7501,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2162,16 +7520,29 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2181,49 +7552,31 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenCost", wireType)
-			}
-			m.OpenCost = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OpenCost |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2233,31 +7586,31 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SettlementDuration = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2267,16 +7620,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.Authorization = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2286,16 +7639,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.QueriesPerMinute = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2305,16 +7658,30 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenCost", wireType)
 			}
-			m.SettlementHeight = 0
+			m.OpenCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2324,11 +7691,87 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementHeight |= int64(b&0x7F) << shift
+				m.OpenCost |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ContractIds = append(m.ContractIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ContractIds) == 0 {
+					m.ContractIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractIds = append(m.ContractIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2350,7 +7793,7 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
+func (m *EventSettleContractGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +7816,66 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettleContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSettleContractGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettleContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSettleContractGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,16 +7902,16 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.ContractId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2427,14 +7921,27 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2462,13 +7969,15 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2478,29 +7987,79 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetDelegateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetDelegateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetDelegateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2527,16 +8086,16 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Type = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2546,16 +8105,16 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2565,16 +8124,29 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Height = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2584,16 +8156,31 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2603,31 +8190,31 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2637,23 +8224,22 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2678,7 +8264,7 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
+func (m *EventSubmitAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,17 +8287,17 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCloseContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubmitAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubmitAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.ContractId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2721,16 +8307,31 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2740,29 +8341,27 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2790,13 +8389,70 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.Attester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
+			}
+			m.Availability = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Availability |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2806,31 +8462,31 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2840,24 +8496,23 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2881,7 +8536,7 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
+func (m *EventSetProber) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,17 +8559,17 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetProber: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetProber: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prober", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2924,31 +8579,29 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
-			}
+			m.Prober = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2958,26 +8611,12 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
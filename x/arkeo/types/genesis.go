@@ -4,6 +4,8 @@ import (
 	fmt "fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 )
 
 // this line is used by starport scaffolding # genesis/types/import
@@ -23,6 +25,11 @@ func DefaultGenesis() *GenesisState {
 		UserContractSets:       make([]UserContractSet, 0),
 		Version:                1,
 		ValidatorVersions:      make([]ValidatorVersion, 0),
+		ProviderUnbondingSets:  make([]ProviderUnbondingSet, 0),
+		ConfigOverrides:        make([]ConfigOverride, 0),
+		ContractGroups:         make([]ContractGroup, 0),
+		Probers:                make([]string, 0),
+		Evidence:               make([]EvidenceRecord, 0),
 	}
 }
 
@@ -52,6 +59,67 @@ func (gs GenesisState) Validate() error {
 		seenContracts[contract.Id] = true
 	}
 
+	for _, group := range gs.ContractGroups {
+		if seenContracts[group.Id] {
+			return fmt.Errorf("duplicate contract group ID found: %d", group.Id)
+		}
+		seenContracts[group.Id] = true
+	}
+
+	seenUnbondingHeights := make(map[int64]bool)
+	for _, set := range gs.ProviderUnbondingSets {
+		if set.Height <= 0 {
+			return fmt.Errorf("invalid provider unbonding set height: %d", set.Height)
+		}
+		if seenUnbondingHeights[set.Height] {
+			return fmt.Errorf("duplicate provider unbonding set height: %d", set.Height)
+		}
+		seenUnbondingHeights[set.Height] = true
+		for _, unbonding := range set.Unbondings {
+			if unbonding.Amount.IsNil() || unbonding.Amount.IsNegative() {
+				return fmt.Errorf("invalid provider unbonding amount at height %d for %s", set.Height, unbonding.Provider)
+			}
+		}
+	}
+
+	seenConfigs := make(map[string]bool)
+	compiled := configs.GetConfigValues(gs.Version)
+	for _, override := range gs.ConfigOverrides {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("invalid config override: %w", err)
+		}
+		if seenConfigs[override.Name] {
+			return fmt.Errorf("duplicate config override: %s", override.Name)
+		}
+		seenConfigs[override.Name] = true
+		name, _ := configs.GetConfigName(override.Name)
+		if valueType, ok := compiled.GetValueType(name); !ok || ConfigTypeOf(valueType) != override.Type {
+			return fmt.Errorf("config override %s does not match the type of the compiled value", override.Name)
+		}
+	}
+
+	seenProbers := make(map[string]bool)
+	for _, prober := range gs.Probers {
+		if _, err := sdk.AccAddressFromBech32(prober); err != nil {
+			return fmt.Errorf("invalid prober address %s: %w", prober, err)
+		}
+		if seenProbers[prober] {
+			return fmt.Errorf("duplicate prober: %s", prober)
+		}
+		seenProbers[prober] = true
+	}
+
+	seenEvidence := make(map[EvidenceRecord]bool)
+	for _, evidence := range gs.Evidence {
+		if evidence.Nonce <= 0 {
+			return fmt.Errorf("invalid evidence nonce for contract %d: %d", evidence.ContractId, evidence.Nonce)
+		}
+		if seenEvidence[evidence] {
+			return fmt.Errorf("duplicate evidence for contract %d nonce %d", evidence.ContractId, evidence.Nonce)
+		}
+		seenEvidence[evidence] = true
+	}
+
 	seenValidators := make(map[string]bool)
 	for _, vv := range gs.ValidatorVersions {
 		if seenValidators[vv.ValidatorAddress] {
@@ -87,6 +87,11 @@ type GenesisState struct {
 	Version                int64                   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	ValidatorVersions      []ValidatorVersion      `protobuf:"bytes,8,rep,name=validator_versions,json=validatorVersions,proto3" json:"validator_versions"`
 	Services               []Service               `protobuf:"bytes,9,rep,name=services,proto3" json:"services"`
+	ProviderUnbondingSets  []ProviderUnbondingSet  `protobuf:"bytes,10,rep,name=provider_unbonding_sets,json=providerUnbondingSets,proto3" json:"provider_unbonding_sets"`
+	ConfigOverrides        []ConfigOverride        `protobuf:"bytes,11,rep,name=config_overrides,json=configOverrides,proto3" json:"config_overrides"`
+	ContractGroups         []ContractGroup         `protobuf:"bytes,12,rep,name=contract_groups,json=contractGroups,proto3" json:"contract_groups"`
+	Probers                []string                `protobuf:"bytes,13,rep,name=probers,proto3" json:"probers,omitempty"`
+	Evidence               []EvidenceRecord        `protobuf:"bytes,14,rep,name=evidence,proto3" json:"evidence"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -185,6 +190,41 @@ func (m *GenesisState) GetServices() []Service {
 	return nil
 }
 
+func (m *GenesisState) GetProviderUnbondingSets() []ProviderUnbondingSet {
+	if m != nil {
+		return m.ProviderUnbondingSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConfigOverrides() []ConfigOverride {
+	if m != nil {
+		return m.ConfigOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractGroups() []ContractGroup {
+	if m != nil {
+		return m.ContractGroups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProbers() []string {
+	if m != nil {
+		return m.Probers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvidence() []EvidenceRecord {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorVersion)(nil), "arkeo.arkeo.ValidatorVersion")
 	proto.RegisterType((*GenesisState)(nil), "arkeo.arkeo.GenesisState")
@@ -193,35 +233,43 @@ func init() {
 func init() { proto.RegisterFile("arkeo/arkeo/genesis.proto", fileDescriptor_caae968dd754c6d4) }
 
 var fileDescriptor_caae968dd754c6d4 = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x6f, 0xb6, 0xb5, 0xbb, 0x9d, 0x8a, 0xd4, 0x71, 0x95, 0x71, 0xd1, 0x58, 0x7a, 0x0a, 0x2c,
-	0x64, 0x71, 0x05, 0xc1, 0xa3, 0x2b, 0x8b, 0x78, 0x5b, 0x52, 0x5c, 0xd0, 0x4b, 0x98, 0x26, 0x8f,
-	0x38, 0xd4, 0xcd, 0x84, 0x79, 0xd3, 0x58, 0xbf, 0x85, 0x5f, 0xc8, 0xfb, 0x1e, 0xf7, 0xe8, 0x49,
-	0xa4, 0xfd, 0x22, 0xd2, 0x99, 0x49, 0xd2, 0x2c, 0xb9, 0x4c, 0x9b, 0xdf, 0xdf, 0x19, 0xde, 0x23,
-	0xcf, 0xb9, 0x5a, 0x82, 0x3c, 0xb3, 0x67, 0x06, 0x39, 0xa0, 0xc0, 0xb0, 0x50, 0x52, 0x4b, 0x3a,
-	0x36, 0x60, 0x68, 0xce, 0x93, 0xe3, 0x4c, 0x66, 0xd2, 0xe0, 0x67, 0xbb, 0x7f, 0x56, 0x72, 0xc2,
-	0xf6, 0xdd, 0x05, 0x57, 0xfc, 0x06, 0xbb, 0x98, 0x25, 0x40, 0x01, 0xca, 0x32, 0xb3, 0x2f, 0x64,
-	0x72, 0xcd, 0xbf, 0x8b, 0x94, 0x6b, 0xa9, 0xae, 0x41, 0xa1, 0x90, 0x39, 0x3d, 0x25, 0x8f, 0xcb,
-	0x0a, 0x8b, 0x79, 0x9a, 0x2a, 0x40, 0x64, 0xde, 0xd4, 0x0b, 0x46, 0xd1, 0xa4, 0x26, 0xde, 0x5b,
-	0x9c, 0x32, 0x72, 0x58, 0x5a, 0x1f, 0x3b, 0x98, 0x7a, 0x41, 0x3f, 0xaa, 0x3e, 0x67, 0xbf, 0x07,
-	0xe4, 0xe1, 0x47, 0xfb, 0x86, 0xb9, 0xe6, 0x1a, 0xe8, 0x6b, 0x32, 0xb4, 0xb7, 0x32, 0x61, 0xe3,
-	0xf3, 0x27, 0xe1, 0xde, 0x9b, 0xc2, 0x2b, 0x43, 0x5d, 0x0c, 0x6e, 0xff, 0xbe, 0xea, 0x45, 0x4e,
-	0x48, 0xdf, 0x91, 0x51, 0xa1, 0x64, 0x29, 0x52, 0x50, 0xc8, 0x0e, 0xa6, 0xfd, 0x60, 0x7c, 0xfe,
-	0xb4, 0xed, 0x72, 0xac, 0xf3, 0x35, 0xea, 0x9d, 0x35, 0x91, 0xb9, 0x56, 0x3c, 0xd1, 0xc8, 0xfa,
-	0x1d, 0xd6, 0x0f, 0x8e, 0xad, 0xac, 0xb5, 0x9a, 0x06, 0x64, 0x92, 0xc3, 0x5a, 0xc7, 0x15, 0x12,
-	0x8b, 0x94, 0x0d, 0xa6, 0x5e, 0x30, 0x88, 0x1e, 0xed, 0xf0, 0xca, 0xf8, 0x29, 0xa5, 0x0b, 0xc2,
-	0x6a, 0x11, 0xac, 0x0b, 0xa1, 0xb8, 0x16, 0x32, 0x8f, 0x11, 0x34, 0xb2, 0x07, 0xa6, 0x73, 0xd6,
-	0xd9, 0x79, 0x59, 0x6b, 0xe7, 0x50, 0x5d, 0xe0, 0x59, 0xd2, 0x45, 0x22, 0xbd, 0x22, 0x74, 0x85,
-	0xa0, 0x9a, 0xdb, 0x98, 0xf4, 0xa1, 0x49, 0x7f, 0xd1, 0x4a, 0xff, 0x8c, 0xa0, 0xaa, 0x86, 0x26,
-	0x77, 0xb2, 0x6a, 0xc3, 0xad, 0x99, 0x1d, 0xb6, 0x66, 0x46, 0x23, 0x42, 0x9b, 0xd1, 0x3b, 0x10,
-	0xd9, 0x91, 0xe9, 0x7a, 0xd9, 0xea, 0xba, 0xbf, 0x35, 0xae, 0xac, 0xd9, 0x1c, 0x87, 0x23, 0x7d,
-	0x4b, 0x8e, 0x10, 0x54, 0x29, 0x12, 0x40, 0x36, 0x32, 0x49, 0xc7, 0xad, 0xa4, 0xb9, 0x25, 0x5d,
-	0x40, 0xad, 0xbd, 0xb8, 0xbc, 0xdd, 0xf8, 0xde, 0xdd, 0xc6, 0xf7, 0xfe, 0x6d, 0x7c, 0xef, 0xd7,
-	0xd6, 0xef, 0xdd, 0x6d, 0xfd, 0xde, 0x9f, 0xad, 0xdf, 0xfb, 0x7a, 0x9a, 0x09, 0xfd, 0x6d, 0xb5,
-	0x08, 0x13, 0x79, 0x63, 0x77, 0x3a, 0x07, 0xfd, 0x43, 0xaa, 0xa5, 0x5b, 0xf0, 0xb5, 0xfb, 0xd5,
-	0x3f, 0x0b, 0xc0, 0xc5, 0xd0, 0x2c, 0xfa, 0x9b, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x06, 0x32,
-	0x9e, 0x5a, 0x5c, 0x03, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd6, 0xb1, 0xb6, 0xee, 0xe8, 0x3a, 0xb3, 0x81, 0x29, 0x10, 0x4a, 0x4f, 0x91, 0x26,
+	0xb5, 0x62, 0x48, 0x48, 0x1c, 0x38, 0x30, 0x54, 0x4d, 0x93, 0x90, 0x98, 0x52, 0x6d, 0x12, 0x5c,
+	0xa2, 0x34, 0x79, 0x0b, 0x56, 0x59, 0x1c, 0xd9, 0x6e, 0x28, 0xdf, 0x62, 0x1f, 0x6b, 0xc7, 0x1d,
+	0x39, 0x21, 0xd4, 0x7e, 0x11, 0x14, 0xc7, 0x4e, 0x9b, 0x2a, 0x97, 0xb4, 0xfe, 0xfd, 0x7b, 0x7e,
+	0x79, 0x4f, 0x41, 0xcf, 0x7d, 0x3e, 0x03, 0x36, 0xca, 0x9f, 0x11, 0xc4, 0x20, 0xa8, 0x18, 0x26,
+	0x9c, 0x49, 0x86, 0xdb, 0x0a, 0x1c, 0xaa, 0x67, 0xef, 0x28, 0x62, 0x11, 0x53, 0xf8, 0x28, 0xfb,
+	0x97, 0x4b, 0x7a, 0x64, 0xd3, 0x9d, 0xf8, 0xdc, 0xbf, 0x15, 0x55, 0xcc, 0x0c, 0x20, 0x01, 0x9e,
+	0x33, 0x83, 0x6f, 0xa8, 0x7b, 0xed, 0xff, 0xa4, 0xa1, 0x2f, 0x19, 0xbf, 0x06, 0x2e, 0x28, 0x8b,
+	0xf1, 0x09, 0x3a, 0x4c, 0x0d, 0xe6, 0xf9, 0x61, 0xc8, 0x41, 0x08, 0x62, 0xf5, 0x2d, 0xa7, 0xe5,
+	0x76, 0x0b, 0xe2, 0x53, 0x8e, 0x63, 0x82, 0x1a, 0x69, 0xee, 0x23, 0x3b, 0x7d, 0xcb, 0xa9, 0xbb,
+	0xe6, 0x38, 0xb8, 0x6b, 0xa0, 0xfd, 0xf3, 0xbc, 0x87, 0x89, 0xf4, 0x25, 0xe0, 0xb7, 0x68, 0x2f,
+	0xbf, 0x95, 0x0a, 0x6b, 0x9f, 0x3e, 0x19, 0x6e, 0xf4, 0x34, 0xbc, 0x54, 0xd4, 0xd9, 0xee, 0xfd,
+	0xdf, 0xd7, 0x35, 0x57, 0x0b, 0xf1, 0x07, 0xd4, 0x4a, 0x38, 0x4b, 0x69, 0x08, 0x5c, 0x90, 0x9d,
+	0x7e, 0xdd, 0x69, 0x9f, 0x1e, 0x97, 0x5d, 0x9a, 0xd5, 0xbe, 0xb5, 0x3a, 0xb3, 0x06, 0x2c, 0x96,
+	0xdc, 0x0f, 0xa4, 0x20, 0xf5, 0x0a, 0xeb, 0x67, 0xcd, 0x1a, 0x6b, 0xa1, 0xc6, 0x0e, 0xea, 0xc6,
+	0xb0, 0x90, 0x9e, 0x41, 0x3c, 0x1a, 0x92, 0xdd, 0xbe, 0xe5, 0xec, 0xba, 0x9d, 0x0c, 0x37, 0xc6,
+	0x8b, 0x10, 0x4f, 0x11, 0x29, 0x44, 0xb0, 0x48, 0x28, 0xf7, 0x25, 0x65, 0xb1, 0x27, 0x40, 0x0a,
+	0xf2, 0x48, 0xd5, 0x1c, 0x54, 0xd6, 0x1c, 0x17, 0xda, 0x09, 0x98, 0x0b, 0x3c, 0x0d, 0xaa, 0x48,
+	0x81, 0x2f, 0x11, 0x9e, 0x0b, 0xe0, 0xeb, 0xdb, 0xa8, 0xf4, 0x3d, 0x95, 0xfe, 0xb2, 0x94, 0x7e,
+	0x25, 0x80, 0x9b, 0x0a, 0xeb, 0xdc, 0xee, 0xbc, 0x0c, 0x97, 0x66, 0xd6, 0x28, 0xcd, 0x0c, 0xbb,
+	0x08, 0xaf, 0x47, 0xaf, 0x41, 0x41, 0x9a, 0xaa, 0xd6, 0xab, 0x52, 0xad, 0xed, 0xad, 0xd1, 0xc5,
+	0x0e, 0xd3, 0x2d, 0x5c, 0xe0, 0xf7, 0xa8, 0x29, 0x80, 0xa7, 0x34, 0x00, 0x41, 0x5a, 0x2a, 0xe9,
+	0xa8, 0x94, 0x34, 0xc9, 0x49, 0x1d, 0x50, 0x68, 0xb1, 0x87, 0x9e, 0x99, 0x69, 0x7a, 0xf3, 0x78,
+	0xca, 0xe2, 0x90, 0xc6, 0x51, 0xde, 0x3c, 0x52, 0x31, 0x6f, 0x2a, 0x37, 0xe1, 0xca, 0x48, 0xd7,
+	0x6f, 0xe0, 0x38, 0xa9, 0xe0, 0x04, 0xfe, 0x82, 0xba, 0x01, 0x8b, 0x6f, 0x68, 0xe4, 0xb1, 0x14,
+	0x38, 0xa7, 0x21, 0x08, 0xd2, 0x56, 0xc9, 0x2f, 0xb6, 0x87, 0x76, 0x43, 0xa3, 0xaf, 0x5a, 0xa3,
+	0x33, 0x0f, 0x82, 0x12, 0x2a, 0xf0, 0x05, 0x3a, 0x28, 0x26, 0x14, 0x71, 0x36, 0x4f, 0x04, 0xd9,
+	0x57, 0x61, 0xbd, 0xca, 0x0d, 0x38, 0xcf, 0x24, 0x3a, 0xab, 0x13, 0x6c, 0x82, 0x6a, 0x3e, 0x09,
+	0x67, 0xd3, 0x6c, 0xe7, 0x1f, 0xf7, 0xeb, 0x4e, 0xcb, 0x35, 0x47, 0xfc, 0x11, 0x35, 0x21, 0xeb,
+	0x24, 0x0e, 0x80, 0x74, 0x2a, 0xae, 0x3a, 0xd6, 0xa4, 0x0b, 0x01, 0xe3, 0xa1, 0x79, 0xa5, 0xc6,
+	0x72, 0x36, 0xbe, 0x5f, 0xda, 0xd6, 0xc3, 0xd2, 0xb6, 0xfe, 0x2d, 0x6d, 0xeb, 0x6e, 0x65, 0xd7,
+	0x1e, 0x56, 0x76, 0xed, 0xcf, 0xca, 0xae, 0x7d, 0x3f, 0x89, 0xa8, 0xfc, 0x31, 0x9f, 0x0e, 0x03,
+	0x76, 0x9b, 0x7f, 0x26, 0x62, 0x90, 0xbf, 0x18, 0x9f, 0xe5, 0x87, 0xd1, 0x42, 0xff, 0xca, 0xdf,
+	0x09, 0x88, 0xe9, 0x9e, 0xfa, 0x76, 0xbc, 0xfb, 0x3f, 0x00, 0x1d, 0xd9, 0x31, 0x5d, 0xaf, 0x04,
+	0x00, 0x00,
 }
 
 func (m *ValidatorVersion) Marshal() (dAtA []byte, err error) {
@@ -279,6 +327,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Probers) > 0 {
+		for iNdEx := len(m.Probers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Probers[iNdEx])
+			copy(dAtA[i:], m.Probers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Probers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ContractGroups) > 0 {
+		for iNdEx := len(m.ContractGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ConfigOverrides) > 0 {
+		for iNdEx := len(m.ConfigOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProviderUnbondingSets) > 0 {
+		for iNdEx := len(m.ProviderUnbondingSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderUnbondingSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Services) > 0 {
 		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -463,6 +576,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProviderUnbondingSets) > 0 {
+		for _, e := range m.ProviderUnbondingSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfigOverrides) > 0 {
+		for _, e := range m.ConfigOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractGroups) > 0 {
+		for _, e := range m.ContractGroups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Probers) > 0 {
+		for _, s := range m.Probers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -877,6 +1020,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnbondingSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUnbondingSets = append(m.ProviderUnbondingSets, ProviderUnbondingSet{})
+			if err := m.ProviderUnbondingSets[len(m.ProviderUnbondingSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigOverrides = append(m.ConfigOverrides, ConfigOverride{})
+			if err := m.ConfigOverrides[len(m.ConfigOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractGroups = append(m.ContractGroups, ContractGroup{})
+			if err := m.ContractGroups[len(m.ContractGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Probers = append(m.Probers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, EvidenceRecord{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,6 +28,72 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicate provider unbonding height",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderUnbondingSets: []types.ProviderUnbondingSet{
+					{Height: 10},
+					{Height: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid provider unbonding height",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				ProviderUnbondingSets: []types.ProviderUnbondingSet{{Height: 0}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate evidence",
+			genState: &types.GenesisState{
+				Params:   types.DefaultParams(),
+				Evidence: []types.EvidenceRecord{{ContractId: 1, Nonce: 5}, {ContractId: 1, Nonce: 5}},
+			},
+			valid: false,
+		},
+		{
+			desc: "config override of the compiled type",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConfigOverrides: []types.ConfigOverride{
+					{Name: "MinProviderBond", Type: types.ConfigType_INT64, Int64Value: 100},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "config override of another type",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConfigOverrides: []types.ConfigOverride{
+					{Name: "MinProviderBond", Type: types.ConfigType_STRING, StringValue: "100"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate config override",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConfigOverrides: []types.ConfigOverride{
+					{Name: "MinProviderBond", Type: types.ConfigType_INT64, Int64Value: 100},
+					{Name: "MinProviderBond", Type: types.ConfigType_INT64, Int64Value: 200},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown config override",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				ConfigOverrides: []types.ConfigOverride{{Name: "NotAConfig"}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
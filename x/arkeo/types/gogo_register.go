@@ -15,6 +15,12 @@ func init() {
 	cosmosproto.RegisterFile("arkeo/arkeo/misc.proto", fileDescriptor_64a3fa5463db3b34)
 	cosmosproto.RegisterFile("arkeo/arkeo/params.proto", fileDescriptor_47c871f4fc73dfc5)
 	cosmosproto.RegisterFile("arkeo/arkeo/events.proto", fileDescriptor_39b4417094f69f41)
+	// enums are needed to parse typed events, ie EventOpenContract
+	cosmosproto.RegisterEnum("arkeo.arkeo.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
+	cosmosproto.RegisterEnum("arkeo.arkeo.ContractType", ContractType_name, ContractType_value)
+	cosmosproto.RegisterEnum("arkeo.arkeo.ContractAuthorization", ContractAuthorization_name, ContractAuthorization_value)
+	cosmosproto.RegisterEnum("arkeo.arkeo.ConfigType", ConfigType_name, ConfigType_value)
+	cosmosproto.RegisterEnum("arkeo.arkeo.ConfigSource", ConfigSource_name, ConfigSource_value)
 	cosmosproto.RegisterType((*MsgBondProvider)(nil), "arkeo.arkeo.MsgBondProvider")
 	cosmosproto.RegisterType((*MsgBondProviderResponse)(nil), "arkeo.arkeo.MsgBondProviderResponse")
 	cosmosproto.RegisterType((*MsgModProvider)(nil), "arkeo.arkeo.MsgModProvider")
@@ -33,10 +39,41 @@ func init() {
 	cosmosproto.RegisterType((*MsgUpdateServiceResponse)(nil), "arkeo.arkeo.MsgUpdateServiceResponse")
 	cosmosproto.RegisterType((*MsgRemoveService)(nil), "arkeo.arkeo.MsgRemoveService")
 	cosmosproto.RegisterType((*MsgRemoveServiceResponse)(nil), "arkeo.arkeo.MsgRemoveServiceResponse")
+	cosmosproto.RegisterType((*MsgSubmitEvidence)(nil), "arkeo.arkeo.MsgSubmitEvidence")
+	cosmosproto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "arkeo.arkeo.MsgSubmitEvidenceResponse")
+	cosmosproto.RegisterType((*MsgSubmitUnsignedNonceEvidence)(nil), "arkeo.arkeo.MsgSubmitUnsignedNonceEvidence")
+	cosmosproto.RegisterType((*MsgSubmitUnsignedNonceEvidenceResponse)(nil), "arkeo.arkeo.MsgSubmitUnsignedNonceEvidenceResponse")
+	cosmosproto.RegisterType((*MsgSetConfig)(nil), "arkeo.arkeo.MsgSetConfig")
+	cosmosproto.RegisterType((*MsgSetConfigResponse)(nil), "arkeo.arkeo.MsgSetConfigResponse")
+	cosmosproto.RegisterType((*MsgTopUpContract)(nil), "arkeo.arkeo.MsgTopUpContract")
+	cosmosproto.RegisterType((*MsgTopUpContractResponse)(nil), "arkeo.arkeo.MsgTopUpContractResponse")
+	cosmosproto.RegisterType((*MsgSetContractRenewal)(nil), "arkeo.arkeo.MsgSetContractRenewal")
+	cosmosproto.RegisterType((*MsgSetContractRenewalResponse)(nil), "arkeo.arkeo.MsgSetContractRenewalResponse")
+	cosmosproto.RegisterType((*MsgOpenContractGroup)(nil), "arkeo.arkeo.MsgOpenContractGroup")
+	cosmosproto.RegisterType((*MsgOpenContractGroupResponse)(nil), "arkeo.arkeo.MsgOpenContractGroupResponse")
+	cosmosproto.RegisterType((*MsgSetDelegateLimit)(nil), "arkeo.arkeo.MsgSetDelegateLimit")
+	cosmosproto.RegisterType((*MsgSetDelegateLimitResponse)(nil), "arkeo.arkeo.MsgSetDelegateLimitResponse")
+	cosmosproto.RegisterType((*MsgSubmitAttestation)(nil), "arkeo.arkeo.MsgSubmitAttestation")
+	cosmosproto.RegisterType((*MsgSubmitAttestationResponse)(nil), "arkeo.arkeo.MsgSubmitAttestationResponse")
+	cosmosproto.RegisterType((*MsgSetProber)(nil), "arkeo.arkeo.MsgSetProber")
+	cosmosproto.RegisterType((*MsgSetProberResponse)(nil), "arkeo.arkeo.MsgSetProberResponse")
 	cosmosproto.RegisterType((*EventBondProvider)(nil), "arkeo.arkeo.EventBondProvider")
 	cosmosproto.RegisterType((*EventModProvider)(nil), "arkeo.arkeo.EventModProvider")
 	cosmosproto.RegisterType((*EventOpenContract)(nil), "arkeo.arkeo.EventOpenContract")
 	cosmosproto.RegisterType((*EventSettleContract)(nil), "arkeo.arkeo.EventSettleContract")
 	cosmosproto.RegisterType((*EventCloseContract)(nil), "arkeo.arkeo.EventCloseContract")
 	cosmosproto.RegisterType((*EventValidatorPayout)(nil), "arkeo.arkeo.EventValidatorPayout")
+	cosmosproto.RegisterType((*EventProviderSlashed)(nil), "arkeo.arkeo.EventProviderSlashed")
+	cosmosproto.RegisterType((*EventProviderUnbonding)(nil), "arkeo.arkeo.EventProviderUnbonding")
+	cosmosproto.RegisterType((*EventProviderUnbonded)(nil), "arkeo.arkeo.EventProviderUnbonded")
+	cosmosproto.RegisterType((*EventSetConfig)(nil), "arkeo.arkeo.EventSetConfig")
+	cosmosproto.RegisterType((*EventTopUpContract)(nil), "arkeo.arkeo.EventTopUpContract")
+	cosmosproto.RegisterType((*EventSetContractRenewal)(nil), "arkeo.arkeo.EventSetContractRenewal")
+	cosmosproto.RegisterType((*EventContractRenewed)(nil), "arkeo.arkeo.EventContractRenewed")
+	cosmosproto.RegisterType((*EventContractRenewalFailed)(nil), "arkeo.arkeo.EventContractRenewalFailed")
+	cosmosproto.RegisterType((*EventOpenContractGroup)(nil), "arkeo.arkeo.EventOpenContractGroup")
+	cosmosproto.RegisterType((*EventSettleContractGroup)(nil), "arkeo.arkeo.EventSettleContractGroup")
+	cosmosproto.RegisterType((*EventSetDelegateLimit)(nil), "arkeo.arkeo.EventSetDelegateLimit")
+	cosmosproto.RegisterType((*EventSubmitAttestation)(nil), "arkeo.arkeo.EventSubmitAttestation")
+	cosmosproto.RegisterType((*EventSetProber)(nil), "arkeo.arkeo.EventSetProber")
 }
@@ -5,10 +5,16 @@ import (
 	fmt "fmt"
 	"strconv"
 
+	"cosmossdk.io/errors"
+
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 )
 
+// maximum length of a string config override
+const maxConfigStringLength = 256
+
 func NewProvider(pubkey common.PubKey, service common.Service) Provider {
 	return Provider{
 		PubKey:           pubkey,
@@ -16,6 +22,7 @@ func NewProvider(pubkey common.PubKey, service common.Service) Provider {
 		Bond:             cosmos.ZeroInt(),
 		SubscriptionRate: make([]cosmos.Coin, 0),
 		PayAsYouGoRate:   make([]cosmos.Coin, 0),
+		Reputation:       ProviderReputation{Weight: cosmos.ZeroInt()},
 	}
 }
 
@@ -23,14 +30,22 @@ func (provider Provider) Key() string {
 	return fmt.Sprintf("%s/%s", provider.PubKey, provider.Service)
 }
 
+// IsJailed returns true if the provider is jailed at the given height
+func (provider Provider) IsJailed(height int64) bool {
+	return provider.JailedUntil > height
+}
+
 func NewContract(provider common.PubKey, service common.Service, client common.PubKey) Contract {
 	return Contract{
-		Provider: provider,
-		Service:  service,
-		Client:   client,
-		Delegate: common.EmptyPubKey,
-		Deposit:  cosmos.ZeroInt(),
-		Paid:     cosmos.ZeroInt(),
+		Provider:      provider,
+		Service:       service,
+		Client:        client,
+		Delegate:      common.EmptyPubKey,
+		Deposit:       cosmos.ZeroInt(),
+		Paid:          cosmos.ZeroInt(),
+		RenewalEscrow: cosmos.ZeroInt(),
+		DelegateLimit: NewDelegateLimit(0, cosmos.ZeroInt(), 0),
+		DelegateSpent: cosmos.ZeroInt(),
 	}
 }
 
@@ -117,6 +132,15 @@ func (contract Contract) IsSettlementPeriod(height int64) bool {
 	return contract.Expiration() < height && contract.SettlementPeriodEnd() > height
 }
 
+// GetRenewalEscrow returns the renewal escrow of the contract, contracts
+// stored before renewals existed have none
+func (contract Contract) GetRenewalEscrow() cosmos.Int {
+	if contract.RenewalEscrow.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	return contract.RenewalEscrow
+}
+
 func (contract Contract) IsEmpty() bool {
 	return contract.Height == 0
 }
@@ -129,6 +153,27 @@ func (contract Contract) ClientAddress() cosmos.AccAddress {
 	return addr
 }
 
+// IsGroupMember returns true when the contract is paid from the deposit of a
+// contract group
+func (contract Contract) IsGroupMember() bool {
+	return contract.GroupId > 0
+}
+
+func (group ContractGroup) IsEmpty() bool {
+	return group.Height == 0
+}
+
+// Remaining returns the part of the shared deposit not paid to providers yet
+func (group ContractGroup) Remaining() cosmos.Int {
+	if group.Deposit.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	if group.Paid.IsNil() {
+		return group.Deposit
+	}
+	return group.Deposit.Sub(group.Paid)
+}
+
 func (contractType *ContractType) UnmarshalJSON(b []byte) error {
 	var item interface{}
 	if err := json.Unmarshal(b, &item); err != nil {
@@ -147,6 +192,10 @@ func (exp *ContractExpirationSet) Append(id uint64) {
 	exp.ContractSet.ContractIds = append(exp.ContractSet.ContractIds, id)
 }
 
+func (set *ProviderUnbondingSet) Append(unbonding ProviderUnbonding) {
+	set.Unbondings = append(set.Unbondings, unbonding)
+}
+
 func (contractAuth *ContractAuthorization) UnmarshalJSON(b []byte) error {
 	var item interface{}
 	if err := json.Unmarshal(b, &item); err != nil {
@@ -182,3 +231,62 @@ func (userContractSet *UserContractSet) RemoveContractFromSet(contractIdToRemove
 	}
 	return fmt.Errorf("contract %d not found in user contract set", contractIdToRemove)
 }
+
+// ConfigTypeOf returns the ConfigType of a config value type
+func ConfigTypeOf(valueType configs.ValueType) ConfigType {
+	switch valueType {
+	case configs.BoolType:
+		return ConfigType_BOOL
+	case configs.StringType:
+		return ConfigType_STRING
+	default:
+		return ConfigType_INT64
+	}
+}
+
+// Validate checks the override names a known config and its value is in range
+func (c ConfigOverride) Validate() error {
+	name, ok := configs.GetConfigName(c.Name)
+	if !ok {
+		return errors.Wrapf(ErrInvalidConfig, "unknown config %q", c.Name)
+	}
+	switch c.Type {
+	case ConfigType_INT64:
+		if err := configs.ValidateInt64Value(name, c.Int64Value); err != nil {
+			return errors.Wrap(ErrInvalidConfig, err.Error())
+		}
+	case ConfigType_BOOL:
+	case ConfigType_STRING:
+		if len(c.StringValue) > maxConfigStringLength {
+			return errors.Wrapf(ErrInvalidConfig, "%s is longer than %d characters", c.Name, maxConfigStringLength)
+		}
+	default:
+		return errors.Wrapf(ErrInvalidConfig, "unknown config type %d", c.Type)
+	}
+	return nil
+}
+
+// Value returns the value of the override as a string
+func (c ConfigOverride) Value() string {
+	switch c.Type {
+	case ConfigType_BOOL:
+		return strconv.FormatBool(c.BoolValue)
+	case ConfigType_STRING:
+		return c.StringValue
+	default:
+		return strconv.FormatInt(c.Int64Value, 10)
+	}
+}
+
+// Remove removes a contract id from the set
+func (exp *ContractExpirationSet) Remove(id uint64) {
+	if exp.ContractSet == nil {
+		return
+	}
+	for i, contractId := range exp.ContractSet.ContractIds {
+		if contractId == id {
+			exp.ContractSet.ContractIds = append(exp.ContractSet.ContractIds[:i], exp.ContractSet.ContractIds[i+1:]...)
+			return
+		}
+	}
+}
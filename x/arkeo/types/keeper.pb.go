@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_arkeonetwork_arkeo_common "github.com/arkeonetwork/arkeo/common"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -111,6 +112,38 @@ func (ContractAuthorization) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f833050061122841, []int{2}
 }
 
+// ConfigType defines the type of a config value.
+type ConfigType int32
+
+const (
+	// INT64 is an integer config value.
+	ConfigType_INT64 ConfigType = 0
+	// BOOL is a boolean config value.
+	ConfigType_BOOL ConfigType = 1
+	// STRING is a string config value.
+	ConfigType_STRING ConfigType = 2
+)
+
+var ConfigType_name = map[int32]string{
+	0: "INT64",
+	1: "BOOL",
+	2: "STRING",
+}
+
+var ConfigType_value = map[string]int32{
+	"INT64":  0,
+	"BOOL":   1,
+	"STRING": 2,
+}
+
+func (x ConfigType) String() string {
+	return proto.EnumName(ConfigType_name, int32(x))
+}
+
+func (ConfigType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{3}
+}
+
 // ProviderStatus defines the status of a provider.
 type Provider struct {
 	PubKey              github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"pub_key,omitempty"`
@@ -125,6 +158,10 @@ type Provider struct {
 	Bond                cosmossdk_io_math.Int                        `protobuf:"bytes,10,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
 	LastUpdate          int64                                        `protobuf:"varint,11,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
 	SettlementDuration  int64                                        `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// height until which the provider is jailed for proven misbehavior
+	JailedUntil int64              `protobuf:"varint,13,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	RateCard    RateCard           `protobuf:"bytes,14,opt,name=rate_card,json=rateCard,proto3" json:"rate_card"`
+	Reputation  ProviderReputation `protobuf:"bytes,15,opt,name=reputation,proto3" json:"reputation"`
 }
 
 func (m *Provider) Reset()         { *m = Provider{} }
@@ -237,38 +274,51 @@ func (m *Provider) GetSettlementDuration() int64 {
 	return 0
 }
 
-// Contract represents a contract between client and provider.
-type Contract struct {
-	Provider           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
-	Service            github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
-	Client             github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
-	Delegate           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,4,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
-	Type               ContractType                                 `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Height             int64                                        `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
-	Duration           int64                                        `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               types.Coin                                   `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate"`
-	Deposit            cosmossdk_io_math.Int                        `protobuf:"bytes,9,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
-	Paid               cosmossdk_io_math.Int                        `protobuf:"bytes,10,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
-	Nonce              int64                                        `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	SettlementHeight   int64                                        `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
-	Id                 uint64                                       `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
-	SettlementDuration int64                                        `protobuf:"varint,14,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization                        `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                                        `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+func (m *Provider) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
 }
 
-func (m *Contract) Reset()         { *m = Contract{} }
-func (m *Contract) String() string { return proto.CompactTextString(m) }
-func (*Contract) ProtoMessage()    {}
-func (*Contract) Descriptor() ([]byte, []int) {
+func (m *Provider) GetRateCard() RateCard {
+	if m != nil {
+		return m.RateCard
+	}
+	return RateCard{}
+}
+
+func (m *Provider) GetReputation() ProviderReputation {
+	if m != nil {
+		return m.Reputation
+	}
+	return ProviderReputation{}
+}
+
+// ProviderReputation aggregates the latency and availability attestations of
+// a provider. The score is the average of the attestations, in basis points,
+// weighted by the paid volume of the attesting contract or the prober weight.
+// Weights halve every ReputationHalfLife blocks so recent attestations count
+// the most.
+type ProviderReputation struct {
+	Score        int64                 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Weight       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight"`
+	LastUpdate   int64                 `protobuf:"varint,3,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	Attestations uint64                `protobuf:"varint,4,opt,name=attestations,proto3" json:"attestations,omitempty"`
+}
+
+func (m *ProviderReputation) Reset()         { *m = ProviderReputation{} }
+func (m *ProviderReputation) String() string { return proto.CompactTextString(m) }
+func (*ProviderReputation) ProtoMessage()    {}
+func (*ProviderReputation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f833050061122841, []int{1}
 }
-func (m *Contract) XXX_Unmarshal(b []byte) error {
+func (m *ProviderReputation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProviderReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProviderReputation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -278,133 +328,166 @@ func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Contract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract.Merge(m, src)
+func (m *ProviderReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderReputation.Merge(m, src)
 }
-func (m *Contract) XXX_Size() int {
+func (m *ProviderReputation) XXX_Size() int {
 	return m.Size()
 }
-func (m *Contract) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract.DiscardUnknown(m)
+func (m *ProviderReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderReputation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Contract proto.InternalMessageInfo
+var xxx_messageInfo_ProviderReputation proto.InternalMessageInfo
 
-func (m *Contract) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+func (m *ProviderReputation) GetScore() int64 {
 	if m != nil {
-		return m.Provider
+		return m.Score
 	}
-	return nil
+	return 0
 }
 
-func (m *Contract) GetService() github_com_arkeonetwork_arkeo_common.Service {
+func (m *ProviderReputation) GetLastUpdate() int64 {
 	if m != nil {
-		return m.Service
+		return m.LastUpdate
 	}
 	return 0
 }
 
-func (m *Contract) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+func (m *ProviderReputation) GetAttestations() uint64 {
 	if m != nil {
-		return m.Client
+		return m.Attestations
 	}
-	return nil
+	return 0
 }
 
-func (m *Contract) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
-	if m != nil {
-		return m.Delegate
-	}
-	return nil
+// RateTier is a price that applies from a threshold on. For pay-as-you-go
+// the threshold is a number of queries, for subscriptions it is a number of
+// queries per minute.
+type RateTier struct {
+	Threshold int64        `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Rate      []types.Coin `protobuf:"bytes,2,rep,name=rate,proto3" json:"rate"`
 }
 
-func (m *Contract) GetType() ContractType {
-	if m != nil {
-		return m.Type
-	}
-	return ContractType_SUBSCRIPTION
+func (m *RateTier) Reset()         { *m = RateTier{} }
+func (m *RateTier) String() string { return proto.CompactTextString(m) }
+func (*RateTier) ProtoMessage()    {}
+func (*RateTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{2}
 }
-
-func (m *Contract) GetHeight() int64 {
-	if m != nil {
-		return m.Height
+func (m *RateTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *RateTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateTier.Merge(m, src)
+}
+func (m *RateTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateTier.DiscardUnknown(m)
 }
 
-func (m *Contract) GetDuration() int64 {
+var xxx_messageInfo_RateTier proto.InternalMessageInfo
+
+func (m *RateTier) GetThreshold() int64 {
 	if m != nil {
-		return m.Duration
+		return m.Threshold
 	}
 	return 0
 }
 
-func (m *Contract) GetRate() types.Coin {
+func (m *RateTier) GetRate() []types.Coin {
 	if m != nil {
 		return m.Rate
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *Contract) GetNonce() int64 {
-	if m != nil {
-		return m.Nonce
-	}
-	return 0
+// DurationDiscount discounts subscriptions of at least min_duration blocks.
+type DurationDiscount struct {
+	MinDuration int64 `protobuf:"varint,1,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	DiscountBps int64 `protobuf:"varint,2,opt,name=discount_bps,json=discountBps,proto3" json:"discount_bps,omitempty"`
 }
 
-func (m *Contract) GetSettlementHeight() int64 {
-	if m != nil {
-		return m.SettlementHeight
-	}
-	return 0
+func (m *DurationDiscount) Reset()         { *m = DurationDiscount{} }
+func (m *DurationDiscount) String() string { return proto.CompactTextString(m) }
+func (*DurationDiscount) ProtoMessage()    {}
+func (*DurationDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{3}
 }
-
-func (m *Contract) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
+func (m *DurationDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Contract) GetSettlementDuration() int64 {
-	if m != nil {
-		return m.SettlementDuration
+func (m *DurationDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *DurationDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationDiscount.Merge(m, src)
+}
+func (m *DurationDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationDiscount.DiscardUnknown(m)
 }
 
-func (m *Contract) GetAuthorization() ContractAuthorization {
+var xxx_messageInfo_DurationDiscount proto.InternalMessageInfo
+
+func (m *DurationDiscount) GetMinDuration() int64 {
 	if m != nil {
-		return m.Authorization
+		return m.MinDuration
 	}
-	return ContractAuthorization_STRICT
+	return 0
 }
 
-func (m *Contract) GetQueriesPerMinute() int64 {
+func (m *DurationDiscount) GetDiscountBps() int64 {
 	if m != nil {
-		return m.QueriesPerMinute
+		return m.DiscountBps
 	}
 	return 0
 }
 
-// ContractSet defines a set of contracts.
-type ContractSet struct {
-	ContractIds []uint64 `protobuf:"varint,1,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+// RateCard is the pricing schedule of a provider on top of its flat rates.
+type RateCard struct {
+	PayAsYouGoTiers   []RateTier         `protobuf:"bytes,1,rep,name=pay_as_you_go_tiers,json=payAsYouGoTiers,proto3" json:"pay_as_you_go_tiers"`
+	SubscriptionTiers []RateTier         `protobuf:"bytes,2,rep,name=subscription_tiers,json=subscriptionTiers,proto3" json:"subscription_tiers"`
+	DurationDiscounts []DurationDiscount `protobuf:"bytes,3,rep,name=duration_discounts,json=durationDiscounts,proto3" json:"duration_discounts"`
 }
 
-func (m *ContractSet) Reset()         { *m = ContractSet{} }
-func (m *ContractSet) String() string { return proto.CompactTextString(m) }
-func (*ContractSet) ProtoMessage()    {}
-func (*ContractSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f833050061122841, []int{2}
+func (m *RateCard) Reset()         { *m = RateCard{} }
+func (m *RateCard) String() string { return proto.CompactTextString(m) }
+func (*RateCard) ProtoMessage()    {}
+func (*RateCard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{4}
 }
-func (m *ContractSet) XXX_Unmarshal(b []byte) error {
+func (m *RateCard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateCard.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -414,43 +497,59 @@ func (m *ContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ContractSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSet.Merge(m, src)
+func (m *RateCard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateCard.Merge(m, src)
 }
-func (m *ContractSet) XXX_Size() int {
+func (m *RateCard) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSet) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSet.DiscardUnknown(m)
+func (m *RateCard) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateCard.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSet proto.InternalMessageInfo
+var xxx_messageInfo_RateCard proto.InternalMessageInfo
 
-func (m *ContractSet) GetContractIds() []uint64 {
+func (m *RateCard) GetPayAsYouGoTiers() []RateTier {
 	if m != nil {
-		return m.ContractIds
+		return m.PayAsYouGoTiers
 	}
 	return nil
 }
 
-// ContractExpirationSet defines a set of contract expirations.
-type ContractExpirationSet struct {
-	Height      int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+func (m *RateCard) GetSubscriptionTiers() []RateTier {
+	if m != nil {
+		return m.SubscriptionTiers
+	}
+	return nil
 }
 
-func (m *ContractExpirationSet) Reset()         { *m = ContractExpirationSet{} }
-func (m *ContractExpirationSet) String() string { return proto.CompactTextString(m) }
-func (*ContractExpirationSet) ProtoMessage()    {}
-func (*ContractExpirationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f833050061122841, []int{3}
+func (m *RateCard) GetDurationDiscounts() []DurationDiscount {
+	if m != nil {
+		return m.DurationDiscounts
+	}
+	return nil
 }
-func (m *ContractExpirationSet) XXX_Unmarshal(b []byte) error {
+
+// ProviderUnbonding is bond withdrawn by a provider, held until its release
+// height so that it can still be slashed.
+type ProviderUnbonding struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
+	Amount   cosmossdk_io_math.Int                        `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ProviderUnbonding) Reset()         { *m = ProviderUnbonding{} }
+func (m *ProviderUnbonding) String() string { return proto.CompactTextString(m) }
+func (*ProviderUnbonding) ProtoMessage()    {}
+func (*ProviderUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{5}
+}
+func (m *ProviderUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractExpirationSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProviderUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractExpirationSet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProviderUnbonding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -460,50 +559,50 @@ func (m *ContractExpirationSet) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ContractExpirationSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractExpirationSet.Merge(m, src)
+func (m *ProviderUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderUnbonding.Merge(m, src)
 }
-func (m *ContractExpirationSet) XXX_Size() int {
+func (m *ProviderUnbonding) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractExpirationSet) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractExpirationSet.DiscardUnknown(m)
+func (m *ProviderUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderUnbonding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractExpirationSet proto.InternalMessageInfo
+var xxx_messageInfo_ProviderUnbonding proto.InternalMessageInfo
 
-func (m *ContractExpirationSet) GetHeight() int64 {
+func (m *ProviderUnbonding) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
 	if m != nil {
-		return m.Height
+		return m.Provider
 	}
-	return 0
+	return nil
 }
 
-func (m *ContractExpirationSet) GetContractSet() *ContractSet {
+func (m *ProviderUnbonding) GetService() github_com_arkeonetwork_arkeo_common.Service {
 	if m != nil {
-		return m.ContractSet
+		return m.Service
 	}
-	return nil
+	return 0
 }
 
-// UserContractSet defines a set of contracts belonging to a user.
-type UserContractSet struct {
-	User        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=user,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"user,omitempty"`
-	ContractSet *ContractSet                                `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+// ProviderUnbondingSet defines the provider unbondings released at a height.
+type ProviderUnbondingSet struct {
+	Height     int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Unbondings []ProviderUnbonding `protobuf:"bytes,2,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func (m *UserContractSet) Reset()         { *m = UserContractSet{} }
-func (m *UserContractSet) String() string { return proto.CompactTextString(m) }
-func (*UserContractSet) ProtoMessage()    {}
-func (*UserContractSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f833050061122841, []int{4}
+func (m *ProviderUnbondingSet) Reset()         { *m = ProviderUnbondingSet{} }
+func (m *ProviderUnbondingSet) String() string { return proto.CompactTextString(m) }
+func (*ProviderUnbondingSet) ProtoMessage()    {}
+func (*ProviderUnbondingSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{6}
 }
-func (m *UserContractSet) XXX_Unmarshal(b []byte) error {
+func (m *ProviderUnbondingSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProviderUnbondingSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserContractSet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProviderUnbondingSet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -513,56 +612,51 @@ func (m *UserContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *UserContractSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserContractSet.Merge(m, src)
+func (m *ProviderUnbondingSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderUnbondingSet.Merge(m, src)
 }
-func (m *UserContractSet) XXX_Size() int {
+func (m *ProviderUnbondingSet) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserContractSet) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserContractSet.DiscardUnknown(m)
+func (m *ProviderUnbondingSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderUnbondingSet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserContractSet proto.InternalMessageInfo
+var xxx_messageInfo_ProviderUnbondingSet proto.InternalMessageInfo
 
-func (m *UserContractSet) GetUser() github_com_arkeonetwork_arkeo_common.PubKey {
+func (m *ProviderUnbondingSet) GetHeight() int64 {
 	if m != nil {
-		return m.User
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *UserContractSet) GetContractSet() *ContractSet {
+func (m *ProviderUnbondingSet) GetUnbondings() []ProviderUnbonding {
 	if m != nil {
-		return m.ContractSet
+		return m.Unbondings
 	}
 	return nil
 }
 
-// Service captures the metadata for a dynamically-registered provider service.
-type Service struct {
-	// Globally-unique numeric identifier for this service.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Canonical, lowercase name (e.g., "arkeo-mainnet-rest").
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional human-friendly description.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Optional type/classification (e.g., "rpc", "rest", "indexer").
-	ServiceType string `protobuf:"bytes,4,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+// EvidenceRecord marks a contract nonce for which evidence against the
+// provider was accepted, a provider is slashed once per offense.
+type EvidenceRecord struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *Service) Reset()         { *m = Service{} }
-func (m *Service) String() string { return proto.CompactTextString(m) }
-func (*Service) ProtoMessage()    {}
-func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f833050061122841, []int{5}
+func (m *EvidenceRecord) Reset()         { *m = EvidenceRecord{} }
+func (m *EvidenceRecord) String() string { return proto.CompactTextString(m) }
+func (*EvidenceRecord) ProtoMessage()    {}
+func (*EvidenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{7}
 }
-func (m *Service) XXX_Unmarshal(b []byte) error {
+func (m *EvidenceRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EvidenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Service.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EvidenceRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -572,767 +666,3884 @@ func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Service) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Service.Merge(m, src)
+func (m *EvidenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceRecord.Merge(m, src)
 }
-func (m *Service) XXX_Size() int {
+func (m *EvidenceRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *Service) XXX_DiscardUnknown() {
-	xxx_messageInfo_Service.DiscardUnknown(m)
+func (m *EvidenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Service proto.InternalMessageInfo
+var xxx_messageInfo_EvidenceRecord proto.InternalMessageInfo
 
-func (m *Service) GetId() uint64 {
+func (m *EvidenceRecord) GetContractId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.ContractId
 	}
 	return 0
 }
 
-func (m *Service) GetName() string {
+func (m *EvidenceRecord) GetNonce() int64 {
 	if m != nil {
-		return m.Name
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (m *Service) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+// Contract represents a contract between client and provider.
+type Contract struct {
+	Provider           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service            github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
+	Client             github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,4,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Type               ContractType                                 `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Height             int64                                        `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Duration           int64                                        `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rate               types.Coin                                   `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate"`
+	Deposit            cosmossdk_io_math.Int                        `protobuf:"bytes,9,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	Paid               cosmossdk_io_math.Int                        `protobuf:"bytes,10,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	Nonce              int64                                        `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	SettlementHeight   int64                                        `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
+	Id                 uint64                                       `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
+	SettlementDuration int64                                        `protobuf:"varint,14,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization      ContractAuthorization                        `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute   int64                                        `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// subscriptions are renewed with the same terms when they expire
+	AutoRenew bool `protobuf:"varint,17,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// pays for renewals before the client account
+	RenewalEscrow cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=renewal_escrow,json=renewalEscrow,proto3,customtype=cosmossdk.io/math.Int" json:"renewal_escrow"`
+	// volume tiers of the provider rate card when the contract was opened
+	PayAsYouGoTiers []RateTier `protobuf:"bytes,19,rep,name=pay_as_you_go_tiers,json=payAsYouGoTiers,proto3" json:"pay_as_you_go_tiers"`
+	// duration discount of the provider rate card when the contract was opened
+	DiscountBps int64 `protobuf:"varint,20,opt,name=discount_bps,json=discountBps,proto3" json:"discount_bps,omitempty"`
+	// the contract group paying for the contract, 0 for none
+	GroupId uint64 `protobuf:"varint,21,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// limits what the spender of a pay-as-you-go contract can use
+	DelegateLimit DelegateLimit `protobuf:"bytes,22,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	// value used by the spender in the current period of the limit
+	DelegateSpent cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=delegate_spent,json=delegateSpent,proto3,customtype=cosmossdk.io/math.Int" json:"delegate_spent"`
+	// height the current period of the limit started at
+	DelegatePeriodStart int64 `protobuf:"varint,24,opt,name=delegate_period_start,json=delegatePeriodStart,proto3" json:"delegate_period_start,omitempty"`
+	// account that paid the deposit and gets the refunds, the client when empty
+	Payer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,25,opt,name=payer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payer,omitempty"`
 }
 
-func (m *Service) GetServiceType() string {
-	if m != nil {
-		return m.ServiceType
+func (m *Contract) Reset()         { *m = Contract{} }
+func (m *Contract) String() string { return proto.CompactTextString(m) }
+func (*Contract) ProtoMessage()    {}
+func (*Contract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{8}
+}
+func (m *Contract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func init() {
-	proto.RegisterEnum("arkeo.arkeo.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
-	proto.RegisterEnum("arkeo.arkeo.ContractType", ContractType_name, ContractType_value)
-	proto.RegisterEnum("arkeo.arkeo.ContractAuthorization", ContractAuthorization_name, ContractAuthorization_value)
-	proto.RegisterType((*Provider)(nil), "arkeo.arkeo.Provider")
-	proto.RegisterType((*Contract)(nil), "arkeo.arkeo.Contract")
-	proto.RegisterType((*ContractSet)(nil), "arkeo.arkeo.ContractSet")
-	proto.RegisterType((*ContractExpirationSet)(nil), "arkeo.arkeo.ContractExpirationSet")
-	proto.RegisterType((*UserContractSet)(nil), "arkeo.arkeo.UserContractSet")
-	proto.RegisterType((*Service)(nil), "arkeo.arkeo.Service")
+func (m *Contract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract.Merge(m, src)
+}
+func (m *Contract) XXX_Size() int {
+	return m.Size()
+}
+func (m *Contract) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/keeper.proto", fileDescriptor_f833050061122841) }
+var xxx_messageInfo_Contract proto.InternalMessageInfo
 
-var fileDescriptor_f833050061122841 = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0xc1, 0x24, 0xe1, 0x25, 0x64, 0xcd, 0xec, 0x52, 0x19, 0x2a, 0x85, 0x34, 0xd2, 0x4a,
-	0x29, 0x2c, 0x4e, 0x17, 0x7a, 0xeb, 0xa1, 0x22, 0x94, 0x85, 0x94, 0x2d, 0x89, 0x1c, 0x38, 0xd0,
-	0x8b, 0x35, 0xb1, 0x47, 0x61, 0x04, 0xf6, 0xb8, 0x9e, 0x31, 0x25, 0xfd, 0x15, 0x95, 0xfa, 0x57,
-	0xda, 0xff, 0xb0, 0xc7, 0x55, 0xa5, 0x4a, 0x55, 0x0f, 0xa8, 0x82, 0x7f, 0xb1, 0xa7, 0xca, 0x33,
-	0xe3, 0x10, 0x5a, 0x56, 0xa5, 0x74, 0x2f, 0xb6, 0xe7, 0xbd, 0xf7, 0x7d, 0x99, 0x79, 0xef, 0x7b,
-	0x6f, 0x02, 0x36, 0x4e, 0xce, 0x08, 0x6b, 0xab, 0xe7, 0x19, 0x21, 0x31, 0x49, 0x9c, 0x38, 0x61,
-	0x82, 0xa1, 0x8a, 0xb4, 0x39, 0xf2, 0xb9, 0xf2, 0x6c, 0xc4, 0x46, 0x4c, 0xda, 0xdb, 0xd9, 0x97,
-	0x0a, 0x59, 0x59, 0xf6, 0x19, 0x0f, 0x19, 0xf7, 0x94, 0x43, 0x2d, 0xb4, 0xab, 0xae, 0x56, 0xed,
-	0x21, 0xe6, 0xa4, 0x7d, 0xf1, 0x72, 0x48, 0x04, 0x7e, 0xd9, 0xf6, 0x19, 0x8d, 0x94, 0xbf, 0xf9,
-	0xcb, 0x1c, 0x94, 0xfb, 0x09, 0xbb, 0xa0, 0x01, 0x49, 0xd0, 0x3e, 0x94, 0xe2, 0x74, 0xe8, 0x9d,
-	0x91, 0xb1, 0x6d, 0x34, 0x8c, 0x56, 0xb5, 0xd3, 0x7e, 0x77, 0xb5, 0xba, 0x3e, 0xa2, 0xe2, 0x34,
-	0x1d, 0x3a, 0x3e, 0x0b, 0xd5, 0xf6, 0x22, 0x22, 0xbe, 0x67, 0xc9, 0x99, 0xde, 0xab, 0xcf, 0xc2,
-	0x90, 0x45, 0x4e, 0x3f, 0x1d, 0x1e, 0x90, 0xb1, 0x5b, 0x8c, 0xe5, 0x1b, 0x7d, 0x0d, 0x25, 0x4e,
-	0x92, 0x0b, 0xea, 0x13, 0x7b, 0xa6, 0x61, 0xb4, 0xe6, 0x3a, 0x9f, 0xbd, 0xbb, 0x5a, 0x7d, 0xf1,
-	0x20, 0xa6, 0x81, 0xc2, 0xb9, 0x39, 0x01, 0xfa, 0x04, 0xaa, 0x21, 0x11, 0x38, 0xc0, 0x02, 0x7b,
-	0x69, 0x42, 0xed, 0xd9, 0x86, 0xd1, 0x9a, 0x77, 0x2b, 0xb9, 0xed, 0x38, 0xa1, 0xe8, 0x39, 0xd4,
-	0x26, 0x21, 0x11, 0x8b, 0x7c, 0x62, 0x9b, 0x0d, 0xa3, 0x65, 0xba, 0x0b, 0xb9, 0xf5, 0x30, 0x33,
-	0xa2, 0x2d, 0x28, 0x72, 0x81, 0x45, 0xca, 0xed, 0xb9, 0x86, 0xd1, 0xaa, 0x6d, 0x7e, 0xec, 0x4c,
-	0xe5, 0xd6, 0xc9, 0xd3, 0x30, 0x90, 0x21, 0xae, 0x0e, 0x45, 0x9b, 0xb0, 0x14, 0xd2, 0xc8, 0xf3,
-	0x59, 0x24, 0x12, 0xec, 0x0b, 0x2f, 0x48, 0x13, 0x2c, 0x28, 0x8b, 0xec, 0x62, 0xc3, 0x68, 0xcd,
-	0xba, 0x4f, 0x43, 0x1a, 0xed, 0x68, 0xdf, 0x57, 0xda, 0x25, 0x31, 0xf8, 0xf2, 0x1e, 0x4c, 0x49,
-	0x63, 0xf0, 0xe5, 0x3f, 0x30, 0xaf, 0x61, 0x91, 0xa7, 0x43, 0xee, 0x27, 0x34, 0xce, 0xd6, 0x5e,
-	0x82, 0x05, 0xb1, 0xcb, 0x8d, 0xd9, 0x56, 0x65, 0x73, 0xd9, 0xd1, 0x35, 0xcd, 0xaa, 0xe8, 0xe8,
-	0x2a, 0x3a, 0x3b, 0x8c, 0x46, 0x1d, 0xf3, 0xcd, 0xd5, 0x6a, 0xc1, 0xb5, 0xa6, 0x91, 0x2e, 0x16,
-	0x04, 0x1d, 0x00, 0x8a, 0xf1, 0xd8, 0xc3, 0xdc, 0x1b, 0xb3, 0xd4, 0x1b, 0x31, 0x45, 0x37, 0xff,
-	0x30, 0xba, 0x5a, 0x8c, 0xc7, 0xdb, 0xfc, 0x84, 0xa5, 0x7b, 0x4c, 0x92, 0x7d, 0x09, 0xe6, 0x90,
-	0x45, 0x81, 0x0d, 0x59, 0xe6, 0x3b, 0xeb, 0x59, 0xcc, 0x1f, 0x57, 0xab, 0x4b, 0x8a, 0x85, 0x07,
-	0x67, 0x0e, 0x65, 0xed, 0x10, 0x8b, 0x53, 0xa7, 0x1b, 0x89, 0x5f, 0x7f, 0xde, 0x00, 0x4d, 0xdf,
-	0x8d, 0x84, 0x2b, 0x81, 0x68, 0x15, 0x2a, 0xe7, 0x98, 0x0b, 0x2f, 0x8d, 0x83, 0x6c, 0x1b, 0x15,
-	0x99, 0x05, 0xc8, 0x4c, 0xc7, 0xd2, 0x82, 0xda, 0xf0, 0x94, 0x13, 0x21, 0xce, 0x49, 0x48, 0xa2,
-	0xa9, 0x74, 0x55, 0x65, 0x20, 0xba, 0x75, 0xe5, 0xd9, 0x6a, 0xfe, 0x56, 0x84, 0x72, 0x9e, 0x42,
-	0x74, 0x00, 0xe5, 0x58, 0x17, 0xef, 0xb1, 0xc2, 0x9d, 0x10, 0x7c, 0x50, 0xe9, 0xee, 0x41, 0xd1,
-	0x3f, 0xa7, 0x24, 0x12, 0x52, 0xb4, 0x8f, 0xe9, 0x27, 0x05, 0xcf, 0x4e, 0x18, 0x90, 0x73, 0x32,
-	0xca, 0xb2, 0x67, 0x3e, 0xf2, 0x84, 0x39, 0x01, 0xda, 0x00, 0x53, 0x8c, 0x63, 0xa2, 0x9b, 0x60,
-	0xf9, 0x4e, 0x13, 0xe4, 0x39, 0x3d, 0x1a, 0xc7, 0xc4, 0x95, 0x61, 0xe8, 0x23, 0x28, 0x9e, 0x12,
-	0x3a, 0x3a, 0x15, 0x5a, 0xf1, 0x7a, 0x85, 0x56, 0xa0, 0xfc, 0x37, 0x5d, 0x4f, 0xd6, 0x68, 0x0b,
-	0x4c, 0xad, 0x5f, 0xe3, 0x21, 0x82, 0x93, 0xc1, 0x68, 0x17, 0x4a, 0x01, 0x89, 0x19, 0xa7, 0xc2,
-	0x9e, 0xff, 0xef, 0x4a, 0xcb, 0xb1, 0x99, 0x5a, 0x63, 0x4c, 0x1f, 0xa7, 0xd6, 0x0c, 0x88, 0x9e,
-	0xc1, 0x9c, 0x1a, 0x22, 0x4a, 0xa7, 0x6a, 0x81, 0xd6, 0x61, 0x71, 0x4a, 0xa2, 0x3a, 0x23, 0x4a,
-	0xa0, 0xd6, 0xad, 0x63, 0x5f, 0xe5, 0xa6, 0x06, 0x33, 0x34, 0xb0, 0x17, 0xe4, 0x10, 0x9a, 0xa1,
-	0xc1, 0xfb, 0xf4, 0x5d, 0x7b, 0x9f, 0xbe, 0xd1, 0x3e, 0x2c, 0xe0, 0x54, 0x9c, 0xb2, 0x84, 0xfe,
-	0xa0, 0x42, 0x9f, 0xc8, 0x62, 0x35, 0xef, 0x2d, 0xd6, 0xf6, 0x74, 0xa4, 0x7b, 0x17, 0x88, 0x5e,
-	0x00, 0xfa, 0x2e, 0x25, 0x09, 0x25, 0xdc, 0x8b, 0x49, 0xe2, 0x85, 0x34, 0x4a, 0x05, 0xb1, 0x2d,
-	0xb5, 0x71, 0xed, 0xe9, 0x93, 0xe4, 0x1b, 0x69, 0x6f, 0x7e, 0x0e, 0x95, 0x9c, 0x75, 0x40, 0x04,
-	0x7a, 0x0e, 0xd5, 0xc9, 0x10, 0xa3, 0x01, 0xb7, 0x8d, 0xc6, 0x6c, 0xcb, 0xec, 0xcc, 0x58, 0x86,
-	0x5b, 0xc9, 0xed, 0xdd, 0x80, 0x37, 0xcf, 0x61, 0x29, 0x47, 0xed, 0x5e, 0xc6, 0x54, 0x9d, 0x21,
-	0xc3, 0xdf, 0x6a, 0xc7, 0xb8, 0xa3, 0x9d, 0x2f, 0xa6, 0x78, 0x39, 0x11, 0xb2, 0xd3, 0x2a, 0x9b,
-	0xf6, 0xbd, 0xa7, 0x1b, 0x10, 0x71, 0xfb, 0x6b, 0x03, 0x22, 0x9a, 0x3f, 0x19, 0xf0, 0xe4, 0x98,
-	0x93, 0x64, 0x7a, 0xa3, 0x3b, 0x60, 0xa6, 0xfc, 0xf1, 0xed, 0x2f, 0xc1, 0xff, 0x6f, 0x57, 0x09,
-	0x94, 0x74, 0xff, 0xeb, 0xea, 0x1b, 0x93, 0xea, 0x23, 0x30, 0x23, 0x1c, 0xaa, 0x79, 0x32, 0xef,
-	0xca, 0x6f, 0xd4, 0x80, 0x4a, 0x40, 0x26, 0x33, 0x3b, 0xbf, 0xd4, 0xa6, 0x4c, 0xd9, 0xbd, 0xa7,
-	0xe7, 0x88, 0x27, 0xdb, 0xd5, 0x54, 0x21, 0xda, 0x96, 0x35, 0xe8, 0xda, 0xa7, 0x50, 0xbb, 0x7b,
-	0x6b, 0xa1, 0x0a, 0x94, 0x7a, 0xaf, 0x5e, 0xbd, 0xee, 0x1e, 0xee, 0x5a, 0x05, 0x04, 0x50, 0xec,
-	0x1d, 0xca, 0x6f, 0x63, 0x6d, 0x0b, 0xaa, 0xd3, 0xbd, 0x8d, 0x2c, 0xa8, 0x0e, 0x8e, 0x3b, 0x83,
-	0x1d, 0xb7, 0xdb, 0x3f, 0xea, 0xf6, 0x0e, 0xad, 0x02, 0x5a, 0x84, 0x85, 0xfe, 0xf6, 0x89, 0xb7,
-	0x3d, 0xf0, 0x4e, 0x7a, 0xc7, 0xde, 0x5e, 0xcf, 0x32, 0xd6, 0x36, 0x6e, 0xeb, 0x7a, 0x47, 0x63,
-	0x19, 0xf3, 0xe0, 0xc8, 0xed, 0xee, 0x1c, 0x59, 0x05, 0x54, 0x06, 0xb3, 0xd7, 0xdf, 0x3d, 0xb4,
-	0x8c, 0xce, 0xee, 0x9b, 0xeb, 0xba, 0xf1, 0xf6, 0xba, 0x6e, 0xfc, 0x79, 0x5d, 0x37, 0x7e, 0xbc,
-	0xa9, 0x17, 0xde, 0xde, 0xd4, 0x0b, 0xbf, 0xdf, 0xd4, 0x0b, 0xdf, 0xfe, 0x4b, 0x31, 0x2e, 0xf5,
-	0x3b, 0x3b, 0x27, 0x1f, 0x16, 0xe5, 0x5f, 0x93, 0xad, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0x1c, 0x84, 0x8b, 0x14, 0x09, 0x00, 0x00,
+func (m *Contract) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
 }
 
-func (m *Provider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Contract) GetService() github_com_arkeonetwork_arkeo_common.Service {
+	if m != nil {
+		return m.Service
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Contract) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
+	}
+	return nil
 }
 
-func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x58
+func (m *Contract) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
 	}
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Contract) GetType() ContractType {
+	if m != nil {
+		return m.Type
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeeper(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return ContractType_SUBSCRIPTION
+}
+
+func (m *Contract) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeeper(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return 0
+}
+
+func (m *Contract) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *Contract) GetRate() types.Coin {
+	if m != nil {
+		return m.Rate
 	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x30
+	return types.Coin{}
+}
+
+func (m *Contract) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.Status != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *Contract) GetSettlementHeight() int64 {
+	if m != nil {
+		return m.SettlementHeight
 	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *Contract) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Contract) GetSettlementDuration() int64 {
+	if m != nil {
+		return m.SettlementDuration
 	}
-	if m.Service != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Contract) GetAuthorization() ContractAuthorization {
+	if m != nil {
+		return m.Authorization
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	return ContractAuthorization_STRICT
+}
+
+func (m *Contract) GetQueriesPerMinute() int64 {
+	if m != nil {
+		return m.QueriesPerMinute
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Contract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Contract) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Contract) GetPayAsYouGoTiers() []RateTier {
+	if m != nil {
+		return m.PayAsYouGoTiers
+	}
+	return nil
 }
 
-func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.Authorization != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.Id != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.SettlementHeight != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementHeight))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size := m.Paid.Size()
-		i -= size
-		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.Duration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Height != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Type != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Service != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+func (m *Contract) GetDiscountBps() int64 {
+	if m != nil {
+		return m.DiscountBps
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ContractSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Contract) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Contract) GetDelegateLimit() DelegateLimit {
+	if m != nil {
+		return m.DelegateLimit
+	}
+	return DelegateLimit{}
 }
 
-func (m *ContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractIds) > 0 {
-		dAtA3 := make([]byte, len(m.ContractIds)*10)
-		var j2 int
-		for _, num := range m.ContractIds {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintKeeper(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+func (m *Contract) GetDelegatePeriodStart() int64 {
+	if m != nil {
+		return m.DelegatePeriodStart
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ContractExpirationSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Contract) GetPayer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Payer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContractExpirationSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegateLimit caps the use of a pay-as-you-go contract by its spender.
+type DelegateLimit struct {
+	// highest nonce paid for, 0 for no limit
+	MaxNonce int64 `protobuf:"varint,1,opt,name=max_nonce,json=maxNonce,proto3" json:"max_nonce,omitempty"`
+	// value paid per period, 0 for no limit
+	MaxValue cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_value,json=maxValue,proto3,customtype=cosmossdk.io/math.Int" json:"max_value"`
+	// length of a period in blocks, 0 for the life of the contract
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
 }
 
-func (m *ContractExpirationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSet != nil {
-		{
-			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeeper(dAtA, i, uint64(size))
+func (m *DelegateLimit) Reset()         { *m = DelegateLimit{} }
+func (m *DelegateLimit) String() string { return proto.CompactTextString(m) }
+func (*DelegateLimit) ProtoMessage()    {}
+func (*DelegateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{9}
+}
+func (m *DelegateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DelegateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateLimit.Merge(m, src)
+}
+func (m *DelegateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateLimit proto.InternalMessageInfo
+
+func (m *DelegateLimit) GetMaxNonce() int64 {
+	if m != nil {
+		return m.MaxNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UserContractSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelegateLimit) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UserContractSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ContractGroup is a pay-as-you-go contract of a client with several
+// providers of a service. Each provider has a member contract settled on its
+// own nonces, all of them paid from the deposit of the group.
+type ContractGroup struct {
+	Id               uint64                                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Service          github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
+	Client           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,3,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate         github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,4,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Height           int64                                        `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Duration         int64                                        `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Denom            string                                       `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	Deposit          cosmossdk_io_math.Int                        `protobuf:"bytes,8,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	Paid             cosmossdk_io_math.Int                        `protobuf:"bytes,9,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	ContractIds      []uint64                                     `protobuf:"varint,10,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	SettlementHeight int64                                        `protobuf:"varint,11,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
 }
 
-func (m *UserContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSet != nil {
-		{
-			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeeper(dAtA, i, uint64(size))
+func (m *ContractGroup) Reset()         { *m = ContractGroup{} }
+func (m *ContractGroup) String() string { return proto.CompactTextString(m) }
+func (*ContractGroup) ProtoMessage()    {}
+func (*ContractGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{10}
+}
+func (m *ContractGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ContractGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGroup.Merge(m, src)
+}
+func (m *ContractGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGroup proto.InternalMessageInfo
+
+func (m *ContractGroup) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractGroup) GetService() github_com_arkeonetwork_arkeo_common.Service {
+	if m != nil {
+		return m.Service
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceType) > 0 {
-		i -= len(m.ServiceType)
-		copy(dAtA[i:], m.ServiceType)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.ServiceType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *ContractGroup) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintKeeper(dAtA []byte, offset int, v uint64) int {
-	offset -= sovKeeper(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractGroup) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Provider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.Service != 0 {
-		n += 1 + sovKeeper(uint64(m.Service))
-	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovKeeper(uint64(m.MetadataNonce))
-	}
-	if m.Status != 0 {
-		n += 1 + sovKeeper(uint64(m.Status))
-	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.MinContractDuration))
-	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.MaxContractDuration))
-	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovKeeper(uint64(l))
-		}
-	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovKeeper(uint64(l))
-		}
-	}
-	l = m.Bond.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	if m.LastUpdate != 0 {
-		n += 1 + sovKeeper(uint64(m.LastUpdate))
-	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+
+func (m *ContractGroup) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *Contract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.Service != 0 {
-		n += 1 + sovKeeper(uint64(m.Service))
-	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovKeeper(uint64(m.Type))
-	}
-	if m.Height != 0 {
-		n += 1 + sovKeeper(uint64(m.Height))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovKeeper(uint64(m.Duration))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	l = m.Deposit.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	if m.Nonce != 0 {
-		n += 1 + sovKeeper(uint64(m.Nonce))
-	}
-	if m.SettlementHeight != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementHeight))
-	}
-	if m.Id != 0 {
-		n += 1 + sovKeeper(uint64(m.Id))
-	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementDuration))
-	}
-	if m.Authorization != 0 {
-		n += 1 + sovKeeper(uint64(m.Authorization))
-	}
-	if m.QueriesPerMinute != 0 {
-		n += 2 + sovKeeper(uint64(m.QueriesPerMinute))
+func (m *ContractGroup) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return n
+	return 0
 }
 
-func (m *ContractSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractIds) > 0 {
-		l = 0
-		for _, e := range m.ContractIds {
-			l += sovKeeper(uint64(e))
-		}
-		n += 1 + sovKeeper(uint64(l)) + l
+func (m *ContractGroup) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *ContractExpirationSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovKeeper(uint64(m.Height))
-	}
-	if m.ContractSet != nil {
-		l = m.ContractSet.Size()
-		n += 1 + l + sovKeeper(uint64(l))
+func (m *ContractGroup) GetContractIds() []uint64 {
+	if m != nil {
+		return m.ContractIds
 	}
-	return n
+	return nil
 }
 
-func (m *UserContractSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.ContractSet != nil {
-		l = m.ContractSet.Size()
-		n += 1 + l + sovKeeper(uint64(l))
+func (m *ContractGroup) GetSettlementHeight() int64 {
+	if m != nil {
+		return m.SettlementHeight
 	}
-	return n
+	return 0
 }
 
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovKeeper(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	l = len(m.ServiceType)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	return n
+// ContractSet defines a set of contracts.
+type ContractSet struct {
+	ContractIds []uint64 `protobuf:"varint,1,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
 }
 
-func sovKeeper(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ContractSet) Reset()         { *m = ContractSet{} }
+func (m *ContractSet) String() string { return proto.CompactTextString(m) }
+func (*ContractSet) ProtoMessage()    {}
+func (*ContractSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{11}
 }
-func sozKeeper(x uint64) (n int) {
-	return sovKeeper(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ContractSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeeper
+func (m *ContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSet.Merge(m, src)
+}
+func (m *ContractSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSet proto.InternalMessageInfo
+
+func (m *ContractSet) GetContractIds() []uint64 {
+	if m != nil {
+		return m.ContractIds
+	}
+	return nil
+}
+
+// ContractExpirationSet defines a set of contract expirations.
+type ContractExpirationSet struct {
+	Height      int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+}
+
+func (m *ContractExpirationSet) Reset()         { *m = ContractExpirationSet{} }
+func (m *ContractExpirationSet) String() string { return proto.CompactTextString(m) }
+func (*ContractExpirationSet) ProtoMessage()    {}
+func (*ContractExpirationSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{12}
+}
+func (m *ContractExpirationSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExpirationSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExpirationSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExpirationSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExpirationSet.Merge(m, src)
+}
+func (m *ContractExpirationSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExpirationSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExpirationSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExpirationSet proto.InternalMessageInfo
+
+func (m *ContractExpirationSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractExpirationSet) GetContractSet() *ContractSet {
+	if m != nil {
+		return m.ContractSet
+	}
+	return nil
+}
+
+// UserContractSet defines a set of contracts belonging to a user.
+type UserContractSet struct {
+	User        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=user,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"user,omitempty"`
+	ContractSet *ContractSet                                `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+}
+
+func (m *UserContractSet) Reset()         { *m = UserContractSet{} }
+func (m *UserContractSet) String() string { return proto.CompactTextString(m) }
+func (*UserContractSet) ProtoMessage()    {}
+func (*UserContractSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{13}
+}
+func (m *UserContractSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserContractSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserContractSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserContractSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserContractSet.Merge(m, src)
+}
+func (m *UserContractSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserContractSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserContractSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserContractSet proto.InternalMessageInfo
+
+func (m *UserContractSet) GetUser() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *UserContractSet) GetContractSet() *ContractSet {
+	if m != nil {
+		return m.ContractSet
+	}
+	return nil
+}
+
+// Service captures the metadata for a dynamically-registered provider service.
+type Service struct {
+	// Globally-unique numeric identifier for this service.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Canonical, lowercase name (e.g., "arkeo-mainnet-rest").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional human-friendly description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional type/classification (e.g., "rpc", "rest", "indexer").
+	ServiceType string `protobuf:"bytes,4,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+}
+
+func (m *Service) Reset()         { *m = Service{} }
+func (m *Service) String() string { return proto.CompactTextString(m) }
+func (*Service) ProtoMessage()    {}
+func (*Service) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{14}
+}
+func (m *Service) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Service.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Service) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Service.Merge(m, src)
+}
+func (m *Service) XXX_Size() int {
+	return m.Size()
+}
+func (m *Service) XXX_DiscardUnknown() {
+	xxx_messageInfo_Service.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Service proto.InternalMessageInfo
+
+func (m *Service) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Service) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Service) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Service) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+// ConfigOverride is a config value set on chain by the authority, it takes
+// precedence over the value compiled in the binary.
+type ConfigOverride struct {
+	// config name, ie "ReserveTax"
+	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        ConfigType `protobuf:"varint,2,opt,name=type,proto3,enum=arkeo.arkeo.ConfigType" json:"type,omitempty"`
+	Int64Value  int64      `protobuf:"varint,3,opt,name=int64_value,json=int64Value,proto3" json:"int64_value,omitempty"`
+	BoolValue   bool       `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	StringValue string     `protobuf:"bytes,5,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+}
+
+func (m *ConfigOverride) Reset()         { *m = ConfigOverride{} }
+func (m *ConfigOverride) String() string { return proto.CompactTextString(m) }
+func (*ConfigOverride) ProtoMessage()    {}
+func (*ConfigOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{15}
+}
+func (m *ConfigOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigOverride.Merge(m, src)
+}
+func (m *ConfigOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigOverride proto.InternalMessageInfo
+
+func (m *ConfigOverride) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConfigOverride) GetType() ConfigType {
+	if m != nil {
+		return m.Type
+	}
+	return ConfigType_INT64
+}
+
+func (m *ConfigOverride) GetInt64Value() int64 {
+	if m != nil {
+		return m.Int64Value
+	}
+	return 0
+}
+
+func (m *ConfigOverride) GetBoolValue() bool {
+	if m != nil {
+		return m.BoolValue
+	}
+	return false
+}
+
+func (m *ConfigOverride) GetStringValue() string {
+	if m != nil {
+		return m.StringValue
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("arkeo.arkeo.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
+	proto.RegisterEnum("arkeo.arkeo.ContractType", ContractType_name, ContractType_value)
+	proto.RegisterEnum("arkeo.arkeo.ContractAuthorization", ContractAuthorization_name, ContractAuthorization_value)
+	proto.RegisterEnum("arkeo.arkeo.ConfigType", ConfigType_name, ConfigType_value)
+	proto.RegisterType((*Provider)(nil), "arkeo.arkeo.Provider")
+	proto.RegisterType((*ProviderReputation)(nil), "arkeo.arkeo.ProviderReputation")
+	proto.RegisterType((*RateTier)(nil), "arkeo.arkeo.RateTier")
+	proto.RegisterType((*DurationDiscount)(nil), "arkeo.arkeo.DurationDiscount")
+	proto.RegisterType((*RateCard)(nil), "arkeo.arkeo.RateCard")
+	proto.RegisterType((*ProviderUnbonding)(nil), "arkeo.arkeo.ProviderUnbonding")
+	proto.RegisterType((*ProviderUnbondingSet)(nil), "arkeo.arkeo.ProviderUnbondingSet")
+	proto.RegisterType((*EvidenceRecord)(nil), "arkeo.arkeo.EvidenceRecord")
+	proto.RegisterType((*Contract)(nil), "arkeo.arkeo.Contract")
+	proto.RegisterType((*DelegateLimit)(nil), "arkeo.arkeo.DelegateLimit")
+	proto.RegisterType((*ContractGroup)(nil), "arkeo.arkeo.ContractGroup")
+	proto.RegisterType((*ContractSet)(nil), "arkeo.arkeo.ContractSet")
+	proto.RegisterType((*ContractExpirationSet)(nil), "arkeo.arkeo.ContractExpirationSet")
+	proto.RegisterType((*UserContractSet)(nil), "arkeo.arkeo.UserContractSet")
+	proto.RegisterType((*Service)(nil), "arkeo.arkeo.Service")
+	proto.RegisterType((*ConfigOverride)(nil), "arkeo.arkeo.ConfigOverride")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/keeper.proto", fileDescriptor_f833050061122841) }
+
+var fileDescriptor_f833050061122841 = []byte{
+	// 1717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0x2b, 0xd1, 0xd4, 0x58, 0x4a, 0x56, 0xce, 0xdf, 0x12, 0x43,
+	0x20, 0x00, 0xff, 0x76, 0x44, 0xd6, 0xb2, 0x11, 0x14, 0xe8, 0xa1, 0x10, 0x69, 0x45, 0x66, 0xec,
+	0x8a, 0xc2, 0x52, 0x2a, 0xea, 0x02, 0xc5, 0x62, 0xb9, 0x3b, 0xa1, 0xa6, 0x22, 0x77, 0xb6, 0x33,
+	0xb3, 0xb2, 0xd4, 0x0f, 0x51, 0x14, 0xe8, 0x87, 0xe8, 0x17, 0xc8, 0x87, 0xc8, 0x31, 0xe8, 0xa9,
+	0xed, 0x41, 0x28, 0xec, 0x63, 0x4f, 0x3d, 0xf4, 0xe2, 0x53, 0x31, 0x2f, 0x4b, 0x2e, 0x25, 0xba,
+	0xb1, 0xd9, 0x1c, 0x8a, 0x5e, 0x48, 0xce, 0xf3, 0xf2, 0xdb, 0x67, 0x9e, 0xf7, 0x25, 0x38, 0x3e,
+	0x3b, 0xc7, 0xb4, 0xa5, 0x3f, 0xcf, 0x31, 0x8e, 0x31, 0x6b, 0xc6, 0x8c, 0x0a, 0x8a, 0x6c, 0x45,
+	0x6b, 0xaa, 0xcf, 0x7b, 0x1b, 0x43, 0x3a, 0xa4, 0x8a, 0xde, 0x92, 0xbf, 0xb4, 0xc8, 0xbd, 0xad,
+	0x80, 0xf2, 0x31, 0xe5, 0x9e, 0x66, 0xe8, 0x83, 0x61, 0x6d, 0xeb, 0x53, 0x6b, 0xe0, 0x73, 0xdc,
+	0xba, 0x78, 0x34, 0xc0, 0xc2, 0x7f, 0xd4, 0x0a, 0x28, 0x89, 0x34, 0xbf, 0xfe, 0x97, 0x22, 0x94,
+	0x8e, 0x19, 0xbd, 0x20, 0x21, 0x66, 0xe8, 0x19, 0xac, 0xc4, 0xc9, 0xc0, 0x3b, 0xc7, 0x57, 0x8e,
+	0x55, 0xb3, 0x1a, 0xab, 0xed, 0xd6, 0xdb, 0xeb, 0x9d, 0x87, 0x43, 0x22, 0xce, 0x92, 0x41, 0x33,
+	0xa0, 0x63, 0x6d, 0x5e, 0x84, 0xc5, 0x2b, 0xca, 0xce, 0x8d, 0xad, 0x01, 0x1d, 0x8f, 0x69, 0xd4,
+	0x3c, 0x4e, 0x06, 0xcf, 0xf1, 0x95, 0x5b, 0x8c, 0xd5, 0x37, 0xfa, 0x0a, 0x56, 0x38, 0x66, 0x17,
+	0x24, 0xc0, 0x4e, 0xae, 0x66, 0x35, 0x96, 0xdb, 0x3f, 0x7a, 0x7b, 0xbd, 0xf3, 0xf9, 0x7b, 0x21,
+	0xf5, 0xb5, 0x9e, 0x9b, 0x02, 0xa0, 0x4f, 0x61, 0x75, 0x8c, 0x85, 0x1f, 0xfa, 0xc2, 0xf7, 0x12,
+	0x46, 0x9c, 0x7c, 0xcd, 0x6a, 0x94, 0x5d, 0x3b, 0xa5, 0x9d, 0x32, 0x82, 0x3e, 0x83, 0xca, 0x44,
+	0x24, 0xa2, 0x51, 0x80, 0x9d, 0x42, 0xcd, 0x6a, 0x14, 0xdc, 0xb5, 0x94, 0x7a, 0x24, 0x89, 0xe8,
+	0x31, 0x14, 0xb9, 0xf0, 0x45, 0xc2, 0x9d, 0xe5, 0x9a, 0xd5, 0xa8, 0xec, 0x7d, 0xd2, 0xcc, 0xf8,
+	0xb6, 0x99, 0xba, 0xa1, 0xaf, 0x44, 0x5c, 0x23, 0x8a, 0xf6, 0x60, 0x73, 0x4c, 0x22, 0x2f, 0xa0,
+	0x91, 0x60, 0x7e, 0x20, 0xbc, 0x30, 0x61, 0xbe, 0x20, 0x34, 0x72, 0x8a, 0x35, 0xab, 0x91, 0x77,
+	0xef, 0x8e, 0x49, 0xd4, 0x31, 0xbc, 0xa7, 0x86, 0xa5, 0x74, 0xfc, 0xcb, 0x39, 0x3a, 0x2b, 0x46,
+	0xc7, 0xbf, 0xbc, 0xa5, 0xf3, 0x02, 0xd6, 0x79, 0x32, 0xe0, 0x01, 0x23, 0xb1, 0x3c, 0x7b, 0xcc,
+	0x17, 0xd8, 0x29, 0xd5, 0xf2, 0x0d, 0x7b, 0x6f, 0xab, 0x69, 0x62, 0x2a, 0xa3, 0xd8, 0x34, 0x51,
+	0x6c, 0x76, 0x28, 0x89, 0xda, 0x85, 0x6f, 0xaf, 0x77, 0x96, 0xdc, 0x6a, 0x56, 0xd3, 0xf5, 0x05,
+	0x46, 0xcf, 0x01, 0xc5, 0xfe, 0x95, 0xe7, 0x73, 0xef, 0x8a, 0x26, 0xde, 0x90, 0x6a, 0xb8, 0xf2,
+	0xfb, 0xc1, 0x55, 0x62, 0xff, 0x6a, 0x9f, 0xbf, 0xa4, 0xc9, 0x21, 0x55, 0x60, 0x3f, 0x85, 0xc2,
+	0x80, 0x46, 0xa1, 0x03, 0xd2, 0xf3, 0xed, 0x87, 0x52, 0xe6, 0xaf, 0xd7, 0x3b, 0x9b, 0x1a, 0x85,
+	0x87, 0xe7, 0x4d, 0x42, 0x5b, 0x63, 0x5f, 0x9c, 0x35, 0xbb, 0x91, 0xf8, 0xd3, 0x37, 0xbb, 0x60,
+	0xe0, 0xbb, 0x91, 0x70, 0x95, 0x22, 0xda, 0x01, 0x7b, 0xe4, 0x73, 0xe1, 0x25, 0x71, 0x28, 0xcd,
+	0xb0, 0x95, 0x17, 0x40, 0x92, 0x4e, 0x15, 0x05, 0xb5, 0xe0, 0x2e, 0xc7, 0x42, 0x8c, 0xf0, 0x18,
+	0x47, 0x19, 0x77, 0xad, 0x2a, 0x41, 0x34, 0x65, 0x4d, 0xbc, 0xf5, 0x29, 0xac, 0xfe, 0xda, 0x27,
+	0x23, 0x1c, 0x7a, 0x49, 0x24, 0xc8, 0xc8, 0x59, 0x53, 0x92, 0xb6, 0xa6, 0x9d, 0x4a, 0x12, 0xfa,
+	0x31, 0x94, 0xe5, 0xa5, 0xbd, 0xc0, 0x67, 0xa1, 0x53, 0xa9, 0x59, 0x0d, 0x7b, 0x6f, 0x73, 0x26,
+	0xe0, 0xf2, 0x6e, 0x1d, 0x9f, 0x85, 0xe6, 0xd6, 0x25, 0x66, 0xce, 0xe8, 0x00, 0x80, 0xe1, 0x38,
+	0x11, 0xda, 0x88, 0x3b, 0x4a, 0x75, 0x67, 0x6e, 0xae, 0xb8, 0x13, 0x31, 0x03, 0x92, 0x51, 0xac,
+	0x7f, 0x63, 0x01, 0xba, 0x2d, 0x88, 0x36, 0x60, 0x99, 0x07, 0x94, 0x61, 0x55, 0x63, 0x79, 0x57,
+	0x1f, 0x50, 0x07, 0x8a, 0xaf, 0x30, 0x19, 0x9e, 0x09, 0x27, 0xf7, 0xe1, 0x5e, 0x36, 0xaa, 0x37,
+	0xfd, 0x9c, 0xbf, 0xe5, 0xe7, 0x3a, 0xac, 0xfa, 0x42, 0x60, 0xae, 0x4d, 0xe1, 0xa6, 0x4c, 0x66,
+	0x68, 0xf5, 0x5f, 0x41, 0x49, 0x7a, 0xe6, 0x84, 0x60, 0x86, 0xfe, 0x0f, 0xca, 0xe2, 0x8c, 0x61,
+	0x7e, 0x46, 0x47, 0xa1, 0xb1, 0x77, 0x4a, 0x40, 0x8f, 0xa1, 0xa0, 0xd2, 0x2a, 0xf7, 0x7e, 0x69,
+	0xa5, 0x84, 0xeb, 0xbf, 0x80, 0x6a, 0x1a, 0xc5, 0xa7, 0x84, 0x07, 0x34, 0x89, 0x84, 0x2a, 0x71,
+	0x12, 0x4d, 0xe3, 0xae, 0x9f, 0x64, 0x8f, 0x49, 0x94, 0x0d, 0x78, 0x68, 0xc4, 0xbd, 0x41, 0xcc,
+	0x95, 0x97, 0xf2, 0xae, 0x9d, 0xd2, 0xda, 0x31, 0xaf, 0xff, 0xd3, 0xd2, 0x96, 0xab, 0x18, 0x76,
+	0xe1, 0xee, 0x6c, 0x01, 0x08, 0x82, 0x19, 0x77, 0xac, 0x5a, 0x7e, 0x6e, 0x1e, 0xc8, 0xdb, 0x1a,
+	0x33, 0xef, 0x4c, 0xb3, 0x5f, 0x52, 0x39, 0xfa, 0x0a, 0xd0, 0x4c, 0x65, 0x6a, 0xa4, 0xdc, 0xf7,
+	0x23, 0xcd, 0x14, 0xb4, 0xc6, 0x72, 0x01, 0xa5, 0xb7, 0xf4, 0x52, 0xdb, 0xb9, 0x93, 0x57, 0x58,
+	0xf7, 0x67, 0xb0, 0x6e, 0x3a, 0x29, 0xc5, 0x0c, 0x6f, 0xd0, 0x79, 0xfd, 0x1f, 0x16, 0xac, 0xa7,
+	0x79, 0x76, 0x1a, 0xc9, 0x82, 0x23, 0xd1, 0x10, 0x3d, 0x87, 0x52, 0x6c, 0x88, 0x8b, 0x76, 0xf3,
+	0x09, 0xc0, 0x0f, 0xda, 0xcf, 0x3b, 0x50, 0xf4, 0xc7, 0xd2, 0x72, 0x27, 0xbf, 0x40, 0xa6, 0x6b,
+	0xd5, 0xba, 0x80, 0x8d, 0x5b, 0x57, 0xee, 0x63, 0x81, 0x3e, 0x82, 0xe2, 0x99, 0x2e, 0x23, 0x9d,
+	0x43, 0xe6, 0x84, 0x9e, 0x02, 0x24, 0xa9, 0x5c, 0x1a, 0xbb, 0xed, 0xb9, 0x25, 0x3d, 0x81, 0x4b,
+	0x2b, 0x7a, 0xaa, 0x57, 0x3f, 0x84, 0xca, 0x81, 0x14, 0x8a, 0x02, 0xec, 0xe2, 0x80, 0x32, 0xd5,
+	0xd9, 0x26, 0x5d, 0x9e, 0xe8, 0x12, 0x29, 0xb8, 0x90, 0x92, 0xba, 0xa1, 0xac, 0x76, 0x3d, 0x91,
+	0x74, 0xc2, 0xea, 0x43, 0xfd, 0xef, 0x00, 0xa5, 0x74, 0x02, 0xfc, 0xf7, 0x46, 0xea, 0x10, 0x8a,
+	0xc1, 0x88, 0x60, 0x13, 0xa9, 0x45, 0xd6, 0x01, 0xad, 0x2e, 0x6f, 0x18, 0xe2, 0x11, 0x1e, 0xca,
+	0x66, 0x51, 0x58, 0xf0, 0x86, 0x29, 0x00, 0xda, 0x85, 0x82, 0xb8, 0x8a, 0xb1, 0x99, 0xe1, 0x5b,
+	0x33, 0x41, 0x4c, 0x7d, 0x7a, 0x72, 0x15, 0x63, 0x57, 0x89, 0x65, 0x32, 0xa2, 0x38, 0x93, 0x11,
+	0xf7, 0xa0, 0x74, 0x63, 0x2c, 0x4f, 0xce, 0x93, 0xc6, 0x56, 0xaa, 0x59, 0xef, 0xdd, 0xd8, 0xd0,
+	0x01, 0xac, 0x84, 0x38, 0xa6, 0x9c, 0x08, 0xa7, 0xfc, 0xe1, 0x89, 0x9d, 0xea, 0xca, 0x61, 0x1b,
+	0xfb, 0x64, 0xb1, 0x61, 0x2b, 0x15, 0xa7, 0x19, 0x67, 0x67, 0x32, 0x0e, 0x3d, 0x84, 0xf5, 0xcc,
+	0x84, 0x35, 0x1e, 0xd1, 0xf3, 0xb5, 0x3a, 0x65, 0x3c, 0xd3, 0xbe, 0xa9, 0x40, 0x8e, 0x84, 0x6a,
+	0xa6, 0x16, 0xdc, 0x1c, 0x09, 0xdf, 0x35, 0x9e, 0x2b, 0xef, 0x1c, 0xcf, 0xcf, 0x60, 0xcd, 0x4f,
+	0xc4, 0x19, 0x65, 0xe4, 0xb7, 0xd3, 0x21, 0x5a, 0xd9, 0xab, 0xcf, 0x0d, 0xd6, 0x7e, 0x56, 0xd2,
+	0x9d, 0x55, 0x44, 0x9f, 0x03, 0xfa, 0x4d, 0x82, 0x19, 0xc1, 0xdc, 0x8b, 0x31, 0xf3, 0xc6, 0x24,
+	0x4a, 0x04, 0x76, 0xaa, 0xda, 0x70, 0xc3, 0x39, 0xc6, 0xec, 0x67, 0x8a, 0x8e, 0xee, 0x03, 0xf8,
+	0x89, 0xa0, 0x1e, 0xc3, 0x11, 0x7e, 0xe5, 0xac, 0xd7, 0xac, 0x46, 0xc9, 0x2d, 0x4b, 0x8a, 0x2b,
+	0x09, 0xc8, 0x85, 0x8a, 0xe2, 0xf8, 0x23, 0x0f, 0xf3, 0x80, 0xd1, 0x57, 0x0e, 0xfa, 0x70, 0x2f,
+	0xaf, 0x19, 0x88, 0x03, 0x85, 0xf0, 0xae, 0x41, 0x73, 0x77, 0x81, 0x41, 0x73, 0x73, 0xc6, 0x6d,
+	0xdc, 0x9a, 0x71, 0x68, 0x0b, 0x4a, 0x43, 0x46, 0x93, 0x58, 0x36, 0x9b, 0x4d, 0x15, 0x9f, 0x15,
+	0x75, 0xee, 0x86, 0xe8, 0x10, 0x2a, 0x69, 0x8d, 0x78, 0x23, 0x32, 0x26, 0xc2, 0xf9, 0x48, 0xa5,
+	0xef, 0xbd, 0xd9, 0xb1, 0x62, 0x44, 0x5e, 0x48, 0x09, 0x63, 0xc8, 0x5a, 0x98, 0x25, 0x4a, 0x2f,
+	0x4d, 0x80, 0x78, 0x2c, 0xcb, 0xff, 0xe3, 0x05, 0xbc, 0x94, 0x42, 0xf4, 0x25, 0x82, 0xdc, 0x88,
+	0x27, 0x98, 0x31, 0x66, 0x84, 0x86, 0x1e, 0x17, 0x3e, 0x13, 0x8e, 0xa3, 0x37, 0xe2, 0x94, 0x79,
+	0xac, 0x78, 0x7d, 0xc9, 0x42, 0x87, 0xb0, 0x1c, 0xfb, 0x57, 0x98, 0x39, 0x5b, 0xaa, 0x65, 0x3c,
+	0x7a, 0x7b, 0xbd, 0xb3, 0x9b, 0x69, 0x19, 0xe6, 0xcd, 0x46, 0x7f, 0xed, 0xf2, 0xf0, 0xbc, 0x25,
+	0xeb, 0x9d, 0x37, 0xf7, 0x83, 0x60, 0x3f, 0x0c, 0x19, 0xe6, 0xdc, 0xd5, 0xfa, 0xf5, 0xdf, 0x59,
+	0xb0, 0x36, 0x73, 0x6f, 0xf4, 0x09, 0x94, 0xe5, 0x82, 0xae, 0xeb, 0x44, 0x4f, 0x8a, 0xd2, 0xd8,
+	0xbf, 0xd4, 0xaf, 0x09, 0xcf, 0x34, 0xf3, 0xc2, 0x1f, 0x25, 0x78, 0x91, 0x6d, 0x4c, 0x22, 0xfd,
+	0x5c, 0x2a, 0xcb, 0xde, 0xa3, 0x2f, 0x6b, 0x56, 0x31, 0x73, 0xaa, 0xff, 0xb1, 0x00, 0x6b, 0x69,
+	0xf6, 0x1f, 0xca, 0xf0, 0x99, 0x8a, 0xb3, 0x26, 0x15, 0xf7, 0xbf, 0xdf, 0xc6, 0xa7, 0x7d, 0x79,
+	0xf9, 0x9d, 0x7d, 0xb9, 0x78, 0xa3, 0x2f, 0x6f, 0xc0, 0x72, 0x88, 0x23, 0x3a, 0x56, 0x0d, 0xbb,
+	0xec, 0xea, 0x43, 0xb6, 0xf1, 0x96, 0x7e, 0x80, 0xc6, 0x5b, 0x5e, 0xb4, 0xf1, 0x7e, 0x06, 0xab,
+	0x99, 0x5d, 0x80, 0x3b, 0x50, 0xcb, 0x37, 0x0a, 0xed, 0x5c, 0xd5, 0x72, 0xed, 0xe9, 0x42, 0xc0,
+	0xe7, 0x77, 0x62, 0x7b, 0x7e, 0x27, 0xae, 0x3f, 0x01, 0x3b, 0x4d, 0x14, 0xb9, 0xde, 0xdc, 0x7c,
+	0x84, 0x35, 0xf7, 0x11, 0xf5, 0x11, 0x6c, 0xa6, 0x5a, 0x07, 0x97, 0x31, 0xd1, 0xde, 0xfb, 0x77,
+	0xeb, 0xd1, 0x4f, 0x32, 0xb8, 0x1c, 0xeb, 0x77, 0x10, 0x7b, 0xcf, 0x99, 0xdb, 0xae, 0xfb, 0x58,
+	0x4c, 0x9f, 0xd6, 0xc7, 0xa2, 0xfe, 0x07, 0x0b, 0xee, 0x9c, 0x72, 0xcc, 0xb2, 0x86, 0x76, 0xa0,
+	0x90, 0xf0, 0xc5, 0xf7, 0x19, 0xa5, 0xfc, 0x9f, 0x59, 0xc5, 0x60, 0xc5, 0x54, 0xc2, 0xad, 0xe2,
+	0x42, 0x50, 0x88, 0xfc, 0xb1, 0xa9, 0x6d, 0x57, 0xfd, 0x46, 0x35, 0xb0, 0x43, 0x3c, 0x59, 0xd6,
+	0xd3, 0x3f, 0x19, 0x32, 0x24, 0xd9, 0x9d, 0x4d, 0x45, 0x79, 0x6a, 0xff, 0x28, 0x68, 0x11, 0x43,
+	0x93, 0x1b, 0x87, 0x7c, 0xe3, 0xab, 0x74, 0x68, 0xf4, 0x35, 0x19, 0xf6, 0x2e, 0x30, 0x63, 0x24,
+	0xc4, 0x93, 0x67, 0x59, 0x99, 0x67, 0x3d, 0x34, 0x1b, 0x4c, 0x4e, 0x0d, 0xc5, 0x8f, 0x6f, 0xde,
+	0xe7, 0x6b, 0x32, 0xcc, 0xec, 0x2f, 0x3b, 0x60, 0x93, 0x48, 0x7c, 0xf1, 0xc4, 0xf4, 0x23, 0xf3,
+	0x4e, 0xa7, 0x48, 0xba, 0xc9, 0xdc, 0x07, 0x18, 0x50, 0x3a, 0x32, 0xfc, 0x82, 0x9e, 0x79, 0x92,
+	0xa2, 0xd9, 0xd2, 0x6c, 0xc1, 0x48, 0x34, 0x34, 0x02, 0xcb, 0xc6, 0x6c, 0x45, 0x53, 0x22, 0x0f,
+	0xfe, 0x1f, 0x2a, 0xb3, 0x7f, 0x7e, 0x20, 0x1b, 0x56, 0x7a, 0x5f, 0x7e, 0xf9, 0xa2, 0x7b, 0x74,
+	0x50, 0x5d, 0x42, 0x00, 0xc5, 0xde, 0x91, 0xfa, 0x6d, 0x3d, 0x78, 0x0c, 0xab, 0xd9, 0x1d, 0x0b,
+	0x55, 0x61, 0xb5, 0x7f, 0xda, 0xee, 0x77, 0xdc, 0xee, 0xf1, 0x49, 0xb7, 0x77, 0x54, 0x5d, 0x42,
+	0xeb, 0xb0, 0x76, 0xbc, 0xff, 0xd2, 0xdb, 0xef, 0x7b, 0x2f, 0x7b, 0xa7, 0xde, 0x61, 0xaf, 0x6a,
+	0x3d, 0xd8, 0x85, 0xcd, 0xb9, 0xb3, 0x5e, 0x22, 0xf7, 0x4f, 0xdc, 0x6e, 0xe7, 0xa4, 0xba, 0x84,
+	0x4a, 0x50, 0xe8, 0x1d, 0x1f, 0x1c, 0x29, 0x71, 0x98, 0x7a, 0x01, 0x95, 0x61, 0xb9, 0x7b, 0x74,
+	0xf2, 0xc5, 0x13, 0x2d, 0xd2, 0xee, 0xf5, 0x5e, 0x54, 0xad, 0x54, 0xf1, 0xe8, 0xb0, 0x9a, 0x6b,
+	0x1f, 0x7c, 0xfb, 0x7a, 0xdb, 0xfa, 0xee, 0xf5, 0xb6, 0xf5, 0xb7, 0xd7, 0xdb, 0xd6, 0xef, 0xdf,
+	0x6c, 0x2f, 0x7d, 0xf7, 0x66, 0x7b, 0xe9, 0xcf, 0x6f, 0xb6, 0x97, 0x7e, 0xf9, 0x3d, 0x29, 0x77,
+	0x69, 0xbe, 0xd5, 0xd8, 0x18, 0x14, 0xd5, 0x1f, 0x62, 0x8f, 0xff, 0x35, 0x00, 0xdd, 0x7c, 0x5a,
+	0x04, 0x8a, 0x13, 0x00, 0x00,
+}
+
+func (m *Provider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.RateCard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.JailedUntil != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastUpdate != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestations != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Attestations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpdate != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Score != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiscountBps != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.DiscountBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MinDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateCard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateCard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateCard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DurationDiscounts) > 0 {
+		for iNdEx := len(m.DurationDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DurationDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SubscriptionTiers) > 0 {
+		for iNdEx := len(m.SubscriptionTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PayAsYouGoTiers) > 0 {
+		for iNdEx := len(m.PayAsYouGoTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderUnbondingSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderUnbondingSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderUnbondingSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Contract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.DelegatePeriodStart != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.DelegatePeriodStart))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.DelegateSpent.Size()
+		i -= size
+		if _, err := m.DelegateSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.GroupId != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DiscountBps != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.DiscountBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PayAsYouGoTiers) > 0 {
+		for iNdEx := len(m.PayAsYouGoTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size := m.RenewalEscrow.Size()
+		i -= size
+		if _, err := m.RenewalEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Id != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementHeight != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Duration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxValue.Size()
+		i -= size
+		if _, err := m.MaxValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxNonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementHeight != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ContractIds) > 0 {
+		dAtA6 := make([]byte, len(m.ContractIds)*10)
+		var j5 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintKeeper(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractIds) > 0 {
+		dAtA8 := make([]byte, len(m.ContractIds)*10)
+		var j7 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintKeeper(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractExpirationSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExpirationSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExpirationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSet != nil {
+		{
+			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeeper(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserContractSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserContractSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSet != nil {
+		{
+			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeeper(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StringValue) > 0 {
+		i -= len(m.StringValue)
+		copy(dAtA[i:], m.StringValue)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.StringValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BoolValue {
+		i--
+		if m.BoolValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Int64Value != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Int64Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeeper(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeeper(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Provider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovKeeper(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeeper(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.LastUpdate != 0 {
+		n += 1 + sovKeeper(uint64(m.LastUpdate))
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovKeeper(uint64(m.JailedUntil))
+	}
+	l = m.RateCard.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Reputation.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	return n
+}
+
+func (m *ProviderReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Score != 0 {
+		n += 1 + sovKeeper(uint64(m.Score))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.LastUpdate != 0 {
+		n += 1 + sovKeeper(uint64(m.LastUpdate))
+	}
+	if m.Attestations != 0 {
+		n += 1 + sovKeeper(uint64(m.Attestations))
+	}
+	return n
+}
+
+func (m *RateTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovKeeper(uint64(m.Threshold))
+	}
+	if len(m.Rate) > 0 {
+		for _, e := range m.Rate {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DurationDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.MinDuration))
+	}
+	if m.DiscountBps != 0 {
+		n += 1 + sovKeeper(uint64(m.DiscountBps))
+	}
+	return n
+}
+
+func (m *RateCard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PayAsYouGoTiers) > 0 {
+		for _, e := range m.PayAsYouGoTiers {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if len(m.SubscriptionTiers) > 0 {
+		for _, e := range m.SubscriptionTiers {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if len(m.DurationDiscounts) > 0 {
+		for _, e := range m.DurationDiscounts {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	return n
+}
+
+func (m *ProviderUnbondingSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvidenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovKeeper(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovKeeper(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeeper(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovKeeper(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovKeeper(uint64(m.Nonce))
+	}
+	if m.SettlementHeight != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementHeight))
+	}
+	if m.Id != 0 {
+		n += 1 + sovKeeper(uint64(m.Id))
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovKeeper(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 2 + sovKeeper(uint64(m.QueriesPerMinute))
+	}
+	if m.AutoRenew {
+		n += 3
+	}
+	l = m.RenewalEscrow.Size()
+	n += 2 + l + sovKeeper(uint64(l))
+	if len(m.PayAsYouGoTiers) > 0 {
+		for _, e := range m.PayAsYouGoTiers {
+			l = e.Size()
+			n += 2 + l + sovKeeper(uint64(l))
+		}
+	}
+	if m.DiscountBps != 0 {
+		n += 2 + sovKeeper(uint64(m.DiscountBps))
+	}
+	if m.GroupId != 0 {
+		n += 2 + sovKeeper(uint64(m.GroupId))
+	}
+	l = m.DelegateLimit.Size()
+	n += 2 + l + sovKeeper(uint64(l))
+	l = m.DelegateSpent.Size()
+	n += 2 + l + sovKeeper(uint64(l))
+	if m.DelegatePeriodStart != 0 {
+		n += 2 + sovKeeper(uint64(m.DelegatePeriodStart))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 2 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxNonce != 0 {
+		n += 1 + sovKeeper(uint64(m.MaxNonce))
+	}
+	l = m.MaxValue.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovKeeper(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *ContractGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovKeeper(uint64(m.Id))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovKeeper(uint64(m.Duration))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovKeeper(uint64(e))
+		}
+		n += 1 + sovKeeper(uint64(l)) + l
+	}
+	if m.SettlementHeight != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementHeight))
+	}
+	return n
+}
+
+func (m *ContractSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovKeeper(uint64(e))
+		}
+		n += 1 + sovKeeper(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ContractExpirationSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if m.ContractSet != nil {
+		l = m.ContractSet.Size()
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *UserContractSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.ContractSet != nil {
+		l = m.ContractSet.Size()
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovKeeper(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeeper(uint64(m.Type))
+	}
+	if m.Int64Value != 0 {
+		n += 1 + sovKeeper(uint64(m.Int64Value))
+	}
+	if m.BoolValue {
+		n += 2
+	}
+	l = len(m.StringValue)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func sovKeeper(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeeper(x uint64) (n int) {
+	return sovKeeper(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateCard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			m.Attestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attestations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = append(m.Rate, types.Coin{})
+			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+			}
+			m.MinDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountBps", wireType)
+			}
+			m.DiscountBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscountBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateCard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateCard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateCard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoTiers = append(m.PayAsYouGoTiers, RateTier{})
+			if err := m.PayAsYouGoTiers[len(m.PayAsYouGoTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionTiers = append(m.SubscriptionTiers, RateTier{})
+			if err := m.SubscriptionTiers[len(m.SubscriptionTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DurationDiscounts = append(m.DurationDiscounts, DurationDiscount{})
+			if err := m.DurationDiscounts[len(m.DurationDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderUnbondingSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderUnbondingSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderUnbondingSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, ProviderUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1342,31 +4553,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Service = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1376,14 +4587,129 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+			}
+			m.SettlementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalEscrow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1411,13 +4737,15 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			if err := m.RenewalEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoTiers", wireType)
 			}
-			m.MetadataNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1427,16 +4755,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoTiers = append(m.PayAsYouGoTiers, RateTier{})
+			if err := m.PayAsYouGoTiers[len(m.PayAsYouGoTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountBps", wireType)
 			}
-			m.Status = 0
+			m.DiscountBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1446,16 +4789,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
+				m.DiscountBps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.MinContractDuration = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1465,16 +4808,83 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatePeriodStart", wireType)
 			}
-			m.MaxContractDuration = 0
+			m.DelegatePeriodStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1484,16 +4894,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
+				m.DelegatePeriodStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1503,31 +4913,81 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNonce", wireType)
+			}
+			m.MaxNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1537,29 +4997,14 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxNonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1587,34 +5032,15 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
-			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			m.SettlementDuration = 0
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1624,7 +5050,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				m.Period |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1650,7 +5076,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *ContractGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,17 +5099,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1693,26 +5119,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
@@ -1801,25 +5212,6 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -1838,7 +5230,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
@@ -1857,11 +5249,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1871,26 +5263,25 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -1924,7 +5315,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
@@ -1958,106 +5349,87 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
-			}
-			m.SettlementHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ContractIds = append(m.ContractIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthKeeper
 				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeeper
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
-			}
-			m.Authorization = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.ContractIds) == 0 {
+					m.ContractIds = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractIds = append(m.ContractIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
 			}
-		case 16:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
 			}
-			m.QueriesPerMinute = 0
+			m.SettlementHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -2067,7 +5439,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				m.SettlementHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2609,6 +5981,178 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfigOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ConfigType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			m.Int64Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int64Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BoolValue = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StringValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeeper(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -120,5 +120,5 @@ func (msg *MsgModProvider) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidModProviderRate, "all pay-as-you-go rates must be positive")
 	}
 
-	return nil
+	return msg.RateCard.Validate()
 }
@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const TypeMsgOpenContract = "open_contract"
@@ -31,6 +32,7 @@ func NewMsgOpenContract(creator cosmos.AccAddress, provider common.PubKey, servi
 		SettlementDuration: settlementDuration,
 		Authorization:      authorization,
 		QueriesPerMinute:   qpm,
+		DelegateLimit:      NewDelegateLimit(0, cosmos.ZeroInt(), 0),
 	}
 }
 
@@ -71,6 +73,15 @@ func (msg *MsgOpenContract) GetSpender() (common.PubKey, error) {
 	}
 }
 
+// PayerAddress returns the account paying for the contract, the client unless
+// another payer is set
+func (msg *MsgOpenContract) PayerAddress() sdk.AccAddress {
+	if msg.Payer == "" {
+		return msg.MustGetSigner()
+	}
+	return sdk.MustAccAddressFromBech32(msg.Payer)
+}
+
 func (msg *MsgOpenContract) ValidateBasic() error {
 	// verify pubkey
 	_, err := common.NewPubKey(msg.Provider)
@@ -98,6 +109,13 @@ func (msg *MsgOpenContract) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidPubKey, "Signer: %s, Client Address: %s", msg.GetSigners(), client)
 	}
 
+	// another account, such as a treasury, can pay for the contract
+	if msg.Payer != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Payer); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address (%s)", err)
+		}
+	}
+
 	if msg.Duration <= 0 {
 		return errors.Wrapf(ErrOpenContractDuration, "contract duration cannot be zero")
 	}
@@ -127,5 +145,16 @@ func (msg *MsgOpenContract) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidAuthorization, "pay-as-you-go contract cannot use open authorization")
 	}
 
+	if msg.AutoRenew && msg.ContractType != ContractType_SUBSCRIPTION {
+		return errors.Wrapf(ErrContractRenewalInvalid, "only subscriptions can be renewed")
+	}
+
+	if err := msg.DelegateLimit.Validate(); err != nil {
+		return err
+	}
+	if !msg.DelegateLimit.IsEmpty() && msg.ContractType != ContractType_PAY_AS_YOU_GO {
+		return errors.Wrapf(ErrDelegateLimitInvalid, "only pay-as-you-go contracts can be limited")
+	}
+
 	return nil
 }
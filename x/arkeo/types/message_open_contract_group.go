@@ -0,0 +1,141 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgOpenContractGroup = "open_contract_group"
+
+var _ sdk.Msg = &MsgOpenContractGroup{}
+
+func NewMsgOpenContractGroup(creator cosmos.AccAddress, providers []common.PubKey, service string, client, delegate common.PubKey, duration int64, rates []cosmos.Coin, deposit cosmos.Int, authorization ContractAuthorization, qpm int64) *MsgOpenContractGroup {
+	pubkeys := make([]string, len(providers))
+	for i, provider := range providers {
+		pubkeys[i] = provider.String()
+	}
+	return &MsgOpenContractGroup{
+		Creator:          creator.String(),
+		Providers:        pubkeys,
+		Service:          service,
+		Client:           client.String(),
+		Delegate:         delegate.String(),
+		Duration:         duration,
+		Rates:            rates,
+		Deposit:          deposit,
+		Authorization:    authorization,
+		QueriesPerMinute: qpm,
+	}
+}
+
+func (msg *MsgOpenContractGroup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOpenContractGroup) Type() string {
+	return TypeMsgOpenContractGroup
+}
+
+func (msg *MsgOpenContractGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgOpenContractGroup) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgOpenContractGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOpenContractGroup) GetSpender() (common.PubKey, error) {
+	pubkey := msg.Client
+	if msg.Delegate != "" {
+		pubkey = msg.Delegate
+	}
+	spender, err := common.NewPubKey(pubkey)
+	if err != nil {
+		return nil, ErrInvalidPubKey
+	}
+	return spender, nil
+}
+
+// Denom returns the denom the group is paid in
+func (msg *MsgOpenContractGroup) Denom() string {
+	if len(msg.Rates) == 0 {
+		return ""
+	}
+	return msg.Rates[0].Denom
+}
+
+func (msg *MsgOpenContractGroup) ValidateBasic() error {
+	if len(msg.Providers) < 2 {
+		return errors.Wrapf(ErrContractGroupInvalid, "a group needs at least two providers")
+	}
+	if len(msg.Rates) != len(msg.Providers) {
+		return errors.Wrapf(ErrContractGroupInvalid, "%d rates for %d providers", len(msg.Rates), len(msg.Providers))
+	}
+	seen := make(map[string]bool, len(msg.Providers))
+	for i, provider := range msg.Providers {
+		if _, err := common.NewPubKey(provider); err != nil {
+			return errors.Wrapf(ErrInvalidPubKey, "invalid provider pubkey (%s)", err)
+		}
+		if seen[provider] {
+			return errors.Wrapf(ErrContractGroupInvalid, "duplicate provider %s", provider)
+		}
+		seen[provider] = true
+
+		rate := msg.Rates[i]
+		if err := rate.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid rate")
+		}
+		if !rate.Amount.IsPositive() {
+			return errors.Wrapf(ErrOpenContractRate, "contract rate cannot be zero")
+		}
+		if rate.Denom != msg.Denom() {
+			return errors.Wrapf(ErrContractGroupInvalid, "rates must share a denom (%s/%s)", rate.Denom, msg.Denom())
+		}
+	}
+
+	if strings.TrimSpace(msg.Service) == "" {
+		return errors.Wrapf(ErrInvalidService, "service cannot be empty")
+	}
+
+	clientPubKey, err := common.NewPubKey(msg.Client)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidPubKey, "invalid pubkey (%s)", err)
+	}
+	client, err := clientPubKey.GetMyAddress()
+	if err != nil {
+		return err
+	}
+	if !msg.MustGetSigner().Equals(client) {
+		return errors.Wrapf(ErrInvalidPubKey, "Signer: %s, Client Address: %s", msg.GetSigners(), client)
+	}
+	if _, err := msg.GetSpender(); err != nil {
+		return err
+	}
+
+	if msg.Duration <= 0 {
+		return errors.Wrapf(ErrOpenContractDuration, "contract duration cannot be zero")
+	}
+	if msg.QueriesPerMinute <= 0 {
+		return errors.Wrapf(ErrContractGroupInvalid, "queries per minute must be greater than zero")
+	}
+	if msg.Deposit.IsNil() || !msg.Deposit.IsPositive() {
+		return errors.Wrapf(ErrContractGroupInvalid, "deposit must be positive")
+	}
+
+	// groups are pay-as-you-go, which can't use open authorization
+	if msg.Authorization == ContractAuthorization_OPEN {
+		return errors.Wrapf(ErrInvalidAuthorization, "pay-as-you-go contract cannot use open authorization")
+	}
+
+	return nil
+}
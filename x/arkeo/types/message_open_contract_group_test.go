@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestOpenContractGroupValidateBasic(t *testing.T) {
+	client := GetRandomPubKey()
+	acct, err := client.GetMyAddress()
+	require.NoError(t, err)
+	providers := []common.PubKey{GetRandomPubKey(), GetRandomPubKey()}
+	rates := []cosmos.Coin{cosmos.NewInt64Coin("uarkeo", 10), cosmos.NewInt64Coin("uarkeo", 15)}
+
+	msg := NewMsgOpenContractGroup(acct, providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates, cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, "uarkeo", msg.Denom())
+
+	// a single provider is an ordinary contract
+	msg = NewMsgOpenContractGroup(acct, providers[:1], "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates[:1], cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractGroupInvalid)
+
+	msg = NewMsgOpenContractGroup(acct, providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates[:1], cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractGroupInvalid)
+
+	msg = NewMsgOpenContractGroup(acct, []common.PubKey{providers[0], providers[0]}, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates, cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractGroupInvalid)
+
+	msg = NewMsgOpenContractGroup(acct, providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, []cosmos.Coin{rates[0], cosmos.NewInt64Coin("uatom", 15)}, cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractGroupInvalid)
+
+	msg = NewMsgOpenContractGroup(acct, providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates, cosmos.ZeroInt(), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractGroupInvalid)
+
+	msg = NewMsgOpenContractGroup(acct, providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates, cosmos.NewInt(1000), ContractAuthorization_OPEN, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAuthorization)
+
+	// only the client can open the group
+	msg = NewMsgOpenContractGroup(GetRandomBech32Addr(), providers, "btc-mainnet-fullnode", client, common.EmptyPubKey, 100, rates, cosmos.NewInt(1000), ContractAuthorization_STRICT, 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidPubKey)
+}
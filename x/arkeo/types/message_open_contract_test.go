@@ -41,4 +41,13 @@ func TestBondProviderValidateBasic(t *testing.T) {
 	msg.ContractType = ContractType_PAY_AS_YOU_GO
 	err = msg.ValidateBasic()
 	require.ErrorIs(t, err, ErrInvalidAuthorization)
+
+	// only subscriptions renew
+	msg.Authorization = ContractAuthorization_STRICT
+	msg.AutoRenew = true
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrContractRenewalInvalid)
+	msg.ContractType = ContractType_SUBSCRIPTION
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
 }
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+)
+
+const TypeMsgSetConfig = "set_config"
+
+var _ sdk.Msg = &MsgSetConfig{}
+
+func NewMsgSetConfig(creator cosmos.AccAddress, config ConfigOverride, remove bool) *MsgSetConfig {
+	return &MsgSetConfig{
+		Creator: creator.String(),
+		Config:  config,
+		Remove:  remove,
+	}
+}
+
+func (msg *MsgSetConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetConfig) Type() string {
+	return TypeMsgSetConfig
+}
+
+func (msg *MsgSetConfig) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetConfig) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Remove {
+		if _, ok := configs.GetConfigName(msg.Config.Name); !ok {
+			return errors.Wrapf(ErrInvalidConfig, "unknown config %q", msg.Config.Name)
+		}
+		return nil
+	}
+	return msg.Config.Validate()
+}
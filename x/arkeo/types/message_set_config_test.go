@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/testutil/sample"
+)
+
+func TestMsgSetConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetConfig
+		err  error
+	}{
+		{
+			name: "valid override",
+			msg: MsgSetConfig{
+				Creator: sample.AccAddress().String(),
+				Config:  ConfigOverride{Name: "OpenContractCost", Type: ConfigType_INT64, Int64Value: 10},
+			},
+		},
+		{
+			name: "invalid address",
+			msg: MsgSetConfig{
+				Creator: "invalid_address",
+				Config:  ConfigOverride{Name: "OpenContractCost", Type: ConfigType_INT64, Int64Value: 10},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unknown config",
+			msg: MsgSetConfig{
+				Creator: sample.AccAddress().String(),
+				Config:  ConfigOverride{Name: "NotAConfig", Type: ConfigType_INT64, Int64Value: 10},
+			},
+			err: ErrInvalidConfig,
+		},
+		{
+			name: "negative value",
+			msg: MsgSetConfig{
+				Creator: sample.AccAddress().String(),
+				Config:  ConfigOverride{Name: "OpenContractCost", Type: ConfigType_INT64, Int64Value: -1},
+			},
+			err: ErrInvalidConfig,
+		},
+		{
+			name: "zero divisor",
+			msg: MsgSetConfig{
+				Creator: sample.AccAddress().String(),
+				Config:  ConfigOverride{Name: "ValidatorPayoutCycle", Type: ConfigType_INT64},
+			},
+			err: ErrInvalidConfig,
+		},
+		{
+			name: "remove ignores the value",
+			msg: MsgSetConfig{
+				Creator: sample.AccAddress().String(),
+				Config:  ConfigOverride{Name: "ValidatorPayoutCycle"},
+				Remove:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
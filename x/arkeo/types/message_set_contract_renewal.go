@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSetContractRenewal = "set_contract_renewal"
+
+var _ sdk.Msg = &MsgSetContractRenewal{}
+
+func NewMsgSetContractRenewal(creator cosmos.AccAddress, contractId uint64, autoRenew bool, escrow cosmos.Int) *MsgSetContractRenewal {
+	return &MsgSetContractRenewal{
+		Creator:    creator.String(),
+		ContractId: contractId,
+		AutoRenew:  autoRenew,
+		Escrow:     escrow,
+	}
+}
+
+func (msg *MsgSetContractRenewal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetContractRenewal) Type() string {
+	return TypeMsgSetContractRenewal
+}
+
+func (msg *MsgSetContractRenewal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetContractRenewal) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetContractRenewal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetContractRenewal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Escrow.IsNil() || msg.Escrow.IsNegative() {
+		return errors.Wrap(ErrContractRenewalInvalid, "escrow cannot be negative")
+	}
+	if !msg.AutoRenew && msg.Escrow.IsPositive() {
+		return errors.Wrap(ErrContractRenewalInvalid, "cannot add escrow when cancelling the renewal")
+	}
+	return nil
+}
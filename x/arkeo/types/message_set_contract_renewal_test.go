@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestSetContractRenewalValidateBasic(t *testing.T) {
+	// setup
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgSetContractRenewal(acct, 50, true, cosmos.NewInt(100))
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Escrow = cosmos.ZeroInt()
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Escrow = cosmos.NewInt(-1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractRenewalInvalid)
+
+	// cancelling can't fund the escrow
+	msg = NewMsgSetContractRenewal(acct, 50, false, cosmos.NewInt(100))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractRenewalInvalid)
+	msg.Escrow = cosmos.ZeroInt()
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Creator = "bogus"
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSetDelegateLimit = "set_delegate_limit"
+
+var _ sdk.Msg = &MsgSetDelegateLimit{}
+
+func NewMsgSetDelegateLimit(creator cosmos.AccAddress, contractId uint64, limit DelegateLimit) *MsgSetDelegateLimit {
+	return &MsgSetDelegateLimit{
+		Creator:       creator.String(),
+		ContractId:    contractId,
+		DelegateLimit: limit,
+	}
+}
+
+func (msg *MsgSetDelegateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDelegateLimit) Type() string {
+	return TypeMsgSetDelegateLimit
+}
+
+func (msg *MsgSetDelegateLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetDelegateLimit) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetDelegateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetDelegateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return msg.DelegateLimit.Validate()
+}
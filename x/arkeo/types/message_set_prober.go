@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSetProber = "set_prober"
+
+var _ sdk.Msg = &MsgSetProber{}
+
+func NewMsgSetProber(creator, prober cosmos.AccAddress, remove bool) *MsgSetProber {
+	return &MsgSetProber{
+		Creator: creator.String(),
+		Prober:  prober.String(),
+		Remove:  remove,
+	}
+}
+
+func (msg *MsgSetProber) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProber) Type() string {
+	return TypeMsgSetProber
+}
+
+func (msg *MsgSetProber) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetProber) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetProber) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProber) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Prober); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid prober address (%s)", err)
+	}
+	return nil
+}
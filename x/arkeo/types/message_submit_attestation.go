@@ -0,0 +1,65 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSubmitAttestation = "submit_attestation"
+
+var _ sdk.Msg = &MsgSubmitAttestation{}
+
+func NewMsgSubmitAttestation(creator cosmos.AccAddress, provider common.PubKey, service string, contractId uint64, availability uint32, latencyMs int64) *MsgSubmitAttestation {
+	return &MsgSubmitAttestation{
+		Creator:      creator.String(),
+		Provider:     provider.String(),
+		Service:      service,
+		ContractId:   contractId,
+		Availability: availability,
+		LatencyMs:    latencyMs,
+	}
+}
+
+func (msg *MsgSubmitAttestation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitAttestation) Type() string {
+	return TypeMsgSubmitAttestation
+}
+
+func (msg *MsgSubmitAttestation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSubmitAttestation) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSubmitAttestation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := common.NewPubKey(msg.Provider); err != nil {
+		return errors.Wrapf(ErrInvalidPubKey, "invalid provider pubkey (%s)", err)
+	}
+	if msg.Service == "" {
+		return errors.Wrap(ErrAttestationInvalid, "service cannot be empty")
+	}
+	if msg.Availability > MaxAttestationScore {
+		return errors.Wrapf(ErrAttestationInvalid, "availability cannot be more than %d basis points", MaxAttestationScore)
+	}
+	if msg.LatencyMs < 0 {
+		return errors.Wrap(ErrAttestationInvalid, "latency cannot be negative")
+	}
+	return nil
+}
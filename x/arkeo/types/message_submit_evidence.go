@@ -0,0 +1,90 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSubmitEvidence = "submit_evidence"
+
+var _ sdk.Msg = &MsgSubmitEvidence{}
+
+func NewMsgSubmitEvidence(creator cosmos.AccAddress, first, second ResponseReceipt) *MsgSubmitEvidence {
+	return &MsgSubmitEvidence{
+		Creator: creator.String(),
+		First:   first,
+		Second:  second,
+	}
+}
+
+func (msg *MsgSubmitEvidence) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitEvidence) Type() string {
+	return TypeMsgSubmitEvidence
+}
+
+func (msg *MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSubmitEvidence) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSubmitEvidence) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrInvalidEvidence, "invalid creator address (%s)", err)
+	}
+	for _, receipt := range []ResponseReceipt{msg.First, msg.Second} {
+		if err := receipt.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if msg.First.ContractId != msg.Second.ContractId || msg.First.Nonce != msg.Second.Nonce {
+		return errors.Wrap(ErrInvalidEvidence, "receipts must be for the same contract and nonce")
+	}
+	if bytes.Equal(msg.First.ResponseHash, msg.Second.ResponseHash) {
+		return errors.Wrap(ErrInvalidEvidence, "receipts must be for different responses")
+	}
+	return nil
+}
+
+func (receipt ResponseReceipt) ValidateBasic() error {
+	if receipt.Nonce <= 0 {
+		return errors.Wrap(ErrInvalidEvidence, "nonce must be greater than zero")
+	}
+	if len(receipt.ResponseHash) == 0 || len(receipt.ResponseHash) > 64 {
+		return errors.Wrap(ErrInvalidEvidence, "bad response hash length")
+	}
+	if len(receipt.Signature) == 0 || len(receipt.Signature) > 100 {
+		return errors.Wrap(ErrInvalidEvidence, "bad signature length")
+	}
+	if len(receipt.ClientSignature) > 100 {
+		return errors.Wrap(ErrInvalidEvidence, "bad client signature length")
+	}
+	return nil
+}
+
+func (receipt ResponseReceipt) GetBytesToSign(chainId string) []byte {
+	return GetResponseBytesToSign(receipt.ContractId, receipt.Nonce, receipt.ResponseHash, receipt.ClientSignature, chainId)
+}
+
+// GetResponseBytesToSign returns the message a provider signs to vouch for the
+// response it served for a contract nonce, and for the client signature of the
+// nonce it was served under
+func GetResponseBytesToSign(contractId uint64, nonce int64, responseHash, clientSignature []byte, chainID string) []byte {
+	return []byte(fmt.Sprintf("%d:%d:%s:%s:%s", contractId, nonce, hex.EncodeToString(responseHash), hex.EncodeToString(clientSignature), chainID))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitEvidenceValidateBasic(t *testing.T) {
+	receipt := func(nonce int64, hash string) ResponseReceipt {
+		return ResponseReceipt{ContractId: 1, Nonce: nonce, ResponseHash: []byte(hash), Signature: []byte("sig")}
+	}
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgSubmitEvidence(acct, receipt(5, "a"), receipt(5, "b"))
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitEvidence(acct, receipt(5, "a"), receipt(5, "a"))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg = NewMsgSubmitEvidence(acct, receipt(5, "a"), receipt(6, "b"))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg = NewMsgSubmitEvidence(acct, receipt(0, "a"), receipt(0, "b"))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg = NewMsgSubmitEvidence(acct, receipt(5, "a"), ResponseReceipt{ContractId: 1, Nonce: 5, ResponseHash: []byte("b")})
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg.Creator = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+}
+
+func TestSubmitUnsignedNonceEvidenceValidateBasic(t *testing.T) {
+	receipt := ResponseReceipt{ContractId: 1, Nonce: 5, ResponseHash: []byte("a"), Signature: []byte("sig")}
+	msg := NewMsgSubmitUnsignedNonceEvidence(GetRandomBech32Addr(), receipt)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Receipt.ClientSignature = make([]byte, 101)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg.Receipt.ClientSignature = nil
+	msg.Receipt.Signature = nil
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+
+	msg = NewMsgSubmitUnsignedNonceEvidence(GetRandomBech32Addr(), receipt)
+	msg.Creator = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidEvidence)
+}
+
+func TestGetResponseBytesToSign(t *testing.T) {
+	require.Equal(t, "3:7:abcd:ef:arkeo", string(GetResponseBytesToSign(3, 7, []byte{0xab, 0xcd}, []byte{0xef}, "arkeo")))
+	require.Equal(t, "3:7:abcd::arkeo", string(GetResponseBytesToSign(3, 7, []byte{0xab, 0xcd}, nil, "arkeo")))
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSubmitUnsignedNonceEvidence = "submit_unsigned_nonce_evidence"
+
+var _ sdk.Msg = &MsgSubmitUnsignedNonceEvidence{}
+
+func NewMsgSubmitUnsignedNonceEvidence(creator cosmos.AccAddress, receipt ResponseReceipt) *MsgSubmitUnsignedNonceEvidence {
+	return &MsgSubmitUnsignedNonceEvidence{
+		Creator: creator.String(),
+		Receipt: receipt,
+	}
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) Type() string {
+	return TypeMsgSubmitUnsignedNonceEvidence
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitUnsignedNonceEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrInvalidEvidence, "invalid creator address (%s)", err)
+	}
+	return msg.Receipt.ValidateBasic()
+}
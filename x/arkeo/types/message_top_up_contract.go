@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgTopUpContract = "top_up_contract"
+
+var _ sdk.Msg = &MsgTopUpContract{}
+
+func NewMsgTopUpContract(creator cosmos.AccAddress, contractId uint64, deposit cosmos.Int, duration int64) *MsgTopUpContract {
+	return &MsgTopUpContract{
+		Creator:    creator.String(),
+		ContractId: contractId,
+		Deposit:    deposit,
+		Duration:   duration,
+	}
+}
+
+func (msg *MsgTopUpContract) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTopUpContract) Type() string {
+	return TypeMsgTopUpContract
+}
+
+func (msg *MsgTopUpContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgTopUpContract) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgTopUpContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTopUpContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Deposit.IsNil() || msg.Deposit.IsNegative() {
+		return errors.Wrap(ErrTopUpContractInvalid, "deposit cannot be negative")
+	}
+	if msg.Duration < 0 {
+		return errors.Wrap(ErrTopUpContractInvalid, "duration cannot be negative")
+	}
+	if msg.Deposit.IsZero() && msg.Duration == 0 {
+		return errors.Wrap(ErrTopUpContractInvalid, "deposit or duration must be added")
+	}
+	return nil
+}
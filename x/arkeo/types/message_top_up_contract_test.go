@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestTopUpContractValidateBasic(t *testing.T) {
+	// setup
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgTopUpContract(acct, 50, cosmos.NewInt(100), 10)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Duration = 0
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Deposit = cosmos.ZeroInt()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractInvalid)
+
+	msg.Duration = -1
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractInvalid)
+
+	msg = NewMsgTopUpContract(acct, 50, cosmos.NewInt(-1), 10)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractInvalid)
+
+	msg.Creator = "bogus"
+	require.Error(t, msg.ValidateBasic())
+}
@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
-	grpc1 "github.com/gogo/protobuf/grpc"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -30,6 +30,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConfigSource defines where an effective config value comes from.
+type ConfigSource int32
+
+const (
+	// COMPILED is the value compiled in the binary.
+	ConfigSource_COMPILED ConfigSource = 0
+	// OVERRIDE is a value set on chain with MsgSetConfig.
+	ConfigSource_OVERRIDE ConfigSource = 1
+)
+
+var ConfigSource_name = map[int32]string{
+	0: "COMPILED",
+	1: "OVERRIDE",
+}
+
+var ConfigSource_value = map[string]int32{
+	"COMPILED": 0,
+	"OVERRIDE": 1,
+}
+
+func (x ConfigSource) String() string {
+	return proto.EnumName(ConfigSource_name, int32(x))
+}
+
+func (ConfigSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{0}
+}
+
 // Request for all known services
 type QueryAllServicesRequest struct {
 }
@@ -472,6 +500,8 @@ func (m *QueryFetchProviderResponse) GetProvider() Provider {
 // QueryAllProviderRequest is the request message for listing all providers.
 type QueryAllProviderRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// service only lists the providers of the given service
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 }
 
 func (m *QueryAllProviderRequest) Reset()         { *m = QueryAllProviderRequest{} }
@@ -514,6 +544,13 @@ func (m *QueryAllProviderRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllProviderRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
 // QueryAllProviderResponse is the response for listing all providers.
 // QueryAllProviderResponse is the response message containing a list of all
 // providers.
@@ -667,6 +704,16 @@ func (m *QueryFetchContractResponse) GetContract() Contract {
 // QueryAllContractRequest is the request message for listing all contracts.
 type QueryAllContractRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// provider, client and delegate pubkeys to filter on, the most selective
+	// one set picks the index the contracts are listed from
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Client   string `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate string `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Service  string `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	// type is either subscription or pay-as-you-go
+	Type string `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	// state is either active or expired
+	State string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
 }
 
 func (m *QueryAllContractRequest) Reset()         { *m = QueryAllContractRequest{} }
@@ -709,6 +756,48 @@ func (m *QueryAllContractRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllContractRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryAllContractRequest) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *QueryAllContractRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryAllContractRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *QueryAllContractRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueryAllContractRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
 // QueryAllContractResponse is the response for listing all contracts.
 // QueryAllContractResponse is the response message containing a list of all
 // contracts.
@@ -871,1308 +960,3597 @@ func (m *QueryActiveContractResponse) GetContract() Contract {
 	return Contract{}
 }
 
-func init() {
-	proto.RegisterType((*QueryAllServicesRequest)(nil), "arkeo.arkeo.QueryAllServicesRequest")
-	proto.RegisterType((*ServiceEnum)(nil), "arkeo.arkeo.ServiceEnum")
-	proto.RegisterType((*QueryAllServicesResponse)(nil), "arkeo.arkeo.QueryAllServicesResponse")
-	proto.RegisterType((*QueryServiceRequest)(nil), "arkeo.arkeo.QueryServiceRequest")
-	proto.RegisterType((*QueryServiceResponse)(nil), "arkeo.arkeo.QueryServiceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "arkeo.arkeo.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "arkeo.arkeo.QueryParamsResponse")
-	proto.RegisterType((*QueryFetchProviderRequest)(nil), "arkeo.arkeo.QueryFetchProviderRequest")
-	proto.RegisterType((*QueryFetchProviderResponse)(nil), "arkeo.arkeo.QueryFetchProviderResponse")
-	proto.RegisterType((*QueryAllProviderRequest)(nil), "arkeo.arkeo.QueryAllProviderRequest")
-	proto.RegisterType((*QueryAllProviderResponse)(nil), "arkeo.arkeo.QueryAllProviderResponse")
-	proto.RegisterType((*QueryFetchContractRequest)(nil), "arkeo.arkeo.QueryFetchContractRequest")
-	proto.RegisterType((*QueryFetchContractResponse)(nil), "arkeo.arkeo.QueryFetchContractResponse")
-	proto.RegisterType((*QueryAllContractRequest)(nil), "arkeo.arkeo.QueryAllContractRequest")
-	proto.RegisterType((*QueryAllContractResponse)(nil), "arkeo.arkeo.QueryAllContractResponse")
-	proto.RegisterType((*QueryActiveContractRequest)(nil), "arkeo.arkeo.QueryActiveContractRequest")
-	proto.RegisterType((*QueryActiveContractResponse)(nil), "arkeo.arkeo.QueryActiveContractResponse")
+// QueryProviderUnbondingsRequest is the request message for the pending bond
+// withdrawals of a provider, optionally for a single service.
+type QueryProviderUnbondingsRequest struct {
+	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/query.proto", fileDescriptor_4b28dca1d1dd051d) }
-
-var fileDescriptor_4b28dca1d1dd051d = []byte{
-	// 900 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0xdc, 0xf4, 0x76, 0xcc, 0xed, 0xbd, 0xcc, 0xcd, 0x6d, 0x5d, 0x43, 0x93, 0xd4,
-	0xea, 0x4f, 0x54, 0x84, 0xad, 0x16, 0x50, 0x85, 0x04, 0x8b, 0x16, 0xb5, 0x50, 0x09, 0xa4, 0x60,
-	0x7e, 0x16, 0x6c, 0x90, 0xe3, 0x0c, 0xa9, 0x95, 0xc4, 0xe3, 0x7a, 0x9c, 0x40, 0x14, 0x75, 0x83,
-	0x84, 0xd8, 0xb0, 0x40, 0x62, 0xcb, 0x8a, 0xa7, 0xe9, 0xb2, 0x12, 0x1b, 0x56, 0x08, 0xb5, 0x3c,
-	0x01, 0x4f, 0x80, 0x32, 0x73, 0x26, 0xb1, 0x1d, 0x9b, 0x54, 0x02, 0x36, 0x69, 0x72, 0xe6, 0x3b,
-	0xe7, 0xfb, 0xce, 0xdf, 0x74, 0xd0, 0x86, 0x13, 0xf6, 0x08, 0xb5, 0xc4, 0xe7, 0xd5, 0x90, 0x84,
-	0x63, 0x33, 0x08, 0x69, 0x44, 0xb1, 0xca, 0x4d, 0x26, 0xff, 0xd4, 0xab, 0x5d, 0xda, 0xa5, 0xdc,
-	0x6e, 0x4d, 0xbf, 0x09, 0x88, 0xfe, 0x6a, 0x97, 0xd2, 0x6e, 0x9f, 0x58, 0x4e, 0xe0, 0x59, 0x8e,
-	0xef, 0xd3, 0xc8, 0x89, 0x3c, 0xea, 0x33, 0x38, 0x3d, 0x70, 0x29, 0x1b, 0x50, 0x66, 0xb5, 0x1d,
-	0x46, 0x44, 0x64, 0x6b, 0x74, 0xd8, 0x26, 0x91, 0x73, 0x68, 0x05, 0x4e, 0xd7, 0xf3, 0x39, 0x18,
-	0xb0, 0x5a, 0x5c, 0x45, 0xe0, 0x84, 0xce, 0x80, 0x65, 0x9d, 0xf4, 0x08, 0x09, 0x48, 0x28, 0x4e,
-	0x8c, 0x4d, 0xb4, 0xf1, 0xf1, 0x34, 0xea, 0x49, 0xbf, 0xff, 0x09, 0x09, 0x47, 0x9e, 0x4b, 0x98,
-	0x4d, 0xae, 0x86, 0x84, 0x45, 0xc6, 0x77, 0x0a, 0x52, 0xc1, 0x76, 0xe6, 0x0f, 0x07, 0x78, 0x0b,
-	0x21, 0x26, 0x7e, 0x7e, 0xe9, 0x75, 0x34, 0xa5, 0xa1, 0x34, 0x1f, 0xd9, 0xab, 0x60, 0xb9, 0xe8,
-	0x60, 0x8c, 0xca, 0xbe, 0x33, 0x20, 0x5a, 0xb1, 0xa1, 0x34, 0x57, 0x6d, 0xfe, 0x1d, 0x37, 0x90,
-	0xda, 0x21, 0xcc, 0x0d, 0xbd, 0x60, 0x2a, 0x53, 0x2b, 0xf1, 0xa3, 0xb8, 0x09, 0x6f, 0xa3, 0x97,
-	0x64, 0xd0, 0x68, 0x1c, 0x10, 0xad, 0x2c, 0x20, 0x60, 0xfb, 0x74, 0x1c, 0x10, 0xa3, 0x85, 0xb4,
-	0x45, 0x89, 0x2c, 0xa0, 0x3e, 0x23, 0xf8, 0x4d, 0xf4, 0x18, 0xa0, 0x4c, 0x53, 0x1a, 0xa5, 0xa6,
-	0x7a, 0xa4, 0x99, 0xb1, 0x92, 0x9b, 0x31, 0xfd, 0xf6, 0x0c, 0x69, 0xbc, 0x8d, 0x9e, 0xf3, 0x88,
-	0x70, 0x0a, 0x09, 0xcf, 0x32, 0x50, 0x62, 0x19, 0xac, 0xa1, 0xa2, 0xd7, 0xe1, 0x39, 0x95, 0xed,
-	0xa2, 0xd7, 0x31, 0x3e, 0x44, 0xd5, 0xa4, 0xeb, 0x4c, 0xc8, 0x0a, 0x84, 0xe7, 0xee, 0xea, 0x51,
-	0x35, 0x4b, 0xc7, 0x69, 0xf9, 0xe6, 0xf7, 0x7a, 0xc1, 0x96, 0x50, 0xa3, 0x8a, 0x30, 0x8f, 0xd6,
-	0xe2, 0xcd, 0x92, 0x85, 0xff, 0x00, 0xe4, 0x49, 0x2b, 0x50, 0x1c, 0xa2, 0x8a, 0x68, 0x2a, 0x30,
-	0x3c, 0x4f, 0x30, 0x08, 0x30, 0x10, 0x00, 0xd0, 0xf8, 0x08, 0x6d, 0xf2, 0x48, 0xe7, 0x24, 0x72,
-	0x2f, 0x5b, 0x21, 0x1d, 0x79, 0x1d, 0x12, 0xca, 0x74, 0xd7, 0x51, 0x25, 0x18, 0xb6, 0x7b, 0x64,
-	0x0c, 0x09, 0xc3, 0x2f, 0xac, 0xcd, 0x53, 0x11, 0xbd, 0x9c, 0xc9, 0xfd, 0x0c, 0xe9, 0x59, 0xe1,
-	0x40, 0xdf, 0x31, 0x7a, 0x1c, 0x80, 0x0d, 0x14, 0xbe, 0x48, 0x2a, 0x84, 0x43, 0xd0, 0x38, 0x03,
-	0x1b, 0xce, 0x7c, 0x06, 0xd3, 0x1a, 0xcf, 0x11, 0x9a, 0x8f, 0x39, 0x44, 0xdd, 0x33, 0xc5, 0x4e,
-	0x98, 0xd3, 0x9d, 0x30, 0xc5, 0xb6, 0xc1, 0x4e, 0x98, 0x2d, 0xa7, 0x2b, 0xdb, 0x69, 0xc7, 0x3c,
-	0x8d, 0x9f, 0x95, 0xf9, 0x10, 0x2d, 0x11, 0x5e, 0x7a, 0xb0, 0x70, 0xfc, 0x7e, 0x42, 0x5d, 0x91,
-	0xab, 0xdb, 0x5f, 0xaa, 0x4e, 0xb0, 0x26, 0xe4, 0xbd, 0x13, 0xef, 0xd3, 0x7b, 0xd4, 0x8f, 0x42,
-	0xc7, 0x8d, 0x64, 0x0d, 0xea, 0x48, 0x75, 0xc1, 0x24, 0x17, 0xaf, 0x6c, 0x23, 0x69, 0xba, 0xe8,
-	0x24, 0xdb, 0x32, 0xf7, 0x9e, 0x67, 0x27, 0xb1, 0x99, 0x6d, 0x91, 0x0e, 0x32, 0x3b, 0x09, 0x8e,
-	0xb7, 0x25, 0x2d, 0xe9, 0xff, 0x68, 0xcb, 0x12, 0xe1, 0xa5, 0x07, 0x0b, 0xff, 0xef, 0xda, 0xd2,
-	0x87, 0xc2, 0x9e, 0xb8, 0x91, 0x37, 0x22, 0xe9, 0x22, 0xe8, 0xa9, 0x79, 0x5f, 0x8d, 0x4d, 0x46,
-	0xee, 0x0e, 0xf1, 0x93, 0x80, 0xf8, 0x53, 0xa7, 0x12, 0x9c, 0x88, 0x9f, 0xc6, 0xe7, 0xe8, 0x95,
-	0x4c, 0xb6, 0x7f, 0xd9, 0xc7, 0xa3, 0xbf, 0x56, 0xd0, 0x23, 0x1e, 0x18, 0xb7, 0x51, 0x45, 0x5c,
-	0x13, 0xb8, 0x9e, 0x70, 0x5d, 0xbc, 0x83, 0xf4, 0x46, 0x3e, 0x40, 0xe8, 0x31, 0x5e, 0x7c, 0xfb,
-	0xeb, 0x9f, 0x3f, 0x15, 0x9f, 0xe2, 0x27, 0x89, 0x7f, 0x38, 0xf8, 0x07, 0x05, 0x3d, 0x49, 0xdc,
-	0x0f, 0x78, 0x6f, 0x31, 0x54, 0xd6, 0x7d, 0xa4, 0xef, 0x2f, 0xc5, 0x01, 0xf3, 0x01, 0x67, 0xde,
-	0xc1, 0x86, 0x64, 0x06, 0x80, 0x35, 0x11, 0x37, 0xd8, 0xb5, 0x35, 0x81, 0x6a, 0x5f, 0xe3, 0x08,
-	0xa9, 0xd2, 0xff, 0xa4, 0xdf, 0xc7, 0x3b, 0x8b, 0x1c, 0x8b, 0xb7, 0x8e, 0xbe, 0xbb, 0x04, 0x05,
-	0x3a, 0x34, 0xae, 0x03, 0xe3, 0x67, 0x29, 0x1d, 0x0c, 0x7f, 0x2f, 0x8b, 0x20, 0x9b, 0x92, 0x5b,
-	0x84, 0xd4, 0x50, 0xe5, 0x16, 0x21, 0x3d, 0x0e, 0xc6, 0x2e, 0x27, 0xaf, 0xe3, 0x2d, 0x20, 0x97,
-	0xed, 0xb6, 0x26, 0xb1, 0xcb, 0x82, 0xe7, 0x2f, 0x5d, 0xf3, 0xf3, 0x4f, 0x8b, 0xd8, 0x5d, 0x82,
-	0xca, 0xc9, 0x5f, 0x12, 0x33, 0xfc, 0x8b, 0x82, 0xd6, 0x92, 0x63, 0x8c, 0x33, 0x12, 0xcb, 0x5c,
-	0x2b, 0xbd, 0xb9, 0x1c, 0x08, 0xfc, 0xef, 0x72, 0xfe, 0x63, 0xfc, 0x16, 0xf0, 0x3b, 0x1c, 0xf6,
-	0xfa, 0xbc, 0x12, 0xb2, 0x21, 0xb1, 0x81, 0xb0, 0x26, 0xb0, 0x6e, 0xd7, 0x98, 0x21, 0x35, 0xf6,
-	0xa4, 0xc8, 0x29, 0x4d, 0xea, 0x51, 0x94, 0x53, 0x9a, 0xf4, 0xbb, 0xc4, 0xd8, 0xe0, 0xd2, 0x5e,
-	0xc6, 0x4f, 0x41, 0x9a, 0x7c, 0x7a, 0xe0, 0xaf, 0xd0, 0x0a, 0x80, 0x71, 0xc6, 0x8a, 0x25, 0x1f,
-	0x24, 0xfa, 0xf6, 0x3f, 0x20, 0x80, 0x68, 0x9d, 0x13, 0x3d, 0xc3, 0x6b, 0x49, 0xa2, 0xd3, 0xb3,
-	0x9b, 0xbb, 0x9a, 0x72, 0x7b, 0x57, 0x53, 0xfe, 0xb8, 0xab, 0x29, 0x3f, 0xde, 0xd7, 0x0a, 0xb7,
-	0xf7, 0xb5, 0xc2, 0x6f, 0xf7, 0xb5, 0xc2, 0x17, 0xaf, 0x75, 0xbd, 0xe8, 0x72, 0xd8, 0x36, 0x5d,
-	0x3a, 0x10, 0x3e, 0x3e, 0x89, 0xbe, 0xa6, 0x61, 0x0f, 0x02, 0x7c, 0x03, 0x7f, 0xa7, 0xaf, 0x31,
-	0xd6, 0xae, 0xf0, 0x57, 0xe2, 0x1b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x81, 0x84, 0x11, 0x35,
-	0xe1, 0x0a, 0x00, 0x00,
+func (m *QueryProviderUnbondingsRequest) Reset()         { *m = QueryProviderUnbondingsRequest{} }
+func (m *QueryProviderUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUnbondingsRequest) ProtoMessage()    {}
+func (*QueryProviderUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{17}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// FetchProvider queries a specific provider by pubkey and service.
-	FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error)
-	// ProviderAll queries for a list of all providers.
-	ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error)
-	// FetchContract queries a specific contract by contract_id.
-	FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error)
-	// ContractAll queries for a list of all contracts.
-	ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
-	// ActiveContract queries an active contract by provider, service, and
-	// spender.
-	ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error)
-	// Returns a list of all service enum values and descriptions.
-	AllServices(ctx context.Context, in *QueryAllServicesRequest, opts ...grpc.CallOption) (*QueryAllServicesResponse, error)
-	// Returns a single service by name or id.
-	Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error)
+func (m *QueryProviderUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProviderUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProviderUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryProviderUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUnbondingsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryProviderUnbondingsRequest) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error) {
-	out := new(QueryFetchProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProviderUnbondingsRequest) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error) {
-	out := new(QueryAllProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryProviderUnbondingsResponse lists the pending bond withdrawals of a
+// provider by release height.
+type QueryProviderUnbondingsResponse struct {
+	Unbondings []ProviderUnbondingSet `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error) {
-	out := new(QueryFetchContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProviderUnbondingsResponse) Reset()         { *m = QueryProviderUnbondingsResponse{} }
+func (m *QueryProviderUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUnbondingsResponse) ProtoMessage()    {}
+func (*QueryProviderUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{18}
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
-	out := new(QueryAllContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderUnbondingsResponse) GetUnbondings() []ProviderUnbondingSet {
+	if m != nil {
+		return m.Unbondings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error) {
-	out := new(QueryActiveContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ActiveContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// EffectiveConfig is a config value in effect.
+type EffectiveConfig struct {
+	Config ConfigOverride `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	Source ConfigSource   `protobuf:"varint,2,opt,name=source,proto3,enum=arkeo.arkeo.ConfigSource" json:"source,omitempty"`
 }
 
-func (c *queryClient) AllServices(ctx context.Context, in *QueryAllServicesRequest, opts ...grpc.CallOption) (*QueryAllServicesResponse, error) {
-	out := new(QueryAllServicesResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/AllServices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EffectiveConfig) Reset()         { *m = EffectiveConfig{} }
+func (m *EffectiveConfig) String() string { return proto.CompactTextString(m) }
+func (*EffectiveConfig) ProtoMessage()    {}
+func (*EffectiveConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{19}
+}
+func (m *EffectiveConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EffectiveConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveConfig.Merge(m, src)
+}
+func (m *EffectiveConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveConfig.DiscardUnknown(m)
 }
 
-func (c *queryClient) Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error) {
-	out := new(QueryServiceResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Service", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EffectiveConfig proto.InternalMessageInfo
+
+func (m *EffectiveConfig) GetConfig() ConfigOverride {
+	if m != nil {
+		return m.Config
 	}
-	return out, nil
+	return ConfigOverride{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// FetchProvider queries a specific provider by pubkey and service.
-	FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error)
-	// ProviderAll queries for a list of all providers.
-	ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error)
-	// FetchContract queries a specific contract by contract_id.
-	FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error)
-	// ContractAll queries for a list of all contracts.
-	ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
-	// ActiveContract queries an active contract by provider, service, and
-	// spender.
-	ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error)
-	// Returns a list of all service enum values and descriptions.
-	AllServices(context.Context, *QueryAllServicesRequest) (*QueryAllServicesResponse, error)
-	// Returns a single service by name or id.
-	Service(context.Context, *QueryServiceRequest) (*QueryServiceResponse, error)
+func (m *EffectiveConfig) GetSource() ConfigSource {
+	if m != nil {
+		return m.Source
+	}
+	return ConfigSource_COMPILED
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryConfigsRequest is the request message for the effective config values.
+type QueryConfigsRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryConfigsRequest) Reset()         { *m = QueryConfigsRequest{} }
+func (m *QueryConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConfigsRequest) ProtoMessage()    {}
+func (*QueryConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{20}
 }
-func (*UnimplementedQueryServer) FetchProvider(ctx context.Context, req *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchProvider not implemented")
+func (m *QueryConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ProviderAll(ctx context.Context, req *QueryAllProviderRequest) (*QueryAllProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderAll not implemented")
+func (m *QueryConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FetchContract(ctx context.Context, req *QueryFetchContractRequest) (*QueryFetchContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchContract not implemented")
+func (m *QueryConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfigsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ContractAll(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractAll not implemented")
-}
-func (*UnimplementedQueryServer) ActiveContract(ctx context.Context, req *QueryActiveContractRequest) (*QueryActiveContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveContract not implemented")
-}
-func (*UnimplementedQueryServer) AllServices(ctx context.Context, req *QueryAllServicesRequest) (*QueryAllServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllServices not implemented")
+func (m *QueryConfigsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Service(ctx context.Context, req *QueryServiceRequest) (*QueryServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Service not implemented")
+func (m *QueryConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfigsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryConfigsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryConfigsResponse lists the effective config values by name.
+type QueryConfigsResponse struct {
+	Configs []EffectiveConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs"`
 }
 
-func _Query_FetchProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFetchProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FetchProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/FetchProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FetchProvider(ctx, req.(*QueryFetchProviderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryConfigsResponse) Reset()         { *m = QueryConfigsResponse{} }
+func (m *QueryConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConfigsResponse) ProtoMessage()    {}
+func (*QueryConfigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{21}
 }
-
-func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ProviderAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderAll(ctx, req.(*QueryAllProviderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryConfigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFetchContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FetchContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/FetchContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FetchContract(ctx, req.(*QueryFetchContractRequest))
+func (m *QueryConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfigsResponse.Merge(m, src)
+}
+func (m *QueryConfigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfigsResponse.DiscardUnknown(m)
 }
 
-func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ContractAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractAll(ctx, req.(*QueryAllContractRequest))
+var xxx_messageInfo_QueryConfigsResponse proto.InternalMessageInfo
+
+func (m *QueryConfigsResponse) GetConfigs() []EffectiveConfig {
+	if m != nil {
+		return m.Configs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ActiveContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveContract(ctx, req.(*QueryActiveContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFetchContractGroupRequest is the request for fetching a contract group.
+type QueryFetchContractGroupRequest struct {
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func _Query_AllServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllServicesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllServices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/AllServices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllServices(ctx, req.(*QueryAllServicesRequest))
+func (m *QueryFetchContractGroupRequest) Reset()         { *m = QueryFetchContractGroupRequest{} }
+func (m *QueryFetchContractGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFetchContractGroupRequest) ProtoMessage()    {}
+func (*QueryFetchContractGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{22}
+}
+func (m *QueryFetchContractGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFetchContractGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFetchContractGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFetchContractGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFetchContractGroupRequest.Merge(m, src)
+}
+func (m *QueryFetchContractGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFetchContractGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFetchContractGroupRequest.DiscardUnknown(m)
 }
 
-func _Query_Service_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Service(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/Service",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Service(ctx, req.(*QueryServiceRequest))
+var xxx_messageInfo_QueryFetchContractGroupRequest proto.InternalMessageInfo
+
+func (m *QueryFetchContractGroupRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "arkeo.arkeo.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FetchProvider",
-			Handler:    _Query_FetchProvider_Handler,
-		},
-		{
-			MethodName: "ProviderAll",
-			Handler:    _Query_ProviderAll_Handler,
-		},
-		{
-			MethodName: "FetchContract",
-			Handler:    _Query_FetchContract_Handler,
-		},
-		{
-			MethodName: "ContractAll",
-			Handler:    _Query_ContractAll_Handler,
-		},
-		{
-			MethodName: "ActiveContract",
-			Handler:    _Query_ActiveContract_Handler,
-		},
-		{
-			MethodName: "AllServices",
-			Handler:    _Query_AllServices_Handler,
-		},
-		{
-			MethodName: "Service",
-			Handler:    _Query_Service_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "arkeo/arkeo/query.proto",
+// QueryFetchContractGroupResponse is the response for fetching a contract
+// group.
+type QueryFetchContractGroupResponse struct {
+	ContractGroup ContractGroup `protobuf:"bytes,1,opt,name=contract_group,json=contractGroup,proto3" json:"contract_group"`
 }
 
-func (m *QueryAllServicesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFetchContractGroupResponse) Reset()         { *m = QueryFetchContractGroupResponse{} }
+func (m *QueryFetchContractGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFetchContractGroupResponse) ProtoMessage()    {}
+func (*QueryFetchContractGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{23}
+}
+func (m *QueryFetchContractGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFetchContractGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFetchContractGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllServicesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFetchContractGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFetchContractGroupResponse.Merge(m, src)
 }
-
-func (m *QueryAllServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFetchContractGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFetchContractGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFetchContractGroupResponse.DiscardUnknown(m)
 }
 
-func (m *ServiceEnum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFetchContractGroupResponse proto.InternalMessageInfo
+
+func (m *QueryFetchContractGroupResponse) GetContractGroup() ContractGroup {
+	if m != nil {
+		return m.ContractGroup
 	}
-	return dAtA[:n], nil
+	return ContractGroup{}
 }
 
-func (m *ServiceEnum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsExpiringRequest is the request for the contracts expiring
+// from from_height up to and including to_height.
+type QueryContractsExpiringRequest struct {
+	FromHeight int64              `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64              `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ServiceEnum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceType) > 0 {
-		i -= len(m.ServiceType)
-		copy(dAtA[i:], m.ServiceType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+func (m *QueryContractsExpiringRequest) Reset()         { *m = QueryContractsExpiringRequest{} }
+func (m *QueryContractsExpiringRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsExpiringRequest) ProtoMessage()    {}
+func (*QueryContractsExpiringRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{24}
+}
+func (m *QueryContractsExpiringRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsExpiringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsExpiringRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryContractsExpiringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsExpiringRequest.Merge(m, src)
+}
+func (m *QueryContractsExpiringRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsExpiringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsExpiringRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsExpiringRequest proto.InternalMessageInfo
+
+func (m *QueryContractsExpiringRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *QueryContractsExpiringRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllServicesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsExpiringRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllServicesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsExpiringResponse lists the expiring contracts by height.
+type QueryContractsExpiringResponse struct {
+	Contract   []Contract          `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractsExpiringResponse) Reset()         { *m = QueryContractsExpiringResponse{} }
+func (m *QueryContractsExpiringResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsExpiringResponse) ProtoMessage()    {}
+func (*QueryContractsExpiringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{25}
+}
+func (m *QueryContractsExpiringResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsExpiringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsExpiringResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractsExpiringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsExpiringResponse.Merge(m, src)
 }
-
-func (m *QueryServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractsExpiringResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsExpiringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsExpiringResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractsExpiringResponse proto.InternalMessageInfo
+
+func (m *QueryContractsExpiringResponse) GetContract() []Contract {
+	if m != nil {
+		return m.Contract
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsExpiringResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("arkeo.arkeo.ConfigSource", ConfigSource_name, ConfigSource_value)
+	proto.RegisterType((*QueryAllServicesRequest)(nil), "arkeo.arkeo.QueryAllServicesRequest")
+	proto.RegisterType((*ServiceEnum)(nil), "arkeo.arkeo.ServiceEnum")
+	proto.RegisterType((*QueryAllServicesResponse)(nil), "arkeo.arkeo.QueryAllServicesResponse")
+	proto.RegisterType((*QueryServiceRequest)(nil), "arkeo.arkeo.QueryServiceRequest")
+	proto.RegisterType((*QueryServiceResponse)(nil), "arkeo.arkeo.QueryServiceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "arkeo.arkeo.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "arkeo.arkeo.QueryParamsResponse")
+	proto.RegisterType((*QueryFetchProviderRequest)(nil), "arkeo.arkeo.QueryFetchProviderRequest")
+	proto.RegisterType((*QueryFetchProviderResponse)(nil), "arkeo.arkeo.QueryFetchProviderResponse")
+	proto.RegisterType((*QueryAllProviderRequest)(nil), "arkeo.arkeo.QueryAllProviderRequest")
+	proto.RegisterType((*QueryAllProviderResponse)(nil), "arkeo.arkeo.QueryAllProviderResponse")
+	proto.RegisterType((*QueryFetchContractRequest)(nil), "arkeo.arkeo.QueryFetchContractRequest")
+	proto.RegisterType((*QueryFetchContractResponse)(nil), "arkeo.arkeo.QueryFetchContractResponse")
+	proto.RegisterType((*QueryAllContractRequest)(nil), "arkeo.arkeo.QueryAllContractRequest")
+	proto.RegisterType((*QueryAllContractResponse)(nil), "arkeo.arkeo.QueryAllContractResponse")
+	proto.RegisterType((*QueryActiveContractRequest)(nil), "arkeo.arkeo.QueryActiveContractRequest")
+	proto.RegisterType((*QueryActiveContractResponse)(nil), "arkeo.arkeo.QueryActiveContractResponse")
+	proto.RegisterType((*QueryProviderUnbondingsRequest)(nil), "arkeo.arkeo.QueryProviderUnbondingsRequest")
+	proto.RegisterType((*QueryProviderUnbondingsResponse)(nil), "arkeo.arkeo.QueryProviderUnbondingsResponse")
+	proto.RegisterType((*EffectiveConfig)(nil), "arkeo.arkeo.EffectiveConfig")
+	proto.RegisterType((*QueryConfigsRequest)(nil), "arkeo.arkeo.QueryConfigsRequest")
+	proto.RegisterType((*QueryConfigsResponse)(nil), "arkeo.arkeo.QueryConfigsResponse")
+	proto.RegisterType((*QueryFetchContractGroupRequest)(nil), "arkeo.arkeo.QueryFetchContractGroupRequest")
+	proto.RegisterType((*QueryFetchContractGroupResponse)(nil), "arkeo.arkeo.QueryFetchContractGroupResponse")
+	proto.RegisterType((*QueryContractsExpiringRequest)(nil), "arkeo.arkeo.QueryContractsExpiringRequest")
+	proto.RegisterType((*QueryContractsExpiringResponse)(nil), "arkeo.arkeo.QueryContractsExpiringResponse")
 }
 
-func (m *QueryServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("arkeo/arkeo/query.proto", fileDescriptor_4b28dca1d1dd051d) }
+
+var fileDescriptor_4b28dca1d1dd051d = []byte{
+	// 1355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6f, 0x13, 0x57,
+	0x10, 0xce, 0xda, 0x89, 0x13, 0xc6, 0x21, 0x84, 0x87, 0x01, 0x67, 0x03, 0x8e, 0xb3, 0x25, 0x24,
+	0x4a, 0x88, 0x57, 0x49, 0x5b, 0x21, 0x54, 0x7a, 0x08, 0x60, 0x42, 0x24, 0x50, 0xd2, 0x0d, 0x70,
+	0xe8, 0x05, 0xad, 0xed, 0xe7, 0xcd, 0x36, 0xf6, 0xee, 0xb2, 0xbb, 0x4e, 0x89, 0xac, 0xa8, 0x52,
+	0xa5, 0xaa, 0x97, 0x4a, 0xad, 0xd4, 0x53, 0x25, 0x4e, 0x48, 0x55, 0xff, 0x0a, 0x47, 0xa4, 0x5e,
+	0x7a, 0xaa, 0xaa, 0xa4, 0x7f, 0xa1, 0xf7, 0x6a, 0xdf, 0x9b, 0xb7, 0xde, 0x5d, 0xaf, 0xe3, 0x88,
+	0x72, 0xe8, 0x25, 0xec, 0x9b, 0xf7, 0xcd, 0x9b, 0x6f, 0x66, 0xde, 0xcc, 0x3c, 0x03, 0x57, 0x75,
+	0x77, 0x9f, 0xda, 0x2a, 0xff, 0xfb, 0xb2, 0x43, 0xdd, 0xc3, 0x8a, 0xe3, 0xda, 0xbe, 0x4d, 0xf2,
+	0x4c, 0x54, 0x61, 0x7f, 0xe5, 0x82, 0x61, 0x1b, 0x36, 0x93, 0xab, 0xc1, 0x17, 0x87, 0xc8, 0xd7,
+	0x0c, 0xdb, 0x36, 0x5a, 0x54, 0xd5, 0x1d, 0x53, 0xd5, 0x2d, 0xcb, 0xf6, 0x75, 0xdf, 0xb4, 0x2d,
+	0x0f, 0x77, 0x97, 0xeb, 0xb6, 0xd7, 0xb6, 0x3d, 0xb5, 0xa6, 0x7b, 0x94, 0x9f, 0xac, 0x1e, 0xac,
+	0xd5, 0xa8, 0xaf, 0xaf, 0xa9, 0x8e, 0x6e, 0x98, 0x16, 0x03, 0x23, 0xb6, 0x18, 0x65, 0xe1, 0xe8,
+	0xae, 0xde, 0xf6, 0xd2, 0x76, 0xf6, 0x29, 0x75, 0xa8, 0xcb, 0x77, 0x94, 0x19, 0xb8, 0xfa, 0x45,
+	0x70, 0xea, 0x46, 0xab, 0xb5, 0x4b, 0xdd, 0x03, 0xb3, 0x4e, 0x3d, 0x8d, 0xbe, 0xec, 0x50, 0xcf,
+	0x57, 0xbe, 0x93, 0x20, 0x8f, 0xb2, 0xaa, 0xd5, 0x69, 0x93, 0xeb, 0x00, 0x1e, 0x5f, 0xbe, 0x30,
+	0x1b, 0x45, 0xa9, 0x2c, 0x2d, 0x8d, 0x69, 0xe7, 0x50, 0xb2, 0xd5, 0x20, 0x04, 0x46, 0x2d, 0xbd,
+	0x4d, 0x8b, 0x99, 0xb2, 0xb4, 0x74, 0x4e, 0x63, 0xdf, 0xa4, 0x0c, 0xf9, 0x06, 0xf5, 0xea, 0xae,
+	0xe9, 0x04, 0x34, 0x8b, 0x59, 0xb6, 0x15, 0x15, 0x91, 0x79, 0x98, 0x14, 0x87, 0xfa, 0x87, 0x0e,
+	0x2d, 0x8e, 0x72, 0x08, 0xca, 0x9e, 0x1e, 0x3a, 0x54, 0xd9, 0x81, 0x62, 0x3f, 0x45, 0xcf, 0xb1,
+	0x2d, 0x8f, 0x92, 0x4f, 0x60, 0x02, 0xa1, 0x5e, 0x51, 0x2a, 0x67, 0x97, 0xf2, 0xeb, 0xc5, 0x4a,
+	0x24, 0xe4, 0x95, 0x08, 0x7f, 0x2d, 0x44, 0x2a, 0x77, 0xe0, 0x12, 0x3b, 0x11, 0x77, 0xd1, 0xe1,
+	0xd0, 0x03, 0x29, 0xe2, 0xc1, 0x14, 0x64, 0xcc, 0x06, 0xf3, 0x69, 0x54, 0xcb, 0x98, 0x0d, 0xe5,
+	0x31, 0x14, 0xe2, 0xaa, 0x21, 0x91, 0x71, 0x3c, 0x9e, 0xa9, 0xe7, 0xd7, 0x0b, 0x69, 0x3c, 0xee,
+	0x8d, 0xbe, 0xfd, 0x73, 0x6e, 0x44, 0x13, 0x50, 0xa5, 0x00, 0x84, 0x9d, 0xb6, 0xc3, 0x92, 0x25,
+	0x02, 0xff, 0x08, 0x2e, 0xc5, 0xa4, 0x68, 0x62, 0x0d, 0x72, 0x3c, 0xa9, 0x68, 0xe1, 0x52, 0xcc,
+	0x02, 0x07, 0xa3, 0x01, 0x04, 0x2a, 0x4f, 0x60, 0x86, 0x9d, 0xf4, 0x90, 0xfa, 0xf5, 0xbd, 0x1d,
+	0xd7, 0x3e, 0x30, 0x1b, 0xd4, 0x15, 0xee, 0x5e, 0x81, 0x9c, 0xd3, 0xa9, 0xed, 0xd3, 0x43, 0x74,
+	0x18, 0x57, 0xa4, 0xd8, 0x73, 0x85, 0xe7, 0x32, 0xa4, 0xfb, 0x0c, 0xe4, 0xb4, 0xe3, 0x90, 0xdf,
+	0x6d, 0x98, 0x70, 0x50, 0x86, 0x0c, 0x2f, 0xc7, 0x19, 0xe2, 0x26, 0x72, 0x0c, 0xc1, 0x4a, 0xb7,
+	0x77, 0x07, 0x93, 0x1c, 0x1f, 0x02, 0xf4, 0xae, 0x39, 0x9e, 0x7a, 0xb3, 0xc2, 0x6b, 0xa2, 0x12,
+	0xd4, 0x44, 0x85, 0x57, 0x1b, 0xd6, 0x44, 0x65, 0x47, 0x37, 0x44, 0x3a, 0xb5, 0x88, 0xe6, 0x29,
+	0x3e, 0xbd, 0x96, 0xa0, 0xd8, 0x6f, 0x3d, 0xd5, 0xa5, 0xec, 0x99, 0x5d, 0x22, 0x9b, 0x31, 0xde,
+	0x19, 0xc6, 0x7b, 0x71, 0x28, 0x6f, 0x6e, 0x35, 0x4a, 0x5c, 0xb9, 0x1b, 0xcd, 0xe0, 0x7d, 0xdb,
+	0xf2, 0x5d, 0xbd, 0xee, 0x8b, 0xe8, 0xcc, 0x41, 0xbe, 0x8e, 0x22, 0x51, 0x92, 0xa3, 0x1a, 0x08,
+	0xd1, 0x56, 0x23, 0x9e, 0xb0, 0x9e, 0x76, 0xcf, 0x3b, 0x81, 0x4d, 0x4d, 0x98, 0x50, 0x10, 0xde,
+	0x09, 0xb0, 0xf2, 0x8f, 0xd4, 0xcb, 0x58, 0x92, 0xd3, 0x87, 0xca, 0x98, 0x1c, 0x09, 0x3d, 0x4f,
+	0x59, 0x2f, 0xba, 0x57, 0x20, 0x57, 0x6f, 0x99, 0xd4, 0xf2, 0xb1, 0xa3, 0xe0, 0x2a, 0xd0, 0x69,
+	0xd0, 0x16, 0x35, 0x74, 0x5f, 0x34, 0x92, 0x70, 0x1d, 0xbd, 0x01, 0x63, 0xb1, 0x1b, 0x10, 0x94,
+	0x3d, 0x6b, 0x3d, 0x39, 0x5e, 0xf6, 0xc1, 0x37, 0x29, 0xc0, 0x98, 0xe7, 0x07, 0xc7, 0x8c, 0x33,
+	0x21, 0x5f, 0xc4, 0xee, 0xca, 0x90, 0x68, 0x66, 0xcf, 0x1c, 0xcd, 0x0f, 0x77, 0x57, 0x5a, 0x98,
+	0xed, 0x8d, 0xba, 0x6f, 0x1e, 0xd0, 0x64, 0x62, 0xe4, 0x44, 0x79, 0x46, 0x03, 0x3a, 0xb0, 0x3c,
+	0xd8, 0x8e, 0x43, 0xad, 0x40, 0x29, 0x8b, 0x3b, 0x7c, 0xa9, 0x3c, 0x87, 0xd9, 0x54, 0x6b, 0xff,
+	0xf5, 0x72, 0x69, 0x50, 0xe2, 0xdd, 0x0f, 0xc9, 0x3d, 0xb3, 0x6a, 0xb6, 0xd5, 0x30, 0x2d, 0xc3,
+	0x7b, 0xff, 0xc6, 0xf5, 0x15, 0xcc, 0x0d, 0x3c, 0x13, 0xf9, 0x6e, 0x02, 0x74, 0x42, 0x29, 0x26,
+	0x70, 0x3e, 0xb5, 0xd8, 0x43, 0xe5, 0x5d, 0x2a, 0xd8, 0x47, 0x54, 0x95, 0x6f, 0xe0, 0x42, 0xb5,
+	0xd9, 0xa4, 0x22, 0x2a, 0x4d, 0xd3, 0x20, 0x77, 0x20, 0x57, 0x67, 0x5f, 0x18, 0x89, 0xd9, 0x64,
+	0x24, 0x9a, 0xa6, 0xb1, 0x7d, 0x40, 0x5d, 0xd7, 0x6c, 0x88, 0x11, 0x81, 0x0a, 0x41, 0xd3, 0xf7,
+	0xec, 0x8e, 0x8b, 0x2e, 0x4d, 0xad, 0xcf, 0xa4, 0xa8, 0xee, 0x32, 0x80, 0x86, 0x40, 0xe5, 0x32,
+	0x8e, 0x0f, 0xbe, 0x19, 0x4e, 0x95, 0xa7, 0x50, 0x88, 0x8b, 0xd1, 0xf1, 0xbb, 0x30, 0xce, 0x6d,
+	0x09, 0xaf, 0xaf, 0xc5, 0x4c, 0x24, 0x7c, 0x11, 0x13, 0x0c, 0x55, 0x94, 0xcf, 0xa0, 0xd4, 0xdf,
+	0x61, 0x36, 0x5d, 0xbb, 0xe3, 0x88, 0x6c, 0xcd, 0xc0, 0x84, 0x11, 0xac, 0x7b, 0x1d, 0x6a, 0x9c,
+	0xad, 0xb7, 0x1a, 0x61, 0x5a, 0xd2, 0x94, 0xc3, 0xb4, 0x4c, 0x85, 0x2d, 0x8e, 0xa9, 0x61, 0x08,
+	0xe5, 0xd4, 0xcb, 0xc4, 0x74, 0x91, 0xe2, 0xf9, 0x7a, 0x54, 0xa8, 0xfc, 0x2a, 0xc1, 0x75, 0xe1,
+	0x3f, 0x13, 0x7b, 0xd5, 0x57, 0x8e, 0xe9, 0x9a, 0x96, 0x11, 0xe9, 0xa6, 0x4d, 0xd7, 0x6e, 0xbf,
+	0xd8, 0xa3, 0xa6, 0xb1, 0xc7, 0x2f, 0x6d, 0x56, 0x83, 0x40, 0xf4, 0x88, 0x49, 0xc8, 0x2c, 0x9c,
+	0xf3, 0x6d, 0xb1, 0x9d, 0x61, 0xdb, 0x13, 0xbe, 0x8d, 0x9b, 0xf1, 0xbe, 0x97, 0x7d, 0xdf, 0xbe,
+	0xa7, 0xbc, 0x91, 0xa0, 0x34, 0x88, 0xe7, 0xff, 0xa5, 0xd3, 0x2c, 0x2f, 0xc3, 0x64, 0xf4, 0xea,
+	0x91, 0x49, 0x98, 0xb8, 0xbf, 0xfd, 0x64, 0x67, 0xeb, 0x71, 0xf5, 0xc1, 0xf4, 0x48, 0xb0, 0xda,
+	0x7e, 0x5e, 0xd5, 0xb4, 0xad, 0x07, 0xd5, 0x69, 0x69, 0xfd, 0xb7, 0x49, 0x18, 0x63, 0x0e, 0x91,
+	0x1a, 0xe4, 0xf8, 0x2b, 0x85, 0xcc, 0xc5, 0xf8, 0xf6, 0x3f, 0x81, 0xe4, 0xf2, 0x60, 0x00, 0xa7,
+	0xa3, 0x5c, 0xfe, 0xf6, 0xf7, 0xbf, 0x7f, 0xce, 0x5c, 0x20, 0xe7, 0x63, 0xef, 0x5d, 0xf2, 0x83,
+	0x04, 0xe7, 0x63, 0xcf, 0x13, 0x72, 0xb3, 0xff, 0xa8, 0xb4, 0xe7, 0x90, 0xbc, 0x38, 0x14, 0x87,
+	0x96, 0x97, 0x99, 0xe5, 0x1b, 0x44, 0x11, 0x96, 0x11, 0xa0, 0x76, 0x79, 0x1f, 0x3a, 0x52, 0xbb,
+	0xd8, 0x77, 0x8e, 0x88, 0x0f, 0x79, 0xa1, 0xbf, 0xd1, 0x6a, 0x91, 0x1b, 0xfd, 0x36, 0xfa, 0x1f,
+	0x3d, 0xf2, 0xc2, 0x10, 0x14, 0xf2, 0x28, 0x32, 0x1e, 0x84, 0x4c, 0x27, 0x78, 0x78, 0xe4, 0x7b,
+	0x11, 0x04, 0x71, 0x13, 0x06, 0x06, 0x21, 0x31, 0x24, 0xe4, 0xc5, 0xa1, 0x38, 0x34, 0xbe, 0xc0,
+	0x8c, 0xcf, 0x91, 0xeb, 0x68, 0x5c, 0xdc, 0x31, 0xb5, 0x1b, 0x79, 0x91, 0x30, 0xff, 0x85, 0xea,
+	0x60, 0xff, 0x93, 0x24, 0x16, 0x86, 0xa0, 0x06, 0xf8, 0x2f, 0x0c, 0x7b, 0xe4, 0x8d, 0x04, 0x53,
+	0xf1, 0xb1, 0x44, 0x52, 0x1c, 0x4b, 0x1d, 0x93, 0xf2, 0xd2, 0x70, 0x20, 0xda, 0xff, 0x9c, 0xd9,
+	0xbf, 0x4d, 0x3e, 0x45, 0xfb, 0x3a, 0x83, 0xad, 0xf6, 0x22, 0x21, 0x12, 0x12, 0xb9, 0x10, 0x6a,
+	0x17, 0xc7, 0xe7, 0x11, 0xf1, 0x20, 0x1f, 0xf9, 0x45, 0x33, 0x20, 0x34, 0x89, 0xdf, 0x64, 0xf2,
+	0xc2, 0x10, 0x14, 0x52, 0xbb, 0xca, 0xa8, 0x5d, 0x24, 0x17, 0x90, 0x9a, 0x27, 0xac, 0x34, 0x61,
+	0x1c, 0xc1, 0x24, 0xa5, 0xc4, 0xe2, 0xbf, 0x87, 0xe4, 0xf9, 0x53, 0x10, 0x68, 0xe8, 0x0a, 0x33,
+	0x34, 0x4d, 0xa6, 0xe2, 0x86, 0xc8, 0x6b, 0x09, 0x48, 0xff, 0xb0, 0x25, 0x2b, 0x29, 0x65, 0x3d,
+	0x68, 0xcc, 0xcb, 0xb7, 0xce, 0x06, 0x46, 0x26, 0x2b, 0x8c, 0xc9, 0x02, 0xf9, 0x28, 0x51, 0x0d,
+	0xab, 0xbd, 0xd1, 0x1c, 0x16, 0x68, 0x10, 0x06, 0x1c, 0x83, 0x69, 0x61, 0x88, 0x0f, 0x4e, 0x79,
+	0xfe, 0x14, 0xc4, 0x80, 0x30, 0xe0, 0x74, 0x24, 0xbf, 0x48, 0x40, 0xfa, 0x87, 0x5b, 0x5a, 0x18,
+	0x06, 0xce, 0x4f, 0xf9, 0xd6, 0xd9, 0xc0, 0xc8, 0x64, 0x89, 0x31, 0x51, 0x48, 0x39, 0x51, 0x14,
+	0xab, 0x6c, 0x78, 0xaa, 0x5d, 0x31, 0x8a, 0x8f, 0xc8, 0x8f, 0x12, 0x5c, 0xec, 0x9b, 0x31, 0x64,
+	0x39, 0xd5, 0xd9, 0xd4, 0x81, 0x29, 0xaf, 0x9c, 0x09, 0x8b, 0xc4, 0xe6, 0x19, 0xb1, 0x59, 0x32,
+	0x93, 0xac, 0xd6, 0x55, 0x8a, 0xd0, 0x7b, 0xd5, 0xb7, 0xc7, 0x25, 0xe9, 0xdd, 0x71, 0x49, 0xfa,
+	0xeb, 0xb8, 0x24, 0xfd, 0x74, 0x52, 0x1a, 0x79, 0x77, 0x52, 0x1a, 0xf9, 0xe3, 0xa4, 0x34, 0xf2,
+	0xe5, 0x8a, 0x61, 0xfa, 0x7b, 0x9d, 0x5a, 0xa5, 0x6e, 0xb7, 0xb9, 0xba, 0x45, 0xfd, 0xaf, 0x6d,
+	0x77, 0x1f, 0xcf, 0x7a, 0x85, 0xff, 0x06, 0x4f, 0x77, 0xaf, 0x96, 0x63, 0xff, 0xb3, 0xf1, 0xf1,
+	0xbf, 0x03, 0x00, 0x67, 0x77, 0x92, 0x09, 0x95, 0x11, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FetchProvider queries a specific provider by pubkey and service.
+	FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error)
+	// ProviderAll queries for a list of all providers.
+	ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error)
+	// FetchContract queries a specific contract by contract_id.
+	FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error)
+	// ContractAll queries for a list of all contracts.
+	ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
+	// ActiveContract queries an active contract by provider, service, and
+	// spender.
+	ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error)
+	// Returns a list of all service enum values and descriptions.
+	AllServices(ctx context.Context, in *QueryAllServicesRequest, opts ...grpc.CallOption) (*QueryAllServicesResponse, error)
+	// Returns a single service by name or id.
+	Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error)
+	// ProviderUnbondings queries the pending bond withdrawals of a provider.
+	ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error)
+	// Configs queries the effective config values and their source.
+	Configs(ctx context.Context, in *QueryConfigsRequest, opts ...grpc.CallOption) (*QueryConfigsResponse, error)
+	// FetchContractGroup queries a contract group by group_id.
+	FetchContractGroup(ctx context.Context, in *QueryFetchContractGroupRequest, opts ...grpc.CallOption) (*QueryFetchContractGroupResponse, error)
+	// ContractsExpiring queries the contracts expiring within a range of
+	// heights.
+	ContractsExpiring(ctx context.Context, in *QueryContractsExpiringRequest, opts ...grpc.CallOption) (*QueryContractsExpiringResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error) {
+	out := new(QueryFetchProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryFetchProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error) {
+	out := new(QueryAllProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFetchProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryFetchProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFetchProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error) {
+	out := new(QueryFetchContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFetchProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFetchProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
+	out := new(QueryAllContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error) {
+	out := new(QueryActiveContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ActiveContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllServices(ctx context.Context, in *QueryAllServicesRequest, opts ...grpc.CallOption) (*QueryAllServicesResponse, error) {
+	out := new(QueryAllServicesResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/AllServices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error) {
+	out := new(QueryServiceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Service", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		for iNdEx := len(m.Provider) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Provider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error) {
+	out := new(QueryProviderUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFetchContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Configs(ctx context.Context, in *QueryConfigsRequest, opts ...grpc.CallOption) (*QueryConfigsResponse, error) {
+	out := new(QueryConfigsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Configs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFetchContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFetchContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) FetchContractGroup(ctx context.Context, in *QueryFetchContractGroupRequest, opts ...grpc.CallOption) (*QueryFetchContractGroupResponse, error) {
+	out := new(QueryFetchContractGroupResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContractGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFetchContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractsExpiring(ctx context.Context, in *QueryContractsExpiringRequest, opts ...grpc.CallOption) (*QueryContractsExpiringResponse, error) {
+	out := new(QueryContractsExpiringResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractsExpiring", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFetchContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FetchProvider queries a specific provider by pubkey and service.
+	FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error)
+	// ProviderAll queries for a list of all providers.
+	ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error)
+	// FetchContract queries a specific contract by contract_id.
+	FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error)
+	// ContractAll queries for a list of all contracts.
+	ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
+	// ActiveContract queries an active contract by provider, service, and
+	// spender.
+	ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error)
+	// Returns a list of all service enum values and descriptions.
+	AllServices(context.Context, *QueryAllServicesRequest) (*QueryAllServicesResponse, error)
+	// Returns a single service by name or id.
+	Service(context.Context, *QueryServiceRequest) (*QueryServiceResponse, error)
+	// ProviderUnbondings queries the pending bond withdrawals of a provider.
+	ProviderUnbondings(context.Context, *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error)
+	// Configs queries the effective config values and their source.
+	Configs(context.Context, *QueryConfigsRequest) (*QueryConfigsResponse, error)
+	// FetchContractGroup queries a contract group by group_id.
+	FetchContractGroup(context.Context, *QueryFetchContractGroupRequest) (*QueryFetchContractGroupResponse, error)
+	// ContractsExpiring queries the contracts expiring within a range of
+	// heights.
+	ContractsExpiring(context.Context, *QueryContractsExpiringRequest) (*QueryContractsExpiringResponse, error)
 }
 
-func (m *QueryFetchContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) FetchProvider(ctx context.Context, req *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchProvider not implemented")
 }
-
-func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ProviderAll(ctx context.Context, req *QueryAllProviderRequest) (*QueryAllProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderAll not implemented")
 }
-
-func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) FetchContract(ctx context.Context, req *QueryFetchContractRequest) (*QueryFetchContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchContract not implemented")
+}
+func (*UnimplementedQueryServer) ContractAll(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAll not implemented")
+}
+func (*UnimplementedQueryServer) ActiveContract(ctx context.Context, req *QueryActiveContractRequest) (*QueryActiveContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveContract not implemented")
+}
+func (*UnimplementedQueryServer) AllServices(ctx context.Context, req *QueryAllServicesRequest) (*QueryAllServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllServices not implemented")
+}
+func (*UnimplementedQueryServer) Service(ctx context.Context, req *QueryServiceRequest) (*QueryServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Service not implemented")
+}
+func (*UnimplementedQueryServer) ProviderUnbondings(ctx context.Context, req *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) Configs(ctx context.Context, req *QueryConfigsRequest) (*QueryConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configs not implemented")
+}
+func (*UnimplementedQueryServer) FetchContractGroup(ctx context.Context, req *QueryFetchContractGroupRequest) (*QueryFetchContractGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchContractGroup not implemented")
+}
+func (*UnimplementedQueryServer) ContractsExpiring(ctx context.Context, req *QueryContractsExpiringRequest) (*QueryContractsExpiringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsExpiring not implemented")
 }
 
-func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Contract) > 0 {
-		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FetchProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFetchProviderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Spender) > 0 {
-		i -= len(m.Spender)
-		copy(dAtA[i:], m.Spender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).FetchProvider(ctx, in)
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/FetchProvider",
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FetchProvider(ctx, req.(*QueryFetchProviderRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderAll(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ProviderAll",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryAllServicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderAll(ctx, req.(*QueryAllProviderRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ServiceEnum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFetchContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FetchContract(ctx, in)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/FetchContract",
 	}
-	l = len(m.ServiceType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FetchContract(ctx, req.(*QueryFetchContractRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllServicesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAll(ctx, req.(*QueryAllContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveContract(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ActiveContract",
 	}
-	return n
-}
-
-func (m *QueryServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveContract(ctx, req.(*QueryActiveContractRequest))
 	}
-	var l int
-	_ = l
-	l = m.Service.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).AllServices(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/AllServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllServices(ctx, req.(*QueryAllServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFetchProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Service_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Service(ctx, in)
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Service",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Service(ctx, req.(*QueryServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFetchProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ProviderUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderUnbondings(ctx, req.(*QueryProviderUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Configs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Configs(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Configs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Configs(ctx, req.(*QueryConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FetchContractGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFetchContractGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Provider) > 0 {
-		for _, e := range m.Provider {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FetchContractGroup(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/FetchContractGroup",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FetchContractGroup(ctx, req.(*QueryFetchContractGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFetchContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractsExpiring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsExpiringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovQuery(uint64(m.ContractId))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsExpiring(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractsExpiring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsExpiring(ctx, req.(*QueryContractsExpiringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFetchContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arkeo.arkeo.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FetchProvider",
+			Handler:    _Query_FetchProvider_Handler,
+		},
+		{
+			MethodName: "ProviderAll",
+			Handler:    _Query_ProviderAll_Handler,
+		},
+		{
+			MethodName: "FetchContract",
+			Handler:    _Query_FetchContract_Handler,
+		},
+		{
+			MethodName: "ContractAll",
+			Handler:    _Query_ContractAll_Handler,
+		},
+		{
+			MethodName: "ActiveContract",
+			Handler:    _Query_ActiveContract_Handler,
+		},
+		{
+			MethodName: "AllServices",
+			Handler:    _Query_AllServices_Handler,
+		},
+		{
+			MethodName: "Service",
+			Handler:    _Query_Service_Handler,
+		},
+		{
+			MethodName: "ProviderUnbondings",
+			Handler:    _Query_ProviderUnbondings_Handler,
+		},
+		{
+			MethodName: "Configs",
+			Handler:    _Query_Configs_Handler,
+		},
+		{
+			MethodName: "FetchContractGroup",
+			Handler:    _Query_FetchContractGroup_Handler,
+		},
+		{
+			MethodName: "ContractsExpiring",
+			Handler:    _Query_ContractsExpiring_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arkeo/arkeo/query.proto",
 }
 
-func (m *QueryAllContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllServicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllServicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ServiceEnum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *ServiceEnum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceEnum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllServicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllServicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		for iNdEx := len(m.Provider) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractGroup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsExpiringRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsExpiringRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsExpiringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsExpiringResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsExpiringResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsExpiringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllServicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ServiceEnum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllServicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Service.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFetchProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFetchProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		for _, e := range m.Provider {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFetchContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryFetchContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		for _, e := range m.Contract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EffectiveConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	return n
+}
+
+func (m *QueryConfigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFetchContractGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryFetchContractGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractGroup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractsExpiringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsExpiringResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Contract) > 0 {
 		for _, e := range m.Contract {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryActiveContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Spender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllServicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllServicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceEnum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceEnum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllServicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllServicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceEnum{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider, Provider{})
+			if err := m.Provider[len(m.Provider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryActiveContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAllServicesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,12 +4573,45 @@ func (m *QueryAllServicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllServicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2222,7 +4633,7 @@ func (m *QueryAllServicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2245,17 +4656,17 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceEnum: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceEnum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ServiceId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2265,14 +4676,31 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2300,11 +4728,11 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2332,11 +4760,11 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2364,7 +4792,103 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2387,7 +4911,7 @@ func (m *ServiceEnum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllServicesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,15 +4934,49 @@ func (m *QueryAllServicesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllServicesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = append(m.Contract, Contract{})
+			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2445,8 +5003,10 @@ func (m *QueryAllServicesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceEnum{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2471,7 +5031,7 @@ func (m *QueryAllServicesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2494,15 +5054,15 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2530,13 +5090,13 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2546,66 +5106,29 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2615,24 +5138,23 @@ func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2655,57 +5177,7 @@ func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2728,15 +5200,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2763,7 +5235,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2788,7 +5260,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2811,10 +5283,10 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderUnbondingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2838,104 +5310,22 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchProviderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2945,24 +5335,23 @@ func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2985,7 +5374,7 @@ func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3008,15 +5397,15 @@ func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderUnbondingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3043,10 +5432,8 @@ func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, ProviderUnbondingSet{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3071,7 +5458,7 @@ func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *EffectiveConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3094,15 +5481,15 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3129,16 +5516,15 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider, Provider{})
-			if err := m.Provider[len(m.Provider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3148,28 +5534,11 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Source |= ConfigSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3191,7 +5560,7 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConfigsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3214,31 +5583,12 @@ func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConfigsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3260,7 +5610,7 @@ func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConfigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3283,15 +5633,15 @@ func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConfigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3318,7 +5668,8 @@ func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Configs = append(m.Configs, EffectiveConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3343,7 +5694,7 @@ func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFetchContractGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3366,17 +5717,17 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFetchContractGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFetchContractGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3386,28 +5737,11 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3429,7 +5763,7 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFetchContractGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,49 +5786,15 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFetchContractGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFetchContractGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = append(m.Contract, Contract{})
-			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3521,10 +5821,7 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3549,7 +5846,7 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsExpiringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,17 +5869,17 @@ func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsExpiringRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsExpiringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			var stringLen uint64
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3592,29 +5889,16 @@ func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			var stringLen uint64
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3624,29 +5908,16 @@ func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3656,23 +5927,27 @@ func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spender = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3695,7 +5970,7 @@ func (m *QueryActiveContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsExpiringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3718,10 +5993,10 @@ func (m *QueryActiveContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsExpiringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsExpiringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3753,7 +6028,44 @@ func (m *QueryActiveContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contract = append(m.Contract, Contract{})
+			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
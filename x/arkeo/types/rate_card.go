@@ -0,0 +1,145 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+)
+
+// maximum number of tiers or discounts in each schedule of a rate card
+const maxRateCardEntries = 10
+
+// Validate checks the tiers and discounts of the rate card are sorted by
+// strictly increasing thresholds and priced
+func (card RateCard) Validate() error {
+	if err := validateRateTiers("pay-as-you-go", card.PayAsYouGoTiers); err != nil {
+		return err
+	}
+	if err := validateRateTiers("subscription", card.SubscriptionTiers); err != nil {
+		return err
+	}
+
+	if len(card.DurationDiscounts) > maxRateCardEntries {
+		return errors.Wrapf(ErrInvalidModProviderRateCard, "too many duration discounts (%d/%d)", len(card.DurationDiscounts), maxRateCardEntries)
+	}
+	last := int64(0)
+	for _, discount := range card.DurationDiscounts {
+		if discount.MinDuration <= last {
+			return errors.Wrapf(ErrInvalidModProviderRateCard, "duration discounts must have increasing positive min durations (%d)", discount.MinDuration)
+		}
+		if discount.DiscountBps <= 0 || discount.DiscountBps >= configs.MaxBasisPoints {
+			return errors.Wrapf(ErrInvalidModProviderRateCard, "duration discount must be between 0 and %d basis points (%d)", configs.MaxBasisPoints, discount.DiscountBps)
+		}
+		last = discount.MinDuration
+	}
+	return nil
+}
+
+func validateRateTiers(name string, tiers []RateTier) error {
+	if len(tiers) > maxRateCardEntries {
+		return errors.Wrapf(ErrInvalidModProviderRateCard, "too many %s tiers (%d/%d)", name, len(tiers), maxRateCardEntries)
+	}
+	last := int64(0)
+	for _, tier := range tiers {
+		if tier.Threshold <= last {
+			return errors.Wrapf(ErrInvalidModProviderRateCard, "%s tiers must have increasing positive thresholds (%d)", name, tier.Threshold)
+		}
+		rate := cosmos.NewCoins(tier.Rate...)
+		if err := rate.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid %s tier rate", name)
+		}
+		if rate.Empty() || !rate.IsAllPositive() {
+			return errors.Wrapf(ErrInvalidModProviderRateCard, "%s tier rates must be positive", name)
+		}
+		last = tier.Threshold
+	}
+	return nil
+}
+
+// GetDurationDiscount returns the discount, in basis points, of a
+// subscription of the given duration
+func (card RateCard) GetDurationDiscount(duration int64) int64 {
+	discount := int64(0)
+	for _, d := range card.DurationDiscounts {
+		if d.MinDuration > duration {
+			break
+		}
+		discount = d.DiscountBps
+	}
+	return discount
+}
+
+// PayAsYouGoTiersOf returns the pay-as-you-go tiers priced in the given
+// denom, with their rate restricted to it
+func (card RateCard) PayAsYouGoTiersOf(denom string) []RateTier {
+	var tiers []RateTier
+	for _, tier := range card.PayAsYouGoTiers {
+		rate := cosmos.NewCoins(tier.Rate...).AmountOf(denom)
+		if rate.IsZero() {
+			continue
+		}
+		tiers = append(tiers, RateTier{
+			Threshold: tier.Threshold,
+			Rate:      cosmos.NewCoins(cosmos.NewCoin(denom, rate)),
+		})
+	}
+	return tiers
+}
+
+// SubscriptionRateOf returns the rate per block and query per minute of a
+// subscription with the given queries per minute. The highest tier reached
+// applies, the flat subscription rate otherwise.
+func (provider Provider) SubscriptionRateOf(queriesPerMinute int64, denom string) cosmos.Int {
+	rate := cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(denom)
+	for _, tier := range provider.RateCard.SubscriptionTiers {
+		if tier.Threshold > queriesPerMinute {
+			break
+		}
+		if tierRate := cosmos.NewCoins(tier.Rate...).AmountOf(denom); !tierRate.IsZero() {
+			rate = tierRate
+		}
+	}
+	return rate
+}
+
+// SubscriptionCost returns the cost of a subscription of the given blocks,
+// rate and queries per minute, less the discount in basis points
+func SubscriptionCost(rate cosmos.Int, blocks, queriesPerMinute, discountBps int64) cosmos.Int {
+	cost := rate.MulRaw(blocks).MulRaw(queriesPerMinute)
+	if discountBps <= 0 {
+		return cost
+	}
+	return cost.MulRaw(configs.MaxBasisPoints - discountBps).QuoRaw(configs.MaxBasisPoints)
+}
+
+// SubscriptionCost returns the cost of the first given blocks of the
+// subscription
+func (contract Contract) SubscriptionCost(blocks int64) cosmos.Int {
+	return SubscriptionCost(contract.Rate.Amount, blocks, contract.QueriesPerMinute, contract.DiscountBps)
+}
+
+// PayAsYouGoCost returns the cost of the first given queries of the
+// contract. Queries past the threshold of a tier are charged its rate,
+// the ones below the first tier the contract rate.
+func (contract Contract) PayAsYouGoCost(queries int64) cosmos.Int {
+	cost := cosmos.ZeroInt()
+	rate := contract.Rate.Amount
+	from := int64(0)
+	for _, tier := range contract.PayAsYouGoTiers {
+		if tier.Threshold >= queries {
+			break
+		}
+		tierRate := cosmos.NewCoins(tier.Rate...).AmountOf(contract.Rate.Denom)
+		if tierRate.IsZero() {
+			continue
+		}
+		cost = cost.Add(rate.MulRaw(tier.Threshold - from))
+		rate = tierRate
+		from = tier.Threshold
+	}
+	if queries > from {
+		cost = cost.Add(rate.MulRaw(queries - from))
+	}
+	return cost
+}
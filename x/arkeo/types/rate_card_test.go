@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestRateCardValidate(t *testing.T) {
+	card := RateCard{
+		PayAsYouGoTiers: []RateTier{
+			{Threshold: 1000, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 8))},
+			{Threshold: 10000, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 5))},
+		},
+		SubscriptionTiers: []RateTier{
+			{Threshold: 10, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 12))},
+		},
+		DurationDiscounts: []DurationDiscount{
+			{MinDuration: 1000, DiscountBps: 500},
+			{MinDuration: 5000, DiscountBps: 1000},
+		},
+	}
+	require.NoError(t, card.Validate())
+	require.NoError(t, RateCard{}.Validate())
+
+	// thresholds must increase
+	bad := card
+	bad.PayAsYouGoTiers = []RateTier{card.PayAsYouGoTiers[1], card.PayAsYouGoTiers[0]}
+	require.ErrorIs(t, bad.Validate(), ErrInvalidModProviderRateCard)
+	bad.PayAsYouGoTiers = []RateTier{{Threshold: 0, Rate: card.PayAsYouGoTiers[0].Rate}}
+	require.ErrorIs(t, bad.Validate(), ErrInvalidModProviderRateCard)
+
+	// tiers must be priced
+	bad = card
+	bad.SubscriptionTiers = []RateTier{{Threshold: 10}}
+	require.ErrorIs(t, bad.Validate(), ErrInvalidModProviderRateCard)
+
+	// discounts are in range
+	bad = card
+	bad.DurationDiscounts = []DurationDiscount{{MinDuration: 1000, DiscountBps: 10000}}
+	require.ErrorIs(t, bad.Validate(), ErrInvalidModProviderRateCard)
+	bad.DurationDiscounts = []DurationDiscount{{MinDuration: 1000, DiscountBps: 500}, {MinDuration: 1000, DiscountBps: 600}}
+	require.ErrorIs(t, bad.Validate(), ErrInvalidModProviderRateCard)
+
+	// checked by mod provider
+	pubkey := GetRandomPubKey()
+	acct, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15))
+	msg := NewMsgModProvider(acct, pubkey, "btc-mainnet-fullnode", "", 0, ProviderStatus_ONLINE, 10, 100, rates, rates, 0)
+	msg.RateCard = bad
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidModProviderRateCard)
+	msg.RateCard = card
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestRateCardPricing(t *testing.T) {
+	provider := NewProvider(GetRandomPubKey(), 0)
+	provider.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15), cosmos.NewInt64Coin("uatom", 3))
+	provider.RateCard = RateCard{
+		PayAsYouGoTiers: []RateTier{
+			{Threshold: 100, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 8), cosmos.NewInt64Coin("uatom", 1))},
+			{Threshold: 1000, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 5))},
+		},
+		SubscriptionTiers: []RateTier{
+			{Threshold: 10, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 12))},
+			{Threshold: 100, Rate: cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 10))},
+		},
+		DurationDiscounts: []DurationDiscount{
+			{MinDuration: 1000, DiscountBps: 500},
+			{MinDuration: 5000, DiscountBps: 1000},
+		},
+	}
+
+	// subscription tiers by queries per minute
+	require.Equal(t, int64(15), provider.SubscriptionRateOf(9, "uarkeo").Int64())
+	require.Equal(t, int64(12), provider.SubscriptionRateOf(10, "uarkeo").Int64())
+	require.Equal(t, int64(10), provider.SubscriptionRateOf(500, "uarkeo").Int64())
+	require.Equal(t, int64(3), provider.SubscriptionRateOf(500, "uatom").Int64())
+	require.True(t, provider.SubscriptionRateOf(500, "bogus").IsZero())
+
+	// duration discounts
+	require.Equal(t, int64(0), provider.RateCard.GetDurationDiscount(999))
+	require.Equal(t, int64(500), provider.RateCard.GetDurationDiscount(1000))
+	require.Equal(t, int64(1000), provider.RateCard.GetDurationDiscount(8000))
+	require.Equal(t, int64(1000*10*15), SubscriptionCost(cosmos.NewInt(15), 1000, 10, 0).Int64())
+	require.Equal(t, int64(1000*10*15*95/100), SubscriptionCost(cosmos.NewInt(15), 1000, 10, 500).Int64())
+
+	// pay-as-you-go volume tiers
+	contract := NewContract(provider.PubKey, provider.Service, GetRandomPubKey())
+	contract.Type = ContractType_PAY_AS_YOU_GO
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+	contract.PayAsYouGoTiers = provider.RateCard.PayAsYouGoTiersOf("uarkeo")
+	require.Len(t, contract.PayAsYouGoTiers, 2)
+	require.True(t, contract.PayAsYouGoCost(0).IsZero())
+	require.Equal(t, int64(50*10), contract.PayAsYouGoCost(50).Int64())
+	require.Equal(t, int64(100*10), contract.PayAsYouGoCost(100).Int64())
+	require.Equal(t, int64(100*10+8), contract.PayAsYouGoCost(101).Int64())
+	require.Equal(t, int64(100*10+900*8+500*5), contract.PayAsYouGoCost(1500).Int64())
+
+	// tiers of other denoms are left out
+	contract.Rate = cosmos.NewInt64Coin("uatom", 2)
+	contract.PayAsYouGoTiers = provider.RateCard.PayAsYouGoTiersOf("uatom")
+	require.Len(t, contract.PayAsYouGoTiers, 1)
+	require.Equal(t, int64(100*2+1400*1), contract.PayAsYouGoCost(1500).Int64())
+}
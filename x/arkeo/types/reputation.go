@@ -0,0 +1,79 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+// MaxAttestationScore is the score of a provider serving every request within
+// the latency target, in basis points
+const MaxAttestationScore = 10_000
+
+// AttestationScore returns the score of an attestation in basis points, the
+// availability reduced in proportion to the latency exceeding the target
+func AttestationScore(availability uint32, latencyMs, latencyTarget int64) int64 {
+	score := int64(availability)
+	if score > MaxAttestationScore {
+		score = MaxAttestationScore
+	}
+	if latencyTarget > 0 && latencyMs > latencyTarget {
+		score = score * latencyTarget / latencyMs
+	}
+	return score
+}
+
+// GetWeight returns the weight of the attestations, providers stored before
+// reputations existed have none
+func (rep ProviderReputation) GetWeight() cosmos.Int {
+	if rep.Weight.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	return rep.Weight
+}
+
+// DecayedWeight returns the weight of the attestations at the given height, it
+// halves every half life blocks since the last attestation
+func (rep ProviderReputation) DecayedWeight(height, halfLife int64) cosmos.Int {
+	return rep.Decay(height, halfLife).GetWeight()
+}
+
+// Decay returns the reputation at the given height, its score and weight
+// halve every half life blocks since the last update so providers that are no
+// longer attested lose their standing
+func (rep ProviderReputation) Decay(height, halfLife int64) ProviderReputation {
+	weight := rep.GetWeight()
+	if halfLife <= 0 || height <= rep.LastUpdate {
+		rep.Weight = weight
+		return rep
+	}
+	halvings := (height - rep.LastUpdate) / halfLife
+	rep.LastUpdate += halvings * halfLife
+	if halvings >= int64(weight.BigInt().BitLen()) {
+		rep.Weight = cosmos.ZeroInt()
+	} else {
+		rep.Weight = cosmos.NewIntFromBigInt(new(big.Int).Rsh(weight.BigInt(), uint(halvings)))
+	}
+	if halvings >= 63 {
+		rep.Score = 0
+	} else {
+		rep.Score >>= uint(halvings)
+	}
+	return rep
+}
+
+// Attest adds an attestation of the given score and weight at the given
+// height to the reputation
+func (rep *ProviderReputation) Attest(height, halfLife, score int64, weight cosmos.Int) {
+	decayed := rep.Decay(height, halfLife)
+	previous := decayed.GetWeight()
+	total := previous.Add(weight)
+	if total.IsPositive() {
+		rep.Score = previous.MulRaw(decayed.Score).Add(weight.MulRaw(score)).Quo(total).Int64()
+	} else {
+		rep.Score = score
+	}
+	rep.Weight = total
+	rep.LastUpdate = height
+	rep.Attestations++
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestAttestationScore(t *testing.T) {
+	require.Equal(t, int64(9000), AttestationScore(9000, 200, 500))
+	require.Equal(t, int64(4500), AttestationScore(9000, 1000, 500))
+	require.Equal(t, int64(MaxAttestationScore), AttestationScore(20000, 0, 500))
+	require.Equal(t, int64(9000), AttestationScore(9000, 1000, 0))
+}
+
+func TestProviderReputation(t *testing.T) {
+	var rep ProviderReputation
+	require.True(t, rep.GetWeight().IsZero())
+
+	rep.Attest(100, 1000, 8000, cosmos.NewInt(100))
+	require.Equal(t, int64(8000), rep.Score)
+	require.Equal(t, int64(100), rep.GetWeight().Int64())
+	require.Equal(t, uint64(1), rep.Attestations)
+
+	// equal weights average out
+	rep.Attest(100, 1000, 6000, cosmos.NewInt(100))
+	require.Equal(t, int64(7000), rep.Score)
+	require.Equal(t, int64(200), rep.GetWeight().Int64())
+
+	// the weight halves every half life
+	require.Equal(t, int64(200), rep.DecayedWeight(1099, 1000).Int64())
+	require.Equal(t, int64(100), rep.DecayedWeight(1100, 1000).Int64())
+	require.Equal(t, int64(50), rep.DecayedWeight(2100, 1000).Int64())
+	require.True(t, rep.DecayedWeight(100_000, 1000).IsZero())
+
+	// the score decays along with the weight
+	require.Equal(t, int64(7000), rep.Decay(1099, 1000).Score)
+	decayed := rep.Decay(2150, 1000)
+	require.Equal(t, int64(1750), decayed.Score)
+	require.Equal(t, int64(2100), decayed.LastUpdate)
+	require.Equal(t, decayed, decayed.Decay(2150, 1000))
+	require.Zero(t, rep.Decay(100_000, 1000).Score)
+
+	// recent attestations outweigh older ones
+	rep.Attest(2100, 1000, 10000, cosmos.NewInt(150))
+	require.Equal(t, int64(7937), rep.Score)
+	require.Equal(t, int64(200), rep.GetWeight().Int64())
+	require.Equal(t, int64(2100), rep.LastUpdate)
+}
@@ -13,7 +13,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
-	grpc1 "github.com/gogo/protobuf/grpc"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -146,6 +146,7 @@ type MsgModProvider struct {
 	SubscriptionRate    []types.Coin                                `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate"`
 	PayAsYouGoRate      []types.Coin                                `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate"`
 	SettlementDuration  int64                                       `protobuf:"varint,11,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	RateCard            RateCard                                    `protobuf:"bytes,12,opt,name=rate_card,json=rateCard,proto3" json:"rate_card"`
 }
 
 func (m *MsgModProvider) Reset()         { *m = MsgModProvider{} }
@@ -258,6 +259,13 @@ func (m *MsgModProvider) GetSettlementDuration() int64 {
 	return 0
 }
 
+func (m *MsgModProvider) GetRateCard() RateCard {
+	if m != nil {
+		return m.RateCard
+	}
+	return RateCard{}
+}
+
 // MsgModProviderResponse is the response for MsgModProvider.
 type MsgModProviderResponse struct {
 }
@@ -309,6 +317,13 @@ type MsgOpenContract struct {
 	SettlementDuration int64                 `protobuf:"varint,10,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
 	Authorization      ContractAuthorization `protobuf:"varint,11,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
 	QueriesPerMinute   int64                 `protobuf:"varint,12,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// renew the subscription with the same terms when it expires
+	AutoRenew bool `protobuf:"varint,13,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// limits what the spender of a pay-as-you-go contract can use
+	DelegateLimit DelegateLimit `protobuf:"bytes,14,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	// account paying the open cost and the deposit instead of the client, it
+	// has to grant the client MsgOpenContract through authz
+	Payer string `protobuf:"bytes,15,opt,name=payer,proto3" json:"payer,omitempty"`
 }
 
 func (m *MsgOpenContract) Reset()         { *m = MsgOpenContract{} }
@@ -421,6 +436,27 @@ func (m *MsgOpenContract) GetQueriesPerMinute() int64 {
 	return 0
 }
 
+func (m *MsgOpenContract) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *MsgOpenContract) GetDelegateLimit() DelegateLimit {
+	if m != nil {
+		return m.DelegateLimit
+	}
+	return DelegateLimit{}
+}
+
+func (m *MsgOpenContract) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
 // MsgOpenContractResponse is the response for MsgOpenContract.
 type MsgOpenContractResponse struct {
 }
@@ -1091,1606 +1127,6610 @@ func (m *MsgRemoveServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveServiceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBondProvider)(nil), "arkeo.arkeo.MsgBondProvider")
-	proto.RegisterType((*MsgBondProviderResponse)(nil), "arkeo.arkeo.MsgBondProviderResponse")
-	proto.RegisterType((*MsgModProvider)(nil), "arkeo.arkeo.MsgModProvider")
-	proto.RegisterType((*MsgModProviderResponse)(nil), "arkeo.arkeo.MsgModProviderResponse")
-	proto.RegisterType((*MsgOpenContract)(nil), "arkeo.arkeo.MsgOpenContract")
-	proto.RegisterType((*MsgOpenContractResponse)(nil), "arkeo.arkeo.MsgOpenContractResponse")
-	proto.RegisterType((*MsgCloseContract)(nil), "arkeo.arkeo.MsgCloseContract")
-	proto.RegisterType((*MsgCloseContractResponse)(nil), "arkeo.arkeo.MsgCloseContractResponse")
-	proto.RegisterType((*MsgClaimContractIncome)(nil), "arkeo.arkeo.MsgClaimContractIncome")
-	proto.RegisterType((*MsgClaimContractIncomeResponse)(nil), "arkeo.arkeo.MsgClaimContractIncomeResponse")
-	proto.RegisterType((*MsgSetVersion)(nil), "arkeo.arkeo.MsgSetVersion")
-	proto.RegisterType((*MsgSetVersionResponse)(nil), "arkeo.arkeo.MsgSetVersionResponse")
-	proto.RegisterType((*MsgRegisterService)(nil), "arkeo.arkeo.MsgRegisterService")
-	proto.RegisterType((*MsgRegisterServiceResponse)(nil), "arkeo.arkeo.MsgRegisterServiceResponse")
-	proto.RegisterType((*MsgUpdateService)(nil), "arkeo.arkeo.MsgUpdateService")
-	proto.RegisterType((*MsgUpdateServiceResponse)(nil), "arkeo.arkeo.MsgUpdateServiceResponse")
-	proto.RegisterType((*MsgRemoveService)(nil), "arkeo.arkeo.MsgRemoveService")
-	proto.RegisterType((*MsgRemoveServiceResponse)(nil), "arkeo.arkeo.MsgRemoveServiceResponse")
+// ResponseReceipt is a provider signature over the hash of the response it
+// served for a contract nonce, and over the client signature of the nonce.
+type ResponseReceipt struct {
+	ContractId   uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce        int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ResponseHash []byte `protobuf:"bytes,3,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
+	Signature    []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// arkauth signature of the nonce by the client, empty for open contracts
+	ClientSignature []byte `protobuf:"bytes,5,opt,name=client_signature,json=clientSignature,proto3" json:"client_signature,omitempty"`
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/tx.proto", fileDescriptor_a12700967a3e4015) }
-
-var fileDescriptor_a12700967a3e4015 = []byte{
-	// 1255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0x14, 0x47,
-	0x10, 0xf6, 0x78, 0xd7, 0x36, 0xae, 0xb5, 0x8d, 0x69, 0x0c, 0x8c, 0x07, 0xb3, 0xde, 0x2c, 0xa0,
-	0x58, 0xfc, 0xec, 0xca, 0x46, 0xb9, 0xf8, 0x90, 0x08, 0x3b, 0x88, 0x58, 0x66, 0x03, 0x1a, 0x87,
-	0x48, 0x49, 0x0e, 0xab, 0xde, 0x99, 0xd6, 0xd0, 0xb2, 0x67, 0x7a, 0xd2, 0xdd, 0xeb, 0x78, 0x73,
-	0x8a, 0x72, 0x4c, 0x14, 0x29, 0x4f, 0x90, 0x47, 0x88, 0x38, 0xf0, 0x10, 0x1c, 0x11, 0x27, 0x94,
-	0x03, 0x42, 0x10, 0x89, 0x4b, 0x9e, 0x20, 0xa7, 0x68, 0x7a, 0x7a, 0x66, 0xe7, 0x67, 0x81, 0x05,
-	0x94, 0x48, 0xb9, 0x8c, 0xb7, 0xea, 0xab, 0xaa, 0xae, 0xaa, 0xf9, 0xaa, 0x7a, 0x0c, 0x4b, 0x98,
-	0xef, 0x13, 0xd6, 0x8e, 0x9f, 0xf2, 0xa8, 0x15, 0x72, 0x26, 0x19, 0xaa, 0x29, 0xb9, 0xa5, 0x9e,
-	0xd6, 0x92, 0xc7, 0x3c, 0xa6, 0xf4, 0xed, 0xe8, 0x57, 0x6c, 0x62, 0x2d, 0x3b, 0x4c, 0xf8, 0x4c,
-	0x74, 0x63, 0x20, 0x16, 0x34, 0x54, 0x8f, 0xa5, 0x76, 0x0f, 0x0b, 0xd2, 0x3e, 0x5c, 0xef, 0x11,
-	0x89, 0xd7, 0xdb, 0x0e, 0xa3, 0x81, 0xc6, 0xcd, 0xec, 0x99, 0xfb, 0x84, 0x84, 0x84, 0x6b, 0xe4,
-	0x8c, 0xf6, 0xf4, 0x85, 0xd7, 0x3e, 0x5c, 0x8f, 0xfe, 0x68, 0xe0, 0x04, 0xf6, 0x69, 0xc0, 0xda,
-	0xea, 0x19, 0xab, 0x9a, 0x7f, 0x19, 0x70, 0xbc, 0x23, 0xbc, 0x2d, 0x16, 0xb8, 0x77, 0x38, 0x3b,
-	0xa4, 0x2e, 0xe1, 0x68, 0x03, 0x66, 0x1c, 0x4e, 0xb0, 0x64, 0xdc, 0x34, 0x1a, 0xc6, 0xda, 0xec,
-	0x96, 0xf9, 0xf8, 0xc1, 0xd5, 0x25, 0x9d, 0xdc, 0x75, 0xd7, 0xe5, 0x44, 0x88, 0x3d, 0xc9, 0x69,
-	0xe0, 0xd9, 0x89, 0x21, 0xb2, 0xe0, 0x58, 0xa8, 0xfd, 0xcd, 0xc9, 0xc8, 0xc9, 0x4e, 0x65, 0x64,
-	0xc2, 0x8c, 0x20, 0xfc, 0x90, 0x3a, 0xc4, 0xac, 0x28, 0x28, 0x11, 0xd1, 0x27, 0x50, 0xed, 0xb1,
-	0xc0, 0x35, 0xab, 0xea, 0x98, 0xcb, 0x0f, 0x9f, 0xae, 0x4e, 0xfc, 0xf1, 0x74, 0xf5, 0x54, 0x7c,
-	0x94, 0x70, 0xf7, 0x5b, 0x94, 0xb5, 0x7d, 0x2c, 0xef, 0xb5, 0x76, 0x02, 0xf9, 0xf8, 0xc1, 0x55,
-	0xd0, 0x39, 0xec, 0x04, 0xd2, 0x56, 0x8e, 0x9b, 0xad, 0x1f, 0x5f, 0xde, 0xbf, 0x94, 0x24, 0xf1,
-	0xd3, 0xcb, 0xfb, 0x97, 0xce, 0xc5, 0xfd, 0x38, 0xd2, 0x7d, 0x29, 0x94, 0xd6, 0x5c, 0x86, 0x33,
-	0x05, 0x95, 0x4d, 0x44, 0xc8, 0x02, 0x41, 0x9a, 0xbf, 0x4c, 0xc1, 0x42, 0x47, 0x78, 0x1d, 0xf6,
-	0x7e, 0x8d, 0xd8, 0x2d, 0x34, 0x62, 0x6e, 0xab, 0xfd, 0xf7, 0xd3, 0xd5, 0xcb, 0x1e, 0x95, 0xf7,
-	0xfa, 0xbd, 0x96, 0xc3, 0xfc, 0x38, 0xb3, 0x80, 0xc8, 0xef, 0x18, 0xdf, 0xd7, 0x69, 0x3a, 0xcc,
-	0xf7, 0x59, 0xd0, 0xba, 0xd3, 0xef, 0xed, 0x92, 0xc1, 0x58, 0x9d, 0xfb, 0x00, 0xe6, 0x7c, 0x22,
-	0xb1, 0x8b, 0x25, 0xee, 0xf6, 0x39, 0x8d, 0x3b, 0x68, 0xd7, 0x12, 0xdd, 0x5d, 0x4e, 0xd1, 0x45,
-	0x58, 0x48, 0x4d, 0x02, 0x16, 0x38, 0xc4, 0x9c, 0x6a, 0x18, 0x6b, 0x55, 0x7b, 0x3e, 0xd1, 0x7e,
-	0x1e, 0x29, 0xd1, 0x35, 0x98, 0x16, 0x12, 0xcb, 0xbe, 0x30, 0xa7, 0x1b, 0xc6, 0xda, 0xc2, 0xc6,
-	0xd9, 0x56, 0x86, 0xb6, 0xad, 0xa4, 0x17, 0x7b, 0xca, 0xc4, 0xd6, 0xa6, 0x68, 0x03, 0x4e, 0xf9,
-	0x34, 0xe8, 0x3a, 0x2c, 0x90, 0x1c, 0x3b, 0xb2, 0xeb, 0xf6, 0x39, 0x96, 0x94, 0x05, 0xe6, 0x4c,
-	0xc3, 0x58, 0xab, 0xd8, 0x27, 0x7d, 0x1a, 0x6c, 0x6b, 0xec, 0x53, 0x0d, 0x29, 0x1f, 0x7c, 0x34,
-	0xc2, 0xe7, 0x98, 0xf6, 0xc1, 0x47, 0x25, 0x9f, 0x5b, 0x70, 0x42, 0xf4, 0x7b, 0xc2, 0xe1, 0x34,
-	0x8c, 0xe4, 0x2e, 0xc7, 0x92, 0x98, 0xb3, 0x8d, 0xca, 0x5a, 0x6d, 0x63, 0xb9, 0xa5, 0x5f, 0x44,
-	0x34, 0x20, 0x2d, 0x3d, 0x20, 0xad, 0x6d, 0x46, 0x83, 0xad, 0x6a, 0x44, 0x24, 0x7b, 0x31, 0xeb,
-	0x69, 0x63, 0x49, 0xd0, 0x2e, 0xa0, 0x10, 0x0f, 0xba, 0x58, 0x74, 0x07, 0xac, 0xdf, 0xf5, 0x58,
-	0x1c, 0x0e, 0xc6, 0x0b, 0xb7, 0x10, 0xe2, 0xc1, 0x75, 0xf1, 0x15, 0xeb, 0xdf, 0x64, 0x2a, 0x58,
-	0x1b, 0x4e, 0x0a, 0x22, 0xe5, 0x01, 0xf1, 0x49, 0x90, 0x29, 0xa6, 0xa6, 0x8a, 0x41, 0x43, 0x28,
-	0xa9, 0x65, 0xf3, 0x6a, 0x91, 0xab, 0x2b, 0x25, 0xae, 0x66, 0xc8, 0xd7, 0x34, 0xe1, 0x74, 0x5e,
-	0x93, 0x32, 0xf5, 0x59, 0x55, 0xcd, 0xec, 0xed, 0x90, 0xa4, 0x4d, 0xfe, 0x0f, 0x67, 0xf6, 0x34,
-	0x4c, 0x3b, 0x07, 0x94, 0x04, 0x52, 0x73, 0x4e, 0x4b, 0x51, 0x34, 0x97, 0x1c, 0x10, 0x2f, 0x6a,
-	0xe9, 0x54, 0x1c, 0x2d, 0x91, 0xd1, 0xc7, 0x30, 0x9f, 0xbe, 0x76, 0x39, 0x08, 0x89, 0xa6, 0xda,
-	0x72, 0x8e, 0x6a, 0x49, 0x2d, 0x5f, 0x0c, 0x42, 0x62, 0xcf, 0x39, 0x19, 0x49, 0xc5, 0xce, 0x33,
-	0x2c, 0x95, 0xd1, 0x35, 0xa8, 0xaa, 0xd7, 0x18, 0xb1, 0x68, 0x8c, 0xd7, 0xa8, 0x8c, 0xd1, 0x0d,
-	0x98, 0x71, 0x49, 0xc8, 0x04, 0x95, 0xe6, 0xec, 0xdb, 0xef, 0x9e, 0xc4, 0xf7, 0x55, 0x1c, 0x80,
-	0x57, 0x71, 0x00, 0x7d, 0x06, 0xf3, 0xb8, 0x2f, 0xef, 0x31, 0x4e, 0xbf, 0x1f, 0xd2, 0x65, 0x61,
-	0xa3, 0x39, 0xb2, 0x11, 0xd7, 0xb3, 0x96, 0x76, 0xde, 0x11, 0x5d, 0x01, 0xf4, 0x6d, 0x9f, 0x70,
-	0x4a, 0x44, 0x37, 0x24, 0xbc, 0xeb, 0xd3, 0xa0, 0x2f, 0x89, 0x39, 0xa7, 0x4e, 0x5e, 0xd4, 0xc8,
-	0x1d, 0xc2, 0x3b, 0x4a, 0x3f, 0xce, 0x9e, 0xcc, 0xd2, 0x49, 0xef, 0xc9, 0xac, 0x2a, 0x65, 0xdf,
-	0xef, 0x93, 0xb0, 0xd8, 0x11, 0xde, 0xf6, 0x01, 0x13, 0xe4, 0xbd, 0xe8, 0xb7, 0x0a, 0xb5, 0x94,
-	0x14, 0xd4, 0x55, 0x0c, 0xac, 0xda, 0x90, 0xa8, 0x76, 0x5c, 0x74, 0x33, 0x65, 0x5a, 0xe5, 0xdd,
-	0x16, 0x69, 0x42, 0xcd, 0xdd, 0x0c, 0x35, 0xab, 0xef, 0xb8, 0x93, 0x93, 0x00, 0x9b, 0xed, 0x62,
-	0x2b, 0xeb, 0xa5, 0x56, 0xe6, 0x7a, 0xd3, 0xb4, 0xc0, 0x2c, 0xea, 0xd2, 0x66, 0x3e, 0x31, 0xd4,
-	0x94, 0x6f, 0x1f, 0x60, 0xea, 0x27, 0xe0, 0x4e, 0xe0, 0x30, 0x9f, 0xfc, 0x3b, 0x2d, 0x5d, 0x81,
-	0x59, 0x41, 0xbd, 0x00, 0xcb, 0x3e, 0xd7, 0xad, 0xb0, 0x87, 0x0a, 0xb4, 0x04, 0x53, 0xc3, 0x8b,
-	0xa2, 0x62, 0xc7, 0xc2, 0xe6, 0x47, 0xc5, 0x82, 0x2f, 0x8c, 0x28, 0xb8, 0x94, 0x7f, 0xb3, 0x01,
-	0xf5, 0xd1, 0x48, 0x5a, 0xfc, 0xcf, 0x06, 0xcc, 0x77, 0x84, 0xb7, 0x47, 0xe4, 0x97, 0x84, 0x8b,
-	0xf8, 0x8a, 0x78, 0xfb, 0x9a, 0x4d, 0x98, 0x39, 0x8c, 0xdd, 0x55, 0xbd, 0x15, 0x3b, 0x11, 0x37,
-	0xaf, 0x14, 0x13, 0x3f, 0x5b, 0x4a, 0x7c, 0x78, 0x76, 0xf3, 0x0c, 0x9c, 0xca, 0x29, 0xd2, 0x34,
-	0xff, 0x34, 0x00, 0x75, 0x84, 0x67, 0x13, 0x8f, 0x0a, 0x49, 0xf8, 0x9e, 0xde, 0x83, 0xef, 0x92,
-	0xeb, 0x02, 0x4c, 0xa6, 0xaf, 0x65, 0x92, 0xba, 0x08, 0x41, 0x35, 0xc0, 0x7e, 0xb2, 0x62, 0xd5,
-	0x6f, 0xd4, 0x80, 0x9a, 0x4b, 0xd2, 0x7b, 0x2b, 0xb9, 0xd8, 0x33, 0xaa, 0xe8, 0xee, 0xd7, 0xcb,
-	0x38, 0x5e, 0xa6, 0xf1, 0xb6, 0xad, 0x69, 0x5d, 0xb4, 0x30, 0x37, 0xd7, 0x8b, 0xa5, 0x37, 0x4a,
-	0xa5, 0x17, 0xea, 0x69, 0xae, 0x80, 0x55, 0xd6, 0x0e, 0xef, 0x1c, 0x43, 0x4d, 0xfd, 0xdd, 0xd0,
-	0xc5, 0x92, 0xfc, 0x2f, 0x5a, 0x30, 0xc6, 0x9c, 0xe6, 0xaa, 0xd1, 0x73, 0x9a, 0xd3, 0x65, 0xa9,
-	0xba, 0xa8, 0xba, 0xe3, 0xb3, 0xc3, 0xf7, 0x2a, 0x3f, 0x29, 0x77, 0x72, 0x58, 0xee, 0x38, 0x99,
-	0xe6, 0x0e, 0xd6, 0x99, 0xe6, 0x74, 0x49, 0xa6, 0x1b, 0xbf, 0x4d, 0x43, 0xa5, 0x23, 0x3c, 0x64,
-	0xc3, 0x5c, 0xee, 0xa3, 0x7e, 0x25, 0x77, 0xc5, 0x14, 0x3e, 0x82, 0xad, 0x0b, 0xaf, 0x43, 0x93,
-	0xd8, 0xe8, 0x36, 0xd4, 0xb2, 0x9f, 0xc7, 0x67, 0x8b, 0x4e, 0x19, 0xd0, 0x3a, 0xff, 0x1a, 0x30,
-	0x0d, 0x68, 0xc3, 0x5c, 0xee, 0x2b, 0xa6, 0x94, 0x64, 0x16, 0x2d, 0x27, 0x39, 0xea, 0x7e, 0x42,
-	0x77, 0x61, 0x3e, 0x7f, 0x37, 0x9d, 0x2b, 0xba, 0xe5, 0x60, 0xeb, 0xe2, 0x6b, 0xe1, 0x34, 0xac,
-	0x07, 0x27, 0x47, 0x6d, 0xe9, 0xf3, 0x65, 0xef, 0x92, 0x91, 0x75, 0x79, 0x0c, 0xa3, 0xf4, 0xa0,
-	0x5b, 0x00, 0x99, 0x8d, 0x68, 0x15, 0x5d, 0x87, 0x98, 0xd5, 0x7c, 0x35, 0x96, 0x46, 0xfb, 0x06,
-	0x8e, 0x17, 0x17, 0xd7, 0x6a, 0xd1, 0xad, 0x60, 0x60, 0x7d, 0xf8, 0x06, 0x83, 0x6c, 0xab, 0xf3,
-	0x0b, 0xa1, 0xd4, 0xea, 0x1c, 0x5c, 0x6e, 0xf5, 0xc8, 0x61, 0x8b, 0xc2, 0xe6, 0x07, 0xed, 0x5c,
-	0x39, 0xa1, 0x0c, 0x5c, 0x0e, 0x3b, 0x72, 0x32, 0xac, 0xa9, 0x1f, 0x5e, 0xde, 0xbf, 0x64, 0x6c,
-	0xdd, 0x78, 0xf8, 0xbc, 0x6e, 0x3c, 0x7a, 0x5e, 0x37, 0x9e, 0x3d, 0xaf, 0x1b, 0xbf, 0xbe, 0xa8,
-	0x4f, 0x3c, 0x7a, 0x51, 0x9f, 0x78, 0xf2, 0xa2, 0x3e, 0xf1, 0xf5, 0x1b, 0x3e, 0x08, 0x92, 0x71,
-	0x8c, 0x16, 0x8e, 0xe8, 0x4d, 0xab, 0xff, 0x9f, 0xaf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x31,
-	0x2d, 0xa2, 0x21, 0xfb, 0x0f, 0x00, 0x00,
+func (m *ResponseReceipt) Reset()         { *m = ResponseReceipt{} }
+func (m *ResponseReceipt) String() string { return proto.CompactTextString(m) }
+func (*ResponseReceipt) ProtoMessage()    {}
+func (*ResponseReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// BondProvider creates or updates a provider bond.
-	BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error)
-	// ModProvider modifies provider details.
-	ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error)
-	// OpenContract opens a contract between a client and provider.
-	OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error)
-	// CloseContract closes an existing contract.
-	CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error)
-	// ClaimContractIncome allows a provider to claim contract income.
-	ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error)
-	// SetVersion sets the chain version.
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
-	// RegisterService registers a new provider service in the on-chain registry.
-	RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error)
-	// UpdateService updates an existing service in the registry.
-	UpdateService(ctx context.Context, in *MsgUpdateService, opts ...grpc.CallOption) (*MsgUpdateServiceResponse, error)
-	// RemoveService removes an existing service from the registry.
-	RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error)
+func (m *ResponseReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *ResponseReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *ResponseReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseReceipt.Merge(m, src)
+}
+func (m *ResponseReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseReceipt.DiscardUnknown(m)
 }
 
-func (c *msgClient) BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error) {
-	out := new(MsgBondProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/BondProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResponseReceipt proto.InternalMessageInfo
+
+func (m *ResponseReceipt) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error) {
-	out := new(MsgModProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ModProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResponseReceipt) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error) {
-	out := new(MsgOpenContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/OpenContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResponseReceipt) GetResponseHash() []byte {
+	if m != nil {
+		return m.ResponseHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error) {
-	out := new(MsgCloseContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/CloseContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResponseReceipt) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error) {
-	out := new(MsgClaimContractIncomeResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncome", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResponseReceipt) GetClientSignature() []byte {
+	if m != nil {
+		return m.ClientSignature
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error) {
-	out := new(MsgSetVersionResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSubmitEvidence submits two conflicting receipts signed by a provider.
+type MsgSubmitEvidence struct {
+	Creator string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	First   ResponseReceipt `protobuf:"bytes,2,opt,name=first,proto3" json:"first"`
+	Second  ResponseReceipt `protobuf:"bytes,3,opt,name=second,proto3" json:"second"`
 }
 
-func (c *msgClient) RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error) {
-	out := new(MsgRegisterServiceResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RegisterService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitEvidence) Reset()         { *m = MsgSubmitEvidence{} }
+func (m *MsgSubmitEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEvidence) ProtoMessage()    {}
+func (*MsgSubmitEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{19}
+}
+func (m *MsgSubmitEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEvidence.Merge(m, src)
+}
+func (m *MsgSubmitEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEvidence.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateService(ctx context.Context, in *MsgUpdateService, opts ...grpc.CallOption) (*MsgUpdateServiceResponse, error) {
-	out := new(MsgUpdateServiceResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/UpdateService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitEvidence) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error) {
-	out := new(MsgRemoveServiceResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RemoveService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitEvidence) GetFirst() ResponseReceipt {
+	if m != nil {
+		return m.First
 	}
-	return out, nil
+	return ResponseReceipt{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// BondProvider creates or updates a provider bond.
-	BondProvider(context.Context, *MsgBondProvider) (*MsgBondProviderResponse, error)
-	// ModProvider modifies provider details.
-	ModProvider(context.Context, *MsgModProvider) (*MsgModProviderResponse, error)
-	// OpenContract opens a contract between a client and provider.
-	OpenContract(context.Context, *MsgOpenContract) (*MsgOpenContractResponse, error)
-	// CloseContract closes an existing contract.
-	CloseContract(context.Context, *MsgCloseContract) (*MsgCloseContractResponse, error)
-	// ClaimContractIncome allows a provider to claim contract income.
-	ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error)
-	// SetVersion sets the chain version.
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
-	// RegisterService registers a new provider service in the on-chain registry.
-	RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error)
-	// UpdateService updates an existing service in the registry.
-	UpdateService(context.Context, *MsgUpdateService) (*MsgUpdateServiceResponse, error)
-	// RemoveService removes an existing service from the registry.
-	RemoveService(context.Context, *MsgRemoveService) (*MsgRemoveServiceResponse, error)
+func (m *MsgSubmitEvidence) GetSecond() ResponseReceipt {
+	if m != nil {
+		return m.Second
+	}
+	return ResponseReceipt{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSubmitEvidenceResponse is the response for MsgSubmitEvidence.
+type MsgSubmitEvidenceResponse struct {
 }
 
-func (*UnimplementedMsgServer) BondProvider(ctx context.Context, req *MsgBondProvider) (*MsgBondProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BondProvider not implemented")
+func (m *MsgSubmitEvidenceResponse) Reset()         { *m = MsgSubmitEvidenceResponse{} }
+func (m *MsgSubmitEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{20}
 }
-func (*UnimplementedMsgServer) ModProvider(ctx context.Context, req *MsgModProvider) (*MsgModProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModProvider not implemented")
+func (m *MsgSubmitEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) OpenContract(ctx context.Context, req *MsgOpenContract) (*MsgOpenContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenContract not implemented")
+func (m *MsgSubmitEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CloseContract(ctx context.Context, req *MsgCloseContract) (*MsgCloseContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseContract not implemented")
+func (m *MsgSubmitEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClaimContractIncome(ctx context.Context, req *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncome not implemented")
+func (m *MsgSubmitEvidenceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgSetVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
+func (m *MsgSubmitEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RegisterService(ctx context.Context, req *MsgRegisterService) (*MsgRegisterServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
+
+var xxx_messageInfo_MsgSubmitEvidenceResponse proto.InternalMessageInfo
+
+// MsgSubmitUnsignedNonceEvidence submits a receipt signed by a provider whose
+// client signature is not a signature of the nonce by the client.
+type MsgSubmitUnsignedNonceEvidence struct {
+	Creator string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receipt ResponseReceipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt"`
 }
-func (*UnimplementedMsgServer) UpdateService(ctx context.Context, req *MsgUpdateService) (*MsgUpdateServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+
+func (m *MsgSubmitUnsignedNonceEvidence) Reset()         { *m = MsgSubmitUnsignedNonceEvidence{} }
+func (m *MsgSubmitUnsignedNonceEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitUnsignedNonceEvidence) ProtoMessage()    {}
+func (*MsgSubmitUnsignedNonceEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{21}
 }
-func (*UnimplementedMsgServer) RemoveService(ctx context.Context, req *MsgRemoveService) (*MsgRemoveServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+func (m *MsgSubmitUnsignedNonceEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSubmitUnsignedNonceEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitUnsignedNonceEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitUnsignedNonceEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitUnsignedNonceEvidence.Merge(m, src)
+}
+func (m *MsgSubmitUnsignedNonceEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitUnsignedNonceEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitUnsignedNonceEvidence.DiscardUnknown(m)
 }
 
-func _Msg_BondProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBondProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BondProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/BondProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BondProvider(ctx, req.(*MsgBondProvider))
+var xxx_messageInfo_MsgSubmitUnsignedNonceEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitUnsignedNonceEvidence) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ModProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/ModProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModProvider(ctx, req.(*MsgModProvider))
+func (m *MsgSubmitUnsignedNonceEvidence) GetReceipt() ResponseReceipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ResponseReceipt{}
 }
 
-func _Msg_OpenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/OpenContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenContract(ctx, req.(*MsgOpenContract))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSubmitUnsignedNonceEvidenceResponse is the response for
+// MsgSubmitUnsignedNonceEvidence.
+type MsgSubmitUnsignedNonceEvidenceResponse struct {
 }
 
-func _Msg_CloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/CloseContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseContract(ctx, req.(*MsgCloseContract))
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) Reset() {
+	*m = MsgSubmitUnsignedNonceEvidenceResponse{}
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitUnsignedNonceEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitUnsignedNonceEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{22}
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitUnsignedNonceEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitUnsignedNonceEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitUnsignedNonceEvidenceResponse.DiscardUnknown(m)
 }
 
-func _Msg_ClaimContractIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimContractIncome)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimContractIncome(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncome",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimContractIncome(ctx, req.(*MsgClaimContractIncome))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSubmitUnsignedNonceEvidenceResponse proto.InternalMessageInfo
+
+// MsgSetConfig sets or removes a config override.
+type MsgSetConfig struct {
+	// authority setting the config
+	Creator string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Config  ConfigOverride `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// removes the override of config.name, the value of config is ignored
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetVersion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/SetVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetVersion(ctx, req.(*MsgSetVersion))
+func (m *MsgSetConfig) Reset()         { *m = MsgSetConfig{} }
+func (m *MsgSetConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConfig) ProtoMessage()    {}
+func (*MsgSetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{23}
+}
+func (m *MsgSetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConfig.Merge(m, src)
+}
+func (m *MsgSetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConfig.DiscardUnknown(m)
 }
 
-func _Msg_RegisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/RegisterService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterService(ctx, req.(*MsgRegisterService))
+var xxx_messageInfo_MsgSetConfig proto.InternalMessageInfo
+
+func (m *MsgSetConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/UpdateService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateService(ctx, req.(*MsgUpdateService))
+func (m *MsgSetConfig) GetConfig() ConfigOverride {
+	if m != nil {
+		return m.Config
 	}
-	return interceptor(ctx, in, info, handler)
+	return ConfigOverride{}
 }
 
-func _Msg_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/RemoveService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveService(ctx, req.(*MsgRemoveService))
+func (m *MsgSetConfig) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "arkeo.arkeo.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BondProvider",
-			Handler:    _Msg_BondProvider_Handler,
-		},
-		{
-			MethodName: "ModProvider",
-			Handler:    _Msg_ModProvider_Handler,
-		},
-		{
-			MethodName: "OpenContract",
-			Handler:    _Msg_OpenContract_Handler,
-		},
-		{
-			MethodName: "CloseContract",
-			Handler:    _Msg_CloseContract_Handler,
-		},
-		{
-			MethodName: "ClaimContractIncome",
-			Handler:    _Msg_ClaimContractIncome_Handler,
-		},
-		{
-			MethodName: "SetVersion",
-			Handler:    _Msg_SetVersion_Handler,
-		},
-		{
-			MethodName: "RegisterService",
-			Handler:    _Msg_RegisterService_Handler,
-		},
-		{
-			MethodName: "UpdateService",
-			Handler:    _Msg_UpdateService_Handler,
-		},
-		{
-			MethodName: "RemoveService",
-			Handler:    _Msg_RemoveService_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "arkeo/arkeo/tx.proto",
+// MsgSetConfigResponse is the response for MsgSetConfig.
+type MsgSetConfigResponse struct {
 }
 
-func (m *MsgBondProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetConfigResponse) Reset()         { *m = MsgSetConfigResponse{} }
+func (m *MsgSetConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConfigResponse) ProtoMessage()    {}
+func (*MsgSetConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{24}
+}
+func (m *MsgSetConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConfigResponse.Merge(m, src)
+}
+func (m *MsgSetConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBondProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetConfigResponse proto.InternalMessageInfo
+
+// MsgTopUpContract adds deposit and/or duration to an open contract, keeping
+// its id.
+type MsgTopUpContract struct {
+	// client of the contract
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// deposit added to the contract, for subscriptions it must pay for the
+	// added duration at the contract rate
+	Deposit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	// blocks added to the contract duration
+	Duration int64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *MsgBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgTopUpContract) Reset()         { *m = MsgTopUpContract{} }
+func (m *MsgTopUpContract) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpContract) ProtoMessage()    {}
+func (*MsgTopUpContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{25}
+}
+func (m *MsgTopUpContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTopUpContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpContract.Merge(m, src)
+}
+func (m *MsgTopUpContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpContract proto.InternalMessageInfo
+
+func (m *MsgTopUpContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgBondProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgBondProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTopUpContract) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-func (m *MsgBondProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgTopUpContractResponse is the response for MsgTopUpContract.
+type MsgTopUpContractResponse struct {
 }
 
-func (m *MsgModProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpContractResponse) Reset()         { *m = MsgTopUpContractResponse{} }
+func (m *MsgTopUpContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpContractResponse) ProtoMessage()    {}
+func (*MsgTopUpContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{26}
+}
+func (m *MsgTopUpContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTopUpContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpContractResponse.Merge(m, src)
+}
+func (m *MsgTopUpContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgModProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTopUpContractResponse proto.InternalMessageInfo
+
+// MsgSetContractRenewal sets whether a subscription is renewed when it
+// expires. Escrow is added to the renewal escrow of the contract, which pays
+// for renewals before the client account. Cancelling refunds the escrow.
+type MsgSetContractRenewal struct {
+	// client of the contract
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	AutoRenew  bool   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// added to the renewal escrow, in the denom of the contract rate
+	Escrow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=escrow,proto3,customtype=cosmossdk.io/math.Int" json:"escrow"`
 }
 
-func (m *MsgModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+func (m *MsgSetContractRenewal) Reset()         { *m = MsgSetContractRenewal{} }
+func (m *MsgSetContractRenewal) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractRenewal) ProtoMessage()    {}
+func (*MsgSetContractRenewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{27}
+}
+func (m *MsgSetContractRenewal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractRenewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractRenewal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetContractRenewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractRenewal.Merge(m, src)
+}
+func (m *MsgSetContractRenewal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractRenewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractRenewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractRenewal proto.InternalMessageInfo
+
+func (m *MsgSetContractRenewal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgModProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractRenewal) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgModProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetContractRenewal) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
 }
 
-func (m *MsgModProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetContractRenewalResponse is the response for MsgSetContractRenewal.
+type MsgSetContractRenewalResponse struct {
 }
 
-func (m *MsgOpenContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractRenewalResponse) Reset()         { *m = MsgSetContractRenewalResponse{} }
+func (m *MsgSetContractRenewalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractRenewalResponse) ProtoMessage()    {}
+func (*MsgSetContractRenewalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{28}
+}
+func (m *MsgSetContractRenewalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractRenewalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractRenewalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetContractRenewalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractRenewalResponse.Merge(m, src)
+}
+func (m *MsgSetContractRenewalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractRenewalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractRenewalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetContractRenewalResponse proto.InternalMessageInfo
+
+// MsgOpenContractGroup opens a pay-as-you-go contract with each of the
+// providers, all of them paid from the deposit.
+type MsgOpenContractGroup struct {
+	Creator   string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Providers []string `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	Service   string   `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client    string   `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate  string   `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Duration  int64    `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// pay-as-you-go rate of each provider, in the same order and denom
+	Rates            []types.Coin          `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates"`
+	Deposit          cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	Authorization    ContractAuthorization `protobuf:"varint,9,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute int64                 `protobuf:"varint,10,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
 }
 
-func (m *MsgOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Authorization != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgOpenContractGroup) Reset()         { *m = MsgOpenContractGroup{} }
+func (m *MsgOpenContractGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenContractGroup) ProtoMessage()    {}
+func (*MsgOpenContractGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{29}
+}
+func (m *MsgOpenContractGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenContractGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenContractGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.Duration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ContractType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractType))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *MsgOpenContractGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenContractGroup.Merge(m, src)
+}
+func (m *MsgOpenContractGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenContractGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenContractGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenContractGroup proto.InternalMessageInfo
+
+func (m *MsgOpenContractGroup) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgOpenContractGroup) GetProviders() []string {
+	if m != nil {
+		return m.Providers
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *MsgOpenContractGroup) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgOpenContractGroup) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgOpenContractGroup) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgOpenContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenContractGroup) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgOpenContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenContractGroup) GetRates() []types.Coin {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
 }
 
-func (m *MsgOpenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgOpenContractGroup) GetAuthorization() ContractAuthorization {
+	if m != nil {
+		return m.Authorization
+	}
+	return ContractAuthorization_STRICT
 }
 
-func (m *MsgCloseContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenContractGroup) GetQueriesPerMinute() int64 {
+	if m != nil {
+		return m.QueriesPerMinute
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCloseContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOpenContractGroupResponse is the response for MsgOpenContractGroup.
+type MsgOpenContractGroupResponse struct {
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (m *MsgCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgOpenContractGroupResponse) Reset()         { *m = MsgOpenContractGroupResponse{} }
+func (m *MsgOpenContractGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenContractGroupResponse) ProtoMessage()    {}
+func (*MsgOpenContractGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{30}
+}
+func (m *MsgOpenContractGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenContractGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenContractGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOpenContractGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenContractGroupResponse.Merge(m, src)
+}
+func (m *MsgOpenContractGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenContractGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenContractGroupResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOpenContractGroupResponse proto.InternalMessageInfo
+
+func (m *MsgOpenContractGroupResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCloseContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetDelegateLimit sets the spend limit of the spender of a pay-as-you-go
+// contract.
+type MsgSetDelegateLimit struct {
+	// payer of the contract
+	Creator       string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId    uint64        `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	DelegateLimit DelegateLimit `protobuf:"bytes,3,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
 }
 
-func (m *MsgCloseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetDelegateLimit) Reset()         { *m = MsgSetDelegateLimit{} }
+func (m *MsgSetDelegateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegateLimit) ProtoMessage()    {}
+func (*MsgSetDelegateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{31}
+}
+func (m *MsgSetDelegateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegateLimit.Merge(m, src)
+}
+func (m *MsgSetDelegateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegateLimit.DiscardUnknown(m)
 }
 
-func (m *MsgClaimContractIncome) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDelegateLimit proto.InternalMessageInfo
+
+func (m *MsgSetDelegateLimit) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimContractIncome) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDelegateLimit) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgClaimContractIncome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x28
+func (m *MsgSetDelegateLimit) GetDelegateLimit() DelegateLimit {
+	if m != nil {
+		return m.DelegateLimit
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return DelegateLimit{}
 }
 
-func (m *MsgClaimContractIncomeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSetDelegateLimitResponse is the response for MsgSetDelegateLimit.
+type MsgSetDelegateLimitResponse struct {
 }
 
-func (m *MsgClaimContractIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDelegateLimitResponse) Reset()         { *m = MsgSetDelegateLimitResponse{} }
+func (m *MsgSetDelegateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegateLimitResponse) ProtoMessage()    {}
+func (*MsgSetDelegateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{32}
+}
+func (m *MsgSetDelegateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetDelegateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimContractIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetDelegateLimitResponse proto.InternalMessageInfo
+
+// MsgSubmitAttestation attests the latency and availability of a provider,
+// either by the client of a contract with it or by a registered prober.
+type MsgSubmitAttestation struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// contract of the client with the provider, zero for a prober
+	ContractId uint64 `protobuf:"varint,4,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// share of the requests served, in basis points
+	Availability uint32 `protobuf:"varint,5,opt,name=availability,proto3" json:"availability,omitempty"`
+	LatencyMs    int64  `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
 }
 
-func (m *MsgSetVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitAttestation) Reset()         { *m = MsgSubmitAttestation{} }
+func (m *MsgSubmitAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestation) ProtoMessage()    {}
+func (*MsgSubmitAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{33}
+}
+func (m *MsgSubmitAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestation.Merge(m, src)
+}
+func (m *MsgSubmitAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestation.DiscardUnknown(m)
 }
 
-func (m *MsgSetVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgSubmitAttestation proto.InternalMessageInfo
+
+func (m *MsgSubmitAttestation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSubmitAttestation) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitAttestation) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAttestation) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgSetVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitAttestation) GetAvailability() uint32 {
+	if m != nil {
+		return m.Availability
+	}
+	return 0
 }
 
-func (m *MsgRegisterService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitAttestation) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRegisterService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSubmitAttestationResponse is the response for MsgSubmitAttestation.
+type MsgSubmitAttestationResponse struct {
 }
 
-func (m *MsgRegisterService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceType) > 0 {
-		i -= len(m.ServiceType)
-		copy(dAtA[i:], m.ServiceType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitAttestationResponse) Reset()         { *m = MsgSubmitAttestationResponse{} }
+func (m *MsgSubmitAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationResponse) ProtoMessage()    {}
+func (*MsgSubmitAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{34}
+}
+func (m *MsgSubmitAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationResponse.Merge(m, src)
+}
+func (m *MsgSubmitAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitAttestationResponse proto.InternalMessageInfo
+
+// MsgSetProber registers or removes a prober.
+type MsgSetProber struct {
+	// module authority
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Prober  string `protobuf:"bytes,2,opt,name=prober,proto3" json:"prober,omitempty"`
+	Remove  bool   `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgSetProber) Reset()         { *m = MsgSetProber{} }
+func (m *MsgSetProber) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProber) ProtoMessage()    {}
+func (*MsgSetProber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{35}
+}
+func (m *MsgSetProber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetProber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProber.Merge(m, src)
+}
+func (m *MsgSetProber) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProber) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProber.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetProber proto.InternalMessageInfo
+
+func (m *MsgSetProber) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgRegisterServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetProber) GetProber() string {
+	if m != nil {
+		return m.Prober
+	}
+	return ""
 }
 
-func (m *MsgUpdateService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProber) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetProberResponse is the response for MsgSetProber.
+type MsgSetProberResponse struct {
 }
 
-func (m *MsgUpdateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceType) > 0 {
-		i -= len(m.ServiceType)
-		copy(dAtA[i:], m.ServiceType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgSetProberResponse) Reset()         { *m = MsgSetProberResponse{} }
+func (m *MsgSetProberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProberResponse) ProtoMessage()    {}
+func (*MsgSetProberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{36}
+}
+func (m *MsgSetProberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgSetProberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProberResponse.Merge(m, src)
+}
+func (m *MsgSetProberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProberResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBondProvider)(nil), "arkeo.arkeo.MsgBondProvider")
+	proto.RegisterType((*MsgBondProviderResponse)(nil), "arkeo.arkeo.MsgBondProviderResponse")
+	proto.RegisterType((*MsgModProvider)(nil), "arkeo.arkeo.MsgModProvider")
+	proto.RegisterType((*MsgModProviderResponse)(nil), "arkeo.arkeo.MsgModProviderResponse")
+	proto.RegisterType((*MsgOpenContract)(nil), "arkeo.arkeo.MsgOpenContract")
+	proto.RegisterType((*MsgOpenContractResponse)(nil), "arkeo.arkeo.MsgOpenContractResponse")
+	proto.RegisterType((*MsgCloseContract)(nil), "arkeo.arkeo.MsgCloseContract")
+	proto.RegisterType((*MsgCloseContractResponse)(nil), "arkeo.arkeo.MsgCloseContractResponse")
+	proto.RegisterType((*MsgClaimContractIncome)(nil), "arkeo.arkeo.MsgClaimContractIncome")
+	proto.RegisterType((*MsgClaimContractIncomeResponse)(nil), "arkeo.arkeo.MsgClaimContractIncomeResponse")
+	proto.RegisterType((*MsgSetVersion)(nil), "arkeo.arkeo.MsgSetVersion")
+	proto.RegisterType((*MsgSetVersionResponse)(nil), "arkeo.arkeo.MsgSetVersionResponse")
+	proto.RegisterType((*MsgRegisterService)(nil), "arkeo.arkeo.MsgRegisterService")
+	proto.RegisterType((*MsgRegisterServiceResponse)(nil), "arkeo.arkeo.MsgRegisterServiceResponse")
+	proto.RegisterType((*MsgUpdateService)(nil), "arkeo.arkeo.MsgUpdateService")
+	proto.RegisterType((*MsgUpdateServiceResponse)(nil), "arkeo.arkeo.MsgUpdateServiceResponse")
+	proto.RegisterType((*MsgRemoveService)(nil), "arkeo.arkeo.MsgRemoveService")
+	proto.RegisterType((*MsgRemoveServiceResponse)(nil), "arkeo.arkeo.MsgRemoveServiceResponse")
+	proto.RegisterType((*ResponseReceipt)(nil), "arkeo.arkeo.ResponseReceipt")
+	proto.RegisterType((*MsgSubmitEvidence)(nil), "arkeo.arkeo.MsgSubmitEvidence")
+	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "arkeo.arkeo.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgSubmitUnsignedNonceEvidence)(nil), "arkeo.arkeo.MsgSubmitUnsignedNonceEvidence")
+	proto.RegisterType((*MsgSubmitUnsignedNonceEvidenceResponse)(nil), "arkeo.arkeo.MsgSubmitUnsignedNonceEvidenceResponse")
+	proto.RegisterType((*MsgSetConfig)(nil), "arkeo.arkeo.MsgSetConfig")
+	proto.RegisterType((*MsgSetConfigResponse)(nil), "arkeo.arkeo.MsgSetConfigResponse")
+	proto.RegisterType((*MsgTopUpContract)(nil), "arkeo.arkeo.MsgTopUpContract")
+	proto.RegisterType((*MsgTopUpContractResponse)(nil), "arkeo.arkeo.MsgTopUpContractResponse")
+	proto.RegisterType((*MsgSetContractRenewal)(nil), "arkeo.arkeo.MsgSetContractRenewal")
+	proto.RegisterType((*MsgSetContractRenewalResponse)(nil), "arkeo.arkeo.MsgSetContractRenewalResponse")
+	proto.RegisterType((*MsgOpenContractGroup)(nil), "arkeo.arkeo.MsgOpenContractGroup")
+	proto.RegisterType((*MsgOpenContractGroupResponse)(nil), "arkeo.arkeo.MsgOpenContractGroupResponse")
+	proto.RegisterType((*MsgSetDelegateLimit)(nil), "arkeo.arkeo.MsgSetDelegateLimit")
+	proto.RegisterType((*MsgSetDelegateLimitResponse)(nil), "arkeo.arkeo.MsgSetDelegateLimitResponse")
+	proto.RegisterType((*MsgSubmitAttestation)(nil), "arkeo.arkeo.MsgSubmitAttestation")
+	proto.RegisterType((*MsgSubmitAttestationResponse)(nil), "arkeo.arkeo.MsgSubmitAttestationResponse")
+	proto.RegisterType((*MsgSetProber)(nil), "arkeo.arkeo.MsgSetProber")
+	proto.RegisterType((*MsgSetProberResponse)(nil), "arkeo.arkeo.MsgSetProberResponse")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/tx.proto", fileDescriptor_a12700967a3e4015) }
+
+var fileDescriptor_a12700967a3e4015 = []byte{
+	// 2059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xd4, 0x07, 0x1f, 0x29, 0x59, 0x5e, 0xcb, 0xf6, 0x6a, 0x25, 0x51, 0xd4, 0xda,
+	0x4a, 0x69, 0xcb, 0x26, 0x63, 0xa9, 0x01, 0x12, 0xa2, 0x1f, 0xb0, 0x14, 0xc3, 0x11, 0x6c, 0xd5,
+	0xc6, 0x2a, 0x2a, 0xfa, 0x01, 0x94, 0x18, 0x72, 0xc7, 0xd4, 0xc2, 0xe4, 0xce, 0x76, 0x66, 0x28,
+	0x5b, 0xbd, 0xb4, 0xe8, 0xa1, 0x87, 0xf6, 0xd2, 0x6b, 0xff, 0x89, 0xc2, 0x87, 0xa0, 0x40, 0xaf,
+	0xbd, 0x34, 0xc7, 0x34, 0xa7, 0xa0, 0x07, 0x23, 0xb0, 0x0b, 0xf8, 0x52, 0xa0, 0x40, 0x4f, 0x45,
+	0x8b, 0x02, 0xc5, 0xee, 0xce, 0x0e, 0xf7, 0x4b, 0x14, 0x2d, 0xc5, 0x01, 0x7a, 0xa1, 0x35, 0xef,
+	0x6b, 0xde, 0xfb, 0xed, 0x7b, 0x6f, 0xe6, 0x8d, 0x61, 0x1e, 0xd1, 0x27, 0x98, 0x34, 0x82, 0x5f,
+	0xfe, 0xac, 0xee, 0x52, 0xc2, 0x89, 0x5a, 0xf2, 0xd7, 0x75, 0xff, 0x57, 0x9f, 0xef, 0x92, 0x2e,
+	0xf1, 0xe9, 0x0d, 0xef, 0xaf, 0x40, 0x44, 0x5f, 0xe8, 0x10, 0xd6, 0x27, 0xac, 0x15, 0x30, 0x82,
+	0x85, 0x60, 0x55, 0x82, 0x55, 0xa3, 0x8d, 0x18, 0x6e, 0x1c, 0xde, 0x6e, 0x63, 0x8e, 0x6e, 0x37,
+	0x3a, 0xc4, 0x76, 0x04, 0x5f, 0x8b, 0xee, 0xf9, 0x04, 0x63, 0x17, 0x53, 0xc1, 0xb9, 0x22, 0x34,
+	0xfb, 0xac, 0xdb, 0x38, 0xbc, 0xed, 0xfd, 0x23, 0x18, 0x17, 0x50, 0xdf, 0x76, 0x48, 0xc3, 0xff,
+	0x0d, 0x48, 0xc6, 0xdf, 0x15, 0x38, 0xbf, 0xcb, 0xba, 0x5b, 0xc4, 0xb1, 0x1e, 0x51, 0x72, 0x68,
+	0x5b, 0x98, 0xaa, 0x1b, 0x30, 0xd5, 0xa1, 0x18, 0x71, 0x42, 0x35, 0xa5, 0xaa, 0xd4, 0x8a, 0x5b,
+	0xda, 0xe7, 0x9f, 0xdc, 0x9a, 0x17, 0xce, 0xdd, 0xb1, 0x2c, 0x8a, 0x19, 0xdb, 0xe3, 0xd4, 0x76,
+	0xba, 0x66, 0x28, 0xa8, 0xea, 0x30, 0xed, 0x0a, 0x7d, 0x2d, 0xe7, 0x29, 0x99, 0x72, 0xad, 0x6a,
+	0x30, 0xc5, 0x30, 0x3d, 0xb4, 0x3b, 0x58, 0xcb, 0xfb, 0xac, 0x70, 0xa9, 0x7e, 0x17, 0x0a, 0x6d,
+	0xe2, 0x58, 0x5a, 0xc1, 0xdf, 0x66, 0xfd, 0xd3, 0x17, 0x2b, 0xe7, 0xfe, 0xfa, 0x62, 0xe5, 0x52,
+	0xb0, 0x15, 0xb3, 0x9e, 0xd4, 0x6d, 0xd2, 0xe8, 0x23, 0x7e, 0x50, 0xdf, 0x71, 0xf8, 0xe7, 0x9f,
+	0xdc, 0x02, 0xe1, 0xc3, 0x8e, 0xc3, 0x4d, 0x5f, 0xb1, 0x59, 0xff, 0xe5, 0xeb, 0xe7, 0x37, 0x42,
+	0x27, 0x7e, 0xfd, 0xfa, 0xf9, 0x8d, 0xe5, 0x00, 0x8f, 0x67, 0x02, 0x97, 0x44, 0x68, 0xc6, 0x02,
+	0x5c, 0x49, 0x90, 0x4c, 0xcc, 0x5c, 0xe2, 0x30, 0x6c, 0xfc, 0x79, 0x02, 0x66, 0x77, 0x59, 0x77,
+	0x97, 0x9c, 0x0d, 0x88, 0xfb, 0x09, 0x20, 0xca, 0x5b, 0x8d, 0x7f, 0xbf, 0x58, 0x59, 0xef, 0xda,
+	0xfc, 0x60, 0xd0, 0xae, 0x77, 0x48, 0x3f, 0xf0, 0xcc, 0xc1, 0xfc, 0x29, 0xa1, 0x4f, 0x84, 0x9b,
+	0x1d, 0xd2, 0xef, 0x13, 0xa7, 0xfe, 0x68, 0xd0, 0xbe, 0x8f, 0x8f, 0xc6, 0x42, 0x6e, 0x15, 0xca,
+	0x7d, 0xcc, 0x91, 0x85, 0x38, 0x6a, 0x0d, 0xa8, 0x1d, 0x20, 0x68, 0x96, 0x42, 0xda, 0x3e, 0xb5,
+	0xd5, 0x35, 0x98, 0x95, 0x22, 0x0e, 0x71, 0x3a, 0x58, 0x9b, 0xa8, 0x2a, 0xb5, 0x82, 0x39, 0x13,
+	0x52, 0xbf, 0xe7, 0x11, 0xd5, 0x4d, 0x98, 0x64, 0x1c, 0xf1, 0x01, 0xd3, 0x26, 0xab, 0x4a, 0x6d,
+	0x76, 0x63, 0xb1, 0x1e, 0x49, 0xdb, 0x7a, 0x88, 0xc5, 0x9e, 0x2f, 0x62, 0x0a, 0x51, 0x75, 0x03,
+	0x2e, 0xf5, 0x6d, 0xa7, 0xd5, 0x21, 0x0e, 0xa7, 0xa8, 0xc3, 0x5b, 0xd6, 0x80, 0x22, 0x6e, 0x13,
+	0x47, 0x9b, 0xaa, 0x2a, 0xb5, 0xbc, 0x79, 0xb1, 0x6f, 0x3b, 0xdb, 0x82, 0xf7, 0xa1, 0x60, 0xf9,
+	0x3a, 0xe8, 0x59, 0x86, 0xce, 0xb4, 0xd0, 0x41, 0xcf, 0x52, 0x3a, 0x0f, 0xe0, 0x02, 0x1b, 0xb4,
+	0x59, 0x87, 0xda, 0xae, 0xb7, 0x6e, 0x51, 0xc4, 0xb1, 0x56, 0xac, 0xe6, 0x6b, 0xa5, 0x8d, 0x85,
+	0xba, 0xf8, 0x10, 0x5e, 0x81, 0xd4, 0x45, 0x81, 0xd4, 0xb7, 0x89, 0xed, 0x6c, 0x15, 0xbc, 0x44,
+	0x32, 0xe7, 0xa2, 0x9a, 0x26, 0xe2, 0x58, 0xbd, 0x0f, 0xaa, 0x8b, 0x8e, 0x5a, 0x88, 0xb5, 0x8e,
+	0xc8, 0xa0, 0xd5, 0x25, 0x81, 0x39, 0x18, 0xcf, 0xdc, 0xac, 0x8b, 0x8e, 0xee, 0xb0, 0x1f, 0x92,
+	0xc1, 0x3d, 0xe2, 0x1b, 0x6b, 0xc0, 0x45, 0x86, 0x39, 0xef, 0xe1, 0x3e, 0x76, 0x22, 0xc1, 0x94,
+	0xfc, 0x60, 0xd4, 0x21, 0x4b, 0xc6, 0xf2, 0x3e, 0x14, 0xbd, 0xfd, 0x5a, 0x1d, 0x44, 0x2d, 0xad,
+	0x5c, 0x55, 0x6a, 0xa5, 0x8d, 0x4b, 0x31, 0xac, 0x3d, 0xb3, 0xdb, 0x88, 0x5a, 0x62, 0xc3, 0x69,
+	0x2a, 0xd6, 0xcd, 0x5b, 0xc9, 0x2c, 0x5f, 0x4a, 0x65, 0x79, 0x24, 0x6d, 0x0d, 0x0d, 0x2e, 0xc7,
+	0x29, 0x32, 0xc7, 0xff, 0x35, 0xe1, 0x57, 0xfb, 0x43, 0x17, 0xcb, 0xcf, 0xf3, 0x35, 0x56, 0xfb,
+	0x65, 0x98, 0xec, 0xf4, 0x6c, 0xec, 0x70, 0x91, 0xad, 0x62, 0xe5, 0x59, 0xb3, 0x70, 0x0f, 0x77,
+	0x11, 0x0f, 0x52, 0xb4, 0x68, 0xca, 0xb5, 0xfa, 0x1d, 0x98, 0x91, 0x09, 0xc3, 0x8f, 0x5c, 0x2c,
+	0x92, 0x74, 0x21, 0x06, 0x5c, 0x18, 0xcb, 0xc7, 0x47, 0x2e, 0x36, 0xcb, 0x9d, 0xc8, 0xca, 0xb7,
+	0x1d, 0xcf, 0x4d, 0xb9, 0x56, 0x37, 0xa1, 0xe0, 0x27, 0xc0, 0x74, 0x55, 0x19, 0x27, 0x01, 0x7c,
+	0x61, 0xf5, 0x2e, 0x4c, 0x59, 0xd8, 0x25, 0xcc, 0xe6, 0x5a, 0xf1, 0xcd, 0xbb, 0x56, 0xa8, 0x7b,
+	0x5c, 0xf6, 0xc0, 0xb1, 0xd9, 0xf3, 0x11, 0xcc, 0xa0, 0x01, 0x3f, 0x20, 0xd4, 0xfe, 0xd9, 0x30,
+	0xd1, 0x66, 0x37, 0x8c, 0x4c, 0x20, 0xee, 0x44, 0x25, 0xcd, 0xb8, 0xa2, 0x7a, 0x13, 0xd4, 0x9f,
+	0x0e, 0x30, 0xb5, 0x31, 0x6b, 0xb9, 0x98, 0xb6, 0xfa, 0xb6, 0x33, 0xe0, 0xd8, 0x4f, 0xc8, 0xbc,
+	0x39, 0x27, 0x38, 0x8f, 0x30, 0xdd, 0xf5, 0xe9, 0xea, 0x32, 0x00, 0x1a, 0x70, 0xd2, 0xa2, 0xd8,
+	0xc1, 0x4f, 0xb5, 0x99, 0xaa, 0x52, 0x9b, 0x36, 0x8b, 0x1e, 0xc5, 0xf4, 0x08, 0xea, 0x3d, 0x98,
+	0x0d, 0xbf, 0x55, 0xab, 0x67, 0xf7, 0x6d, 0xae, 0xcd, 0xfa, 0x68, 0xea, 0x31, 0xbf, 0x3e, 0x14,
+	0x22, 0x0f, 0x3c, 0x09, 0x01, 0xe7, 0x8c, 0x15, 0x25, 0xaa, 0x75, 0x98, 0x70, 0xd1, 0x11, 0xa6,
+	0xda, 0xf9, 0x13, 0x92, 0x30, 0x10, 0x1b, 0xa7, 0xf3, 0x47, 0xd3, 0x5c, 0x74, 0xfe, 0x28, 0x49,
+	0x56, 0xc5, 0xef, 0x73, 0x30, 0xb7, 0xcb, 0xba, 0xdb, 0x3d, 0xc2, 0xf0, 0x99, 0xca, 0x62, 0x05,
+	0x4a, 0x32, 0x59, 0x6d, 0xcb, 0xaf, 0x8c, 0x82, 0x09, 0x21, 0x69, 0xc7, 0x52, 0xef, 0xc9, 0x0a,
+	0xc8, 0x9f, 0xee, 0x68, 0x08, 0x4b, 0xe6, 0x7e, 0xa4, 0x64, 0x0a, 0xa7, 0x3c, 0x65, 0x42, 0x03,
+	0xcd, 0x46, 0x12, 0xca, 0x4a, 0x0a, 0xca, 0x18, 0x36, 0x86, 0x0e, 0x5a, 0x92, 0x26, 0xc1, 0xfc,
+	0x42, 0xf1, 0xbb, 0xcf, 0x76, 0x0f, 0xd9, 0xfd, 0x90, 0xb9, 0xe3, 0x74, 0x48, 0x1f, 0xbf, 0x1d,
+	0x48, 0x97, 0xa0, 0xc8, 0xec, 0xae, 0x83, 0xf8, 0x80, 0x0a, 0x28, 0xcc, 0x21, 0x41, 0x9d, 0x87,
+	0x89, 0xe1, 0xd1, 0x97, 0x37, 0x83, 0x45, 0xf3, 0xbd, 0x64, 0xc0, 0xd7, 0x32, 0x02, 0x4e, 0xf9,
+	0x6f, 0x54, 0xa1, 0x92, 0xcd, 0x91, 0xc1, 0xff, 0x46, 0x81, 0x99, 0x5d, 0xd6, 0xdd, 0xc3, 0xfc,
+	0xfb, 0x98, 0xb2, 0xe0, 0xd0, 0x7b, 0xf3, 0x98, 0x35, 0x98, 0x3a, 0x0c, 0xd4, 0xfd, 0x78, 0xf3,
+	0x66, 0xb8, 0x6c, 0xde, 0x4c, 0x3a, 0xbe, 0x98, 0x72, 0x7c, 0xb8, 0xb7, 0x71, 0x05, 0x2e, 0xc5,
+	0x08, 0xd2, 0xcd, 0xbf, 0x29, 0xa0, 0xee, 0xb2, 0xae, 0x89, 0xbb, 0x36, 0xe3, 0x98, 0xee, 0x89,
+	0xfe, 0x7c, 0x1a, 0x5f, 0x67, 0x21, 0x27, 0x3f, 0x4b, 0xce, 0xb6, 0x54, 0x15, 0x0a, 0x0e, 0xea,
+	0x87, 0xad, 0xdf, 0xff, 0x5b, 0xad, 0x42, 0xc9, 0xc2, 0xf2, 0x24, 0x0e, 0xaf, 0x2a, 0x11, 0x92,
+	0x77, 0x9b, 0x11, 0x87, 0x44, 0xd0, 0xe4, 0x83, 0x53, 0xa0, 0x24, 0x68, 0x5e, 0x23, 0x6f, 0xde,
+	0x4e, 0x86, 0x5e, 0x4d, 0x85, 0x9e, 0x88, 0xc7, 0x58, 0x02, 0x3d, 0x4d, 0x95, 0x20, 0x7c, 0xa9,
+	0xf8, 0x55, 0xbf, 0xef, 0x5a, 0x88, 0xe3, 0xff, 0x0b, 0x08, 0xc6, 0xa8, 0xd3, 0x58, 0x34, 0xa2,
+	0x4e, 0x63, 0xb4, 0x68, 0xaa, 0xce, 0xf9, 0xe8, 0xf4, 0xc9, 0xe1, 0x99, 0xc2, 0x0f, 0xc3, 0xcd,
+	0x0d, 0xc3, 0x1d, 0xc7, 0xd3, 0xd8, 0xc6, 0xc2, 0xd3, 0x18, 0x4d, 0x7a, 0xfa, 0x07, 0x05, 0xce,
+	0x87, 0x0b, 0x13, 0x77, 0xb0, 0xed, 0xf2, 0x64, 0x5b, 0x50, 0x52, 0x6d, 0x41, 0x16, 0x7e, 0x2e,
+	0x52, 0xf8, 0xea, 0x55, 0x98, 0xa1, 0xc2, 0x52, 0xeb, 0x00, 0xb1, 0x83, 0xa0, 0x0d, 0x9b, 0xe5,
+	0x90, 0xf8, 0x11, 0x62, 0x07, 0x27, 0x74, 0x94, 0xeb, 0x30, 0x17, 0xf4, 0xe0, 0xd6, 0x50, 0x68,
+	0xc2, 0x17, 0x3a, 0x1f, 0xd0, 0xf7, 0x42, 0xb2, 0xf1, 0x0f, 0x05, 0x2e, 0x78, 0x05, 0x38, 0x68,
+	0xf7, 0x6d, 0x7e, 0xd7, 0xbb, 0x1d, 0x39, 0xa7, 0xc4, 0xf8, 0x7d, 0x98, 0x78, 0x6c, 0x53, 0xc6,
+	0xfd, 0x68, 0x4a, 0x1b, 0x4b, 0xf1, 0x6b, 0x63, 0x1c, 0x1b, 0x71, 0xbc, 0x06, 0x0a, 0x6a, 0x13,
+	0x26, 0x19, 0xee, 0x78, 0x33, 0x56, 0x7e, 0x6c, 0x55, 0xa1, 0xd1, 0x7c, 0x37, 0xf9, 0x15, 0x57,
+	0xd2, 0xdd, 0x26, 0x16, 0x9b, 0xb1, 0x08, 0x0b, 0x29, 0xa2, 0xfc, 0x8e, 0x7f, 0x51, 0xa0, 0x22,
+	0xb9, 0xfb, 0x8e, 0x07, 0x1f, 0xb6, 0xfc, 0x19, 0xe4, 0x4c, 0xd8, 0x7c, 0x0b, 0xa6, 0x68, 0xe0,
+	0xfe, 0x1b, 0xa0, 0x13, 0xaa, 0x34, 0xbf, 0x9d, 0x8c, 0xf1, 0xe6, 0x31, 0x31, 0x66, 0x3a, 0x6c,
+	0xd4, 0xe0, 0x9d, 0xd1, 0x12, 0x32, 0xfa, 0x3f, 0x29, 0x50, 0x0e, 0xba, 0xf1, 0x36, 0x71, 0x1e,
+	0xdb, 0xdd, 0x53, 0xc5, 0xfa, 0x01, 0x4c, 0x76, 0x7c, 0x6d, 0x11, 0xea, 0x62, 0xf2, 0xf6, 0xf7,
+	0xd8, 0xee, 0x3e, 0x3c, 0xc4, 0x94, 0xda, 0x16, 0x0e, 0x3f, 0x66, 0xa0, 0xe0, 0x5d, 0xbe, 0xa9,
+	0x5f, 0x5e, 0x7e, 0x22, 0x4c, 0x9b, 0x62, 0xd5, 0x5c, 0x4f, 0x02, 0xa0, 0x67, 0x1d, 0x29, 0x81,
+	0x69, 0xe3, 0x32, 0xcc, 0x47, 0xd7, 0x32, 0xb8, 0xff, 0x04, 0xcd, 0xe4, 0x63, 0xe2, 0xee, 0xbb,
+	0x6f, 0xf7, 0x06, 0x15, 0xb9, 0x7e, 0xe7, 0xcf, 0x70, 0xfd, 0x8e, 0x8e, 0x05, 0x85, 0xf8, 0x58,
+	0x30, 0x4e, 0xf3, 0x8a, 0x05, 0x2a, 0x9a, 0x57, 0x8c, 0x26, 0x91, 0xf9, 0x55, 0x2e, 0x3c, 0x84,
+	0x87, 0x2c, 0x07, 0x3f, 0x45, 0xbd, 0xb7, 0x03, 0x4f, 0xfc, 0xb6, 0x9e, 0x4f, 0xde, 0xd6, 0xb7,
+	0x61, 0x12, 0xb3, 0x0e, 0x25, 0x4f, 0x4f, 0xf3, 0xe2, 0x22, 0x54, 0x9b, 0xdf, 0x4c, 0xe2, 0x73,
+	0xf5, 0x98, 0x8c, 0x89, 0x86, 0x6b, 0xac, 0xc0, 0x72, 0x26, 0x43, 0x22, 0xf5, 0xdf, 0x3c, 0xcc,
+	0x27, 0x6e, 0xe8, 0xf7, 0x28, 0x19, 0xb8, 0xa7, 0x02, 0x6a, 0x09, 0x8a, 0xe1, 0x40, 0xca, 0xb4,
+	0x5c, 0x35, 0x5f, 0x2b, 0x9a, 0x43, 0xc2, 0x57, 0x3c, 0xa2, 0x46, 0x73, 0x69, 0x32, 0x31, 0x62,
+	0xbe, 0x07, 0x13, 0x14, 0x71, 0xcc, 0xb4, 0xa9, 0xf1, 0x1e, 0x19, 0x02, 0xe9, 0x68, 0x96, 0x4f,
+	0x9f, 0x21, 0xcb, 0x53, 0x33, 0x63, 0xf1, 0xab, 0x9d, 0x19, 0x21, 0x7b, 0x66, 0x6c, 0x6e, 0x26,
+	0x33, 0xc4, 0x18, 0x39, 0x9b, 0xf9, 0x9f, 0xd9, 0xf8, 0x00, 0x96, 0xb2, 0xe8, 0x61, 0x7e, 0xa8,
+	0x0b, 0x30, 0xdd, 0xf5, 0x08, 0xc3, 0xf3, 0x7e, 0xca, 0x5f, 0xef, 0x58, 0xc6, 0x6b, 0x05, 0x2e,
+	0x06, 0xc9, 0x15, 0x1b, 0x34, 0xdf, 0xd6, 0x0c, 0x97, 0x9c, 0x78, 0xf3, 0xa7, 0x9a, 0x78, 0x9b,
+	0x1b, 0x49, 0x94, 0x56, 0xb3, 0xea, 0x28, 0x66, 0xc8, 0x58, 0x86, 0xc5, 0x0c, 0xb2, 0xac, 0xa1,
+	0xdf, 0xe5, 0x60, 0x5e, 0x9e, 0x47, 0x77, 0x38, 0xc7, 0x8c, 0x87, 0x6f, 0x6f, 0x5f, 0xd7, 0x23,
+	0x4f, 0x02, 0xbf, 0x42, 0x0a, 0x3f, 0x03, 0xca, 0xe8, 0x10, 0xd9, 0x3d, 0xd4, 0xb6, 0x7b, 0x36,
+	0x3f, 0xf2, 0xcb, 0x69, 0xc6, 0x8c, 0xd1, 0xbc, 0x36, 0xd6, 0x43, 0x1c, 0x3b, 0x9d, 0xa3, 0x56,
+	0x9f, 0x89, 0xa2, 0x2a, 0x0a, 0xca, 0x2e, 0x1b, 0x27, 0xbf, 0x52, 0x10, 0x18, 0x15, 0x58, 0xca,
+	0xa2, 0x4b, 0xec, 0xfe, 0x28, 0x0f, 0xe8, 0x47, 0x94, 0xb4, 0x4f, 0xf9, 0xfa, 0xfb, 0x2e, 0x4c,
+	0xba, 0xbe, 0xb6, 0x96, 0x3b, 0x41, 0x45, 0xc8, 0x9d, 0xf1, 0x5c, 0x0e, 0x5c, 0x1d, 0x9e, 0xcb,
+	0xc1, 0x3a, 0x8c, 0x69, 0xe3, 0x9f, 0x65, 0xc8, 0xef, 0xb2, 0xae, 0x6a, 0x42, 0x39, 0xf6, 0xc2,
+	0x1f, 0xbf, 0x22, 0x25, 0x5e, 0xc4, 0xf5, 0x6b, 0xa3, 0xb8, 0xb2, 0x1e, 0x1f, 0x42, 0x29, 0xfa,
+	0x56, 0xbe, 0x98, 0x54, 0x8a, 0x30, 0xf5, 0xab, 0x23, 0x98, 0xd2, 0xa0, 0x09, 0xe5, 0xd8, 0xc3,
+	0x64, 0xca, 0xc9, 0x28, 0x57, 0xbf, 0x36, 0x8a, 0x2b, 0x6d, 0xee, 0xc3, 0x4c, 0xfc, 0x59, 0x67,
+	0x39, 0xa9, 0x16, 0x63, 0xeb, 0x6b, 0x23, 0xd9, 0xd2, 0x6c, 0x17, 0x2e, 0x66, 0x3d, 0x70, 0x5c,
+	0x4d, 0x6b, 0xa7, 0x84, 0xf4, 0xf5, 0x31, 0x84, 0xe4, 0x46, 0x0f, 0x00, 0x22, 0x8f, 0x09, 0x7a,
+	0x52, 0x75, 0xc8, 0xd3, 0x8d, 0xe3, 0x79, 0xd2, 0xda, 0x8f, 0xe1, 0x7c, 0x62, 0x1a, 0x56, 0x57,
+	0x92, 0x6a, 0x09, 0x01, 0xfd, 0x1b, 0x27, 0x08, 0x44, 0xa1, 0x8e, 0xcf, 0xd2, 0x29, 0xa8, 0x63,
+	0x6c, 0x7d, 0x6d, 0x24, 0x3b, 0x6a, 0x36, 0x3e, 0xa3, 0x2e, 0xa7, 0x1d, 0x8a, 0xb0, 0xf5, 0xb5,
+	0x91, 0x6c, 0x69, 0xf6, 0x07, 0x30, 0x9b, 0x98, 0xcb, 0x2a, 0x29, 0x00, 0x63, 0x7c, 0xfd, 0x9d,
+	0xd1, 0x7c, 0x69, 0xf9, 0xe7, 0xb0, 0x38, 0x6a, 0xc4, 0x59, 0xcf, 0x36, 0x93, 0x29, 0xac, 0x6f,
+	0xbe, 0x81, 0xb0, 0x74, 0x60, 0x07, 0x8a, 0xc3, 0x29, 0x63, 0x21, 0x23, 0x2d, 0x02, 0x96, 0xbe,
+	0x7a, 0x2c, 0x2b, 0x0a, 0x7e, 0xfc, 0x4e, 0x9f, 0x02, 0x3f, 0xc6, 0xd6, 0xd7, 0x46, 0xb2, 0xa5,
+	0x59, 0x0b, 0xd4, 0x8c, 0x0b, 0xb1, 0x91, 0xed, 0x4f, 0x54, 0x46, 0xbf, 0x71, 0xb2, 0x8c, 0xdc,
+	0x05, 0xc1, 0x85, 0xf4, 0x65, 0x72, 0x75, 0x54, 0xdb, 0xf0, 0x45, 0xf4, 0xeb, 0x27, 0x8a, 0xc8,
+	0x2d, 0x7e, 0x02, 0x73, 0xa9, 0x4b, 0x47, 0x35, 0xc3, 0xc5, 0x98, 0x84, 0x5e, 0x3b, 0x49, 0x22,
+	0x1a, 0x42, 0xfa, 0x2c, 0x5f, 0xcd, 0x4e, 0x8a, 0x88, 0x88, 0x7e, 0xfd, 0x44, 0x91, 0x44, 0xb6,
+	0x88, 0x23, 0x2f, 0x2b, 0x5b, 0x02, 0x96, 0xbe, 0x7a, 0x2c, 0x2b, 0x34, 0xa5, 0x4f, 0xfc, 0xe2,
+	0xf5, 0xf3, 0x1b, 0xca, 0xd6, 0xdd, 0x4f, 0x5f, 0x56, 0x94, 0xcf, 0x5e, 0x56, 0x94, 0x2f, 0x5f,
+	0x56, 0x94, 0xdf, 0xbe, 0xaa, 0x9c, 0xfb, 0xec, 0x55, 0xe5, 0xdc, 0x17, 0xaf, 0x2a, 0xe7, 0x7e,
+	0x74, 0xc2, 0xfb, 0x74, 0x78, 0xb4, 0x79, 0xef, 0x5f, 0xac, 0x3d, 0xe9, 0xff, 0x07, 0xf5, 0xe6,
+	0xff, 0x06, 0x00, 0x20, 0x6e, 0x8d, 0xc2, 0x5c, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// BondProvider creates or updates a provider bond.
+	BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error)
+	// ModProvider modifies provider details.
+	ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error)
+	// OpenContract opens a contract between a client and provider.
+	OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error)
+	// CloseContract closes an existing contract.
+	CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error)
+	// ClaimContractIncome allows a provider to claim contract income.
+	ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error)
+	// SetVersion sets the chain version.
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
+	// RegisterService registers a new provider service in the on-chain registry.
+	RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error)
+	// UpdateService updates an existing service in the registry.
+	UpdateService(ctx context.Context, in *MsgUpdateService, opts ...grpc.CallOption) (*MsgUpdateServiceResponse, error)
+	// RemoveService removes an existing service from the registry.
+	RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error)
+	// SubmitEvidence slashes and jails a provider that signed two different
+	// responses for the same contract nonce.
+	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// SubmitUnsignedNonceEvidence slashes and jails a provider that served a
+	// contract nonce the client never signed.
+	SubmitUnsignedNonceEvidence(ctx context.Context, in *MsgSubmitUnsignedNonceEvidence, opts ...grpc.CallOption) (*MsgSubmitUnsignedNonceEvidenceResponse, error)
+	// SetConfig overrides a config value, or removes the override.
+	SetConfig(ctx context.Context, in *MsgSetConfig, opts ...grpc.CallOption) (*MsgSetConfigResponse, error)
+	// TopUpContract adds deposit and/or duration to an open contract.
+	TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error)
+	// SetContractRenewal enables, funds or cancels the auto-renewal of a
+	// subscription contract.
+	SetContractRenewal(ctx context.Context, in *MsgSetContractRenewal, opts ...grpc.CallOption) (*MsgSetContractRenewalResponse, error)
+	// OpenContractGroup opens a pay-as-you-go contract with several providers
+	// of a service, paid from one deposit.
+	OpenContractGroup(ctx context.Context, in *MsgOpenContractGroup, opts ...grpc.CallOption) (*MsgOpenContractGroupResponse, error)
+	// SetDelegateLimit sets the spend limit of the spender of a contract.
+	SetDelegateLimit(ctx context.Context, in *MsgSetDelegateLimit, opts ...grpc.CallOption) (*MsgSetDelegateLimitResponse, error)
+	// SubmitAttestation attests the latency and availability of a provider.
+	SubmitAttestation(ctx context.Context, in *MsgSubmitAttestation, opts ...grpc.CallOption) (*MsgSubmitAttestationResponse, error)
+	// SetProber registers or removes an account allowed to attest providers
+	// without a contract.
+	SetProber(ctx context.Context, in *MsgSetProber, opts ...grpc.CallOption) (*MsgSetProberResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error) {
+	out := new(MsgBondProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/BondProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error) {
+	out := new(MsgModProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ModProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error) {
+	out := new(MsgOpenContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/OpenContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error) {
+	out := new(MsgCloseContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/CloseContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error) {
+	out := new(MsgClaimContractIncomeResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error) {
+	out := new(MsgSetVersionResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error) {
+	out := new(MsgRegisterServiceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RegisterService", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateService(ctx context.Context, in *MsgUpdateService, opts ...grpc.CallOption) (*MsgUpdateServiceResponse, error) {
+	out := new(MsgUpdateServiceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/UpdateService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error) {
+	out := new(MsgRemoveServiceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RemoveService", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error) {
+	out := new(MsgSubmitEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SubmitEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitUnsignedNonceEvidence(ctx context.Context, in *MsgSubmitUnsignedNonceEvidence, opts ...grpc.CallOption) (*MsgSubmitUnsignedNonceEvidenceResponse, error) {
+	out := new(MsgSubmitUnsignedNonceEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SubmitUnsignedNonceEvidence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetConfig(ctx context.Context, in *MsgSetConfig, opts ...grpc.CallOption) (*MsgSetConfigResponse, error) {
+	out := new(MsgSetConfigResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error) {
+	out := new(MsgTopUpContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/TopUpContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) SetContractRenewal(ctx context.Context, in *MsgSetContractRenewal, opts ...grpc.CallOption) (*MsgSetContractRenewalResponse, error) {
+	out := new(MsgSetContractRenewalResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetContractRenewal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgBondProvider) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) OpenContractGroup(ctx context.Context, in *MsgOpenContractGroup, opts ...grpc.CallOption) (*MsgOpenContractGroupResponse, error) {
+	out := new(MsgOpenContractGroupResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/OpenContractGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetDelegateLimit(ctx context.Context, in *MsgSetDelegateLimit, opts ...grpc.CallOption) (*MsgSetDelegateLimitResponse, error) {
+	out := new(MsgSetDelegateLimitResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetDelegateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitAttestation(ctx context.Context, in *MsgSubmitAttestation, opts ...grpc.CallOption) (*MsgSubmitAttestationResponse, error) {
+	out := new(MsgSubmitAttestationResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Bond.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgBondProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetProber(ctx context.Context, in *MsgSetProber, opts ...grpc.CallOption) (*MsgSetProberResponse, error) {
+	out := new(MsgSetProberResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetProber", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgModProvider) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// BondProvider creates or updates a provider bond.
+	BondProvider(context.Context, *MsgBondProvider) (*MsgBondProviderResponse, error)
+	// ModProvider modifies provider details.
+	ModProvider(context.Context, *MsgModProvider) (*MsgModProviderResponse, error)
+	// OpenContract opens a contract between a client and provider.
+	OpenContract(context.Context, *MsgOpenContract) (*MsgOpenContractResponse, error)
+	// CloseContract closes an existing contract.
+	CloseContract(context.Context, *MsgCloseContract) (*MsgCloseContractResponse, error)
+	// ClaimContractIncome allows a provider to claim contract income.
+	ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error)
+	// SetVersion sets the chain version.
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
+	// RegisterService registers a new provider service in the on-chain registry.
+	RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error)
+	// UpdateService updates an existing service in the registry.
+	UpdateService(context.Context, *MsgUpdateService) (*MsgUpdateServiceResponse, error)
+	// RemoveService removes an existing service from the registry.
+	RemoveService(context.Context, *MsgRemoveService) (*MsgRemoveServiceResponse, error)
+	// SubmitEvidence slashes and jails a provider that signed two different
+	// responses for the same contract nonce.
+	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// SubmitUnsignedNonceEvidence slashes and jails a provider that served a
+	// contract nonce the client never signed.
+	SubmitUnsignedNonceEvidence(context.Context, *MsgSubmitUnsignedNonceEvidence) (*MsgSubmitUnsignedNonceEvidenceResponse, error)
+	// SetConfig overrides a config value, or removes the override.
+	SetConfig(context.Context, *MsgSetConfig) (*MsgSetConfigResponse, error)
+	// TopUpContract adds deposit and/or duration to an open contract.
+	TopUpContract(context.Context, *MsgTopUpContract) (*MsgTopUpContractResponse, error)
+	// SetContractRenewal enables, funds or cancels the auto-renewal of a
+	// subscription contract.
+	SetContractRenewal(context.Context, *MsgSetContractRenewal) (*MsgSetContractRenewalResponse, error)
+	// OpenContractGroup opens a pay-as-you-go contract with several providers
+	// of a service, paid from one deposit.
+	OpenContractGroup(context.Context, *MsgOpenContractGroup) (*MsgOpenContractGroupResponse, error)
+	// SetDelegateLimit sets the spend limit of the spender of a contract.
+	SetDelegateLimit(context.Context, *MsgSetDelegateLimit) (*MsgSetDelegateLimitResponse, error)
+	// SubmitAttestation attests the latency and availability of a provider.
+	SubmitAttestation(context.Context, *MsgSubmitAttestation) (*MsgSubmitAttestationResponse, error)
+	// SetProber registers or removes an account allowed to attest providers
+	// without a contract.
+	SetProber(context.Context, *MsgSetProber) (*MsgSetProberResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) BondProvider(ctx context.Context, req *MsgBondProvider) (*MsgBondProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondProvider not implemented")
+}
+func (*UnimplementedMsgServer) ModProvider(ctx context.Context, req *MsgModProvider) (*MsgModProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModProvider not implemented")
+}
+func (*UnimplementedMsgServer) OpenContract(ctx context.Context, req *MsgOpenContract) (*MsgOpenContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenContract not implemented")
+}
+func (*UnimplementedMsgServer) CloseContract(ctx context.Context, req *MsgCloseContract) (*MsgCloseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseContract not implemented")
+}
+func (*UnimplementedMsgServer) ClaimContractIncome(ctx context.Context, req *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncome not implemented")
+}
+func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgSetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
+}
+func (*UnimplementedMsgServer) RegisterService(ctx context.Context, req *MsgRegisterService) (*MsgRegisterServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
+}
+func (*UnimplementedMsgServer) UpdateService(ctx context.Context, req *MsgUpdateService) (*MsgUpdateServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+}
+func (*UnimplementedMsgServer) RemoveService(ctx context.Context, req *MsgRemoveService) (*MsgRemoveServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
+}
+func (*UnimplementedMsgServer) SubmitUnsignedNonceEvidence(ctx context.Context, req *MsgSubmitUnsignedNonceEvidence) (*MsgSubmitUnsignedNonceEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnsignedNonceEvidence not implemented")
+}
+func (*UnimplementedMsgServer) SetConfig(ctx context.Context, req *MsgSetConfig) (*MsgSetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
+}
+func (*UnimplementedMsgServer) TopUpContract(ctx context.Context, req *MsgTopUpContract) (*MsgTopUpContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpContract not implemented")
+}
+func (*UnimplementedMsgServer) SetContractRenewal(ctx context.Context, req *MsgSetContractRenewal) (*MsgSetContractRenewalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractRenewal not implemented")
+}
+func (*UnimplementedMsgServer) OpenContractGroup(ctx context.Context, req *MsgOpenContractGroup) (*MsgOpenContractGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenContractGroup not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegateLimit(ctx context.Context, req *MsgSetDelegateLimit) (*MsgSetDelegateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAttestation(ctx context.Context, req *MsgSubmitAttestation) (*MsgSubmitAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
+}
+func (*UnimplementedMsgServer) SetProber(ctx context.Context, req *MsgSetProber) (*MsgSetProberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProber not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_BondProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BondProvider(ctx, in)
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/BondProvider",
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondProvider(ctx, req.(*MsgBondProvider))
 	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovTx(uint64(m.MetadataNonce))
+	if interceptor == nil {
+		return srv.(MsgServer).ModProvider(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ModProvider",
 	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovTx(uint64(m.MinContractDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModProvider(ctx, req.(*MsgModProvider))
 	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovTx(uint64(m.MaxContractDuration))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenContract(ctx, in)
 	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/OpenContract",
 	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovTx(uint64(m.SettlementDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenContract(ctx, req.(*MsgOpenContract))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgModProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CloseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/CloseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseContract(ctx, req.(*MsgCloseContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimContractIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimContractIncome)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimContractIncome(ctx, in)
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncome",
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimContractIncome(ctx, req.(*MsgClaimContractIncome))
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVersion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetVersion(ctx, in)
 	}
-	if m.ContractType != 0 {
-		n += 1 + sovTx(uint64(m.ContractType))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetVersion",
 	}
-	if m.Duration != 0 {
-		n += 1 + sovTx(uint64(m.Duration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVersion(ctx, req.(*MsgSetVersion))
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovTx(uint64(m.SettlementDuration))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterService)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Authorization != 0 {
-		n += 1 + sovTx(uint64(m.Authorization))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterService(ctx, in)
 	}
-	if m.QueriesPerMinute != 0 {
-		n += 1 + sovTx(uint64(m.QueriesPerMinute))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RegisterService",
 	}
-	return n
-}
-
-func (m *MsgOpenContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterService(ctx, req.(*MsgRegisterService))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateService)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateService(ctx, in)
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/UpdateService",
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateService(ctx, req.(*MsgUpdateService))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveService)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RemoveService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveService(ctx, req.(*MsgRemoveService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimContractIncome) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEvidence(ctx, in)
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SubmitEvidence",
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEvidence(ctx, req.(*MsgSubmitEvidence))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimContractIncomeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitUnsignedNonceEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitUnsignedNonceEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetVersion) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitUnsignedNonceEvidence(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SubmitUnsignedNonceEvidence",
 	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitUnsignedNonceEvidence(ctx, req.(*MsgSubmitUnsignedNonceEvidence))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRegisterService) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetConfig(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetConfig",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConfig(ctx, req.(*MsgSetConfig))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpContract(ctx, in)
 	}
-	l = len(m.ServiceType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/TopUpContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpContract(ctx, req.(*MsgTopUpContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetContractRenewal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractRenewal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractRenewal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetContractRenewal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractRenewal(ctx, req.(*MsgSetContractRenewal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateService) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_OpenContractGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenContractGroup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).OpenContractGroup(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/OpenContractGroup",
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenContractGroup(ctx, req.(*MsgOpenContractGroup))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ServiceType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateLimit(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetDelegateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateLimit(ctx, req.(*MsgSetDelegateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SubmitAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestation(ctx, req.(*MsgSubmitAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveService) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetProber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProber)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetProber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetProber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProber(ctx, req.(*MsgSetProber))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arkeo.arkeo.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BondProvider",
+			Handler:    _Msg_BondProvider_Handler,
+		},
+		{
+			MethodName: "ModProvider",
+			Handler:    _Msg_ModProvider_Handler,
+		},
+		{
+			MethodName: "OpenContract",
+			Handler:    _Msg_OpenContract_Handler,
+		},
+		{
+			MethodName: "CloseContract",
+			Handler:    _Msg_CloseContract_Handler,
+		},
+		{
+			MethodName: "ClaimContractIncome",
+			Handler:    _Msg_ClaimContractIncome_Handler,
+		},
+		{
+			MethodName: "SetVersion",
+			Handler:    _Msg_SetVersion_Handler,
+		},
+		{
+			MethodName: "RegisterService",
+			Handler:    _Msg_RegisterService_Handler,
+		},
+		{
+			MethodName: "UpdateService",
+			Handler:    _Msg_UpdateService_Handler,
+		},
+		{
+			MethodName: "RemoveService",
+			Handler:    _Msg_RemoveService_Handler,
+		},
+		{
+			MethodName: "SubmitEvidence",
+			Handler:    _Msg_SubmitEvidence_Handler,
+		},
+		{
+			MethodName: "SubmitUnsignedNonceEvidence",
+			Handler:    _Msg_SubmitUnsignedNonceEvidence_Handler,
+		},
+		{
+			MethodName: "SetConfig",
+			Handler:    _Msg_SetConfig_Handler,
+		},
+		{
+			MethodName: "TopUpContract",
+			Handler:    _Msg_TopUpContract_Handler,
+		},
+		{
+			MethodName: "SetContractRenewal",
+			Handler:    _Msg_SetContractRenewal_Handler,
+		},
+		{
+			MethodName: "OpenContractGroup",
+			Handler:    _Msg_OpenContractGroup_Handler,
+		},
+		{
+			MethodName: "SetDelegateLimit",
+			Handler:    _Msg_SetDelegateLimit_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _Msg_SubmitAttestation_Handler,
+		},
+		{
+			MethodName: "SetProber",
+			Handler:    _Msg_SetProber_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arkeo/arkeo/tx.proto",
+}
+
+func (m *MsgBondProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateCard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SettlementDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientSignature) > 0 {
+		i -= len(m.ClientSignature)
+		copy(dAtA[i:], m.ClientSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitUnsignedNonceEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitUnsignedNonceEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUnsignedNonceEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractRenewal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractRenewal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractRenewal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Escrow.Size()
+		i -= size
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractRenewalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractRenewalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractRenewalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContractGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContractGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContractGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContractGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContractGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContractGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatencyMs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Availability != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Availability))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Prober) > 0 {
+		i -= len(m.Prober)
+		copy(dAtA[i:], m.Prober)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prober)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBondProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovTx(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovTx(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovTx(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovTx(uint64(m.SettlementDuration))
+	}
+	l = m.RateCard.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovTx(uint64(m.ContractType))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovTx(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovTx(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 1 + sovTx(uint64(m.QueriesPerMinute))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = m.DelegateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimContractIncome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgClaimContractIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgSetVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRemoveServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResponseReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.First.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Second.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitUnsignedNonceEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Receipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTopUpContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgTopUpContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractRenewal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetContractRenewalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenContractGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Authorization != 0 {
+		n += 1 + sovTx(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 1 + sovTx(uint64(m.QueriesPerMinute))
+	}
+	return n
+}
+
+func (m *MsgOpenContractGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgSetDelegateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.DelegateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDelegateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Availability != 0 {
+		n += 1 + sovTx(uint64(m.Availability))
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovTx(uint64(m.LatencyMs))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Prober)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateCard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimContractIncome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimContractIncome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimContractIncomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRemoveServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRemoveServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
+func (m *ResponseReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2713,17 +7753,55 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBondProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2733,29 +7811,31 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ResponseHash = append(m.ResponseHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResponseHash == nil {
+				m.ResponseHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2765,27 +7845,113 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSignature = append(m.ClientSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientSignature == nil {
+				m.ClientSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2813,13 +7979,46 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2829,23 +8028,22 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2870,7 +8068,7 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2893,10 +8091,10 @@ func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBondProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBondProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2920,7 +8118,7 @@ func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitUnsignedNonceEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2943,10 +8141,10 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitUnsignedNonceEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitUnsignedNonceEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2983,215 +8181,7 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
-			}
-			m.MetadataNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
-			}
-			m.MinContractDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
-			}
-			m.MaxContractDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3211,37 +8201,17 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3263,7 +8233,7 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitUnsignedNonceEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3286,10 +8256,10 @@ func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitUnsignedNonceEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitUnsignedNonceEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3313,7 +8283,7 @@ func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,10 +8306,10 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3376,9 +8346,9 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3388,29 +8358,30 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3420,59 +8391,115 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3500,51 +8527,13 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
-			}
-			m.ContractType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractType |= ContractType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3554,26 +8543,12 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -3607,49 +8582,11 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
-			}
-			m.Authorization = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.QueriesPerMinute = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3659,7 +8596,7 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3685,7 +8622,7 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3708,10 +8645,10 @@ func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3735,7 +8672,7 @@ func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractRenewal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3758,10 +8695,10 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractRenewal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3816,10 +8753,10 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3829,31 +8766,17 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
-			}
-			iNdEx = postIndex
+			m.AutoRenew = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3863,24 +8786,24 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3904,7 +8827,7 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractRenewalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3927,10 +8850,10 @@ func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractRenewalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractRenewalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3954,7 +8877,7 @@ func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
+func (m *MsgOpenContractGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3977,10 +8900,10 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimContractIncome: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOpenContractGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimContractIncome: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOpenContractGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4016,10 +8939,10 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			m.ContractId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4029,16 +8952,29 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4048,31 +8984,29 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4082,114 +9016,112 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgClaimContractIncomeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVersion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVersion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, types.Coin{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4217,13 +9149,15 @@ func (m *MsgSetVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
-			m.Version = 0
+			m.Authorization = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4233,7 +9167,26 @@ func (m *MsgSetVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4259,7 +9212,7 @@ func (m *MsgSetVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOpenContractGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4282,12 +9235,31 @@ func (m *MsgSetVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOpenContractGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOpenContractGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4309,7 +9281,7 @@ func (m *MsgSetVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDelegateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4332,10 +9304,10 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDelegateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDelegateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4372,9 +9344,9 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4384,48 +9356,16 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4435,55 +9375,24 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ServiceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4506,7 +9415,7 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDelegateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4529,10 +9438,10 @@ func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDelegateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDelegateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4556,7 +9465,7 @@ func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4579,10 +9488,10 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4618,10 +9527,10 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4631,14 +9540,27 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4666,13 +9588,13 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var stringLen uint64
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4682,29 +9604,16 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
 			}
-			var stringLen uint64
+			m.Availability = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4714,24 +9623,30 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Availability |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ServiceType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4753,7 +9668,7 @@ func (m *MsgUpdateService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4776,10 +9691,10 @@ func (m *MsgUpdateServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4803,7 +9718,7 @@ func (m *MsgUpdateServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProber) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4826,10 +9741,10 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProber: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProber: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4866,7 +9781,7 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prober", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4894,8 +9809,28 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Prober = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4917,7 +9852,7 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4940,10 +9875,10 @@ func (m *MsgRemoveServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: